@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	domservice "github.com/ramsesyok/oss-catalog/internal/domain/service"
+	infradb "github.com/ramsesyok/oss-catalog/internal/infra/db"
+	"github.com/ramsesyok/oss-catalog/internal/infra/migration"
+)
+
+// runCatalogImport は import-catalog サブコマンドを実行する。
+// CSV を OSS カタログに一括登録し、行ごとの結果を out に書き出す。
+//
+//	oss-catalog [-config path] import-catalog [-strict] [-user name] catalog.csv
+func runCatalogImport(dsn string, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("import-catalog", flag.ContinueOnError)
+	strict := fs.Bool("strict", false, "reject all rows if any row is invalid")
+	user := fs.String("user", "cli", "user name recorded in audit logs")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: import-catalog [-strict] [-user name] <catalog.csv>")
+	}
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	swagger, err := gen.GetSwagger()
+	if err != nil {
+		return err
+	}
+	dbConn, err := infradb.Open(dsn)
+	if err != nil {
+		return err
+	}
+	defer dbConn.Close()
+	if err := migration.Apply(dbConn.DB, dsn); err != nil {
+		return err
+	}
+
+	svc := newCatalogImportService(dbConn, catalogEnums(swagger))
+	report, err := svc.Import(context.Background(), f, domservice.CatalogImportOptions{Strict: *strict, User: *user})
+	if err != nil {
+		return err
+	}
+	writeCatalogImportReport(out, report)
+	if !report.Committed {
+		return errors.New("no rows were imported because of invalid rows (strict mode)")
+	}
+	return nil
+}
+
+// writeCatalogImportReport は取り込み結果を 1 行 1 件のテキストで書き出す。
+func writeCatalogImportReport(out io.Writer, r *domservice.CatalogImportReport) {
+	for _, row := range r.Rows {
+		target := row.Name
+		if row.Version != "" {
+			target += " " + row.Version
+		}
+		line := fmt.Sprintf("line %d\t%s\t%s", row.Line, row.Result, target)
+		if len(row.Errors) > 0 {
+			line += "\t" + strings.Join(row.Errors, "; ")
+		}
+		fmt.Fprintln(out, line)
+	}
+	fmt.Fprintf(out, "total=%d created=%d matched=%d rejected=%d committed=%t\n", r.Total, r.Created, r.Matched, r.Rejected, r.Committed)
+}
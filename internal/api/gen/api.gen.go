@@ -24,6 +24,72 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CatalogImportRowResult.
+const (
+	CatalogImportRowResultCREATED  CatalogImportRowResult = "CREATED"
+	CatalogImportRowResultMATCHED  CatalogImportRowResult = "MATCHED"
+	CatalogImportRowResultREJECTED CatalogImportRowResult = "REJECTED"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatBundle        ExportFormat = "bundle"
+	ExportFormatCsv           ExportFormat = "csv"
+	ExportFormatCyclonedxJson ExportFormat = "cyclonedx-json"
+	ExportFormatCyclonedxVex  ExportFormat = "cyclonedx-vex"
+	ExportFormatCyclonedxXml  ExportFormat = "cyclonedx-xml"
+	ExportFormatNotice        ExportFormat = "notice"
+	ExportFormatNoticeHtml    ExportFormat = "notice-html"
+	ExportFormatOpenvex       ExportFormat = "openvex"
+	ExportFormatSpdxJson      ExportFormat = "spdx-json"
+	ExportFormatTemplate      ExportFormat = "template"
+	ExportFormatXlsx          ExportFormat = "xlsx"
+)
+
+// Defines values for ExportJobStatus.
+const (
+	EXPIRED   ExportJobStatus = "EXPIRED"
+	FAILED    ExportJobStatus = "FAILED"
+	QUEUED    ExportJobStatus = "QUEUED"
+	RUNNING   ExportJobStatus = "RUNNING"
+	SUCCEEDED ExportJobStatus = "SUCCEEDED"
+)
+
+// Defines values for ExportTemplateEngine.
+const (
+	Html ExportTemplateEngine = "html"
+	Text ExportTemplateEngine = "text"
+)
+
+// Defines values for ImportDecision.
+const (
+	ImportDecisionACCEPTED ImportDecision = "ACCEPTED"
+	ImportDecisionPENDING  ImportDecision = "PENDING"
+	ImportDecisionREJECTED ImportDecision = "REJECTED"
+	ImportDecisionREMAPPED ImportDecision = "REMAPPED"
+)
+
+// Defines values for ImportProposal.
+const (
+	MATCH        ImportProposal = "MATCH"
+	NEWCOMPONENT ImportProposal = "NEW_COMPONENT"
+	NEWVERSION   ImportProposal = "NEW_VERSION"
+	SKIP         ImportProposal = "SKIP"
+)
+
+// Defines values for ImportResult.
+const (
+	CREATED ImportResult = "CREATED"
+	MATCHED ImportResult = "MATCHED"
+	SKIPPED ImportResult = "SKIPPED"
+)
+
+// Defines values for ImportSessionStatus.
+const (
+	ImportSessionStatusCOMMITTED ImportSessionStatus = "COMMITTED"
+	ImportSessionStatusOPEN      ImportSessionStatus = "OPEN"
+)
+
 // Defines values for Layer.
 const (
 	DB         Layer = "DB"
@@ -39,6 +105,35 @@ const (
 	TOOLTEST   Layer = "TOOL_TEST"
 )
 
+// Defines values for LicenseCategory.
+const (
+	NETWORKCOPYLEFT LicenseCategory = "NETWORK_COPYLEFT"
+	PERMISSIVE      LicenseCategory = "PERMISSIVE"
+	STRONGCOPYLEFT  LicenseCategory = "STRONG_COPYLEFT"
+	WEAKCOPYLEFT    LicenseCategory = "WEAK_COPYLEFT"
+)
+
+// Defines values for ObligationStatus.
+const (
+	ObligationStatusFULFILLED ObligationStatus = "FULFILLED"
+	ObligationStatusOPEN      ObligationStatus = "OPEN"
+	ObligationStatusWAIVED    ObligationStatus = "WAIVED"
+)
+
+// Defines values for PolicySeverity.
+const (
+	DENY   PolicySeverity = "DENY"
+	REVIEW PolicySeverity = "REVIEW"
+	WARN   PolicySeverity = "WARN"
+)
+
+// Defines values for ProjectStatus.
+const (
+	ACTIVE    ProjectStatus = "ACTIVE"
+	ARCHIVED  ProjectStatus = "ARCHIVED"
+	DELIVERED ProjectStatus = "DELIVERED"
+)
+
 // Defines values for ReviewStatus.
 const (
 	Draft    ReviewStatus = "draft"
@@ -79,15 +174,561 @@ const (
 	TESTONLY        UsageRole = "TEST_ONLY"
 )
 
-// Defines values for ExportProjectArtifactsParamsFormat.
+// Defines values for VulnerabilityAnalysisJustification.
+const (
+	ComponentNotPresent                         VulnerabilityAnalysisJustification = "component_not_present"
+	InlineMitigationsAlreadyExist               VulnerabilityAnalysisJustification = "inline_mitigations_already_exist"
+	VulnerableCodeCannotBeControlledByAdversary VulnerabilityAnalysisJustification = "vulnerable_code_cannot_be_controlled_by_adversary"
+	VulnerableCodeNotInExecutePath              VulnerabilityAnalysisJustification = "vulnerable_code_not_in_execute_path"
+	VulnerableCodeNotPresent                    VulnerabilityAnalysisJustification = "vulnerable_code_not_present"
+)
+
+// Defines values for VulnerabilityAnalysisResponse.
+const (
+	CanNotFix           VulnerabilityAnalysisResponse = "can_not_fix"
+	Rollback            VulnerabilityAnalysisResponse = "rollback"
+	Update              VulnerabilityAnalysisResponse = "update"
+	WillNotFix          VulnerabilityAnalysisResponse = "will_not_fix"
+	WorkaroundAvailable VulnerabilityAnalysisResponse = "workaround_available"
+)
+
+// Defines values for VulnerabilityAnalysisState.
+const (
+	Affected           VulnerabilityAnalysisState = "affected"
+	Fixed              VulnerabilityAnalysisState = "fixed"
+	NotAffected        VulnerabilityAnalysisState = "not_affected"
+	UnderInvestigation VulnerabilityAnalysisState = "under_investigation"
+)
+
+// Defines values for VulnerabilityMatchedBy.
+const (
+	CPE  VulnerabilityMatchedBy = "CPE"
+	PURL VulnerabilityMatchedBy = "PURL"
+)
+
+// Defines values for VulnerabilityReportFormat.
 const (
-	Csv      ExportProjectArtifactsParamsFormat = "csv"
-	SpdxJson ExportProjectArtifactsParamsFormat = "spdx-json"
+	VulnerabilityReportFormatCsv  VulnerabilityReportFormat = "csv"
+	VulnerabilityReportFormatJson VulnerabilityReportFormat = "json"
 )
 
+// Defines values for VulnerabilitySeverity.
+const (
+	CRITICAL VulnerabilitySeverity = "CRITICAL"
+	HIGH     VulnerabilitySeverity = "HIGH"
+	LOW      VulnerabilitySeverity = "LOW"
+	MEDIUM   VulnerabilitySeverity = "MEDIUM"
+	NONE     VulnerabilitySeverity = "NONE"
+)
+
+// CatalogImportReport OSS カタログ一括取り込み結果
+type CatalogImportReport struct {
+	// Committed 有効な行を登録したか (strict でエラーがあった場合は false)
+	Committed bool `json:"committed"`
+
+	// Created 新規登録した行数
+	Created int `json:"created"`
+
+	// Matched 既存と一致した行数
+	Matched int `json:"matched"`
+
+	// Rejected 登録しなかった行数
+	Rejected int                `json:"rejected"`
+	Rows     []CatalogImportRow `json:"rows"`
+
+	// Total 行数
+	Total int `json:"total"`
+}
+
+// CatalogImportRow CSV 1 行分の取り込み結果
+type CatalogImportRow struct {
+	// Errors 検証エラー
+	Errors []string `json:"errors"`
+
+	// Line CSV 上の行番号 (ヘッダが 1 行目)
+	Line int `json:"line"`
+
+	// Name コンポーネント名
+	Name string `json:"name"`
+
+	// OssId 対応するコンポーネント ID
+	OssId *openapi_types.UUID `json:"ossId"`
+
+	// OssVersionId 対応するバージョン ID
+	OssVersionId *openapi_types.UUID `json:"ossVersionId"`
+
+	// Result 一括取り込みの行単位の結果
+	Result CatalogImportRowResult `json:"result"`
+
+	// Version バージョン (コンポーネントのみの行は null)
+	Version *string `json:"version"`
+}
+
+// CatalogImportRowResult 一括取り込みの行単位の結果
+type CatalogImportRowResult string
+
+// ComplianceReport プロジェクトのライセンスポリシー評価結果
+type ComplianceReport struct {
+	// Compliant DENY の違反が無い場合 true (エクスポート可能)
+	Compliant   bool               `json:"compliant"`
+	EvaluatedAt time.Time          `json:"evaluatedAt"`
+	ProjectId   openapi_types.UUID `json:"projectId"`
+
+	// Scopes 評価対象としたスコープ
+	Scopes []ScopeStatus `json:"scopes"`
+
+	// Summary 重大度毎の違反件数
+	Summary struct {
+		Deny   int `json:"deny"`
+		Review int `json:"review"`
+		Warn   int `json:"warn"`
+	} `json:"summary"`
+	Violations []PolicyViolation `json:"violations"`
+}
+
+// CvssMetric CVSS 評価 1 件 (評価元・バージョン毎)
+type CvssMetric struct {
+	BaseScore    float64 `json:"baseScore"`
+	BaseSeverity string  `json:"baseSeverity"`
+
+	// Source 評価元 (例 nvd@nist.gov)
+	Source string `json:"source"`
+
+	// Type Primary / Secondary
+	Type   string `json:"type"`
+	Vector string `json:"vector"`
+
+	// Version CVSS バージョン (例 3.1, 2.0)
+	Version string `json:"version"`
+}
+
+// ExportFormat エクスポート形式
+type ExportFormat string
+
+// ExportJob 非同期エクスポートジョブ
+type ExportJob struct {
+	// CreatedAt 登録日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy 要求ユーザ
+	CreatedBy string `json:"createdBy"`
+
+	// Error 失敗理由
+	Error *string `json:"error"`
+
+	// ExpiresAt 生成物の保持期限
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	// FileName 生成ファイル名
+	FileName *string `json:"fileName"`
+
+	// FileSize 生成ファイルサイズ (byte)
+	FileSize *int64 `json:"fileSize"`
+
+	// FinishedAt 終了日時
+	FinishedAt *time.Time `json:"finishedAt"`
+
+	// Format エクスポート形式
+	Format ExportFormat `json:"format"`
+
+	// Id ジョブ ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Progress 進捗 (%)
+	Progress int `json:"progress"`
+
+	// ProjectId プロジェクト ID
+	ProjectId openapi_types.UUID `json:"projectId"`
+
+	// Scopes 出力対象スコープ
+	Scopes []ScopeStatus `json:"scopes"`
+
+	// StartedAt 開始日時
+	StartedAt *time.Time `json:"startedAt"`
+
+	// Status エクスポートジョブ状態
+	Status ExportJobStatus `json:"status"`
+}
+
+// ExportJobCreateRequest エクスポートジョブ登録リクエスト (format=template は非同期ジョブでは利用不可)
+type ExportJobCreateRequest struct {
+	// Format エクスポート形式
+	Format ExportFormat `json:"format"`
+
+	// Scopes 出力対象スコープ (未指定時は IN_SCOPE)
+	Scopes *[]ScopeStatus `json:"scopes,omitempty"`
+}
+
+// ExportJobStatus エクスポートジョブ状態
+type ExportJobStatus string
+
+// ExportTemplate ユーザ定義エクスポートテンプレート
+type ExportTemplate struct {
+	// Body テンプレート本文
+	Body string `json:"body"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Description 説明
+	Description *string `json:"description"`
+
+	// Engine テンプレートエンジン (text=text/template, html=html/template)
+	Engine ExportTemplateEngine `json:"engine"`
+
+	// FileExtension 出力ファイル拡張子
+	FileExtension string `json:"fileExtension"`
+
+	// Id テンプレート ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Name テンプレート名 (export の template パラメータで指定)
+	Name string `json:"name"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// UpdatedBy 最終更新者
+	UpdatedBy string `json:"updatedBy"`
+}
+
+// ExportTemplateCreateRequest エクスポートテンプレート登録リクエスト。
+// 登録時にサンプルデータで描画し、失敗した場合は 400 を返す。
+// テンプレートに渡されるデータは以下の通り (未設定の項目は空文字)。
+//   - .Project: Code, Name, Department, Manager, DeliveryDate (YYYY-MM-DD), Description
+//   - .GeneratedAt (日時), .GeneratedBy, .Scopes (文字列配列)
+//   - .Usages[]: UsageRole, ScopeStatus, DirectDependency, InclusionNote,
+//     Component (Name, NormalizedName, HomepageURL, RepositoryURL, Description, PrimaryLanguage, Layers),
+//     Version (Version, ReleaseDate, LicenseConcluded, LicenseExpressionRaw, Purl, HashSha256,
+//     Modified, ModificationDescription, SupplierType, ForkOriginURL, CopyrightText)
+//   - .Licenses[]: LicenseID, Text, HasText, Components[] (Name, Version, Homepage, Expression, Copyright)
+//
+// 利用可能な関数: join, upper, lower, date (Go レイアウト), csv (CSV フィールドのエスケープ)
+type ExportTemplateCreateRequest struct {
+	Body        string  `json:"body"`
+	Description *string `json:"description"`
+
+	// Engine テンプレートエンジン (text=text/template, html=html/template)
+	Engine ExportTemplateEngine `json:"engine"`
+
+	// FileExtension 未指定時は engine に応じて txt / html
+	FileExtension *string `json:"fileExtension,omitempty"`
+	Name          string  `json:"name"`
+}
+
+// ExportTemplateEngine テンプレートエンジン (text=text/template, html=html/template)
+type ExportTemplateEngine string
+
+// ExportTemplateUpdateRequest エクスポートテンプレート更新リクエスト (指定項目のみ更新)
+type ExportTemplateUpdateRequest struct {
+	Body        *string `json:"body,omitempty"`
+	Description *string `json:"description"`
+
+	// Engine テンプレートエンジン (text=text/template, html=html/template)
+	Engine        *ExportTemplateEngine `json:"engine,omitempty"`
+	FileExtension *string               `json:"fileExtension,omitempty"`
+	Name          *string               `json:"name,omitempty"`
+}
+
+// ImportDecision 取り込み項目のレビュー結果
+type ImportDecision string
+
+// ImportProposal dry-run 時の照合結果 (取り込み時の扱いの提案)
+type ImportProposal string
+
+// ImportReport SBOM 取り込み結果
+type ImportReport struct {
+	// Created 新規登録件数
+	Created int `json:"created"`
+
+	// Format 取り込んだ SBOM 形式
+	Format string             `json:"format"`
+	Items  []ImportReportItem `json:"items"`
+
+	// Matched 既存一致件数
+	Matched int `json:"matched"`
+
+	// ProjectId プロジェクト ID
+	ProjectId openapi_types.UUID `json:"projectId"`
+
+	// Skipped スキップ件数
+	Skipped int `json:"skipped"`
+}
+
+// ImportReportItem パッケージ単位の取り込み結果
+type ImportReportItem struct {
+	// Name パッケージ名
+	Name string `json:"name"`
+
+	// OssId 対応するコンポーネント ID
+	OssId *openapi_types.UUID `json:"ossId"`
+
+	// OssVersionId 対応するバージョン ID
+	OssVersionId *openapi_types.UUID `json:"ossVersionId"`
+
+	// Purl Package URL
+	Purl *string `json:"purl"`
+
+	// Reason 判定理由
+	Reason *string `json:"reason"`
+
+	// Ref SBOM 内の参照 ID (SPDXID / bom-ref)
+	Ref string `json:"ref"`
+
+	// Result パッケージ単位の取り込み結果
+	Result      ImportResult `json:"result"`
+	ScopeStatus *ScopeStatus `json:"scopeStatus"`
+
+	// UsageId 登録済み (または既存) の利用情報 ID
+	UsageId   *openapi_types.UUID `json:"usageId"`
+	UsageRole *UsageRole          `json:"usageRole"`
+
+	// Version バージョン
+	Version string `json:"version"`
+}
+
+// ImportResult パッケージ単位の取り込み結果
+type ImportResult string
+
+// ImportSession SBOM 取り込みの dry-run 結果 (レビュー後に確定する)
+type ImportSession struct {
+	CommittedAt *time.Time `json:"committedAt"`
+	CommittedBy *string    `json:"committedBy"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	CreatedBy   string     `json:"createdBy"`
+
+	// DocumentName SBOM 文書名
+	DocumentName *string `json:"documentName"`
+
+	// Format SBOM 形式
+	Format string `json:"format"`
+
+	// Id セッション ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Items 項目一覧 (一覧取得時は省略)
+	Items *[]ImportSessionItem `json:"items,omitempty"`
+
+	// ProjectId プロジェクト ID
+	ProjectId openapi_types.UUID `json:"projectId"`
+
+	// Status 取り込みセッションの状態
+	Status    ImportSessionStatus `json:"status"`
+	UsageRole *UsageRole          `json:"usageRole"`
+}
+
+// ImportSessionItem 取り込みセッションの項目 (SBOM のパッケージ 1 件)
+type ImportSessionItem struct {
+	// Decision 取り込み項目のレビュー結果
+	Decision ImportDecision `json:"decision"`
+
+	// DetectedUsageRole SBOM の形式から推定した利用形態
+	DetectedUsageRole *UsageRole `json:"detectedUsageRole"`
+	DirectDependency  bool       `json:"directDependency"`
+
+	// Id 項目 ID
+	Id openapi_types.UUID `json:"id"`
+
+	// InclusionNote 利用情報に記録する組み込み経緯 (取得元イメージなど)
+	InclusionNote *string `json:"inclusionNote"`
+
+	// Layers コンポーネントを新規登録する際に設定する技術レイヤ分類
+	Layers           *[]Layer `json:"layers,omitempty"`
+	LicenseConcluded *string  `json:"licenseConcluded"`
+	LicenseDeclared  *string  `json:"licenseDeclared"`
+
+	// Name パッケージ名
+	Name string `json:"name"`
+
+	// OssId 照合 (または付け替え) 先のコンポーネント ID
+	OssId *openapi_types.UUID `json:"ossId"`
+
+	// OssVersionId 照合 (または付け替え) 先のバージョン ID
+	OssVersionId *openapi_types.UUID `json:"ossVersionId"`
+
+	// Proposal dry-run 時の照合結果 (取り込み時の扱いの提案)
+	Proposal ImportProposal `json:"proposal"`
+
+	// Purl Package URL
+	Purl *string `json:"purl"`
+
+	// Reason 判定理由
+	Reason *string `json:"reason"`
+
+	// Ref SBOM 内の参照 ID (SPDXID / bom-ref)
+	Ref    string        `json:"ref"`
+	Result *ImportResult `json:"result"`
+
+	// Seq 文書内の順序
+	Seq int `json:"seq"`
+
+	// UsageId 確定時に登録 (または既存) の利用情報 ID
+	UsageId *openapi_types.UUID `json:"usageId"`
+
+	// UsageRole 確定時に用いる利用形態 (レビューでの指定が無ければ推定値)
+	UsageRole *UsageRole `json:"usageRole"`
+
+	// Version バージョン
+	Version string `json:"version"`
+}
+
+// ImportSessionItemUpdateRequest 取り込み項目のレビュー結果の設定
+type ImportSessionItemUpdateRequest struct {
+	// Decision 取り込み項目のレビュー結果
+	Decision ImportDecision `json:"decision"`
+
+	// OssId REMAPPED の付け替え先コンポーネント (同一バージョンが無ければ draft で追加)
+	OssId *openapi_types.UUID `json:"ossId,omitempty"`
+
+	// OssVersionId REMAPPED の付け替え先バージョン
+	OssVersionId *openapi_types.UUID `json:"ossVersionId,omitempty"`
+
+	// ResetUsageRole true の場合は利用形態の上書きを解除し、推定した利用形態に戻す (usageRole とは同時に指定できない)
+	ResetUsageRole *bool `json:"resetUsageRole,omitempty"`
+
+	// UsageRole 利用形態の上書き (未指定・null の場合は現在の指定を維持)
+	UsageRole *UsageRole `json:"usageRole"`
+}
+
+// ImportSessionStatus 取り込みセッションの状態
+type ImportSessionStatus string
+
 // Layer OSS 技術レイヤ分類（OS=OS, LIB=ライブラリ 等）
 type Layer string
 
+// License ライセンスカタログの項目。SPDX ライセンスリストの項目は起動時に登録し、
+// 管理者は LicenseRef- で始まる独自ライセンスを登録できる。
+// NOTICE 生成ではここに登録した本文を優先して用いる。
+// 同梱の本文は主要な permissive ライセンス (MIT, BSD, Apache-2.0 など)・MPL-2.0・GPL / LGPL に限られる。
+// AGPL・EPL・CDDL などの本文は同梱していないため、hasText=false で一覧し、管理者が PATCH /licenses/{licenseId} で登録すること。
+type License struct {
+	// Category 分類 (未分類の場合 null)
+	Category *LicenseCategory `json:"category"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Custom 管理者が登録した独自ライセンス
+	Custom bool `json:"custom"`
+
+	// FsfLibre FSF が自由ソフトウェアライセンスと認めたもの
+	FsfLibre bool `json:"fsfLibre"`
+
+	// HasText 本文が登録されている (false の場合、NOTICE には本文の代わりに SPDX ライセンスリストの URL を出力する)
+	HasText bool `json:"hasText"`
+
+	// Id SPDX ライセンス ID または LicenseRef-xxx
+	Id string `json:"id"`
+
+	// Name ライセンス名
+	Name string `json:"name"`
+
+	// OsiApproved OSI 承認ライセンス
+	OsiApproved bool `json:"osiApproved"`
+
+	// Text ライセンス全文 (一覧では省略)
+	Text *string `json:"text"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// UpdatedBy 最終更新者
+	UpdatedBy *string `json:"updatedBy"`
+}
+
+// LicenseCategory ライセンスの分類 (コピーレフトの強さ)
+type LicenseCategory string
+
+// LicenseCreateRequest 独自ライセンス登録リクエスト
+type LicenseCreateRequest struct {
+	Category *LicenseCategory `json:"category"`
+	FsfLibre *bool            `json:"fsfLibre,omitempty"`
+
+	// Id LicenseRef- で始まるライセンス ID
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	OsiApproved *bool   `json:"osiApproved,omitempty"`
+	Text        *string `json:"text"`
+}
+
+// LicensePolicyRule 管理者が定義するライセンスポリシーのルール。
+// licenses (ID パターン) または categories に該当するライセンスを、
+// usageRoles・scopeStatuses の条件 (空の場合は全て) に該当する利用で違反とする。
+// projectStatuses を指定した場合はその状態のプロジェクトのみを評価の対象とする (例: DELIVERED のプロジェクトでは AGPL-3.0 を禁止)。
+type LicensePolicyRule struct {
+	// Categories 対象とするライセンス分類
+	Categories  []LicenseCategory `json:"categories"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	Description *string           `json:"description"`
+
+	// Enabled 無効のルールは評価しない
+	Enabled bool               `json:"enabled"`
+	Id      openapi_types.UUID `json:"id"`
+
+	// Licenses ライセンス ID パターン (大文字小文字を区別しない。末尾 * は前方一致。例 AGPL-3.0*, LicenseRef-*)
+	Licenses []string `json:"licenses"`
+
+	// Name ルール名 (一意)
+	Name string `json:"name"`
+
+	// ProjectStatuses 対象とするプロジェクトの状態 (空の場合は全て)
+	ProjectStatuses []ProjectStatus `json:"projectStatuses"`
+
+	// ScopeStatuses 対象とするスコープ (空の場合は全て。納品物のみを対象とする場合は IN_SCOPE)
+	ScopeStatuses []ScopeStatus `json:"scopeStatuses"`
+
+	// Severity ライセンスポリシー違反の重大度
+	Severity  PolicySeverity `json:"severity"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+
+	// UpdatedBy 最終更新者
+	UpdatedBy string `json:"updatedBy"`
+
+	// UsageRoles 対象とする利用形態 (空の場合は全て)
+	UsageRoles []UsageRole `json:"usageRoles"`
+}
+
+// LicensePolicyRuleCreateRequest ライセンスポリシールール登録リクエスト。licenses または categories のいずれかを指定する
+type LicensePolicyRuleCreateRequest struct {
+	Categories  *[]LicenseCategory `json:"categories,omitempty"`
+	Description *string            `json:"description"`
+
+	// Enabled 未指定時は true
+	Enabled         *bool            `json:"enabled,omitempty"`
+	Licenses        *[]string        `json:"licenses,omitempty"`
+	Name            string           `json:"name"`
+	ProjectStatuses *[]ProjectStatus `json:"projectStatuses,omitempty"`
+	ScopeStatuses   *[]ScopeStatus   `json:"scopeStatuses,omitempty"`
+
+	// Severity ライセンスポリシー違反の重大度
+	Severity   PolicySeverity `json:"severity"`
+	UsageRoles *[]UsageRole   `json:"usageRoles,omitempty"`
+}
+
+// LicensePolicyRuleUpdateRequest ライセンスポリシールール更新リクエスト (指定項目のみ更新)
+type LicensePolicyRuleUpdateRequest struct {
+	Categories      *[]LicenseCategory `json:"categories,omitempty"`
+	Description     *string            `json:"description"`
+	Enabled         *bool              `json:"enabled,omitempty"`
+	Licenses        *[]string          `json:"licenses,omitempty"`
+	Name            *string            `json:"name,omitempty"`
+	ProjectStatuses *[]ProjectStatus   `json:"projectStatuses,omitempty"`
+	ScopeStatuses   *[]ScopeStatus     `json:"scopeStatuses,omitempty"`
+
+	// Severity ライセンスポリシー違反の重大度
+	Severity   *PolicySeverity `json:"severity,omitempty"`
+	UsageRoles *[]UsageRole    `json:"usageRoles,omitempty"`
+}
+
+// LicenseUpdateRequest ライセンス更新リクエスト (指定項目のみ更新)。SPDX ライセンスリストの項目も本文・分類を補える
+type LicenseUpdateRequest struct {
+	Category    *LicenseCategory `json:"category"`
+	FsfLibre    *bool            `json:"fsfLibre,omitempty"`
+	Name        *string          `json:"name,omitempty"`
+	OsiApproved *bool            `json:"osiApproved,omitempty"`
+	Text        *string          `json:"text"`
+}
+
 // LoginResponse ログイン成功時のレスポンス
 type LoginResponse struct {
 	// AccessToken JWT アクセストークン。Authorization: Bearer にセットする
@@ -97,6 +738,55 @@ type LoginResponse struct {
 	ExpiresIn int `json:"expiresIn"`
 }
 
+// NvdImportReport NVD フィード 1 ファイル分の取り込み結果
+type NvdImportReport struct {
+	// Created 新規登録した件数
+	Created int `json:"created"`
+
+	// File フィードのファイル名
+	File string `json:"file"`
+
+	// Total フィード中の脆弱性の件数
+	Total int `json:"total"`
+
+	// Unchanged 最終更新日時が同じため置き換えなかった件数
+	Unchanged int `json:"unchanged"`
+
+	// Updated 最終更新日時が変わったため置き換えた件数
+	Updated int `json:"updated"`
+}
+
+// ObligationReport プロジェクトの義務一覧と履行状況
+type ObligationReport struct {
+	Items     []ProjectObligation `json:"items"`
+	ProjectId openapi_types.UUID  `json:"projectId"`
+
+	// ReadyForDelivery 未対応 (OPEN) の義務が無く、現在の利用から導出される義務がすべて登録済みの場合 true (未同期の場合は sync が必要)
+	ReadyForDelivery bool `json:"readyForDelivery"`
+
+	// Summary 状態毎の件数 (status による絞り込みに関わらず全件)
+	Summary struct {
+		Fulfilled int `json:"fulfilled"`
+		Open      int `json:"open"`
+
+		// Unsynced 現在の利用から導出されるが未登録の義務の件数 (sync で登録される)
+		Unsynced int `json:"unsynced"`
+		Waived   int `json:"waived"`
+	} `json:"summary"`
+}
+
+// ObligationStatus 義務の履行状況
+type ObligationStatus string
+
+// ObligationSyncResult 義務の導出結果
+type ObligationSyncResult struct {
+	// Added 新たに登録した義務の件数
+	Added int `json:"added"`
+
+	// Removed 導出されなくなり削除した未対応の義務の件数
+	Removed int `json:"removed"`
+}
+
 // OssComponent OSS の論理的名称（バージョン共通情報）
 type OssComponent struct {
 	// CreatedAt 作成日時
@@ -198,6 +888,9 @@ type OssComponentUpdateRequest struct {
 
 // OssVersion 個別バージョン情報
 type OssVersion struct {
+	// CopyrightText 著作権表示 (NOTICE 生成に利用)
+	CopyrightText *string `json:"copyrightText"`
+
 	// CpeList CPE 文字列配列（脆弱性紐付け用）
 	CpeList *[]string `json:"cpeList,omitempty"`
 
@@ -210,13 +903,16 @@ type OssVersion struct {
 	// HashSha256 配布アーカイブ等の SHA-256 ハッシュ
 	HashSha256 *string `json:"hashSha256"`
 
+	// HashSha512 配布アーカイブ等の SHA-512 ハッシュ (npm の integrity など)
+	HashSha512 *string `json:"hashSha512"`
+
 	// Id バージョン ID
 	Id openapi_types.UUID `json:"id"`
 
 	// LastReviewedAt 最終レビュー日時
 	LastReviewedAt *time.Time `json:"lastReviewedAt"`
 
-	// LicenseConcluded 社内審査確定ライセンス式
+	// LicenseConcluded 社内審査確定ライセンス式 (含まれる各ライセンスは GET /licenses/{licenseId} で参照できる)
 	LicenseConcluded *string `json:"licenseConcluded"`
 
 	// LicenseExpressionRaw SPDX 互換ライセンス式（上流取得時点）
@@ -255,16 +951,20 @@ type OssVersion struct {
 
 // OssVersionCreateRequest バージョン作成リクエスト
 type OssVersionCreateRequest struct {
+	// CopyrightText 著作権表示 (NOTICE 生成に利用)
+	CopyrightText *string `json:"copyrightText"`
+
 	// CpeList CPE 配列
 	CpeList *[]string `json:"cpeList,omitempty"`
 
 	// ForkOriginUrl フォーク元 URL
 	ForkOriginUrl *string `json:"forkOriginUrl"`
+	HashSha256    *string `json:"hashSha256"`
 
-	// HashSha256 アーカイブ SHA-256
-	HashSha256 *string `json:"hashSha256"`
+	// HashSha512 アーカイブ SHA-256
+	HashSha512 *string `json:"hashSha512"`
 
-	// LicenseExpressionRaw 生ライセンス式
+	// LicenseExpressionRaw 生ライセンス式 (SPDX ライセンス式として検証し、正規化した表記で保存)
 	LicenseExpressionRaw *string `json:"licenseExpressionRaw"`
 
 	// ModificationDescription 改変概要
@@ -288,19 +988,23 @@ type OssVersionCreateRequest struct {
 
 // OssVersionUpdateRequest バージョン更新リクエスト（部分）
 type OssVersionUpdateRequest struct {
+	// CopyrightText 著作権表示 (NOTICE 生成に利用)
+	CopyrightText *string `json:"copyrightText"`
+
 	// CpeList CPE 配列
 	CpeList *[]string `json:"cpeList,omitempty"`
 
 	// ForkOriginUrl フォーク元 URL
 	ForkOriginUrl *string `json:"forkOriginUrl"`
+	HashSha256    *string `json:"hashSha256"`
 
-	// HashSha256 SHA-256 ハッシュ
-	HashSha256 *string `json:"hashSha256"`
+	// HashSha512 SHA-256 ハッシュ
+	HashSha512 *string `json:"hashSha512"`
 
-	// LicenseConcluded 確定ライセンス式
+	// LicenseConcluded 確定ライセンス式 (SPDX ライセンス式として検証し、正規化した表記で保存)。LicenseRef- で始まる独自ライセンスはライセンスカタログに登録済みであること
 	LicenseConcluded *string `json:"licenseConcluded"`
 
-	// LicenseExpressionRaw 生ライセンス式
+	// LicenseExpressionRaw 生ライセンス式 (SPDX ライセンス式として検証し、正規化した表記で保存)
 	LicenseExpressionRaw *string `json:"licenseExpressionRaw"`
 
 	// ModificationDescription 改変概要
@@ -325,13 +1029,49 @@ type OssVersionUpdateRequest struct {
 	SupplierType *SupplierType `json:"supplierType,omitempty"`
 }
 
-// PagedResultOssComponent OSSコンポーネントページング結果
-type PagedResultOssComponent struct {
-	// Items 結果アイテム配列
-	Items *[]OssComponent `json:"items,omitempty"`
+// OsvImportReport OSV データセット 1 ファイル分の取り込み結果
+type OsvImportReport struct {
+	// Created 新規登録した件数
+	Created int `json:"created"`
 
-	// Page 現在ページ (1 始まり)
-	Page *int `json:"page,omitempty"`
+	// File データセットのファイル名
+	File string `json:"file"`
+
+	// Skipped NVD から登録済みの ID と重複したため取り込まなかった件数
+	Skipped int `json:"skipped"`
+
+	// Total データセット中の脆弱性の件数
+	Total int `json:"total"`
+
+	// Unchanged 最終更新日時が同じため置き換えなかった件数
+	Unchanged int `json:"unchanged"`
+
+	// Updated 最終更新日時が変わったため置き換えた件数
+	Updated int `json:"updated"`
+}
+
+// PagedResultLicense ライセンス一覧ページング結果
+type PagedResultLicense struct {
+	// Items 結果アイテム配列 (text は含まない)
+	Items *[]License `json:"items,omitempty"`
+
+	// Page 現在ページ
+	Page *int `json:"page,omitempty"`
+
+	// Size ページサイズ
+	Size *int `json:"size,omitempty"`
+
+	// Total 総件数
+	Total *int `json:"total,omitempty"`
+}
+
+// PagedResultOssComponent OSSコンポーネントページング結果
+type PagedResultOssComponent struct {
+	// Items 結果アイテム配列
+	Items *[]OssComponent `json:"items,omitempty"`
+
+	// Page 現在ページ (1 始まり)
+	Page *int `json:"page,omitempty"`
 
 	// Size ページサイズ
 	Size *int `json:"size,omitempty"`
@@ -393,6 +1133,37 @@ type PagedResultUser struct {
 	Total *int    `json:"total,omitempty"`
 }
 
+// PolicySeverity ライセンスポリシー違反の重大度
+type PolicySeverity string
+
+// PolicyViolation 利用 1 件のライセンスポリシー違反。
+// ライセンス式が未設定・NOASSERTION・不正な場合は ruleId を持たない REVIEW の違反とする。
+type PolicyViolation struct {
+	ComponentName string `json:"componentName"`
+
+	// License 違反となったライセンス ID
+	License *string `json:"license"`
+
+	// LicenseExpression 評価したライセンス式 (確定ライセンス、未設定の場合は生のライセンス式)
+	LicenseExpression string              `json:"licenseExpression"`
+	Message           string              `json:"message"`
+	OssId             openapi_types.UUID  `json:"ossId"`
+	OssVersionId      openapi_types.UUID  `json:"ossVersionId"`
+	RuleId            *openapi_types.UUID `json:"ruleId"`
+	RuleName          *string             `json:"ruleName"`
+
+	// ScopeStatus 納品対象スコープ判定状態（IN_SCOPE=含む, OUT_SCOPE=除外, REVIEW_NEEDED=要判定）
+	ScopeStatus ScopeStatus `json:"scopeStatus"`
+
+	// Severity ライセンスポリシー違反の重大度
+	Severity PolicySeverity     `json:"severity"`
+	UsageId  openapi_types.UUID `json:"usageId"`
+
+	// UsageRole プロジェクト内での利用形態（配布対象か／工程限定か）
+	UsageRole UsageRole `json:"usageRole"`
+	Version   string    `json:"version"`
+}
+
 // Problem RFC 9457 / RFC 7807 型エラー応答ボディ
 type Problem struct {
 	// Code アプリケーション独自エラーコード
@@ -402,13 +1173,7 @@ type Problem struct {
 	Detail *string `json:"detail"`
 
 	// Errors フィールド単位バリデーションエラー配列
-	Errors *[]struct {
-		// Field エラーが発生したフィールド名（JSON Pointer など）
-		Field *string `json:"field,omitempty"`
-
-		// Message フィールドに対するエラーメッセージ
-		Message *string `json:"message,omitempty"`
-	} `json:"errors,omitempty"`
+	Errors *[]ProblemFieldError `json:"errors,omitempty"`
 
 	// Instance エラーが発生した具体的インスタンス URI（トレースID等）
 	Instance *string `json:"instance"`
@@ -423,6 +1188,15 @@ type Problem struct {
 	Type *string `json:"type"`
 }
 
+// ProblemFieldError フィールド単位のバリデーションエラー
+type ProblemFieldError struct {
+	// Field エラーが発生したフィールド名（JSON Pointer など）
+	Field *string `json:"field,omitempty"`
+
+	// Message フィールドに対するエラーメッセージ
+	Message *string `json:"message,omitempty"`
+}
+
 // Project プロジェクト（納品単位）
 type Project struct {
 	// CreatedAt 作成日時
@@ -452,6 +1226,9 @@ type Project struct {
 	// ProjectCode 社内識別コード（ユニーク）
 	ProjectCode string `json:"projectCode"`
 
+	// Status プロジェクトの状態（ACTIVE=開発中, DELIVERED=納品済み, ARCHIVED=終了）
+	Status ProjectStatus `json:"status"`
+
 	// UpdatedAt 更新日時
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -475,8 +1252,58 @@ type ProjectCreateRequest struct {
 
 	// ProjectCode ユニークなプロジェクトコード
 	ProjectCode string `json:"projectCode"`
+
+	// Status 状態 (作成時の省略値は ACTIVE)
+	Status *ProjectStatus `json:"status,omitempty"`
+}
+
+// ProjectObligation プロジェクトの利用 1 件がライセンスから負う義務。
+// 義務はライセンスと利用形態の組み合わせから導出する (ルール表は README を参照)。
+type ProjectObligation struct {
+	ClosedAt *time.Time `json:"closedAt"`
+
+	// ClosedBy FULFILLED / WAIVED にしたユーザ
+	ClosedBy      *string   `json:"closedBy"`
+	ComponentName string    `json:"componentName"`
+	CreatedAt     time.Time `json:"createdAt"`
+
+	// Description 義務の内容
+	Description string `json:"description"`
+
+	// EvidenceNote 履行の証跡または免除の理由
+	EvidenceNote *string            `json:"evidenceNote"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// License 義務の根拠となったライセンス ID
+	License string `json:"license"`
+
+	// Obligation 義務の種類 (LICENSE_TEXT, NOTICE_FILE, SOURCE_OFFER, RELINKABLE_OBJECTS, NETWORK_SOURCE_OFFER, STATE_CHANGES)
+	Obligation string `json:"obligation"`
+
+	// ScopeStatus 納品対象スコープ判定状態（IN_SCOPE=含む, OUT_SCOPE=除外, REVIEW_NEEDED=要判定）
+	ScopeStatus ScopeStatus `json:"scopeStatus"`
+
+	// Status 義務の履行状況
+	Status    ObligationStatus   `json:"status"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+	UsageId   openapi_types.UUID `json:"usageId"`
+
+	// UsageRole プロジェクト内での利用形態（配布対象か／工程限定か）
+	UsageRole UsageRole `json:"usageRole"`
+	Version   string    `json:"version"`
 }
 
+// ProjectObligationUpdateRequest 義務の状態更新リクエスト。WAIVED の場合は evidenceNote (理由) が必須
+type ProjectObligationUpdateRequest struct {
+	EvidenceNote *string `json:"evidenceNote"`
+
+	// Status 義務の履行状況
+	Status ObligationStatus `json:"status"`
+}
+
+// ProjectStatus プロジェクトの状態（ACTIVE=開発中, DELIVERED=納品済み, ARCHIVED=終了）
+type ProjectStatus string
+
 // ProjectUpdateRequest プロジェクト更新リクエスト
 type ProjectUpdateRequest struct {
 	// DeliveryDate 納品日
@@ -493,6 +1320,9 @@ type ProjectUpdateRequest struct {
 
 	// Name 名称
 	Name *string `json:"name,omitempty"`
+
+	// Status 状態 (作成時の省略値は ACTIVE)
+	Status *ProjectStatus `json:"status,omitempty"`
 }
 
 // ProjectUsage プロジェクトにおける特定 OSS バージョンの利用レコード
@@ -567,6 +1397,38 @@ type ProjectUsageUpdateRequest struct {
 	UsageRole *UsageRole `json:"usageRole,omitempty"`
 }
 
+// ProjectVulnerability プロジェクトの利用 1 件に該当した脆弱性
+type ProjectVulnerability struct {
+	Aliases []string `json:"aliases"`
+
+	// Analysis 記録済みの VEX 分析 (未分析の場合は null)
+	Analysis      *VulnerabilityAnalysis `json:"analysis"`
+	ComponentName string                 `json:"componentName"`
+	FixedVersions []string               `json:"fixedVersions"`
+	Matches       []VulnerabilityMatch   `json:"matches"`
+	OssId         openapi_types.UUID     `json:"ossId"`
+	OssVersionId  openapi_types.UUID     `json:"ossVersionId"`
+
+	// ScopeStatus 納品対象スコープ判定状態（IN_SCOPE=含む, OUT_SCOPE=除外, REVIEW_NEEDED=要判定）
+	ScopeStatus ScopeStatus        `json:"scopeStatus"`
+	UsageId     openapi_types.UUID `json:"usageId"`
+
+	// UsageRole プロジェクト内での利用形態（配布対象か／工程限定か）
+	UsageRole UsageRole `json:"usageRole"`
+	Version   string    `json:"version"`
+
+	// Vulnerability 脆弱性データベースから取り込んだ脆弱性。severity / cvssScore は代表の評価 (新しい CVSS バージョンの NVD 評価を優先)。
+	// OSV の脆弱性は CVSS v3 のベクトルから算出した評価、無い場合は GitHub Advisory の深刻度を用いる。
+	// cvss と aliases は詳細取得時のみ設定する。
+	Vulnerability Vulnerability `json:"vulnerability"`
+}
+
+// ProjectVulnerabilityReport プロジェクトの利用に該当する脆弱性の一覧 (利用のコンポーネント名・バージョン順、利用毎に CVSS スコアの高い順)
+type ProjectVulnerabilityReport struct {
+	Items     []ProjectVulnerability `json:"items"`
+	ProjectId openapi_types.UUID     `json:"projectId"`
+}
+
 // ReviewStatus バージョンレビュー状態（draft=未承認, verified=確認済）
 type ReviewStatus string
 
@@ -711,6 +1573,147 @@ type UserUpdateRequest struct {
 	Roles *[]Role `json:"roles,omitempty"`
 }
 
+// VersionVulnerability OSS バージョンに該当した脆弱性と、その根拠。別名で結び付く脆弱性 (CVE と GHSA など) は 1 件にまとめ、
+// vulnerability には NVD のものを優先して用いる。
+type VersionVulnerability struct {
+	// Aliases vulnerability 以外の同じ脆弱性を指す ID
+	Aliases []string `json:"aliases"`
+
+	// FixedVersions 該当した範囲の修正バージョン (OSV の fixed と CPE 条件の versionEndExcluding)。空の場合は修正版が無い
+	FixedVersions []string             `json:"fixedVersions"`
+	Matches       []VulnerabilityMatch `json:"matches"`
+
+	// Vulnerability 脆弱性データベースから取り込んだ脆弱性。severity / cvssScore は代表の評価 (新しい CVSS バージョンの NVD 評価を優先)。
+	// OSV の脆弱性は CVSS v3 のベクトルから算出した評価、無い場合は GitHub Advisory の深刻度を用いる。
+	// cvss と aliases は詳細取得時のみ設定する。
+	Vulnerability Vulnerability `json:"vulnerability"`
+}
+
+// VersionVulnerabilityList OSS バージョンに該当する脆弱性の一覧 (CVSS スコアの高い順)
+type VersionVulnerabilityList struct {
+	Items        []VersionVulnerability `json:"items"`
+	OssVersionId openapi_types.UUID     `json:"ossVersionId"`
+}
+
+// Vulnerability 脆弱性データベースから取り込んだ脆弱性。severity / cvssScore は代表の評価 (新しい CVSS バージョンの NVD 評価を優先)。
+// OSV の脆弱性は CVSS v3 のベクトルから算出した評価、無い場合は GitHub Advisory の深刻度を用いる。
+// cvss と aliases は詳細取得時のみ設定する。
+type Vulnerability struct {
+	// Aliases 同じ脆弱性を指す別の ID (この脆弱性の aliases と、この脆弱性を aliases に持つ脆弱性)
+	Aliases     *[]string     `json:"aliases,omitempty"`
+	Cvss        *[]CvssMetric `json:"cvss,omitempty"`
+	CvssScore   *float64      `json:"cvssScore"`
+	CvssVector  *string       `json:"cvssVector"`
+	CvssVersion *string       `json:"cvssVersion"`
+	Description *string       `json:"description"`
+
+	// Id 脆弱性 ID (例 CVE-2021-44228, GHSA-jfh8-c2jp-5v3q)
+	Id             string     `json:"id"`
+	LastModifiedAt *time.Time `json:"lastModifiedAt"`
+	PublishedAt    *time.Time `json:"publishedAt"`
+
+	// References 参考情報の URL
+	References []string               `json:"references"`
+	Severity   *VulnerabilitySeverity `json:"severity"`
+
+	// Source 取り込み元 (NVD / OSV)
+	Source string `json:"source"`
+
+	// Status 取り込み元での状態 (例 Analyzed, Modified, Rejected。OSV は取り下げ時のみ Withdrawn)
+	Status *string `json:"status"`
+
+	// Weaknesses CWE ID
+	Weaknesses []string `json:"weaknesses"`
+}
+
+// VulnerabilityAnalysis プロジェクトの利用 1 件と脆弱性 1 件の組に対する VEX 分析
+type VulnerabilityAnalysis struct {
+	CreatedAt     time.Time                           `json:"createdAt"`
+	Id            openapi_types.UUID                  `json:"id"`
+	Justification *VulnerabilityAnalysisJustification `json:"justification"`
+
+	// Note 分析の補足 (影響しない理由・対応内容など)
+	Note      *string                        `json:"note"`
+	ProjectId openapi_types.UUID             `json:"projectId"`
+	Response  *VulnerabilityAnalysisResponse `json:"response"`
+
+	// State VEX 分析の状態 (OpenVEX の status)
+	State     VulnerabilityAnalysisState `json:"state"`
+	UpdatedAt time.Time                  `json:"updatedAt"`
+	UpdatedBy string                     `json:"updatedBy"`
+	UsageId   openapi_types.UUID         `json:"usageId"`
+
+	// VulnerabilityId 分析を記録した脆弱性 ID
+	VulnerabilityId string `json:"vulnerabilityId"`
+}
+
+// VulnerabilityAnalysisJustification not_affected の根拠 (OpenVEX の justification)
+type VulnerabilityAnalysisJustification string
+
+// VulnerabilityAnalysisRequest VEX 分析の記録リクエスト。not_affected の場合は justification が必須で、他の状態では指定できない
+type VulnerabilityAnalysisRequest struct {
+	// Justification not_affected の根拠 (OpenVEX の justification)
+	Justification *VulnerabilityAnalysisJustification `json:"justification,omitempty"`
+	Note          *string                             `json:"note,omitempty"`
+
+	// Response 対応方針 (CycloneDX の analysis.response)
+	Response *VulnerabilityAnalysisResponse `json:"response,omitempty"`
+
+	// State VEX 分析の状態 (OpenVEX の status)
+	State VulnerabilityAnalysisState `json:"state"`
+}
+
+// VulnerabilityAnalysisResponse 対応方針 (CycloneDX の analysis.response)
+type VulnerabilityAnalysisResponse string
+
+// VulnerabilityAnalysisState VEX 分析の状態 (OpenVEX の status)
+type VulnerabilityAnalysisState string
+
+// VulnerabilityMatch 脆弱性が該当すると判定した根拠。matchedBy が CPE の場合は criteria / version* を、
+// PURL の場合は ecosystem 以降の項目を設定する。
+type VulnerabilityMatch struct {
+	// Criteria 該当した脆弱性側の条件 (CPE)
+	Criteria *string `json:"criteria"`
+
+	// Ecosystem OSV のエコシステム (例 npm, Maven, Debian:12)
+	Ecosystem *string `json:"ecosystem"`
+
+	// Fixed 修正されたバージョン
+	Fixed *string `json:"fixed"`
+
+	// Identifier 該当したバージョン側の識別子 (CPE または purl)
+	Identifier string `json:"identifier"`
+
+	// Introduced 影響を受ける最初のバージョン (無い場合は下限無し)
+	Introduced *string `json:"introduced"`
+
+	// LastAffected 影響を受ける最後のバージョン
+	LastAffected *string `json:"lastAffected"`
+
+	// MatchedBy 脆弱性の該当判定の方法
+	MatchedBy   VulnerabilityMatchedBy `json:"matchedBy"`
+	PackageName *string                `json:"packageName"`
+
+	// RangeType SEMVER / ECOSYSTEM / VERSIONS (列挙されたバージョン)
+	RangeType             *string `json:"rangeType"`
+	VersionEndExcluding   *string `json:"versionEndExcluding"`
+	VersionEndIncluding   *string `json:"versionEndIncluding"`
+	VersionStartExcluding *string `json:"versionStartExcluding"`
+	VersionStartIncluding *string `json:"versionStartIncluding"`
+
+	// VulnerabilityId 該当した脆弱性の ID (別名でまとめた場合は代表と異なる)
+	VulnerabilityId string `json:"vulnerabilityId"`
+}
+
+// VulnerabilityMatchedBy 脆弱性の該当判定の方法
+type VulnerabilityMatchedBy string
+
+// VulnerabilityReportFormat プロジェクトの脆弱性一覧の出力形式 (未指定時は json)
+type VulnerabilityReportFormat string
+
+// VulnerabilitySeverity CVSS の深刻度 (baseSeverity)
+type VulnerabilitySeverity string
+
 // PageParam defines model for PageParam.
 type PageParam = int
 
@@ -726,6 +1729,9 @@ type BadRequest = Problem
 // Forbidden RFC 9457 / RFC 7807 型エラー応答ボディ
 type Forbidden = Problem
 
+// LicensePolicyViolation RFC 9457 / RFC 7807 型エラー応答ボディ
+type LicensePolicyViolation = Problem
+
 // NotFound RFC 9457 / RFC 7807 型エラー応答ボディ
 type NotFound = Problem
 
@@ -749,6 +1755,37 @@ type LoginJSONBody struct {
 	Username string `json:"username"`
 }
 
+// ImportCatalogMultipartBody defines parameters for ImportCatalog.
+type ImportCatalogMultipartBody struct {
+	// File カタログ CSV (UTF-8)
+	File openapi_types.File `json:"file"`
+}
+
+// ImportCatalogParams defines parameters for ImportCatalog.
+type ImportCatalogParams struct {
+	// Strict true の場合、エラーのある行が 1 行でもあれば全ての行を登録しない
+	Strict *bool `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// ListLicensesParams defines parameters for ListLicenses.
+type ListLicensesParams struct {
+	// Page 1 始まりのページ番号
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size 1ページ件数 (最大 200)
+	Size *SizeParam `form:"size,omitempty" json:"size,omitempty"`
+
+	// Q ID・名称への部分一致 (大文字小文字を区別しない)
+	Q        *string          `form:"q,omitempty" json:"q,omitempty"`
+	Category *LicenseCategory `form:"category,omitempty" json:"category,omitempty"`
+
+	// Custom true の場合は独自ライセンスのみ、false の場合は SPDX ライセンスリストの項目のみ
+	Custom *bool `form:"custom,omitempty" json:"custom,omitempty"`
+
+	// HasText false の場合は本文が未登録のライセンスのみ (NOTICE 生成前に登録が必要なもの)
+	HasText *bool `form:"hasText,omitempty" json:"hasText,omitempty"`
+}
+
 // ListOssComponentsParams defines parameters for ListOssComponents.
 type ListOssComponentsParams struct {
 	// Page 1 始まりのページ番号
@@ -795,14 +1832,133 @@ type ListProjectsParams struct {
 	Name *string    `form:"name,omitempty" json:"name,omitempty"`
 }
 
+// GetProjectComplianceParams defines parameters for GetProjectCompliance.
+type GetProjectComplianceParams struct {
+	Scopes *string `form:"scopes,omitempty" json:"scopes,omitempty"`
+}
+
 // ExportProjectArtifactsParams defines parameters for ExportProjectArtifacts.
 type ExportProjectArtifactsParams struct {
-	Format ExportProjectArtifactsParamsFormat `form:"format" json:"format"`
-	Scopes *string                            `form:"scopes,omitempty" json:"scopes,omitempty"`
+	Format   ExportFormat `form:"format" json:"format"`
+	Scopes   *string      `form:"scopes,omitempty" json:"scopes,omitempty"`
+	Template *string      `form:"template,omitempty" json:"template,omitempty"`
+}
+
+// ImportProjectCargoMultipartBody defines parameters for ImportProjectCargo.
+type ImportProjectCargoMultipartBody struct {
+	// File Cargo.lock
+	File openapi_types.File `json:"file"`
+}
+
+// ImportProjectCargoParams defines parameters for ImportProjectCargo.
+type ImportProjectCargoParams struct {
+	// UsageRole 利用形態 (未指定時はコンポーネントの既定利用形態、無ければ RUNTIME_REQUIRED)
+	UsageRole *UsageRole `form:"usageRole,omitempty" json:"usageRole,omitempty"`
+
+	// DryRun true の場合はカタログを変更せず、照合結果を取り込みセッションとして保存する
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ImportProjectCyclonedxJSONBody defines parameters for ImportProjectCyclonedx.
+type ImportProjectCyclonedxJSONBody map[string]interface{}
+
+// ImportProjectCyclonedxParams defines parameters for ImportProjectCyclonedx.
+type ImportProjectCyclonedxParams struct {
+	// UsageRole 利用形態 (component.scope が無い場合に適用)
+	UsageRole *UsageRole `form:"usageRole,omitempty" json:"usageRole,omitempty"`
+
+	// DryRun true の場合はカタログを変更せず、照合結果を取り込みセッションとして保存する
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
 }
 
-// ExportProjectArtifactsParamsFormat defines parameters for ExportProjectArtifacts.
-type ExportProjectArtifactsParamsFormat string
+// ImportProjectGomodMultipartBody defines parameters for ImportProjectGomod.
+type ImportProjectGomodMultipartBody struct {
+	// Gomod go.mod
+	Gomod openapi_types.File `json:"gomod"`
+
+	// Gosum go.sum (省略可)
+	Gosum *openapi_types.File `json:"gosum,omitempty"`
+}
+
+// ImportProjectGomodParams defines parameters for ImportProjectGomod.
+type ImportProjectGomodParams struct {
+	// UsageRole 利用形態 (未指定時はコンポーネントの既定利用形態、無ければ RUNTIME_REQUIRED)
+	UsageRole *UsageRole `form:"usageRole,omitempty" json:"usageRole,omitempty"`
+
+	// DryRun true の場合はカタログを変更せず、照合結果を取り込みセッションとして保存する
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ImportProjectMavenMultipartBody defines parameters for ImportProjectMaven.
+type ImportProjectMavenMultipartBody struct {
+	// File 依存一覧ファイル
+	File openapi_types.File `json:"file"`
+}
+
+// ImportProjectMavenParams defines parameters for ImportProjectMaven.
+type ImportProjectMavenParams struct {
+	// DryRun true の場合はカタログを変更せず、照合結果を取り込みセッションとして保存する
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ImportProjectNpmMultipartBody defines parameters for ImportProjectNpm.
+type ImportProjectNpmMultipartBody struct {
+	// Lockfile package-lock.json または yarn.lock
+	Lockfile openapi_types.File `json:"lockfile"`
+
+	// PackageJson package.json (yarn.lock の場合のみ使用、省略可)
+	PackageJson *openapi_types.File `json:"packageJson,omitempty"`
+}
+
+// ImportProjectNpmParams defines parameters for ImportProjectNpm.
+type ImportProjectNpmParams struct {
+	// DryRun true の場合はカタログを変更せず、照合結果を取り込みセッションとして保存する
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ImportProjectPythonMultipartBody defines parameters for ImportProjectPython.
+type ImportProjectPythonMultipartBody struct {
+	// File requirements.txt / poetry.lock / Pipfile.lock
+	File openapi_types.File `json:"file"`
+}
+
+// ImportProjectPythonParams defines parameters for ImportProjectPython.
+type ImportProjectPythonParams struct {
+	// UsageRole 利用形態 (未指定時はコンポーネントの既定利用形態、無ければ RUNTIME_REQUIRED)
+	UsageRole *UsageRole `form:"usageRole,omitempty" json:"usageRole,omitempty"`
+
+	// DryRun true の場合はカタログを変更せず、照合結果を取り込みセッションとして保存する
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ImportProjectSpdxJSONBody defines parameters for ImportProjectSpdx.
+type ImportProjectSpdxJSONBody map[string]interface{}
+
+// ImportProjectSpdxParams defines parameters for ImportProjectSpdx.
+type ImportProjectSpdxParams struct {
+	// UsageRole 利用形態 (未指定時はコンポーネントの既定利用形態、無ければ RUNTIME_REQUIRED)
+	UsageRole *UsageRole `form:"usageRole,omitempty" json:"usageRole,omitempty"`
+
+	// DryRun true の場合はカタログを変更せず、照合結果を取り込みセッションとして保存する
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ImportProjectSyftJSONBody defines parameters for ImportProjectSyft.
+type ImportProjectSyftJSONBody map[string]interface{}
+
+// ImportProjectSyftParams defines parameters for ImportProjectSyft.
+type ImportProjectSyftParams struct {
+	// UsageRole 利用形態 (OS パッケージ以外に適用)
+	UsageRole *UsageRole `form:"usageRole,omitempty" json:"usageRole,omitempty"`
+
+	// DryRun true の場合はカタログを変更せず、照合結果を取り込みセッションとして保存する
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ListProjectObligationsParams defines parameters for ListProjectObligations.
+type ListProjectObligationsParams struct {
+	Status *ObligationStatus `form:"status,omitempty" json:"status,omitempty"`
+}
 
 // ListProjectUsagesParams defines parameters for ListProjectUsages.
 type ListProjectUsagesParams struct {
@@ -818,6 +1974,19 @@ type ListProjectUsagesParams struct {
 	Direct *bool `form:"direct,omitempty" json:"direct,omitempty"`
 }
 
+// ListProjectVulnerabilitiesParams defines parameters for ListProjectVulnerabilities.
+type ListProjectVulnerabilitiesParams struct {
+	Scopes   *string `form:"scopes,omitempty" json:"scopes,omitempty"`
+	Severity *string `form:"severity,omitempty" json:"severity,omitempty"`
+
+	// FixAvailable 修正バージョンがあるもののみ true、無いもののみ false
+	FixAvailable *bool `form:"fixAvailable,omitempty" json:"fixAvailable,omitempty"`
+
+	// IncludeSuppressed true の場合は VEX 分析が not_affected / fixed の該当も返す
+	IncludeSuppressed *bool                      `form:"includeSuppressed,omitempty" json:"includeSuppressed,omitempty"`
+	Format            *VulnerabilityReportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
 // ListUsersParams defines parameters for ListUsers.
 type ListUsersParams struct {
 	// Page 1 始まりのページ番号
@@ -833,9 +2002,45 @@ type ListUsersParams struct {
 	Role *Role `form:"role,omitempty" json:"role,omitempty"`
 }
 
+// ImportNvdFeedMultipartBody defines parameters for ImportNvdFeed.
+type ImportNvdFeedMultipartBody struct {
+	// File NVD CVE JSON 2.0 フィード (.json / .json.gz)
+	File openapi_types.File `json:"file"`
+}
+
+// ImportOsvDatasetMultipartBody defines parameters for ImportOsvDataset.
+type ImportOsvDatasetMultipartBody struct {
+	// File OSV のダンプ (.zip)
+	File openapi_types.File `json:"file"`
+}
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody LoginJSONBody
 
+// ImportCatalogMultipartRequestBody defines body for ImportCatalog for multipart/form-data ContentType.
+type ImportCatalogMultipartRequestBody ImportCatalogMultipartBody
+
+// CreateExportTemplateJSONRequestBody defines body for CreateExportTemplate for application/json ContentType.
+type CreateExportTemplateJSONRequestBody = ExportTemplateCreateRequest
+
+// UpdateExportTemplateJSONRequestBody defines body for UpdateExportTemplate for application/json ContentType.
+type UpdateExportTemplateJSONRequestBody = ExportTemplateUpdateRequest
+
+// UpdateImportSessionItemJSONRequestBody defines body for UpdateImportSessionItem for application/json ContentType.
+type UpdateImportSessionItemJSONRequestBody = ImportSessionItemUpdateRequest
+
+// CreateLicensePolicyRuleJSONRequestBody defines body for CreateLicensePolicyRule for application/json ContentType.
+type CreateLicensePolicyRuleJSONRequestBody = LicensePolicyRuleCreateRequest
+
+// UpdateLicensePolicyRuleJSONRequestBody defines body for UpdateLicensePolicyRule for application/json ContentType.
+type UpdateLicensePolicyRuleJSONRequestBody = LicensePolicyRuleUpdateRequest
+
+// CreateLicenseJSONRequestBody defines body for CreateLicense for application/json ContentType.
+type CreateLicenseJSONRequestBody = LicenseCreateRequest
+
+// UpdateLicenseJSONRequestBody defines body for UpdateLicense for application/json ContentType.
+type UpdateLicenseJSONRequestBody = LicenseUpdateRequest
+
 // CreateOssComponentJSONRequestBody defines body for CreateOssComponent for application/json ContentType.
 type CreateOssComponentJSONRequestBody = OssComponentCreateRequest
 
@@ -854,6 +2059,36 @@ type CreateProjectJSONRequestBody = ProjectCreateRequest
 // UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
 type UpdateProjectJSONRequestBody = ProjectUpdateRequest
 
+// CreateExportJobJSONRequestBody defines body for CreateExportJob for application/json ContentType.
+type CreateExportJobJSONRequestBody = ExportJobCreateRequest
+
+// ImportProjectCargoMultipartRequestBody defines body for ImportProjectCargo for multipart/form-data ContentType.
+type ImportProjectCargoMultipartRequestBody ImportProjectCargoMultipartBody
+
+// ImportProjectCyclonedxJSONRequestBody defines body for ImportProjectCyclonedx for application/json ContentType.
+type ImportProjectCyclonedxJSONRequestBody ImportProjectCyclonedxJSONBody
+
+// ImportProjectGomodMultipartRequestBody defines body for ImportProjectGomod for multipart/form-data ContentType.
+type ImportProjectGomodMultipartRequestBody ImportProjectGomodMultipartBody
+
+// ImportProjectMavenMultipartRequestBody defines body for ImportProjectMaven for multipart/form-data ContentType.
+type ImportProjectMavenMultipartRequestBody ImportProjectMavenMultipartBody
+
+// ImportProjectNpmMultipartRequestBody defines body for ImportProjectNpm for multipart/form-data ContentType.
+type ImportProjectNpmMultipartRequestBody ImportProjectNpmMultipartBody
+
+// ImportProjectPythonMultipartRequestBody defines body for ImportProjectPython for multipart/form-data ContentType.
+type ImportProjectPythonMultipartRequestBody ImportProjectPythonMultipartBody
+
+// ImportProjectSpdxJSONRequestBody defines body for ImportProjectSpdx for application/json ContentType.
+type ImportProjectSpdxJSONRequestBody ImportProjectSpdxJSONBody
+
+// ImportProjectSyftJSONRequestBody defines body for ImportProjectSyft for application/json ContentType.
+type ImportProjectSyftJSONRequestBody ImportProjectSyftJSONBody
+
+// UpdateProjectObligationJSONRequestBody defines body for UpdateProjectObligation for application/json ContentType.
+type UpdateProjectObligationJSONRequestBody = ProjectObligationUpdateRequest
+
 // CreateProjectUsageJSONRequestBody defines body for CreateProjectUsage for application/json ContentType.
 type CreateProjectUsageJSONRequestBody = ProjectUsageCreateRequest
 
@@ -863,6 +2098,9 @@ type UpdateProjectUsageJSONRequestBody = ProjectUsageUpdateRequest
 // UpdateProjectUsageScopeJSONRequestBody defines body for UpdateProjectUsageScope for application/json ContentType.
 type UpdateProjectUsageScopeJSONRequestBody = ScopeStatusUpdateRequest
 
+// PutVulnerabilityAnalysisJSONRequestBody defines body for PutVulnerabilityAnalysis for application/json ContentType.
+type PutVulnerabilityAnalysisJSONRequestBody = VulnerabilityAnalysisRequest
+
 // UpdateScopePolicyJSONRequestBody defines body for UpdateScopePolicy for application/json ContentType.
 type UpdateScopePolicyJSONRequestBody = ScopePolicyUpdateRequest
 
@@ -875,6 +2113,12 @@ type CreateUserJSONRequestBody = UserCreateRequest
 // UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
 type UpdateUserJSONRequestBody = UserUpdateRequest
 
+// ImportNvdFeedMultipartRequestBody defines body for ImportNvdFeed for multipart/form-data ContentType.
+type ImportNvdFeedMultipartRequestBody ImportNvdFeedMultipartBody
+
+// ImportOsvDatasetMultipartRequestBody defines body for ImportOsvDataset for multipart/form-data ContentType.
+type ImportOsvDatasetMultipartRequestBody ImportOsvDatasetMultipartBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// 監査ログ簡易検索 (Phase1簡易)
@@ -886,6 +2130,72 @@ type ServerInterface interface {
 	// ログアウト（アクセストークン無効化／ログ記録用）
 	// (POST /auth/logout)
 	Logout(ctx echo.Context) error
+	// OSS カタログ一括取り込み (CSV)
+	// (POST /catalog/import)
+	ImportCatalog(ctx echo.Context, params ImportCatalogParams) error
+	// エクスポートジョブ状態取得
+	// (GET /export/jobs/{jobId})
+	GetExportJob(ctx echo.Context, jobId openapi_types.UUID) error
+	// エクスポートジョブ生成物ダウンロード
+	// (GET /export/jobs/{jobId}/download)
+	DownloadExportJob(ctx echo.Context, jobId openapi_types.UUID) error
+	// エクスポートテンプレート一覧
+	// (GET /export/templates)
+	ListExportTemplates(ctx echo.Context) error
+	// エクスポートテンプレート登録 (管理者)
+	// (POST /export/templates)
+	CreateExportTemplate(ctx echo.Context) error
+	// エクスポートテンプレート削除 (管理者)
+	// (DELETE /export/templates/{templateId})
+	DeleteExportTemplate(ctx echo.Context, templateId openapi_types.UUID) error
+	// エクスポートテンプレート取得
+	// (GET /export/templates/{templateId})
+	GetExportTemplate(ctx echo.Context, templateId openapi_types.UUID) error
+	// エクスポートテンプレート更新 (管理者)
+	// (PATCH /export/templates/{templateId})
+	UpdateExportTemplate(ctx echo.Context, templateId openapi_types.UUID) error
+	// 取り込みセッション破棄
+	// (DELETE /import/sessions/{sessionId})
+	DeleteImportSession(ctx echo.Context, sessionId openapi_types.UUID) error
+	// 取り込みセッション取得
+	// (GET /import/sessions/{sessionId})
+	GetImportSession(ctx echo.Context, sessionId openapi_types.UUID) error
+	// 取り込みセッション確定
+	// (POST /import/sessions/{sessionId}/commit)
+	CommitImportSession(ctx echo.Context, sessionId openapi_types.UUID) error
+	// 取り込み項目のレビュー
+	// (PATCH /import/sessions/{sessionId}/items/{itemId})
+	UpdateImportSessionItem(ctx echo.Context, sessionId openapi_types.UUID, itemId openapi_types.UUID) error
+	// ライセンスポリシールール一覧
+	// (GET /license-policies)
+	ListLicensePolicyRules(ctx echo.Context) error
+	// ライセンスポリシールール登録 (管理者)
+	// (POST /license-policies)
+	CreateLicensePolicyRule(ctx echo.Context) error
+	// ライセンスポリシールール削除 (管理者)
+	// (DELETE /license-policies/{ruleId})
+	DeleteLicensePolicyRule(ctx echo.Context, ruleId openapi_types.UUID) error
+	// ライセンスポリシールール取得
+	// (GET /license-policies/{ruleId})
+	GetLicensePolicyRule(ctx echo.Context, ruleId openapi_types.UUID) error
+	// ライセンスポリシールール更新 (管理者)
+	// (PATCH /license-policies/{ruleId})
+	UpdateLicensePolicyRule(ctx echo.Context, ruleId openapi_types.UUID) error
+	// ライセンス一覧
+	// (GET /licenses)
+	ListLicenses(ctx echo.Context, params ListLicensesParams) error
+	// 独自ライセンス登録 (管理者)
+	// (POST /licenses)
+	CreateLicense(ctx echo.Context) error
+	// 独自ライセンス削除 (管理者)
+	// (DELETE /licenses/{licenseId})
+	DeleteLicense(ctx echo.Context, licenseId string) error
+	// ライセンス取得 (全文を含む)
+	// (GET /licenses/{licenseId})
+	GetLicense(ctx echo.Context, licenseId string) error
+	// ライセンス更新 (管理者)
+	// (PATCH /licenses/{licenseId})
+	UpdateLicense(ctx echo.Context, licenseId string) error
 	// 現在ログイン中ユーザー情報取得
 	// (GET /me)
 	GetCurrentUser(ctx echo.Context) error
@@ -919,6 +2229,9 @@ type ServerInterface interface {
 	// バージョン更新
 	// (PATCH /oss/{ossId}/versions/{versionId})
 	UpdateOssVersion(ctx echo.Context, ossId openapi_types.UUID, versionId openapi_types.UUID) error
+	// バージョンに該当する脆弱性
+	// (GET /oss/{ossId}/versions/{versionId}/vulnerabilities)
+	ListOssVersionVulnerabilities(ctx echo.Context, ossId openapi_types.UUID, versionId openapi_types.UUID) error
 	// プロジェクト一覧
 	// (GET /projects)
 	ListProjects(ctx echo.Context, params ListProjectsParams) error
@@ -934,9 +2247,51 @@ type ServerInterface interface {
 	// プロジェクト更新
 	// (PATCH /projects/{projectId})
 	UpdateProject(ctx echo.Context, projectId openapi_types.UUID) error
-	// プロジェクト納品用エクスポート (プレースホルダ)
+	// プロジェクトのライセンスポリシー評価
+	// (GET /projects/{projectId}/compliance)
+	GetProjectCompliance(ctx echo.Context, projectId openapi_types.UUID, params GetProjectComplianceParams) error
+	// プロジェクト納品用エクスポート
 	// (GET /projects/{projectId}/export)
 	ExportProjectArtifacts(ctx echo.Context, projectId openapi_types.UUID, params ExportProjectArtifactsParams) error
+	// エクスポートジョブ登録
+	// (POST /projects/{projectId}/export/jobs)
+	CreateExportJob(ctx echo.Context, projectId openapi_types.UUID) error
+	// Rust (Cargo.lock) 取り込み
+	// (POST /projects/{projectId}/import/cargo)
+	ImportProjectCargo(ctx echo.Context, projectId openapi_types.UUID, params ImportProjectCargoParams) error
+	// CycloneDX JSON SBOM 取り込み
+	// (POST /projects/{projectId}/import/cyclonedx)
+	ImportProjectCyclonedx(ctx echo.Context, projectId openapi_types.UUID, params ImportProjectCyclonedxParams) error
+	// Go モジュール (go.mod / go.sum) 取り込み
+	// (POST /projects/{projectId}/import/gomod)
+	ImportProjectGomod(ctx echo.Context, projectId openapi_types.UUID, params ImportProjectGomodParams) error
+	// Maven / Gradle 依存一覧取り込み
+	// (POST /projects/{projectId}/import/maven)
+	ImportProjectMaven(ctx echo.Context, projectId openapi_types.UUID, params ImportProjectMavenParams) error
+	// npm (package-lock.json / yarn.lock) 取り込み
+	// (POST /projects/{projectId}/import/npm)
+	ImportProjectNpm(ctx echo.Context, projectId openapi_types.UUID, params ImportProjectNpmParams) error
+	// Python (requirements.txt / poetry.lock / Pipfile.lock) 取り込み
+	// (POST /projects/{projectId}/import/python)
+	ImportProjectPython(ctx echo.Context, projectId openapi_types.UUID, params ImportProjectPythonParams) error
+	// 取り込みセッション一覧
+	// (GET /projects/{projectId}/import/sessions)
+	ListImportSessions(ctx echo.Context, projectId openapi_types.UUID) error
+	// SPDX JSON SBOM 取り込み
+	// (POST /projects/{projectId}/import/spdx)
+	ImportProjectSpdx(ctx echo.Context, projectId openapi_types.UUID, params ImportProjectSpdxParams) error
+	// syft JSON (コンテナイメージ SBOM) 取り込み
+	// (POST /projects/{projectId}/import/syft)
+	ImportProjectSyft(ctx echo.Context, projectId openapi_types.UUID, params ImportProjectSyftParams) error
+	// プロジェクトの義務一覧 (納品前の未対応義務の確認)
+	// (GET /projects/{projectId}/obligations)
+	ListProjectObligations(ctx echo.Context, projectId openapi_types.UUID, params ListProjectObligationsParams) error
+	// 義務の導出
+	// (POST /projects/{projectId}/obligations/sync)
+	SyncProjectObligations(ctx echo.Context, projectId openapi_types.UUID) error
+	// 義務の状態更新
+	// (PATCH /projects/{projectId}/obligations/{obligationId})
+	UpdateProjectObligation(ctx echo.Context, projectId openapi_types.UUID, obligationId openapi_types.UUID) error
 	// プロジェクト中利用 OSS 一覧
 	// (GET /projects/{projectId}/usages)
 	ListProjectUsages(ctx echo.Context, projectId openapi_types.UUID, params ListProjectUsagesParams) error
@@ -952,6 +2307,18 @@ type ServerInterface interface {
 	// スコープ判定更新
 	// (PATCH /projects/{projectId}/usages/{usageId}/scope)
 	UpdateProjectUsageScope(ctx echo.Context, projectId openapi_types.UUID, usageId openapi_types.UUID) error
+	// 利用と脆弱性の組の VEX 分析削除
+	// (DELETE /projects/{projectId}/usages/{usageId}/vulnerabilities/{vulnerabilityId}/analysis)
+	DeleteVulnerabilityAnalysis(ctx echo.Context, projectId openapi_types.UUID, usageId openapi_types.UUID, vulnerabilityId string) error
+	// 利用と脆弱性の組の VEX 分析取得
+	// (GET /projects/{projectId}/usages/{usageId}/vulnerabilities/{vulnerabilityId}/analysis)
+	GetVulnerabilityAnalysis(ctx echo.Context, projectId openapi_types.UUID, usageId openapi_types.UUID, vulnerabilityId string) error
+	// 利用と脆弱性の組の VEX 分析記録
+	// (PUT /projects/{projectId}/usages/{usageId}/vulnerabilities/{vulnerabilityId}/analysis)
+	PutVulnerabilityAnalysis(ctx echo.Context, projectId openapi_types.UUID, usageId openapi_types.UUID, vulnerabilityId string) error
+	// プロジェクトの利用に該当する脆弱性 (納品前の脆弱性レビュー)
+	// (GET /projects/{projectId}/vulnerabilities)
+	ListProjectVulnerabilities(ctx echo.Context, projectId openapi_types.UUID, params ListProjectVulnerabilitiesParams) error
 	// 現行スコープポリシー取得
 	// (GET /scope/policy)
 	GetScopePolicy(ctx echo.Context) error
@@ -982,6 +2349,15 @@ type ServerInterface interface {
 	// ユーザー更新
 	// (PATCH /users/{userId})
 	UpdateUser(ctx echo.Context, userId openapi_types.UUID) error
+	// NVD フィード取り込み (オフライン)
+	// (POST /vulnerabilities/import/nvd)
+	ImportNvdFeed(ctx echo.Context) error
+	// OSV データセット取り込み (オフライン)
+	// (POST /vulnerabilities/import/osv)
+	ImportOsvDataset(ctx echo.Context) error
+	// 脆弱性詳細 (CVSS 評価・別名を含む)
+	// (GET /vulnerabilities/{vulnerabilityId})
+	GetVulnerability(ctx echo.Context, vulnerabilityId string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -1050,159 +2426,302 @@ func (w *ServerInterfaceWrapper) Logout(ctx echo.Context) error {
 	return err
 }
 
-// GetCurrentUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCurrentUser(ctx echo.Context) error {
-	var err error
-
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCurrentUser(ctx)
-	return err
-}
-
-// ListOssComponents converts echo context to params.
-func (w *ServerInterfaceWrapper) ListOssComponents(ctx echo.Context) error {
+// ImportCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportCatalog(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListOssComponentsParams
-	// ------------- Optional query parameter "page" -------------
+	var params ImportCatalogParams
+	// ------------- Optional query parameter "strict" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
 	}
 
-	// ------------- Optional query parameter "size" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportCatalog(ctx, params)
+	return err
+}
 
-	// ------------- Optional query parameter "sort" -------------
+// GetExportJob converts echo context to params.
+func (w *ServerInterfaceWrapper) GetExportJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "jobId" -------------
+	var jobId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", ctx.Param("jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter jobId: %s", err))
 	}
 
-	// ------------- Optional query parameter "name" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetExportJob(ctx, jobId)
+	return err
+}
 
-	// ------------- Optional query parameter "layers" -------------
+// DownloadExportJob converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadExportJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "jobId" -------------
+	var jobId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "layers", ctx.QueryParams(), &params.Layers)
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", ctx.Param("jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter layers: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter jobId: %s", err))
 	}
 
-	// ------------- Optional query parameter "tag" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadExportJob(ctx, jobId)
+	return err
+}
 
-	// ------------- Optional query parameter "inScopeOnly" -------------
+// ListExportTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListExportTemplates(ctx echo.Context) error {
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "inScopeOnly", ctx.QueryParams(), &params.InScopeOnly)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inScopeOnly: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListOssComponents(ctx, params)
+	err = w.Handler.ListExportTemplates(ctx)
 	return err
 }
 
-// CreateOssComponent converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateOssComponent(ctx echo.Context) error {
+// CreateExportTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateExportTemplate(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateOssComponent(ctx)
+	err = w.Handler.CreateExportTemplate(ctx)
 	return err
 }
 
-// DeprecateOssComponent converts echo context to params.
-func (w *ServerInterfaceWrapper) DeprecateOssComponent(ctx echo.Context) error {
+// DeleteExportTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteExportTemplate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "ossId" -------------
-	var ossId openapi_types.UUID
+	// ------------- Path parameter "templateId" -------------
+	var templateId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeprecateOssComponent(ctx, ossId)
+	err = w.Handler.DeleteExportTemplate(ctx, templateId)
 	return err
 }
 
-// GetOssComponent converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOssComponent(ctx echo.Context) error {
+// GetExportTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetExportTemplate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "ossId" -------------
-	var ossId openapi_types.UUID
+	// ------------- Path parameter "templateId" -------------
+	var templateId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOssComponent(ctx, ossId)
+	err = w.Handler.GetExportTemplate(ctx, templateId)
 	return err
 }
 
-// UpdateOssComponent converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateOssComponent(ctx echo.Context) error {
+// UpdateExportTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateExportTemplate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "ossId" -------------
-	var ossId openapi_types.UUID
+	// ------------- Path parameter "templateId" -------------
+	var templateId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateOssComponent(ctx, ossId)
+	err = w.Handler.UpdateExportTemplate(ctx, templateId)
 	return err
 }
 
-// ListOssVersions converts echo context to params.
-func (w *ServerInterfaceWrapper) ListOssVersions(ctx echo.Context) error {
+// DeleteImportSession converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteImportSession(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "ossId" -------------
-	var ossId openapi_types.UUID
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteImportSession(ctx, sessionId)
+	return err
+}
+
+// GetImportSession converts echo context to params.
+func (w *ServerInterfaceWrapper) GetImportSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetImportSession(ctx, sessionId)
+	return err
+}
+
+// CommitImportSession converts echo context to params.
+func (w *ServerInterfaceWrapper) CommitImportSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommitImportSession(ctx, sessionId)
+	return err
+}
+
+// UpdateImportSessionItem converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateImportSessionItem(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateImportSessionItem(ctx, sessionId, itemId)
+	return err
+}
+
+// ListLicensePolicyRules converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLicensePolicyRules(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListLicensePolicyRules(ctx)
+	return err
+}
+
+// CreateLicensePolicyRule converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateLicensePolicyRule(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateLicensePolicyRule(ctx)
+	return err
+}
+
+// DeleteLicensePolicyRule converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteLicensePolicyRule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", ctx.Param("ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ruleId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteLicensePolicyRule(ctx, ruleId)
+	return err
+}
+
+// GetLicensePolicyRule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLicensePolicyRule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", ctx.Param("ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ruleId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLicensePolicyRule(ctx, ruleId)
+	return err
+}
+
+// UpdateLicensePolicyRule converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateLicensePolicyRule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", ctx.Param("ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ruleId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateLicensePolicyRule(ctx, ruleId)
+	return err
+}
+
+// ListLicenses converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLicenses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListOssVersionsParams
+	var params ListLicensesParams
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
@@ -1217,97 +2736,190 @@ func (w *ServerInterfaceWrapper) ListOssVersions(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
 	}
 
-	// ------------- Optional query parameter "reviewStatus" -------------
+	// ------------- Optional query parameter "q" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "reviewStatus", ctx.QueryParams(), &params.ReviewStatus)
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewStatus: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
 
-	// ------------- Optional query parameter "scopeStatus" -------------
+	// ------------- Optional query parameter "category" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "scopeStatus", ctx.QueryParams(), &params.ScopeStatus)
+	err = runtime.BindQueryParameter("form", true, false, "category", ctx.QueryParams(), &params.Category)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopeStatus: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category: %s", err))
+	}
+
+	// ------------- Optional query parameter "custom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "custom", ctx.QueryParams(), &params.Custom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter custom: %s", err))
+	}
+
+	// ------------- Optional query parameter "hasText" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hasText", ctx.QueryParams(), &params.HasText)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hasText: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListOssVersions(ctx, ossId, params)
+	err = w.Handler.ListLicenses(ctx, params)
 	return err
 }
 
-// CreateOssVersion converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateOssVersion(ctx echo.Context) error {
+// CreateLicense converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateLicense(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "ossId" -------------
-	var ossId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateLicense(ctx)
+	return err
+}
+
+// DeleteLicense converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteLicense(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "licenseId" -------------
+	var licenseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "licenseId", ctx.Param("licenseId"), &licenseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter licenseId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateOssVersion(ctx, ossId)
+	err = w.Handler.DeleteLicense(ctx, licenseId)
 	return err
 }
 
-// DeleteOssVersion converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteOssVersion(ctx echo.Context) error {
+// GetLicense converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLicense(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "ossId" -------------
-	var ossId openapi_types.UUID
+	// ------------- Path parameter "licenseId" -------------
+	var licenseId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "licenseId", ctx.Param("licenseId"), &licenseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter licenseId: %s", err))
 	}
 
-	// ------------- Path parameter "versionId" -------------
-	var versionId openapi_types.UUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "versionId", ctx.Param("versionId"), &versionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLicense(ctx, licenseId)
+	return err
+}
+
+// UpdateLicense converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateLicense(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "licenseId" -------------
+	var licenseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "licenseId", ctx.Param("licenseId"), &licenseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter licenseId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteOssVersion(ctx, ossId, versionId)
+	err = w.Handler.UpdateLicense(ctx, licenseId)
 	return err
 }
 
-// GetOssVersion converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOssVersion(ctx echo.Context) error {
+// GetCurrentUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCurrentUser(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "ossId" -------------
-	var ossId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCurrentUser(ctx)
+	return err
+}
+
+// ListOssComponents converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOssComponents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOssComponentsParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
 	}
 
-	// ------------- Path parameter "versionId" -------------
-	var versionId openapi_types.UUID
+	// ------------- Optional query parameter "size" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "versionId", ctx.Param("versionId"), &versionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "layers" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "layers", ctx.QueryParams(), &params.Layers)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter layers: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
+	}
+
+	// ------------- Optional query parameter "inScopeOnly" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inScopeOnly", ctx.QueryParams(), &params.InScopeOnly)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inScopeOnly: %s", err))
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOssComponents(ctx, params)
+	return err
+}
+
+// CreateOssComponent converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateOssComponent(ctx echo.Context) error {
+	var err error
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOssVersion(ctx, ossId, versionId)
+	err = w.Handler.CreateOssComponent(ctx)
 	return err
 }
 
-// UpdateOssVersion converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateOssVersion(ctx echo.Context) error {
+// DeprecateOssComponent converts echo context to params.
+func (w *ServerInterfaceWrapper) DeprecateOssComponent(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "ossId" -------------
 	var ossId openapi_types.UUID
@@ -1317,75 +2929,621 @@ func (w *ServerInterfaceWrapper) UpdateOssVersion(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
 	}
 
-	// ------------- Path parameter "versionId" -------------
-	var versionId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "versionId", ctx.Param("versionId"), &versionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionId: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateOssVersion(ctx, ossId, versionId)
+	err = w.Handler.DeprecateOssComponent(ctx, ossId)
+	return err
+}
+
+// GetOssComponent converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOssComponent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ossId" -------------
+	var ossId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOssComponent(ctx, ossId)
+	return err
+}
+
+// UpdateOssComponent converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateOssComponent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ossId" -------------
+	var ossId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateOssComponent(ctx, ossId)
+	return err
+}
+
+// ListOssVersions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOssVersions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ossId" -------------
+	var ossId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOssVersionsParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "reviewStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewStatus", ctx.QueryParams(), &params.ReviewStatus)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewStatus: %s", err))
+	}
+
+	// ------------- Optional query parameter "scopeStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scopeStatus", ctx.QueryParams(), &params.ScopeStatus)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopeStatus: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOssVersions(ctx, ossId, params)
+	return err
+}
+
+// CreateOssVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateOssVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ossId" -------------
+	var ossId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateOssVersion(ctx, ossId)
+	return err
+}
+
+// DeleteOssVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOssVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ossId" -------------
+	var ossId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+	}
+
+	// ------------- Path parameter "versionId" -------------
+	var versionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "versionId", ctx.Param("versionId"), &versionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteOssVersion(ctx, ossId, versionId)
+	return err
+}
+
+// GetOssVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOssVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ossId" -------------
+	var ossId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+	}
+
+	// ------------- Path parameter "versionId" -------------
+	var versionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "versionId", ctx.Param("versionId"), &versionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOssVersion(ctx, ossId, versionId)
+	return err
+}
+
+// UpdateOssVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateOssVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ossId" -------------
+	var ossId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+	}
+
+	// ------------- Path parameter "versionId" -------------
+	var versionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "versionId", ctx.Param("versionId"), &versionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateOssVersion(ctx, ossId, versionId)
+	return err
+}
+
+// ListOssVersionVulnerabilities converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOssVersionVulnerabilities(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ossId" -------------
+	var ossId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ossId", ctx.Param("ossId"), &ossId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ossId: %s", err))
+	}
+
+	// ------------- Path parameter "versionId" -------------
+	var versionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "versionId", ctx.Param("versionId"), &versionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOssVersionVulnerabilities(ctx, ossId, versionId)
+	return err
+}
+
+// ListProjects converts echo context to params.
+func (w *ServerInterfaceWrapper) ListProjects(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectsParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListProjects(ctx, params)
+	return err
+}
+
+// CreateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateProject(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateProject(ctx)
+	return err
+}
+
+// DeleteProject converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProject(ctx, projectId)
+	return err
+}
+
+// GetProject converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProject(ctx, projectId)
+	return err
+}
+
+// UpdateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateProject(ctx, projectId)
+	return err
+}
+
+// GetProjectCompliance converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectCompliance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectComplianceParams
+	// ------------- Optional query parameter "scopes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectCompliance(ctx, projectId, params)
+	return err
+}
+
+// ExportProjectArtifacts converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportProjectArtifacts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportProjectArtifactsParams
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "scopes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
+	}
+
+	// ------------- Optional query parameter "template" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "template", ctx.QueryParams(), &params.Template)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportProjectArtifacts(ctx, projectId, params)
+	return err
+}
+
+// CreateExportJob converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateExportJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateExportJob(ctx, projectId)
+	return err
+}
+
+// ImportProjectCargo converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportProjectCargo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportProjectCargoParams
+	// ------------- Optional query parameter "usageRole" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "usageRole", ctx.QueryParams(), &params.UsageRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter usageRole: %s", err))
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportProjectCargo(ctx, projectId, params)
+	return err
+}
+
+// ImportProjectCyclonedx converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportProjectCyclonedx(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportProjectCyclonedxParams
+	// ------------- Optional query parameter "usageRole" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "usageRole", ctx.QueryParams(), &params.UsageRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter usageRole: %s", err))
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportProjectCyclonedx(ctx, projectId, params)
+	return err
+}
+
+// ImportProjectGomod converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportProjectGomod(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportProjectGomodParams
+	// ------------- Optional query parameter "usageRole" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "usageRole", ctx.QueryParams(), &params.UsageRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter usageRole: %s", err))
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportProjectGomod(ctx, projectId, params)
+	return err
+}
+
+// ImportProjectMaven converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportProjectMaven(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportProjectMavenParams
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportProjectMaven(ctx, projectId, params)
 	return err
 }
 
-// ListProjects converts echo context to params.
-func (w *ServerInterfaceWrapper) ListProjects(ctx echo.Context) error {
+// ImportProjectNpm converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportProjectNpm(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListProjectsParams
-	// ------------- Optional query parameter "page" -------------
+	var params ImportProjectNpmParams
+	// ------------- Optional query parameter "dryRun" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportProjectNpm(ctx, projectId, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+// ImportProjectPython converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportProjectPython(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
 	}
 
-	// ------------- Optional query parameter "code" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "code", ctx.QueryParams(), &params.Code)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportProjectPythonParams
+	// ------------- Optional query parameter "usageRole" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "usageRole", ctx.QueryParams(), &params.UsageRole)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter usageRole: %s", err))
 	}
 
-	// ------------- Optional query parameter "name" -------------
+	// ------------- Optional query parameter "dryRun" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListProjects(ctx, params)
+	err = w.Handler.ImportProjectPython(ctx, projectId, params)
 	return err
 }
 
-// CreateProject converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateProject(ctx echo.Context) error {
+// ListImportSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListImportSessions(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateProject(ctx)
+	err = w.Handler.ListImportSessions(ctx, projectId)
 	return err
 }
 
-// DeleteProject converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProject(ctx echo.Context) error {
+// ImportProjectSpdx converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportProjectSpdx(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectId" -------------
 	var projectId openapi_types.UUID
@@ -1397,13 +3555,29 @@ func (w *ServerInterfaceWrapper) DeleteProject(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportProjectSpdxParams
+	// ------------- Optional query parameter "usageRole" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "usageRole", ctx.QueryParams(), &params.UsageRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter usageRole: %s", err))
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProject(ctx, projectId)
+	err = w.Handler.ImportProjectSpdx(ctx, projectId, params)
 	return err
 }
 
-// GetProject converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
+// ImportProjectSyft converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportProjectSyft(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectId" -------------
 	var projectId openapi_types.UUID
@@ -1415,13 +3589,29 @@ func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportProjectSyftParams
+	// ------------- Optional query parameter "usageRole" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "usageRole", ctx.QueryParams(), &params.UsageRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter usageRole: %s", err))
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProject(ctx, projectId)
+	err = w.Handler.ImportProjectSyft(ctx, projectId, params)
 	return err
 }
 
-// UpdateProject converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateProject(ctx echo.Context) error {
+// ListProjectObligations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListProjectObligations(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectId" -------------
 	var projectId openapi_types.UUID
@@ -1433,13 +3623,22 @@ func (w *ServerInterfaceWrapper) UpdateProject(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectObligationsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateProject(ctx, projectId)
+	err = w.Handler.ListProjectObligations(ctx, projectId, params)
 	return err
 }
 
-// ExportProjectArtifacts converts echo context to params.
-func (w *ServerInterfaceWrapper) ExportProjectArtifacts(ctx echo.Context) error {
+// SyncProjectObligations converts echo context to params.
+func (w *ServerInterfaceWrapper) SyncProjectObligations(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectId" -------------
 	var projectId openapi_types.UUID
@@ -1451,24 +3650,34 @@ func (w *ServerInterfaceWrapper) ExportProjectArtifacts(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ExportProjectArtifactsParams
-	// ------------- Required query parameter "format" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SyncProjectObligations(ctx, projectId)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+// UpdateProjectObligation converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateProjectObligation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
 	}
 
-	// ------------- Optional query parameter "scopes" -------------
+	// ------------- Path parameter "obligationId" -------------
+	var obligationId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
+	err = runtime.BindStyledParameterWithOptions("simple", "obligationId", ctx.Param("obligationId"), &obligationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter obligationId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ExportProjectArtifacts(ctx, projectId, params)
+	err = w.Handler.UpdateProjectObligation(ctx, projectId, obligationId)
 	return err
 }
 
@@ -1623,6 +3832,163 @@ func (w *ServerInterfaceWrapper) UpdateProjectUsageScope(ctx echo.Context) error
 	return err
 }
 
+// DeleteVulnerabilityAnalysis converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteVulnerabilityAnalysis(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	// ------------- Path parameter "usageId" -------------
+	var usageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "usageId", ctx.Param("usageId"), &usageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter usageId: %s", err))
+	}
+
+	// ------------- Path parameter "vulnerabilityId" -------------
+	var vulnerabilityId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vulnerabilityId", ctx.Param("vulnerabilityId"), &vulnerabilityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter vulnerabilityId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteVulnerabilityAnalysis(ctx, projectId, usageId, vulnerabilityId)
+	return err
+}
+
+// GetVulnerabilityAnalysis converts echo context to params.
+func (w *ServerInterfaceWrapper) GetVulnerabilityAnalysis(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	// ------------- Path parameter "usageId" -------------
+	var usageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "usageId", ctx.Param("usageId"), &usageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter usageId: %s", err))
+	}
+
+	// ------------- Path parameter "vulnerabilityId" -------------
+	var vulnerabilityId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vulnerabilityId", ctx.Param("vulnerabilityId"), &vulnerabilityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter vulnerabilityId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetVulnerabilityAnalysis(ctx, projectId, usageId, vulnerabilityId)
+	return err
+}
+
+// PutVulnerabilityAnalysis converts echo context to params.
+func (w *ServerInterfaceWrapper) PutVulnerabilityAnalysis(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	// ------------- Path parameter "usageId" -------------
+	var usageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "usageId", ctx.Param("usageId"), &usageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter usageId: %s", err))
+	}
+
+	// ------------- Path parameter "vulnerabilityId" -------------
+	var vulnerabilityId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vulnerabilityId", ctx.Param("vulnerabilityId"), &vulnerabilityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter vulnerabilityId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutVulnerabilityAnalysis(ctx, projectId, usageId, vulnerabilityId)
+	return err
+}
+
+// ListProjectVulnerabilities converts echo context to params.
+func (w *ServerInterfaceWrapper) ListProjectVulnerabilities(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectVulnerabilitiesParams
+	// ------------- Optional query parameter "scopes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
+	}
+
+	// ------------- Optional query parameter "severity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "severity", ctx.QueryParams(), &params.Severity)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter severity: %s", err))
+	}
+
+	// ------------- Optional query parameter "fixAvailable" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fixAvailable", ctx.QueryParams(), &params.FixAvailable)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fixAvailable: %s", err))
+	}
+
+	// ------------- Optional query parameter "includeSuppressed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeSuppressed", ctx.QueryParams(), &params.IncludeSuppressed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeSuppressed: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListProjectVulnerabilities(ctx, projectId, params)
+	return err
+}
+
 // GetScopePolicy converts echo context to params.
 func (w *ServerInterfaceWrapper) GetScopePolicy(ctx echo.Context) error {
 	var err error
@@ -1791,6 +4157,46 @@ func (w *ServerInterfaceWrapper) UpdateUser(ctx echo.Context) error {
 	return err
 }
 
+// ImportNvdFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportNvdFeed(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportNvdFeed(ctx)
+	return err
+}
+
+// ImportOsvDataset converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportOsvDataset(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportOsvDataset(ctx)
+	return err
+}
+
+// GetVulnerability converts echo context to params.
+func (w *ServerInterfaceWrapper) GetVulnerability(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "vulnerabilityId" -------------
+	var vulnerabilityId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vulnerabilityId", ctx.Param("vulnerabilityId"), &vulnerabilityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter vulnerabilityId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetVulnerability(ctx, vulnerabilityId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -1822,6 +4228,28 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/audit", wrapper.SearchAuditLogs)
 	router.POST(baseURL+"/auth/login", wrapper.Login)
 	router.POST(baseURL+"/auth/logout", wrapper.Logout)
+	router.POST(baseURL+"/catalog/import", wrapper.ImportCatalog)
+	router.GET(baseURL+"/export/jobs/:jobId", wrapper.GetExportJob)
+	router.GET(baseURL+"/export/jobs/:jobId/download", wrapper.DownloadExportJob)
+	router.GET(baseURL+"/export/templates", wrapper.ListExportTemplates)
+	router.POST(baseURL+"/export/templates", wrapper.CreateExportTemplate)
+	router.DELETE(baseURL+"/export/templates/:templateId", wrapper.DeleteExportTemplate)
+	router.GET(baseURL+"/export/templates/:templateId", wrapper.GetExportTemplate)
+	router.PATCH(baseURL+"/export/templates/:templateId", wrapper.UpdateExportTemplate)
+	router.DELETE(baseURL+"/import/sessions/:sessionId", wrapper.DeleteImportSession)
+	router.GET(baseURL+"/import/sessions/:sessionId", wrapper.GetImportSession)
+	router.POST(baseURL+"/import/sessions/:sessionId/commit", wrapper.CommitImportSession)
+	router.PATCH(baseURL+"/import/sessions/:sessionId/items/:itemId", wrapper.UpdateImportSessionItem)
+	router.GET(baseURL+"/license-policies", wrapper.ListLicensePolicyRules)
+	router.POST(baseURL+"/license-policies", wrapper.CreateLicensePolicyRule)
+	router.DELETE(baseURL+"/license-policies/:ruleId", wrapper.DeleteLicensePolicyRule)
+	router.GET(baseURL+"/license-policies/:ruleId", wrapper.GetLicensePolicyRule)
+	router.PATCH(baseURL+"/license-policies/:ruleId", wrapper.UpdateLicensePolicyRule)
+	router.GET(baseURL+"/licenses", wrapper.ListLicenses)
+	router.POST(baseURL+"/licenses", wrapper.CreateLicense)
+	router.DELETE(baseURL+"/licenses/:licenseId", wrapper.DeleteLicense)
+	router.GET(baseURL+"/licenses/:licenseId", wrapper.GetLicense)
+	router.PATCH(baseURL+"/licenses/:licenseId", wrapper.UpdateLicense)
 	router.GET(baseURL+"/me", wrapper.GetCurrentUser)
 	router.GET(baseURL+"/oss", wrapper.ListOssComponents)
 	router.POST(baseURL+"/oss", wrapper.CreateOssComponent)
@@ -1833,17 +4261,36 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/oss/:ossId/versions/:versionId", wrapper.DeleteOssVersion)
 	router.GET(baseURL+"/oss/:ossId/versions/:versionId", wrapper.GetOssVersion)
 	router.PATCH(baseURL+"/oss/:ossId/versions/:versionId", wrapper.UpdateOssVersion)
+	router.GET(baseURL+"/oss/:ossId/versions/:versionId/vulnerabilities", wrapper.ListOssVersionVulnerabilities)
 	router.GET(baseURL+"/projects", wrapper.ListProjects)
 	router.POST(baseURL+"/projects", wrapper.CreateProject)
 	router.DELETE(baseURL+"/projects/:projectId", wrapper.DeleteProject)
 	router.GET(baseURL+"/projects/:projectId", wrapper.GetProject)
 	router.PATCH(baseURL+"/projects/:projectId", wrapper.UpdateProject)
+	router.GET(baseURL+"/projects/:projectId/compliance", wrapper.GetProjectCompliance)
 	router.GET(baseURL+"/projects/:projectId/export", wrapper.ExportProjectArtifacts)
+	router.POST(baseURL+"/projects/:projectId/export/jobs", wrapper.CreateExportJob)
+	router.POST(baseURL+"/projects/:projectId/import/cargo", wrapper.ImportProjectCargo)
+	router.POST(baseURL+"/projects/:projectId/import/cyclonedx", wrapper.ImportProjectCyclonedx)
+	router.POST(baseURL+"/projects/:projectId/import/gomod", wrapper.ImportProjectGomod)
+	router.POST(baseURL+"/projects/:projectId/import/maven", wrapper.ImportProjectMaven)
+	router.POST(baseURL+"/projects/:projectId/import/npm", wrapper.ImportProjectNpm)
+	router.POST(baseURL+"/projects/:projectId/import/python", wrapper.ImportProjectPython)
+	router.GET(baseURL+"/projects/:projectId/import/sessions", wrapper.ListImportSessions)
+	router.POST(baseURL+"/projects/:projectId/import/spdx", wrapper.ImportProjectSpdx)
+	router.POST(baseURL+"/projects/:projectId/import/syft", wrapper.ImportProjectSyft)
+	router.GET(baseURL+"/projects/:projectId/obligations", wrapper.ListProjectObligations)
+	router.POST(baseURL+"/projects/:projectId/obligations/sync", wrapper.SyncProjectObligations)
+	router.PATCH(baseURL+"/projects/:projectId/obligations/:obligationId", wrapper.UpdateProjectObligation)
 	router.GET(baseURL+"/projects/:projectId/usages", wrapper.ListProjectUsages)
 	router.POST(baseURL+"/projects/:projectId/usages", wrapper.CreateProjectUsage)
 	router.DELETE(baseURL+"/projects/:projectId/usages/:usageId", wrapper.DeleteProjectUsage)
 	router.PATCH(baseURL+"/projects/:projectId/usages/:usageId", wrapper.UpdateProjectUsage)
 	router.PATCH(baseURL+"/projects/:projectId/usages/:usageId/scope", wrapper.UpdateProjectUsageScope)
+	router.DELETE(baseURL+"/projects/:projectId/usages/:usageId/vulnerabilities/:vulnerabilityId/analysis", wrapper.DeleteVulnerabilityAnalysis)
+	router.GET(baseURL+"/projects/:projectId/usages/:usageId/vulnerabilities/:vulnerabilityId/analysis", wrapper.GetVulnerabilityAnalysis)
+	router.PUT(baseURL+"/projects/:projectId/usages/:usageId/vulnerabilities/:vulnerabilityId/analysis", wrapper.PutVulnerabilityAnalysis)
+	router.GET(baseURL+"/projects/:projectId/vulnerabilities", wrapper.ListProjectVulnerabilities)
 	router.GET(baseURL+"/scope/policy", wrapper.GetScopePolicy)
 	router.PATCH(baseURL+"/scope/policy", wrapper.UpdateScopePolicy)
 	router.GET(baseURL+"/tags", wrapper.ListTags)
@@ -1854,138 +4301,408 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/users/:userId", wrapper.DeleteUser)
 	router.GET(baseURL+"/users/:userId", wrapper.GetUser)
 	router.PATCH(baseURL+"/users/:userId", wrapper.UpdateUser)
+	router.POST(baseURL+"/vulnerabilities/import/nvd", wrapper.ImportNvdFeed)
+	router.POST(baseURL+"/vulnerabilities/import/osv", wrapper.ImportOsvDataset)
+	router.GET(baseURL+"/vulnerabilities/:vulnerabilityId", wrapper.GetVulnerability)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9bVfT2Nr/V8nK/7wAT7Q4Z+ac/2EtXyCtc+ogcLfg3GfNcLsiROiZ0vSkKSPHxVok",
-	"FShPwiiCKOqgCBUEdNQRocKH2U1SXvEV7rX3TtI02WlTnsfbNwol2fva+/pdj/vaV2/R7Xx3nI9xMTFB",
-	"196i46zAdnMiJ6DfmtlOrhl+An/p4BLtQiQuRvgYXUufp5SlUSBtA3kESGsg9RCkskDe0O4vKxMfaIaO",
-	"wIf+neSEXpqhY2w3R9fScbaToxk60d7FdbN4yBtsMirStecZujsSi3Qnu9HPYm8cPh+JiVwnJ9B9fQwd",
-	"jvzHlRRz9tzW7+r911SVOtevLCxRX9XUVLuQkoj8x4WUb2oYupu9iWn5qqamPGW8ILpQBuRPkLBUWh0b",
-	"UtYeUlW57dFaCpLAsIl2yke1Cxwrch11IgNfdCWWF8QiYnUqEqIQiXXSfZAKgUvE+ViCQ3y7yHaEuH8n",
-	"uYQIf2vnYyIXQz+y8Xg00s5C8nz/SkAab1mG/ZPA3aBr6f/nK2DCh/+a8DUL/PUo140nK15lbmNcXX0O",
-	"pGWQWgbyOpAzQP4IUmm6j6Ev8cL1SEcHFzsOQtTMy93ZydzGeP73t3DyRl68xCdjHccxN1o74rb8EUhj",
-	"yuoDZS4DpBm4LdJtSE1rjE2KXbwQ+Q93LBTll8fzmayy8Ea9P4OAqr8Dh2xgeznBidemcJhSR/rz8/dA",
-	"6hWQF0BqQUkP7s4/2cumm8IXmsIM1RC8eAGkXqI/TsMfUsuUtjq8lx2mGZqLQTH5gW4K0wwdam1sCV4J",
-	"0Aztv0gz9JWg398Q+L4uBD9pCMKPLoXqrgS+bwp9RzN0S1NTw7WLrcEGv/GLP3DV+LElEG6B4zTV0wzd",
-	"1PKPQIhuY2xSwNA3z8L5/YUVJSAtQF5G+uEVksRBID8HqbdAfg3kDwimgyD16142rQyO7w6MKxspIM0C",
-	"eRStbx5rFSDfVp5uao8W8CKVtaf5+TG09LdA3kFP/urLZ/rzy0/g354P7GXTl69eYajmXrGLjzFUI9/B",
-	"nftXorBPIDWEht4BqVkdMnIGDbcBUm9pht7tf5jbmfchElJA3tIJQYT7gLwKUs/RHz6A1AttdRiknoLU",
-	"MEitAHkRyEtAfoYmKeLSXjYN5GcgNQORKq3Af+Fw6z5lYhrII/ntLJB2dPKM5/RVgdR9Y/9+Bal1RMz6",
-	"XjYdjsOdZ6irSc66tnuQkNSw8lrWpjIgdRu9uLKXTV9he7gYQ9VfYX+yvLA7ParNbqpT6+rEO1/QH/Dt",
-	"Pp7VHt7OLz1Xn0wi8XmJhh3EWsU57OXWWERkqHpWbO/6ykrIMNqpF2gX34JUWpt6qqYnfer0kPpoQxmb",
-	"NgehGTq3MZLPPADSivLpHpAWgbSOjNswll8gPQbSWm5rmm7rY+gGvjMSC+kKl6D1U6sIXwsg9VZNTyoj",
-	"T9VZGRnJV2gJj9HGf6QZOi7wcU4QI1hrs+3tXCLRwv+EtWXxoJe/b6EgX6B+3cI7gfkAB+uX63TVgnRI",
-	"LXWRYwVOoCCfIXhS8GmEa9ouNn0Mzd2MRwQuEYyRlmKZRVpT54aVkY/q3NPd2cm9bFpbuou3mmAVBe7f",
-	"yYgANd0PRQuzTleQYf76v7h2ERLTlEjUG2qOrKCAtJZfmdYmB7WHt5XJcW3pNYR2atKQkSWQeqsMvNnt",
-	"f6imBpRf32ASi7fatLvOKXKf5tT0pDrzQp2VaYa+wQvdrEjX0h2syJ0VI90caQt176E1wXZyIT7KlVPb",
-	"hQfRy3GBa4f0OKnZffxEvZNRXmSQDL4EMlysOv06vzihpF9qUxl15Bd19VkRG67zfJRjY7TdItjH1l7P",
-	"qw/u5ZdfqQ/uUD4KiQlUHLFkNMpeh4sQhSRHWG0X381BX65ViDpHVQZeKdkJIL9HApCmWkMN1m1MChEv",
-	"U0Q6iMwH8lskyo+R3I5jsaaC/qIZkpEOEo+i0OYlnMO6Gbz8wpB6/zX23JSJdbzFEZHrTpTjLraufSYN",
-	"rCCwvfB37M/ZCcjPZ7SFTWVyHDFhEaRGTQ2rLCyp00PK6ozyekL/YWxTSb9AO7CM9H8WSOvY7ABpSX2z",
-	"qaw9LEJDYQNicIei0P1oJNKhLsxp755BTK0+h/gamzbFyzL9NEht5TMPlIkPu7MLyp0tl8niQqSbFXob",
-	"2FhnErr9Tjnb2MLWZS+bRm5xPUPV//nPDPUtz1CX2R4WD1wWKQIX5xMRkRd6iXAsOGXQ4D1GGiKNzeG3",
-	"EVG3FvvDqMh2EuCU23qQ27iDHIPXuY3+/OKSV9i0sJ0k0CTjHW66Sn30Tp1+XZGusqlmJCoIlkV6iLFo",
-	"SCsF5TR2PXrLEnt4k2Csc+3hg11pH1DJltCEpg5U5Nl8f+qU6ECPCgu6remZI9JN0OCa+ulgcn4Ywlws",
-	"w9S+5TbYQdhXJf1YnXuqy68eBUAppoJ+SttesO5wWWNTvLs2oUNbXU6UWpHQVSxKWCXYRGkvm95NZZT0",
-	"IMkXOkbfpXIf5Ytkukkm5LI0AyTdB/7MZVP7tKZOPFK2x2AcZUqlc4MrF0ySEF7lhAQRjkr/KPK+ioIN",
-	"HGY4Y4w41xAhiW59c4DSvan0DF4CdDdvDyrZN2r/kvZuMrf1AEi/aFMZm9NZZjXMocc1N3jhpyYh0hmJ",
-	"ufD8PpBfYm9VGUhBnlNVwcaWQKixruHapabQdzBaU359p0ymq/eBhi420RXuYr/65q8E7YKzNfIzNP0K",
-	"TnVoq8NAWqPC/6g7+9U3f6VAasJMkxDmi7OiyAlwsP/5oe7sJfbsjZqzf2+79dev+/5Ee4xJbFDwHIok",
-	"xBDXE+F+dvHr5vq19zLKudxDCYxsab6VV1iRdi6W4Or5WHs02UFS1trCtjI4oKy/VJ9uac9gFKHnj+Qt",
-	"nK5QshMVzBS4GRe4BBSiEPuzc7Zws/+/qdzmXXXikXMaGBBsjKjvJWViWtmeUWdlTf7oMRro5jsiN/RU",
-	"qr+UYVGnPioLw3DJax/VRTm/KHkf3n3/8Kjq3LB2e55o7PhEIkh4O7+4TB0wvI0nSRIaZ9t/Yju5s0kh",
-	"qp8+xH/qrO1me7iY79y5c9XeFH+UYxOcnxWJJgRyCin/ZRxoqTMv7Dj1NguUh7DIismypjJkfRZltvk4",
-	"5+3VsOVR+GYyHo9GOKEFkVPmVeuzhx6YMXSPm9Wx2xvDdlBVYa77KifokoSdrWpvMR8GYmFSC7ZtvCje",
-	"3gojQ92SlokLbQv0Fg6WtK9Oz6Cs7azc1h2yRbPZMsOKHdxwedPJ2tTT/an8CnXufrWtfj57g40mOGZ/",
-	"2resjjywOjwERXgQlVSxCimrLIwRS8t3mWDVPnvFMepnJ+tH46F6cPOO3LU77WqEMNIfXGH80TwnUtDd",
-	"zHZyHSEukYyK18oeARLdZLMICJ+Sa+8n1SdzDkViqggbaNHT6Hx1AZcEVJa7KSKZoG/ixPSLNrGtzGVM",
-	"wqkqS1FVNeFYlcFlSwQMGUvHeS15k/iyyIssAc3ahwlcN0U+yC3HKtdcCTqrLVb8J80jg9b9cugPwZNm",
-	"gUcfE0iaQaUJG6hEZB2k0vh86kS5YlD7f4ElKHPuhS/4aB/lkd8iHTdsrbE8QTbhFXzWvGpNkCrj0Ll8",
-	"Fsi/g1R2X1LjaZfR3CV21333SmyNpw3Qywgd6w5dqqf+/vU3f6N8FPzxb/+/5m+U8mQUlatBB1nZmdNW",
-	"p0BqDqSGgPzc6bjzHZxLpIsK0eQ3aBM/YPugjb7KDy2bg5vo9+IFdXAiGyFgIb/zSRn5Nf/yrfbuta2e",
-	"zsuwnCDwpKMeFCI8x+VjIDWsjD/IfRpH5m7ZqO7TF2UuxylxxXt1I8JFSSlmczukMW12E/rX0gyQntop",
-	"mBzfy6Yvh5saqWYeMlugcPGcS4FGN5dwU0dF4wJpRVnfNs5DDVIcG+mMIh0gs6M6EkuIbKyd875kZeBD",
-	"7tM97eFtXFyH6iZ38A9UayiI6sDSeqmi/DHoN2sBKw3dEqZHXEzYP1pamimjbBQVcEISCigliGFEjJZe",
-	"4RqOZGxbupdN5zY3d6fvKRPr+eVVFyaKuvNtO6W6P7E7P2bUps7kVx8o6Rf6Bqmj80r2PS5yMg+YKtse",
-	"W4YAr9DcszayevHqkuxl09q7ceWehCXqeIr3opEeTuglB2iYmtxmWll7uM8ArYOLs4LYTQxn1NEB5dO9",
-	"3VRG+/Sbt7EO97ScfKhl54rHM4huNsZ2ksxn/rdXua2tfP8A5aPwivP9A7iipCyF5ONvgtPkeg7OJxLI",
-	"cannkyQWGAeuExQMWbDvhd2H3UeD+UyaKNdxDOl6on3D6Ugsd6Z6QNrJWt1HlOijr/ayUm7WfnlP6uuy",
-	"XDaj74g1PNZ4lZfFI5DCk5O/8iKzfxkpVRlSAr1WlKJbPXZWEiyeC+AIWCuBqbJZZDshxETyF0ydBKb6",
-	"SrDVa9gLpBUgjQDpFyCPasMflbWHFCmJJK0542PnZYqODrIW1Wa3dsd+q9RFiAhcu+jn4lysg4u19xKG",
-	"ffROvfMit/1YWX0AhUYepuC+UlW70/fUOy+AtE6hk6tqYp6Z62GjSTe9b/ExZ5T0AvRDPFiC8pGNMefF",
-	"XlItJJxHWXuqTn8yY9/9+hOYXR59iEisPZpMRPhYI08S12Cjr6m1hVLSC+r0qjY5qE298X5xwaX6okTl",
-	"BVWlpF/mtnfU/qXdofH8wlC1lzXwZqrRZTpqf3VDujoNHqbPtv8TgOQ+KkZLOCRBa1lC0Q5ap7JXIzgk",
-	"kzFFv62MSqrYhdFdQ2+ODFFj6GfYGJ6eFAhRXZSREVzIfPTCcZKicAjgK4u1cgCq2F/Rr2x581oqMzkl",
-	"S6zL4GUfSCnBU7Mwmdo/d49dKTn4HLIdqpYsaLBWamojv6sDo3vZdIfA3hAvqHPL6vBOfnmcoXo4AZ1D",
-	"X9CebeaXx9WNdPGtafQCLsnC59We7zirc8tWEnzqwxVl7SH2dNBNWEsh6UbaZ86PbrMam+VM0Rp3TZX0",
-	"78r2PMSyceW2zn8l2HhBGciomZcMFfAHW5pCF7QPmd1Hg8rEOkNdDQa+D4QuKBOyNrAEpDXzarGxVjQA",
-	"zdD4VZqh8Rvel6ytzWuTg/n+AdAvW5Pz+HOf9YKcsjCsPnrnUwYy9aFWP5DGlIn1fOoTzdCYYjxIUzjs",
-	"c0qsTxdZVOINpDVD+W8ZQrylDI/szi6YoxpRfhE5uY1x8wbh7vRv+cUlPGd+eRVIO/gCNt4lnTTIFwTs",
-	"Zj4aIcm+1SfMDy0ro/exx2Zddz6zqqw9dHrGSZG/wgo/XeKFnxLBGJqG5GcVVXHLd/EsVLDxWri+qTlA",
-	"KWPTelJYGiUrHaKXUiDPoyoQkjHo0YZ0xe3HNtSVbr3rwLVQ4L9ag6GAn0Q6ijMQ6SW1ZoITejghEOsJ",
-	"upbThAOhq4HQtUDjVTiPdYYMkHZQ/n3WbX9KZXpQ/fe+ikj1UUk+vQHZgjfvIYazoLCcubNA0spnb+Zu",
-	"P6h08LUkOw8KpMpmOyh43CXLlUtuxkrPp69v59/MO2NJ014Z819QJleA3M9QTa0t+ie7swvKwjRDhQJQ",
-	"TV9rDAT8Af+F/KKEhyhW7cY4NEObI9AMXfRuBXreSry0gmiTcCOD4j+NAnkY00kzevia23ms3Z/dy6bR",
-	"8UrBBkJ624p3rQJsW0PwcqgWODbBx1ySxNjrMjIYVBVIPcEnWsrYtJp6q6w99FieD+cge3b5oWVt6k0+",
-	"8yC/8xrP5+n0a5/el82/tg5DcqXDtgoyWxCDLn+A1FZu+5H2flH59AzD1FqbCeTbyuA4SP2CTs7e6C6Z",
-	"NFoMyNbmcEsoUHeFZor1BwJlc139d3XfBrwDUr/wgBqCAGkbSNvYRYCARGl/K4F72TSuMQSSDOQRw0VA",
-	"bWQchPvw8Te+2ITXi2DawnZWUAYnrVhvsOKDPnxj7sgP0ogW37is58XWuxz1oCEsrT7KHKK4XnEngbCF",
-	"7SyXB8BX+T1F/eUXUJZcV0qLLuiWjTQHB4C0ZKZKTenRwWXozL3shPLhhZYZ3Z2dhN6jXXQutjb6GwL+",
-	"axeDjXWhf9KM+UG4qTVUD9V6uKWuJVh/rSHYCOXJ/8/GuiuFX+02FD5vGj00WrDBf62psQEO7Q9cNX5s",
-	"CYRb8M/e2zylJtFp9whi0V2rfEIgP5nThl8i+zGmPnuDehwZfSHMEiv5LvFJ3I7I7JeEeuqkgTxiXNKz",
-	"zCttWJspQSEfvY/eLerEhOz7DJAW8RQ+HCWZnaXUWVm7+1p5lio8tzOQX5Qg9+aXlLVnivRO3ZxW5Fls",
-	"qI2eTe/RMiZ3pVFtKmOMsIbc0KXcpx102K/zXxt+qSxM2/s1ISA4XzE3BUYxkyvWpk24FxP0HfwBX0Hv",
-	"pZ4gtbajrQ5T5oTWtwvNnNCc5jCEh9sQ8oklWZYGXsaBQCHwcrmUy7aLkR7SbWrU2cin3Z5XRj6W9uwO",
-	"vfwgkohH2d7G0r1hiI2buokVT3rjstQKKrMaxm2nrOTg9/ZdHFDYZI8RHB/l3BulGFmFynqlGP0HjrZZ",
-	"CkMnE5zgVoBQ6PIV9O/XLpnjG9vEGBCt5EweCkjZbLalgNGTLbOKSom8NZYc1BeoZJeH04vyOJtI/MwL",
-	"HW6JdOimwS1aN+s4Ln/fAq2rLOuHibi9m7SDdaaZ6qlQEvSUxOHKg0f8lkWrA6huOCybFLfo6IrvgXlS",
-	"30p6SH208/mg0IY/ZXAcZ/awzcxtbam3J/YFuGKo7WXThaQqTkaixGllvc/IQHTmLFCKpD0pRMTeMHwV",
-	"k3mdTUTa65JiF4FmdMdZm8rs9k8BaY26CB+lcJNT1KFsUH38QtlKqavPcLEeJhrRhVAAny/sUZcoxiGd",
-	"11GnRGNK/Nslg3mXv2+hmRKJcWt7RG0qQ13+vgUZgmWjY6Z+sw8lAmfsBKG57BT1oeOaG7xbURmQlgxn",
-	"Z6s4AZJBp4aj+NRFvpvb6FcGUpij0I3slwj1M+t3zBBBG/ldfSsBKYNH1aYyJixQtwYfVR++Smmrw0hS",
-	"141OllkkstB5xt01jczVUyBLkE11zUFKST/WMjtUVXMXm+Co89WgX/4x9mPszBl17pWW2UFp9XHt0xqQ",
-	"XgDplzNnfoydpfRnKby6WtfuCD77AVN9qNXPUDjkYijnmkmf6QUKVSjEqmYoZ7oHvmU5UUAeO0Npj56r",
-	"T7ewJlXn+pXXE/Bd+/ZUoQlfGW3oHiF3u78arlKdW8a9+6owfqtrKbMPDEOFLzZdoYLdcV4QGaqxqSVY",
-	"H6DwLkNyiu9YDmTU6SHMbYY6cwa1C3Xg8MwZg2ZcD487/u2uPFA2F5WxacyU/Hwmn3mAuRD0U0BaV+48",
-	"VYaHqNbWoJ/q+brQuwatYOaFOvcqv/wE1ymZzQiV7bH86Jv88hNlbFpdmMtn7vwYo81yaB3MiKkrqIYb",
-	"biHlo0zwIRjj9UAMWToV1NLnz9WcqzmLjsu+QueRcS7GxiN0Lf2XczXn/kKje7NdSKH42GRHBJmhTg79",
-	"B60JumIa7KBr6TDHCu1ddfCZBr4zgd4sNED/4RaxHTcXEyNiL8palWrKzZR6G500V/zuDYHvLnrPWw0o",
-	"eTCRr3yoNluj8a9qaipqZV3ucozT2uMRHIaNFb3SzBR2nDSQhZmkP+MIqPx5dbK7mxV6iUMk9ei14hsS",
-	"BKPpaPLd9B187+ua82522WSXr6j/OHrpL+VfKvRv77Muk7ZqPtxHFusSXcmfx5+hYjfUJfMHGgkZ9Bpv",
-	"nkVeSV00yv+MfUt8GNwGZ/BBGn1RvjOC+B7nEwSpRf2XaeyfcgnxIt/RewAUena8rG6d+dIBYsZKfG5z",
-	"vjYiKApvQe+y74BSWrJLXVHv68NEpMUjpGt/aLOizbpvOPzSZjfz82O612siTOyyoYhPiiVhBP/u2Kyv",
-	"nYxr5Kl6ffcOY3G3itzOH9r6iKt9BuRFHBC5+pw43hmb3stO4LfymQe7Y7+ZF3uKt4Yke3rdhaUSwyqN",
-	"GMRE0/ktJ9YnBYGLiSg9d4SQw7ciDxlpBU2m31EtICy3serMJOLjKMuWQqoS+9lTPpFw3dSGSEK09hIg",
-	"eCSkhRYe8RW+sgXa/TIPF75UxcvD5vecwIcdte5KehAGDEPvXL7ERFdkJX0ee6S1gnz8J6jn9RCQRyjU",
-	"I5MybihCzxF1NaMaghcZ/0W370/R23VWOLl+ZkNVqavPtWebeHFuU4hsZ2Xjo+Jwszmi9fB/zV5chmI5",
-	"IC3ktl7kNkbUMQlIC0DGKac1fM5AIimCKxuaYtFeEmmFeoK2I5Rd174ep8iXce1KjeJfh+A3hcOVij3j",
-	"YoFwzrZoX/bv1XjtT1KcKPbkPpw/EkJIGMDE6TytKc9TyzcdnS7soBS7F9S42QjfLVSf3KffYOJweUcx",
-	"fvxG92UbhEhBLAyJC8rBqH0u5jwxHiTHPAStcRR+U+XsgW98Xf4N87uhvPETyHfNdtZUVaHr9QW4bdUU",
-	"kFbMasvKOc64elingq81xyb9x2gBjgYmOK12YGPBiu1dTjzgk5WTgsTRGqXiU6Njjmk/e1jigzaqCrvp",
-	"1Ydhlnx6OrZsLHPVeO54sMocZYREcrMdvXK9IK64DZ/b0MU33byNXFyQeYyuvdlb7fQ49vq3bupfGmZv",
-	"Mmycr1tEgTLBetgO/lWzzfIfW2ET+zkffwxRAmxFEcSp19g2UOJmVRWB0rOi9t3qMS7tlQkq4OfHjlmG",
-	"OG6P5WLml1ilJHbwRTSqCn85ok8bfolO/vXzWHX64+7QY6N4dbS6Iox5ClQ+Z7jUHJPy+sN4mna9RYh7",
-	"DmJMywRAnxnUjtJSn3Rg9RmCHUdTBzfSet+L0hFUs/HQMR4EkWKRdtzCqeKSlbLHPscVopiNhk9PfOLW",
-	"kNkCLZP9hxqQGHtxNMqH2CHumGOEEtw+7gChHMvtJwWlWV5Sk/humb10PLj4BRSUt6LWHj1f/PByPC12",
-	"xXE5crVnFpf3tk8D52qOQ1b/QC6CHQMOl/gAqryUO3xCWDgys3GiDusfwklw+J+HZDF83M04L7gXSwfQ",
-	"n/XJ6gQxcoMlOqZHBjy3qmj8WqmRjfvM7Yke6IzGO26eRcBo8zwJysUXp+HtrUnMCp5lIL2kqgrlS+kZ",
-	"dWy2+sjLqeNRtp3r4qMdHuuN4SfcTdEHN6VoWJMP1yMxFm2CfSyHdGBs4MqseVRGt0JVaRPb2sjv1OVw",
-	"U6OvPnyVQtd+V3ENY/VpkSbzxoHz4gblcnPDInp43fspAyRKIOpe5ikgbMVPHqvwHW/EefDTL5eBi5tJ",
-	"eqtAtfSKK935dQ1IO0bjRtLkuK3f6SjIK/5Sl9MdF6/qd7SawmHKLUqmdKE4ilgZb9Jn42Q5+5+eTIDu",
-	"ir1TFqVj9DmO57wAr1Lt77uF/q8khD9ucJIz4jrZn2WGoAgeek8dFOQfFAzeorvPjMFHq9dOQwR56myq",
-	"tY+pWwB5RGrMlzA6LHqGOu7J+AXvXhzdL3AnWXCXho2HAXoEZ1/c7MzrlqW1NvA9QhZYpzlNN4QntvPz",
-	"Y25NYR23atAqKH0Zh5shtfPhiKSR1CT3mKXxlEKhdGdgqspsO1NdESCsMonfKpE2aWFRe4cDbb2nBjgt",
-	"LOEb508TL1A3S3sEjbbnUOPmFnQj8iikzdGy85hDV8Th0xaxWpqUemIr0bLB13y3RLbTU/CJOVzeRUPj",
-	"ff5RoX5j2B4VVsiCJLpWXkqT4Yvnx1gO5H7VG/f8cMl0WrpXVHLv2mhKBqQl7f0TazNV4u2LStK4egb3",
-	"uNKrh9634MBZLft3WHtrZ+BN35tdIA5f4TvbWx6zxnfj5AknKe0dPStjp6ltYKTOCZ5Uvs7k8jofj/il",
-	"WIhQKFLgWpmK/Vfz2qtX1ZXKqFs0erKsO8XdYE4SAY4iIW9quFS0e+x8Php9f6Jx9GeFMUfiy4tt8NCv",
-	"Cn3BDUaXvRnvK+3+MlWFezxCBZYUonqD00Stz8fGI+e4m2x3PMqdi/LtbBR+4us5T3I2UZt53MzeMU4H",
-	"13POfaw2c8HG1zLgLlhwEv13vBGWD5rCYduvhbp+y+fIpbf8btZfOT8zsouWvxQlNiyf4wZ5lg/02pK+",
-	"tr7/DQAA//92B6FUpq8AAA==",
+	"H4sIAAAAAAAC/+y9fVMTZ98w/FWOyXM9M0mvxahtz+u8eMaZGyHatAhcBOnZu/p4r8mKaUOSczehUMeZ",
+	"bCIIAoVSBd8VRUDQoKe2RVH8MMsm4a9+hXt+x7Evx+4em2x4Ez2d6dSQ7B6vv/fXC75oqjedSgrJjORr",
+	"vOBL8yLfK2QEEf/VwfcIHfAN/BETpKgYT2fiqaSv0XcIqQujiryu5K8oclEp3FQKb5T8avnakjrxp4/z",
+	"xeGhf2YFccDH+ZJ8r+Br9KX5HsHH+aToeaGXJ0Oe47OJjK/xEOfrjSfjvdle/DkzkIbn48mM0COIvosX",
+	"OV8k/rPrUozZN9b+KF17hvyl2zl1bgEdPngw4LIUKf6zy1K+PMj5evl+spbDBw/WXllKzLisTMm/hYUV",
+	"hktjl9XiTeTfWB9tRLAEjpeiKIiiosBnhFhThoMXXRebEjOWxWqrkDJiPNnjuwirEAUpnUpKAr63o3ys",
+	"U/hnVpAy8Fc0lcwISfyRT6cT8SgPywv+IMEaL1DD/oconPM1+v6foAkTQfKrFOwQU2cTQi+ZzLrLjdXx",
+	"0tOHirykFJaU/IqSX1Tyr5TCsO8i5zuWEs/GYzEhuRcLKS0+3rwxubE6XvnjBUzeGo8KSUnoSCXi0YHu",
+	"eCrBkwd3fyVK4bGSn1Pya0rhBT6MO/hs/gRokIuoJdT2HdqUr6kT44o8psh5JT+K/NFUTDjSGm4OtUVC",
+	"ZzraW8PN353pDre3NnWF29uUXF4QxZQoIUVe1l7NT6nDM6WxGwHYbFsqcyyVTcb2ZntLGmjnXynymPr0",
+	"unp7UZFnAAbkS7Cak0k+mzmfEuM/C3uyosrSeGXxjTr3vHRtBmOl9g4M2cxn+ESqJ9ybTomZTgH+70TV",
+	"9kgEKfllJf9OKTxV8s82VnOl0SfqxLSSv1JZf6PI78q/T5bu3vZxvrSYSgtiJk5wLZrq7Y1nMkLMOWbp",
+	"9oh65ZUiL1Vmx5T8VPnG2ubYv/Ax3VPkUeQH/I1mkCIvAM4UHmPowOAgP1Tke+r9l+rksCKvoHN8QhKA",
+	"OmiIfzaVSgh8Eg5aoyCMyaefVeYn6Dkrs2Ola898TiIGBC8TPc8cZeaB+vS6Ii9urOYql1/WHEgUfhCi",
+	"zPVQK1lS5FGyxWojpX7C5xvPCL1SLciwXnHqJ99FY0heFPkB/Hcqwyec63JdAt7NP7NxEXbzPXXP+lDm",
+	"4ZsHSJ2AtoXTxsips/ALLMWxXMeqmiPd6BCqzI6pw0OKXPQAh4Q6MG5w7nZl8Y0BYD7OPFEbG3EeWSKe",
+	"FNhr21gFtl+ZHSMMH/mVwnWlUFAKOUUeIysv3yoGmDdLuJqTV74AYgmU8o1SGMefh9XJcR/nXGdKksIM",
+	"EFNX1tV3txX5hpIfZQ6Hwi0+zncuJfbyGV+jL5uNwzUls4kEfzYh+BozYlZgT9ctiFI8law5a2GSSCJK",
+	"YUEpvNjifKIgYVGkPpjvJG9d5Hx9ZLGMM7Ytz888JZDn5HfkeoH6wHoDtddtwxcMO9plG1vidDD1ghWd",
+	"xjHY5Q07aSZrVcevb7wdV+SigSFCEsS2733NnaGmrhDcxYmmruav8KfO0NehZvjytH0nnK+/Ad5sMWcl",
+	"fEQbxQVWQRgGwr5iO2UlP0XTYmoRJnktskcsrNnHkpcJIUZ+dW6kdOslJqYzAXo/LqQW+W2UQMnJxpKR",
+	"yYo21qb1q182ng3g621O9aYTcT4ZFdy4qFKYwexzVckvgChIgMldGKo8vraxPuvOWfF0jHmwAKXIRUOG",
+	"Kl+aVeRLhGMiAE+A7UUsjb7ST3VYnVipFN6y+ajQxyeyRBaH6QycjfEZoSET7xXMt0xETYspAN5wzPKK",
+	"huaOp6VoKi0wKDQ5BHVlvfJ8VpEXNQEh/wrDxBulMEPT7GoUIQITRDJ8JiuxqLmU7e3lxQHnAjYvj6tz",
+	"C+rr+dLKL8ahEl3KcSkxITlA8Q4L6++LCz+xf/uJF5OsX2w0Aw+uPW0MyCIVfbow711EsGsBjgOyrcW8",
+	"WytwGPfIUQBqHq5lbUwq1ydJJwRANwZn7Y5EEAEHdAhtrP2B/OQvdbDgJAallV8Cjvs5y0tCJJoSBSsU",
+	"p7JAuI3lJLO9Z8nN4OeFPkGMZwaYMoGUyopRwRVqBwtYq0XJvtj/SsalzIGeVF+ABf3kC/soHWIcjg0F",
+	"UUSIppIxcoSOl/uEaCYlMtfnyuzwYTo4Hqz18wOHOHT4wEHGOm1AoA9uHIP2Akeds+0MjcWyLj/UD3Tz",
+	"mHYtThHIQbHePlDfTFCcLCr1wWLSsf4GrDtxvuhANJFKCqwv+nsTPs6XTGXiUcH40HA+g7/uT0j9sPZs",
+	"MkYgQ+hNJ/gMfEylhWSf0G8ZC/4+zbgZsqOvU2cZZOXOXXVyrHT7nnNf+oVMO4m+bhFx0yBKM49KN/I+",
+	"ziOJ1sY7yiB7lXm59DyvFOYxhPzBehtLK843iZ5ZnhwqX33uRaAT+tNxUZCYm7p6rzQ8WR55rMjFjXd3",
+	"SmNy6fa9zRuTbhusOde5eEJoY0rYZCqlcE3JzwJDLiwT8drTkGB/8zKkkv8dPuRfI//ZgYwQoPcRT2b+",
+	"9oX7hBS/OBdPxqXzLmDwe37j9VB1MKi9JQMFq/EMC7pe5HzxGAtpNVBmC/ssmaFHFCSGHLCZ+1dpfAb5",
+	"/9+AjzJBHrKYIA+yTssihtQSyDwu001YUS+/Vq/c0oSVXZBRMrzogv6b06Pqwug2710iM3u6969TZ/WF",
+	"2tgCPjJaQNAWQ8kG2kTUfdO0iKPonDuf+Dp1thk/Rhlza3EMAxw1JcBqkkV+stIjOrVHirxC0WntXbBI",
+	"ySvq8OPy1cWN1XF1YsUpaGwNg+oFK7DlLxHreelGHjSVcNuZSHN7RyiwIxBnu1htU1WvJGKAkPe7uPJH",
+	"aXCUYuP/czJ0kmihJ9vawm3HfZwvcrK5ORRqwd8eawq34g+hf3SEO+vRUfUXGn00M1GHLyv5MeQ3mI06",
+	"cmXzxlxpdViR3wXMCXXO5uP0FTb61CJY6dT1QUWepRas0/6N1aeWxcMLYxuvh8AilFPy81iTfYrPY0TX",
+	"vy4ax9mlCx0MwqWxZbV4s7z+mHG4hSE89oxSeEK+ccrCqdgAa2T7i6XbT0rTl6tIDyxytPH2dml4sk5p",
+	"xDKEQx5ZelK6/osneSLZo1nmauOefsQh8o7GzkP9GSHJlpsJKtI8vTQ6q775XX06ydpSPObliD1yHRfT",
+	"oGM4dXIc+QW8PzADIJOcFX7FFotZDDzvFHmBEA+mSpJNx9xut3TrZWn6WZ23q43HkjVLt3Pl3/Nk1Epu",
+	"sKbiQQyFxHam3bb94jgC3/S0NMDS23OnZzp01M9nHHfCZDhKLn8qSX7B9BvLh9p7y0rhsnlNExPlq2tg",
+	"+8jJhAgRO4jpBPni4EGk5Kcq766CrRXGda5BkZdLq7OKfE3Jj2FzrDHBysbao43VUTBv5G4q+SuYsVQW",
+	"n6rFm/Dd/cHyraIir5Qfvy5NX1afzgTwDA3oQAdh842oORUTOASiNYdahDQvZnqFZIZDJ/gk3yOI8GUi",
+	"3ieIAy0AiP7vvvvuu4YTJxpaWgLwk3GaeNDjQlIQyeUgP4GyAEd9fXSAQwcw45KQnyxIHZ7ZHBxXh2cC",
+	"eISTEt8jSN+fbkT4U2cqIXCIYnUcaomLQjTTIqSFZExIRgc4FE5GE1mAnbZURuBOJRFq1qkG8pONtQGg",
+	"J8BrR/7+KtUrgNv+ZGcrh8DqJ8UzKXEA/0ltikOaIt/KJ3uyfI/AoVZ+QBClAJ5Gs54jv/YBhkoIvCTA",
+	"UXFI89M2p2B9MSFmfBPqT4PoFE8lO/mfONSRFRMc+oqXzkfO84e//Bse+0QqFj8Xh5fIJ+JZtKwtkgWX",
+	"oyB2DaQFDh1LiT+2i/GeeBLvojmVHhDjPeczXUJ/hpytNjs+Xe1zuIVD8ACennwwzk76/rR+fMb+9HPj",
+	"kLkHaq7AqSSRrghLVOSlzekHpWvPGtEPqXiSQ9l0GiAqkfoJ/olhgDqeQgDnoFw9wIx1OMChqNSH/OCQ",
+	"wfT6IcaCZaUwgk3KGAfzz4kkFTiVdGWQtfjU+2VIdgmQTAZ+cOx3ua7I8yjTn0FBpJk2evn+ViHZkznv",
+	"azz0N86X5jMZQYSR/v/vmxr+N9/w88GG/z79n/9RjQFRQ/ztC5chzhxoYI5iI+V2Ko4PvTZFDhlHWosZ",
+	"Yiv9CyxsvkD+jNAP4n1/JqgzRQ6fyxH4n/FdgBJG4WEf54Pfq5h49HWdTMe2yykIG3SoJuSSdVIMPijy",
+	"YOCDgdv3D3gOoCLetBYhGneR9ig3mnn0cFO/KYVHSuGNw5nWEWprISpLU3NzqKPL6k2DjyeaOjrqUVqM",
+	"cRp9pYnJ0uywIj9W5CtK/oq5unzOxxlTY5pALxL5yw9eGxSiyiA2Txm1+3UteIXaQCNxNKMgoj3AyBAq",
+	"iYSi+fC8O/DWrivyr6Vb7xR5WMmP+i4at9QhptIpiRWkEBMHGsRsEuH9FcuDC+rkMLkY5KdvkPxeGnmu",
+	"yJfgAz4IGtex69HH+dpC357pDnVGwu1t2l/N7Sc62ttCbV2gzn0T7vB+fWRM2pnp4rR0zOTqSV10+lBR",
+	"TOTPYQ+lzZtKb8VYBHvY5WrDVt69Va/c13dvxQximFDnppGfWH2BD4kCL6WSAeoC3dyikaPtJ5CXWCIv",
+	"wTyGY45hNnVxLFAY8Jsi30dkPbpvwanS6WYVT/YVeuvhjNDLMuvViC8i4FFlY7ti4fwxnk6z1oSlpqc4",
+	"mmXGdU1VvIWGMZAVHqTPqp/yaVeaTZ0oY9O/wvqIbJdfNSIfPMCYm4ptHfDfMu4mnRUZtLeDj/7I9wjo",
+	"ZGert+AdXmIy2uE5MGV59hlhjGMTkqFBfNX58uACCrcgf6Sj5R/hFhREZ1O9DaJwjmns8BZUpIOeHkok",
+	"UcZTiN1MJNrP+Rq/r8Pietq+VzCUgMoadg0SJHZJ5DfCUwiVCICph6hNpcKgev/5Fq85qyvM3ndk6Njs",
+	"/XgNuKqpK8ASDLuP6XzW7q4arWCHStVFJ6pFSwFP7NibYCmDI5NImHkPwVPu8oa+7i2x8wjR2z3wc7BB",
+	"6jKaLpfR8qm6DuFUREYlFCvgHkhcJQKpJmwbgxAjZO3naQP3Fjzqjl9jqWgW7GJsFzQ+uNL05dKtVa++",
+	"ZxeRppYIw+TraxgZ/qzGJNyFIZs3EutKAGfzC8hP/lUnptX1GaKDlG/L5WuPPDupLADnJkXtihTkySFq",
+	"WZ7pS9tpSurdzap7V727VJ0nXFUXtgGLYSNGfgx5mOpYaCsJ2AowAuZM1bv2ERuKOjZmZHAk+cntnjID",
+	"c4AHYOSB+ND8SOmXRUOf1QyTbx8Ql6WD2cVsdmWKDFDxlCwE1E7QI97RxmqWOGXKAYq8XFm8jkNegbSW",
+	"f7+kyO905jZW/nMFK8nq+gyE0eXndO/QKtb4Hwe8kKEEtmZ7jFq3RfySVW3evIXX+dRgAaUrucrsb5pN",
+	"tzCnDg9tzt71SjCweZ0ds281pntiA9pLLUI0wYse39kFRYIYNijBj7aVBJA6OOwWKr0rGoa35eyM6kGZ",
+	"fmpTCcNQ9O+jtHije1b1hSWqS8I/WeYVLJHgJW7eH1JfTzCtEO5qi2l8XCY4v3+Vlyorv7oIZsP8KM0B",
+	"rHIsDgwq6tZPEnX/K/ha5WeEhai5uYBvNzUkfEpwiZybsuTgTxR2cSY79iQj1HB2eDSeQz4Fpvs7KBy4",
+	"UFDddg3QRlMroFVMuulXJ8c2VnMOFcpyt3YjacALA69OW6st1AESNecSBUnIWOQk62w4IwQQUI8moEEc",
+	"VrB6pXRrVZEhp7ay8HDzxhyJRHCTiyDOYHhNkW8gv4GQRGNdgVA6jE46lizAsNi3wM482WGMdtsZFUmn",
+	"FNYAQS0HUp5Yxzm8OnLnp8p/PC2NyQxsdiRteMUot8i56nK3I3iuvSMETovm9hMnwl3MDC7I/cbyETPN",
+	"lyl3/fVmuD1ypD3Codbw0SNaylJhGj4UllD56chfb0boNURILFxX+ETIx/lajoJlItzS0hr6tqkTvmkN",
+	"w1fHOptOhL5t7/zGx/m62ttbzxw9GW5t0f9oCXXrH7tCEXC8tLQ3+zhfe9dXoU6vppbvfUp+CVdAIN7V",
+	"IRwG8ELJP4NDBNfqkFK4/9ebYXVoHAJIVgu6oZYShPOX1Huvy7fmyCZJyB/e+gsInoEn7wcri7nK0l34",
+	"7eHgX2+Gv+4+waGOgcx5CGxoS8WEAz9I5jmZkTeFG1qeOOWl9nG+zdzNjXezQbyEAr50gvGw8CA2vz/U",
+	"4eBR+emIUrgHUQ0QXz6PVdwHeBLLLf31ZhhCI0ATXsK+niU83EqQhi9tefpz2q4gekI7v/tKYQUvZuWv",
+	"N8ORNJw8h7qzAr2330iQhfosDzyzcImEXfz1ZvgE3ydAnMcJ/kfqhc3p0fKN16WrK6WJl8FwSyi4eedG",
+	"+ealysLD0t1JooTgYYeIJ9w57Ncnk3GIOAEvwmF6ISP4pB7hUwRyTmIyg5o8MzZtDOLjfBurVyqL1yFq",
+	"4u1vijwPtjco3zFCaJMi38GUeNp32qycwGLX1lw+KmPeDKPK5UG4Q/ZnC0tkf3S8VeX3P9XRa7TkRAjv",
+	"qWS5OFueHKrkBsE2p62nUzjXAFxILz0yWh59Urm85FiUkWqPaW9+FMdytbV3hZtDiBwR/mlFkX/D/y3T",
+	"afIkGhSKK1xawiI+GCEN2QgPBTT+wXMgluRZeWVjda0yLyvyEkoLYm9ckuJ9gv0AkP9EuItDRyMtHGpK",
+	"89HzQsPhAweRpoUqhbUTHa3wjVJYO97RioKoFf6Bcg83JuGe8mP6/E3HO1qVwloI/7+5paVVG4Rekb7G",
+	"GXzXl/RbvqfkZSUnnydRTEdwZQGc9oktWOTwqaMfQx1gb0VBTUOUghe0T+HYRXiPVnHxWS7iFTqtm3xG",
+	"6EmJA96ZnB4Vpr/IYnVAuTFjIx8NhuaSs7wLEb3RrJRJMWxJ9BnS0MWEWKZccE461xo/KzKQ8FjkGFLk",
+	"scrlJTBdQyGOa9jqoFFGOzrIi5Wlcbh2uHyID2BOp0EEK/yKQJSxDRxcSYAKypboIKTLEjlZwzSgwfKK",
+	"/npxY+2hkp/A9YKWUS0CAYorRHtqwciGydyboYk1OiidZr4zRVD6+/vrCUW2DOpq3og3pdNiqo/lXm6P",
+	"hFFp5B3ciQcoyDDvxL6MwcXS9GXdDE1om2mArq1Tvs846PqqCtBx0fQpU+higrKBnfXERdvJTk0WKBcJ",
+	"8dGqKVzFDPeJhpNyUX3zpyJfC1gCqDpPhCORcDfIid+Gmr4509ze8V1r6BgOvenqbG87Tn/TFuoCCdL8",
+	"yrMPzvEmLH4cBzYMG1IOWEivPifBQhvrt3CIdl4dfAJCy/pjdfQG8jcd79A5TMDH0esHL/wttfhKvTuq",
+	"cTcnY2NsqtGHT+US48By+fLvk1glwZVmfp3ZeHsbElYGFzdAbFm2rsy6MOtZwiTP3SaxpCwW1rQ71SVJ",
+	"3U+6YJ0N+DGHTlBzXqQApnoEPZPuM0Pmd4l1OjghzV+8kVU3KcxJaW1BuIf/bolkpMYxgxpdYhqpyMh4",
+	"0hiwNs11p6RbJThViAWpLdCZTQjVBQEtl0m+4Tw0W4kwDJY4lhukKV34Qn5gYoVfscz9Rim8CFAsTQOT",
+	"uIBLhFUe/wvL+ayp8lNYwjYMD5JSWKMCPfAAxdKdWVyAoPz4tcVwMrioyPMB+xTE6qDIC3pFjkVtali+",
+	"5sIzR89P0QGUxthEByH6Pj4DVjERMBFMkeIDsC6zasYNLI3gCnstodZwd6iTWJdYwwCDREDWGj4/gLNK",
+	"yvNy6emDQFXZNc5MXLQuwM6Y6/Pp2NHW6d3Zgr++/pho+Jll5b40i8uZUcApr+g3MWOEz7rRkppGPB3K",
+	"a7JcZMMCKIazoGXJPJsgH0B2HHutDj8ylqbk8qXby+qzdfQZZL2qI+Ol6VdaWbNcHupC6ADxGUfLh58F",
+	"6qqZ5SY3akeGM9eggtGliUCVkjI6rngAOAaSEBRyw12v4NhBL4W1VQvNqL1SS1ovc2nA/V+Oq7/JpB4C",
+	"wXXbMMYrO5wJzPkkqgxK7UoyRsEPuwC9+7mClM249rFbnTnbgwjK7lwjkZqW0yWzNIqB4hxNVC37sYOV",
+	"EyVMErXV3EcH266V/liFV+uI7Zb+aPBuF05dxGaZm1inHqU4I9xdDU60U0xlxziEPVML3mdyBJrU109a",
+	"7aJlDcmQQVJ3if69ZypkIQo7jNB2XPaEVrVyxTyg1bZzxfYdynzChg8TG9zAvT4grxeevTtT8nnN1FpY",
+	"06zh+anKw9taltm+MCrsvSrvvLRUTzzZqRVLZ90WdmWBLehFaXhSvXKPpNRhbzGhUJqp1nqcfDQqSFJX",
+	"6keBEePy9bddCCdtr8D9kWvDtjcYLJdv0ipl48T1RnRU4EVBxNZz4gsvDBvSgFtlsTAzsoaaRS6SYtSk",
+	"Hsxfb4bLC1PEiVgjsYneGD0di/639cWq58G1dbfQieoj6BCylCPzWuu4jqLX1bLl4gkmBJjrwxdvL5fm",
+	"JMzswtL0QBurTyEI6NKQ+uZ5KbeAvSGu68omo+f5ZI8Qq64aEKM8WJUmx3AWPLj2ym+LijxemrgFaE9V",
+	"f602HRGVvU42NwJeHDwoa8p7XjPm8OmzSmnr66EPggVt7WcT8R6MM3VVoyXGXN1Tsqg+fwS1qq/8UXoh",
+	"O+CsvkRIjUOa66oZw+8hromPDRxLiXppEabITRLhkB/iYgLI2KIewzUBHl09uke300HQt/rsF/Xya6NY",
+	"ivEWUBv5FTi9qWws07VKquvCvKRoGKVOImkgGQXXpPpusDIvsx12rmVoidWC1KDVO3mQQH9CDIdxbPdd",
+	"KulmeXP6AfYpjijyTXARsALwz2UT5+IJq/BFgX8qLSTZv2STsB2mJczDcSryWOn2kk6KjDsxd0aOasHi",
+	"VbU4OS31c+N97A3YsArvhqP2bLxL7ceJTVWyWR0gSNe6dc9hNbHALerLOBEbCtrCvI6dbD0WbiXl0L5t",
+	"CnfXk4RmvtvoI7PoqYVCXxyCQwVILsC27MU3lT9n4fDxtBRemdM2+tTB8c0bc863SYA0cQdRGx9IRt0S",
+	"88zNY6hx4XN8LObC5TD1tYSt2ADMpTtDL9snbYFdYBsT8P/8FVInTo+LWdJTbou1J7OLEXgn5gqYICNJ",
+	"Rk0dlwYdcrGyPA2nffOSOjleXnj21xt7uKg6+Hwzd5PEWBMJx3OB2S2WdMONgyzBp56VjJiQFoUom/1u",
+	"3rkL4aePFrHT8rGSh80SAUeLI7/ya+npA4sUR5HZqqXmys9mS9d/IwXnUBDh+LEHXkIFzuv1oFhJB+rg",
+	"E8goIhVgC8Na8oHJ5MS4lynizJiJSB1pH56zd9wiQStzl0vXnhGdTJ1YIUe8vbQctlugMrtYnnsNPgG4",
+	"hHmlMGqEHjpdGpo/w+IoXCHxmFAV4zlE8lugwTyApKWsF7NJSPnlA4Cppw8BvsamDfSipp9WCmuVxevq",
+	"xJ+bN+bUX9ZcJktbS4GxGjiskbDLv94MY39dM4ea//M/OXQ8xaGv+T6eDOwh28SoR8YCR7NFEUSC3sEU",
+	"YpjEiR6PZ7Qwyq3BaIbvYYDTxtr1jdVfcMTsMyJgegWbLp5p4tnZwJwqJnqKDtVjR6cpdg0TuhsGE5pb",
+	"KwRim0S2ZtFNFERq/kYlV9gnNNAjwSIFAXeJNgHDNejT9vB8J5DZisNoy3gbjrF8ZsN3SrfvafireSsB",
+	"i8HTXF6fo0+4JrOpbUavhUo1LIpuqMQ0Lf71ZnizsKgOD7FkoT2UXeqXUT5hphtmYvl/BvrdYCb9keNm",
+	"+W2xNHELV9AomljpPOD6EZOFhN1uiYpqbhRLX9YWLFjNYJTyoGqKMiAbRzWWFh8T8or8ttSEZSLfe4rd",
+	"jaaF1jiLSjR3hJCtcixItroJsvxykqTdla8u2uTbmp6nnVahzpkVWUU3Q+pjIhhDvxkIDfeH27pCnW1N",
+	"rWeOtXd+Y0afB7YAeOeNgrIMQkYypiCo/g1OdoEgUchHkoso8lVTw+Ev/4aUwoSRqsSYz1rC8RjfcA6q",
+	"QF742xcX/8PnvpovDx2ufzVfHjpsWQ3yJ9O9uEA21svBNYbcix64LPTQ4b+zV8qu/O0hG55BVKVMJ+72",
+	"5CLsYks0nVi7zTYQrCIJNqozt64ODaorj0v31rS6PbbQvjcTkDy7jENgwXKnTl5yhIavoOOhLvfkGZIO",
+	"b6QpBepYuqU0sksGxMbrKTDLO9YNatfqldLvslGwppx/5VHn6mUXWWbc2dVX6twInGHxVWk+X5mXvQ/v",
+	"fiFk1NLtkfKlWaZI4ZIXXZlfQts0IrALLaRJoYWGrJjQgk/TP/Y09kIeYPDAgQMBb+zVKIbNYtRwU5jF",
+	"LhF1tjTzyA743mYBBIt4qvfTST/rLANXh0Nfompv13yVfnYX8lK8FiEw2CbyR4TebkHUMImItAFvmjUB",
+	"RHNSCrZtd2E93jr1b01eqRnAZtmgN6V7v0gxTlGvpoRSv0SxbblhF9m+jeHrgscOsHBvzKR89R6L+bHi",
+	"UnBFKb1wH+k3Sgop6HZFvYP0Is5FXth4dweKo+wC59kqz8Easa8RZzVyW+NBNTnFtpnCDrCD7RDmugmp",
+	"50aL1alczVAr6+x120M+Ubx9QvG2p1rVInfVxH5XUX8HqZ2Sy9dX0EBesX9jqbuwbA3UWFDkvJGNv3Mq",
+	"xb8VF2CM9IHT+w9N/Gfb5/qqBxi24044eukZPXxyf4YZ2lfpLdjQtWo9jq3EgUi2sC1S+GBx8/J4Ze4y",
+	"Wa2CA/cczS9qxwq6hjraN/Mp4HF7AY/mRbMkog6+R4iR0KIzXkv1aBGPhZu6iASVllwA36XmMXkaRzLP",
+	"kbJSRFAhTYdwUiWxhxnVzupJt2BGSzJ9F1r4nb4V5iVLzH7F5vb1NsX1gHn5z4lql131mmoGN7k0kNmF",
+	"6/J6L5Ylb/VykP8Q0qWbK4EP5apcvUDtzp7y7/uO9LV+1OijxVZ7ifHeRUJXZ+b0v8WV4JgAL/eixUoX",
+	"nuhZ4CS94v1fE9nBR31XJyVWMUyjx7JSeLMlrPGYVSeI1U7X/fSqHI2nA7DmBNaTfqpXMSluXh5X5xbU",
+	"1/NUYHpLqO07HBXe2Ya7xXWHQ996j0nHbzf66ObqUI+I0Tx8qjQ6pU7OG8lf2kzgzJLLD15XlqCm64tr",
+	"EIhtKdFslEOCBTb6Kk/n1V+v+C4aJ9IdTyV4ttJMVkVaGWDsrHVCpA2vU/kfM7vrFtba2psikVBnV7i9",
+	"TSmsbayOl54+VOQlM21EzCYEaKwH2e+4TB0WIBHZMPivWVVlWA1UCOzpMa5uhg6GY90cf0kT9Vk1jeq3",
+	"pDCMiGbBFKY9hWmBAtsJ1a7YrNp79Z7zmtQ37NIivYIkWXGOUZC/7rLONV8gl8t6tLbNIpsQ9Mus+fA2",
+	"jBTbSzL2eA7ZLUXZUab26hZ0fSmm59FyU5wNPWjHpLkyuyvSCc+Wch46RDF1VDF1NsHqstJ5rBn99xdf",
+	"/hcKIvj4X38/+F9IvTuK6wODhVp9d7v89KpSuA1WhfxDBprHBBcfGa78q7We0ApIawZVfXBD9vACfjEh",
+	"w8cZnJgUQa88flF++cxWwNjLsIIoppi9RKwtnLUidAXspy1cpjdlbKdueQeu5FhcSMRCsAgWW44npQyf",
+	"jArs1r7kEKEW6GtMe3D9rsE/N97+Vr55iaRNY/P0O/IBnewM4xSdYa28cv5VuMWoX1yv+0FyyST7qqur",
+	"A+mlrokZ6hV90Qw5Ip5JVN9hkViObdcLkTOvX29O/wZ9u5eeuiRAZAbSjMHVaxObs2N6Pe2ZytPr6vAj",
+	"7YBKo7Pqm99J/okRkFff8djIAdmhcWZVMJQCB48gKRerQ6UzBROm8A5Q9lknx6EGdqS9DXWk4BJFLYjN",
+	"5fgpPld1N+CwW1nXw731pTjw2UNRgTq0U0i9xyWuyFnuTYYaydxkuxbIajZeD0PZnq25FmJCmhczvUzL",
+	"Vml0UH3722Zhsfz2X97G2tmQ8PhONkvr5ZN8D0uTqvzrycbaWiU3iIKI7LiSG/TY4c6tYJxDf3YN9k5J",
+	"EpYZmlNZ1hXoob4TEAGHNMUDa5Kbt4Yqi8PVOu42M5ktiYMgFMwgtJjO0ylsI1vvN+coNrP7mVD0hs3S",
+	"ZfXHZGlLrxmQ5TBbeUyEqo3Lu4DF7w9/a6Pc1nGsWvpEFeinoRwYkeMqGbIHCwG8ld2xocJpjlk1Afk1",
+	"1kAaxOPa32puDpc5be4Kd4cCbkn+NMBXgWeqqoW3OhtWU8KYI6QBPJWVf91X5CEtmRx0eu2jM95BXrT1",
+	"tSFN/kALBufcLWsJBlIK1qghVpmFxjyoM9TUciKEq8rjuGu3aq+JlLTNJqx4BFZpR6MgAQoiUlWAVMkn",
+	"Uo9mjfPY57WGrWP7lWLdKhbgkG7W+3RZBAa24QIMuBcW1FowyiGSogpwpZ7bzNVXULbKXkr3X5VG79e0",
+	"+zg5bhVsMAYvLxZxWfjWcHOoLRI60xX6RxeHSMzYmWPh1hCHIu0nO5tDZ9qPHQt1cqgz1Bpu+6bpaGvo",
+	"TPvRr0PNXREO6TXcrU9Gupq6Qmeav2pqOx6KMI0927CGeHrJUd9ji2VQ948BBU9qWlG2YS7xWQDEilfb",
+	"kSnMM68RCGmCICmpwwqEVHJ5gwJRFXwspU207tgBraTP5n2nQGLH+TqU+PrAy3Zb1fVaimN6rAqFD+qv",
+	"N8OEWx4hLZs2Vp9yZjHxI5p4hQNrONTU2fwVnN+R8u/5jddD1v5gZBgf5zPe9nE+/Q3vrb2MZfg4Hz07",
+	"2CHe3VGL4CWAmIt8jkxPluI7bZ5CzZBZ+2EwgeWTHPp+5ND3Kiy6IZZnjy8Wba7gLpKj5ZFXavEmYsVP",
+	"yEWna5hdComl/mnRbnWaRBg9rG3D3npZ+uXRxjoEpuJaSCNa4bPN6d9KvzyCs8IpAuwaZ0Ifn8i6Kax0",
+	"IXTSYNeLClvbrKzPyRI8yTxq8V5p+m1dgibLfqKJ9jvSxzvcFmw/2YXU4bnS9FPCbrxXI3JJ9quS6If8",
+	"6vDjjfV3pdwCCYjcgVamDJj2eDa70tB/y4LfVuSrWh37XXxR7iIUo3uvjvqna5Ckuk0uminMm+GFSTG0",
+	"ZCECnp4ICLtBRq1e91CdZPeR432iwg4AX01YqwVAdctKWh02bxJTfSynat2UGvCyBUipcqdGtRG09dvd",
+	"c6Lkds/d2URSEPmz8YRLSFBNS5bR9ghnsOgB7k55JRHn6y7pzif5xIAUr0Pgs+ynSX/dKfhVFq9bcgK6",
+	"Q/9A6vBQ6e6k3j4Tt6KlFEH3Dpo1TU/n4v1CTIOoOk+gF1rc1lGy3bL/E/Aya9RdjG7ZJmS/J9MH5+uz",
+	"I4LnU96byBPr+jgDn0wQscPZaY9YX1e1aL3Ar6XZmSWtBcdNIr/+YJHJXNXJcdxl0EI7N+8PKTlZ4yFQ",
+	"9XgZNXcDiSX6Qf4BBL4tX1fkS5v3hwI7U5zadpPbq09dpWqwe2HgTlseWtUUXjqw0TDRxET+XOYI9JrB",
+	"XVQ5BPFIkLp3hERFllaHrcYY/AKBO/ycd/tL6fYSvYRg6eYy9PDDKiduwG3+VlodDhrzYwuMjq/OQCW9",
+	"EYA6/Ie6PgtQp3f6bmo5EW47Av1cFx9zKNQS7mrvPFL+c3Hz1pA6scIhCIgMdR7RC8cUjY7mhuEJBvBx",
+	"PvKqj/ORN7xv2exXmMsbmiIcP/4+SMeAQlbkrZdBdXCxufNkC9gIJ1Yqhbc+zkdWTAZpj0SCTtwKanCP",
+	"C2gBHmlS+JouTa3ptYe1UXX3smU5JH5W77L+r8r8ApmzsvQUvEM4wY2ckrY0uBdMh0ksX3XlvHJ5SR29",
+	"RlRnet8kDtPJ8rOZ1Ale/PFYSvxRCifxNCyF11K4Kj9FZjG6mSGcHkvaaY967nhML8+jTCZmk2Ba6NQw",
+	"uIUoM67r7jzZ1hU+ETrTGfqfk2HcWtG5dGzwwUuvKr5KgtgniKFkX9g1DzsS6uwOdZ4JtXXDPPQMi1iC",
+	"WYJ5XM6nWqyAI8NvR5oaayBrmlU8xAxRUFhL76BAkr5nb3rHVqDSca9Vr3O7gFTfbNsFHnfMcr0l1wr2",
+	"JJCL9NtzGPUMfqXPf4RY6jnUfrJL+wbqKM9Nc1qk+5m2UKgl1HKkMi+TIaykXR/Hx/mMEYy0AO3dOug8",
+	"vXh5Ga9NJokG1p/AsU7W6eM0O+LGuzvlazegnOC8TPNAWO9p66nVAdu0LbQWVIsCL6WSLtFJRP3VTckQ",
+	"CHCXBKWqY9Olwgu1eNNjWS5eclOxSe/9yuL1yrtn3v3WW1UW7L4v6jeWiBWxJd3brEm46BskYqzfKv8+",
+	"TyIqMHc1S4Qo+Uvq0Dj0WC0U9IDqVUUetQLkyY5IV2eo6YSPs9IPDJQdTc3fNB0PeQdIrdAZ7gGMgwPW",
+	"iYjg47R4M3qBEDOLyzLgWhdXdBEBVudceJAEgZPqiWS/GEyh6rb3VFx5ma4PrLfYekfo1O5GcDI5vl4K",
+	"1Quvd4kxxENQjRRqRO/V1Rq7i++pZZDF03szv9beQM3luq7UUv64pslvaBBXWCnS8UhQVIgAl04z/3oz",
+	"of75qLw4unljEufs2FDn6Mm2ltZQy5mj4bamTsgm078gcR64Z35TV7j5DESEgBv5u7amE+afdh7q4yim",
+	"h0cLt7acaW9r/Q67oLv1j12hSBf57BktQSODgPUr+IqmaPwEQL57G/fqXYZmQA+ACJpV9400z/wU88nN",
+	"OzcgaQDiyF/gUDrS4+eKXu2Tmldepct1AJKPXsPvWtr2GzVnyBRBoiXB08V70PvlRr489Ux9UDCfw22K",
+	"4PZmF9TiA1V+WXo9reZv0C51yNWEbUxuyqPlq4v6CEVSamfj7Tscr6/df3nksTo3rb1Y+I3EmRNAcL5i",
+	"HApoMZPLuhYDHn/1WZ48E24JBU26V7iLydq78tMRZExIvw0ZEBiByJzGMIyHT2PIZ6aF5v/Ukynu655Z",
+	"U/FyKXnMRzPxPlatatx2Lqj1Dq8q2e143HtcSif4gbbqnTdYbwq9zLwfbFHHyQOQbDRCegLSyyHvbTkq",
+	"3TxkjxocuwG0xqZ0q0J9nSjY7S93vhZnVhJEt8h3swVjuGWrfMkYXz8mTgfRegKvAEFquhWpJGpPvIxG",
+	"lSoORII5uOtK1Rr6+xfK07wk/ZQSY24eTdzD/5VSWDESCL7+tgu4az6vRXWQ3pvyO0IzDVNPnZigmSR2",
+	"Fh88wm9NaHUAqhsc1vROUjS67sqHnsi3Ony5dOvdxwOFNvhTh8aJZY/wzI21tdKliS0BnBXUIOXLMKoS",
+	"YyQ2nNbXWcp7X2LNI1LD1cmMxGI7N0FeymmamRYxncurw4/AqyEvlH+fVOQXJMPIeAX5m7uxwQUd/yrS",
+	"pBedhxJRhh8Vy5CLYHTIyaeSFn8PMSSuIFLTDFob4/9PqZeW1MFhIrthb8sl12IAlP/Vum/rRBtrj9S5",
+	"aRDncU0xc8u4Eb8i3yAKVh11OO2+T3v2v3m+5ZVL6i3oDbnxrggVEWyedT8uZicXER4SnyXu6XBnlhRn",
+	"QJobLZSMhfrBFBZP9kBKQ/nxa9qRSwYvjwzr/UAv1bWf3XHI7qD3cWcchSycYddnrY43bu7B3XPsMbGd",
+	"7QOvx7PtjK0x367m3qtBdcyzMUoGFm5o+iJJ4TH0vPxvinzffD6X1wsPoCCK9klSJJoSofvmysbaQ5zh",
+	"UySlNZDfjFhp7mbdVhETFvK0QVVINpCGc9QVrpBB+j5HOO/5huYaLiyT9ZaLMzjlCBNMMmJOJnhmxlIc",
+	"j2e+yp5FTbG+uJQSgbwVS38+V4fX1NfzSn7KSspgbxjbNUiGLZJaA0a7BsJF9HIgN+ongm7UDvI5ScVI",
+	"Py4fa6ngSC2I8APrA/kp6oFlXMhlzvg1UF+DmT7JOwI090nSCSEjxqNuQ2FIseakpLJnE1WiapPZ3rOC",
+	"qL/fLUQzJDu+dl5Wn6V4XL1h6ltSHE2mC/e2sT6KmrtDDYcPHj7U8MUXhw//ncM8uOGHc+f/3hA9/EO6",
+	"4cu+z/8ZcOvDckKrg7udBLh09mwiLp3f3iCicE4QhWSUCb8T+UquYDiPtXLW3gGMrviyhUArswTMaUaA",
+	"lJTKilG24d0wYUENbj9QoSBqj3QHfHVUu7ANQ6yRRmD/+ijCYWA/CzEO6XfJoU4BCLQQw/74blyqEw+z",
+	"sTqqyFMGSUHfxjPnYyL/U9KTh+Qngf8xKUhMEtP8bag+2YmlxWtHaZnJAhk1WVATFVJXd6zfoola2jck",
+	"79SsG0EF0lX3AtRj6K9p+PkhK2WMKtjbDBb82jIWE6CTLlHJWsxg5eHtyh8vkF99+3zz3jvMCqF8lx6F",
+	"uka6RpO0UffuU9WD4z10ypfSKS3Zcxun0akPw8bsjJbuVPe4EfzmFnMk6ciDbWVQWmTlcMz1TvNTJF7U",
+	"pgciD+YMRwaAGR1on10/T3qH9RjnPICzY4PJVOYMf+4cpoXIUGeRvz0tJAGVgZlYsCtAOW2Myz4Dw6RF",
+	"QRKSGWpjCeEMFKfy8Gs8eUboF6LZjHAmzWfOM56K8kl48Cz8mcyIKWjmf+bswBk+Bmqf1n0/mYgnhTO9",
+	"8YyWKymd4RO4X/8ZoT8u0admwoAL2LtYlUzyhhO3MVA48kjth2oKvpajNLNIgWfl5I21aYNzwTfyCum4",
+	"rfVDw0TEQVQdpG+7BM8kb1VpyjYoyQ7QDUbyq+AdJzqpfdjwHZPm0vSrzct3kL95IJpIJYUWggZ6MPoB",
+	"/RgsqMAnMSCfi/cDd44nEtSfBG2JQTNxlo/+CI+kxB95MZVNxs7wfXyc0FXP8BnJMPNMLdCpi0A0KhMZ",
+	"il44Das+zkd9xEYCWH0yJohn4sk+QdLxynt1T2O8Rp/GDqHaxAzJgTQmafQRqwyJx4cAlSdm3U894EaR",
+	"cRlHy4Kdo5JKmX4romlYlstXG5i1T0iNfVe6twYZxxftl0GsOVVUenmMNoPgmh04dBJzEcNwSMwysaOg",
+	"Bo9hk5aFZETFeEYQ4zwK6gauzyCKCxsJO6D1p+VpIZqSBqSM0AuWvM0buGTr/cHyrSLmYbX0Y32u6nY6",
+	"Y4Oq/BJYBjbAIX9zR8iTHGMs0aVdBVg2F7FdyHS7EkE+me7l0AloJcihFuFsnE82HjrsaU4NzBzmaWJo",
+	"lK9Bx0r5ns0w4s1xKSQB0ASx+pnZhiYnRwpGqU8n8eEhowIIggYqAXbyaEZMxbJR1mac+FW6nVOH7+jl",
+	"6WhTqs0gs7EKcRn4y5mAt67PUqbJQEJvK1kfc67EW6a5hh/1m1rxWxc5vfWM59KpIjSdYEeLRUInukOd",
+	"KIhCze2R7yJdoRMoiLpDnZFwe1sE0h5mSmM33CDK09kyzNieFm2+RyJB63wvkuHFTKh/q2/WOWctwdvF",
+	"71LUUpY1V4vpMpHvmcCsmT8Xy9ee4UTT0dodKp2iuAl1FiSvKWOcoKHVlTEUyf50flAsTb8qvbhGseVm",
+	"HMoK9N07r4V3nBkjchFpvdUQrcDD96b7BPltr2m+GL2aBq54Arb7yy/1ZTFnAsKFbbU6JbdFTBbW2PMY",
+	"gzuYLMlOOqZpcp5sFsYetRruclG9/Fq9ckt9+wBXlIZMGSxYYzPPCvpBsqo18LeP80WlvtoCmXs1dWJq",
+	"pwzbyH+WlwT9BXrC5s5wV7i5CUx2X4WPf+XjfCdCLeGTEEfa2v6tj/O1tbcxAkcvYsNdNAvDRYAGEiZ+",
+	"lpfi0aZshiGakLat5auLm7mrcF1H4VFUWRqvLL75682w+myodOeRulYoPX1ACmASfywmsNjBDc+byHQ+",
+	"k0nDiZwVeFEQ9SnJX/qN+b7+tsvHVcn5wc4DHGNbeAGmpq+/7cIxLks4zOOJ0e4OS3Yz9gXhuewruog5",
+	"5bmUW6FGaDCnxXGtWWO7FwnBIJm9+amN1Zw6WCDOatKxjVEfZuUXw04GIvcLGUgPHhUXtNc83rgtXBA1",
+	"R7oRNCh3lLf/680I9nyQzolEXryHKVsRNXWEkTp8p7z4Dvk7zvOSgA4R78yp5GeflW4/KS++wxlD47gT",
+	"0yNF/vWzz04lG5D2LCK7a3Rt+By0yweQvsQhEk3KIeeeWd9plkI/jh4NcMgZyc4hOluDBCNyqHzrYene",
+	"GgkSATHh2QSHnMfjh4MLIv0UDcUsqNUPgwndWwLCYUBWb/Fe5eGgn4B5oBEZhIJDkaPtJxDppcYhSxtL",
+	"Dn322dffdiEnRH72mb564o4qzd0uv3ywuXxdfT2vjk2T6yE9/ch94MZjK+ov99SRy+jkyXAL6vsCGT1B",
+	"8SJnHpVuP6ks3SUVeeBpvGZ1fawy+ryydBci9qGB4C9YetfKNmtgja/X3DQKIgMMMUATOAZoonJOG32H",
+	"Dhw8cLAB5wQexo7RtJDk03Ffo+/zAwcPfO7DbSXPY9IS5LOxOCbDPQL+B5QHrCkBD/dFBF6Mnm+CZ1pT",
+	"PRJ+U+R7hYwgStj8GIf5/pkVsLWGRAv5gLVmBrCwpSE2z6xNVu3tcGwr754TU72W97yVSmUPlknVP9Rp",
+	"06SCj/fwwYM+XNA9mdGqPfGQuECU2CBmS40XqElqucmdIU0uyc98HdZX48QbL7j9qEvOWzXnS9neXrDo",
+	"sc27gsj4gRX3Uysy6KLd3+hr/wbe++LgITdVw7iu4Mkkn82cT4nxn4UYeenz2i8dS4ln47GYQJKrjG36",
+	"aBpYfjZbuv4boSUauT9EvsNiLN8j4bRWjIinQRTEoVdNiUTqJyFmZryehhmCsMZgItUTx/eeTkkMrG3F",
+	"PxN5WJAyR1OxgW1AoefoMjp2zXhpG4Gx9QQWGvOdZgKF+Zbm59gWllZt9Qdnb1pGdxAiKdnQ1/j9aRra",
+	"6HMjMablG68rs2NaaJ8BYZnzNihKZTNVwQh+dxzWF4zWnCnUrJ3eTmzugkUA/f70ReZuHyj5eRL16Sp9",
+	"kqDOsem/3kyQt4iB32hAYD0aFu5pyeVUujmNjVE+wydSPcF4r15xQT9KW5DpzANcJKmoTjxTV19goZFY",
+	"KzXBlNkZ0a5aEfm1NPpE78uq2f0OocrsGFgEoarwddyeNEccEKUns1iUnSHxL6brAaoHA+oY4YErWpE8",
+	"6NUkq3MLmhDzbIJ8ALll7DUOntGcn0RehTE4dD7VK0Czq5NiAvn1PwIcEoV0SopnUuIA/sX8M8Ah6oA4",
+	"lBbjcLmtfLIny/cIHErwA4IocQguiENaFLmRxsSdSmrSDodYrZ2RX/s2wCF7R2wOq7ScoUL7o2l4zGz8",
+	"jfzwOcAhurPvqSRZEQriJeEwz/+Psu9xCB/PZcjGz8mmeg5Pmb/IC5W5y6Vrz/SrMOy2xuD2naKgZRl4",
+	"xFZ4GAUR/VDE+lARFzu0tMnG5mXYulJYa+4IKYU1owW5XCSKNNaGttH/2q35NQHXAB6eav9r5JlNjqsj",
+	"uDWVw/ygtfTNT+G6ylfxlMv6fMbpaV9QrU2INRReyk/h3sjD+KcXEDyeXzH7eehrI6ogNUlR67wnL5rD",
+	"5qcq767CtDCnOjmGLVZFl1TKsdLMAwijeHodWumRhErdnqU+v0vCyUiUNC7ufRMCgh37hxg43IqHLFBr",
+	"C2x/bIw5CzrR1NX8Fa5+SzcVo3s6O0ZaQVhrQNrd5mR9ZA3piQIJJjmSB2IOCyw6mjmCK0ia/oucjGkT",
+	"yXigm6FgyBxT5Gfq4KIiz4ONB1+Wvjo4EfTF4cPIeuo+zsajiILXTMiwUz2xUmHH4miQIbiCFzuG6FXX",
+	"WOmM4cZlKRHkWCyKhJESg6trMpL0T1eT3HqziUw8zYuZIMhaDTE+w1cT3lxac9NKJfAi/8muYw1/t1SK",
+	"PBtPEh98dRkMT/C+hS7t/i2d0xmiF6MhOpZWDtaWVo7yMT2CYK/0Cc73xeHDe31EVjResKMs3SPcAv9a",
+	"T3Kd+LB6z1sVJCL2mGBIBBv6NQQWIlpDao9EautHQj9sLfhD6qwUvPBD6mw4dtHVunFcyITw41+nzrqY",
+	"NrTYFQ2b8Xg+O2QzrQQuMeendxELzL28X0UY3vii9httqcwxiJawAQarTyjhT9Mk/oHEiFNwQfa9FfGd",
+	"ASzBWOqnZCLFx1yhpkV7YH+DTiqaETINUkYU+F4rCNWm8A7g0YzYlPCG/Jq+16CLpZoIKS+T8p6B/Qtu",
+	"8MJ/7xjW6V0gGcdmAC62VI9B3XqY/NDBvZh8492d0phcun0P6jOA+jFWB6Lh+4ZiBoUcVrJf6Al/IzuJ",
+	"dxmhN53gM4LkimugRZFpuoxnt0lBPWVcWOdkmB73jaGRcYsQX/MCu3OekG+MfODt3RznYici6eO2I9u6",
+	"9dH7vVjz1j3JnId2aSkskCDLi+1zGXNvSKGmLjtA06a8bgG4iRyK/EYCcsAjoFcjSMEL+kdNfowJCSEj",
+	"OGG/BX/vgP3a8oA5/g4LBbthnd0DGkVqYW7jGrkaMv7+uJ2De0h/PmSR3wkfOyP1Y9d39LwTTkjtifcM",
+	"KrvNMK0FNvbYSOMdYPcvr9yfesbuMVdSZmWbzJU4x4IScc9IwQvaJwdrtRdmWSo/eE2iKbHfoUD14Z7S",
+	"aydrBmjalATKqGZY183WubyPY3JuYg+LGK3/a+O7sfiPkm/vXyAnsGD0d7BBhA22aTui7cny/Zelh5co",
+	"MA73uoMxg3+4SRn7B5J2jmRb98S4FCMThQQVOy5ly1C7dWGiytU7ZAj96muRKVhAb7yKf99SxV/zHC6r",
+	"679hq/h8dQ+kueB8TsnlO0JtLeG248jM85FXShOTpdlhRX4Mzeawh7kzBN1TQy2Wx6itrxuE71SyPLhA",
+	"PIIaCt3Il6EWSdFKMxfUoXH19Tz2ew1BkR2sV+k5zYtOgz4MSEU5QYkYLaWWTkeymQrwOX68qPIR+Z4+",
+	"djaAx9kqG6hFL7CRMXgB/tGknDQ7tdAgoKTTB/I3NTeHOrpCLQEoKzD+cmN1FPl1ZIfvcBWyX0u33iny",
+	"MPxyoqmjI9QSQBTW6V8iknKM6MpGVMgKbmUDzxjBSd7DkBwBLA5MJ6qGBdPDGaF3D7GdY45NrmQ/qmqO",
+	"s3qv2prz5hjISNQDkgdIIPkTUdtToqaz/iItgGyHqGnRcg1paNsRr+GZaSUPkxYfndnEHjlnHNN6888g",
+	"Pwk0wxXp3o8d1JbPQuXO4Draltqt+hVqu0Ud+o3sqNfGeZa7Q9wc87xX3w0Dgj65b2pYmBT5uh6oWTSg",
+	"tYaFyQO8V3XfeIP9avQreEHMJrw5cdio8DHYaOq+lKrOmPouxd1g4uG8D+4txrd/8zHdod3YsQOMpKbk",
+	"TpBtu9J1VRfNe+NY71Ucrwt+P4nf+4W7VfWfbJm7eZLKGcm6rOMxHwl28D1CB/zpu8jVfDgS/5l62NZq",
+	"rwWMF7hnkSKvgm6Iq/KTghDIz8guyk/Zs4tc4un/uZW04CifEXpS4oDlXQ/Y1qy/d9G5R1sugbxCOnDZ",
+	"gYK0xMnJONbf8jxipdVgCHpFil6Yuh3uB8TcWFbKpHpZR0K1/LOv3LmU0u0npenLkLNye0kP6y4yd4L8",
+	"lkx6jC/L+itjpNkQXCAuZ+92h+d5qUvoz1Rf9m7aagHQY52ClE1kzmhXva9C+qwn76YR7o4muLvcdD9o",
+	"fZ90PS/ckJS2cFKBahyRSQK9KHh1sL7gBe1TPTqd75O3vU4g8MqcVvQ4DKqgqQeg8KJgblex3AN18mNS",
+	"Iom+iPzQmX36slknbGcYT7W0TNtCjBr/TWk+el5oOHzgIIe0qTuFcw18tFcwRAur+mmQhqoa6BY1zt3l",
+	"jPtBu/w30CmrYYAXZc0DpyKFTdyIU3NWFIVkBvel3MUbxePvdPURk6ZPrIMMQFUd2Vh96myh6TBBwaqk",
+	"rSQMpaTqOm+7JDUbe9hLxbfWwykx46Yl00qxi6aE/6mh8Dozu8Ftf9coOqGVi8DJew9xt7l3hLy2ho9y",
+	"LUfdlDRSkaLeybVmxcgPDbkevCabc5siw/fUN75V56a73heZlVIUeW5j7RF03caterQma9UU6jhp6d+e",
+	"TAzsD/WUBuz9pKO6FR4kyqoD8V1Tt7eqrVrOZXcYMz3Fe9Vba8HAB6C8eoMdXFPEC9S48YjgBRzUVEMx",
+	"TItC1AlCtZ0beOxPUd/JmMf7VPJTm3fuln5ZVB8tIn9MP/cYri4BEWvLpH79lm7cXf3bF/d6cM+wv/2b",
+	"DxxMSKnVbTOLahrc+wKJ3WVK71Vl/OjBUtcGiZge2Am2FOyjuuZW02WMRq57A6vcbmpILDFbFPriwk8R",
+	"0v7Pq1Ouk37J1dcngfxe58gR6p09Fe21e95Pgj2J7CZVKR1qlcMVBY/RXYe3JuBXNQfiEoSsIo+k17bn",
+	"4oRQDdOlPuHGuzs4AN6IZt9YHcddZJZMb+0XBw8atehgLEEUUyLuA0tM4aRofGVpDvJz2J15XPQYHQg+",
+	"dL6k7eN9q0pVcOoD8fJtx6BpQVdSOrIudPXMwoIX+vScEg9+uD0Hc3byRx/V4PuTFlcVdnQPXWV5ujw5",
+	"FCyPPMYdM7TuBaSfHpQszQ/r7Xg8w5gnFe5jBpeDe0Tv2r/5MGGPpRFuR8xgp955lTNQ0FFD+gMXPQyl",
+	"+CNDst0Ua963su0Bzf8NJBqilO++RBOk28dZ0+DsRMS9N1t+ik7VM7L7qCZtpCw7abGWn3JrsSYXXVqs",
+	"LeqZ/TO4uDvdmtTsCpefQqRxGenelH8A0TvL1xX50ub9IahIb1bXbmb70uQx9BmVN9xgKaFNFs+oXT5m",
+	"60jJOCntvCEH2exOpzXQM6iX26KKpZWrlTcFCF+99gxiiAtrldHn8EEuVubl8sv7mC4u44IGl5ScLEZR",
+	"EJ0VMjwiLdrxmpbwf29wSBIuQQ9XtKQfiHYh+nmvIK1XJPXdIvQwRUF0PIWCKCryGUE6EE+ZU6CI0Msn",
+	"M/GoDqbxZI+Sk0mzUyjifzabzGQxP4mlf+xBePUT6shlSMzWdmichdEmsfz7pCK/wLngE2bzcn9zdwj3",
+	"6jv+VaRJWwJhVVpv/2WzvSIekcDnxuqoIk8p+RHS4RL5O4UfSKfrIPo2njkfE/mfkgGztxZIgRAbJFM1",
+	"Jhw8xmrJ6rah0yeprmrbU+ehDcB5fkwyHmAmi15RtN0ONFuIG9Ha9Fc3uHboD+1h3Agzmj8V21LHtJpR",
+	"Intl0dQOcn/F0tu7GjpsmMb172iAgn4WuyOXaqO/V1tbldu2GNr2wZXbAwuqX3lVShK8oH3yZPcyoaA2",
+	"vzPG/WScSsZq3qnVPkV62wY8X3FtE9R+uLmDe4Gr7d98sDDgsBNtg5RXCx94T7Cwa2zjvdoyPgghwWFn",
+	"2CGOgdeViPPJqOBqUijdHlGvvALdykOCMSTesDqmq5OXtIbY+anK42sb67PESrApX1MnxiEy6/K4Oreg",
+	"vp7HSuS41RDAMmpq1QPti8rJpdtLusmRyoa9es+ZPQbjQEfsRdxJjKi07aBNL2zKq6Urd43ehPZJ5BVt",
+	"3ZCimlPy+craWzBg4Lcq0PxxqvTLLB4YNtnUhmuflX+f1Kwj8j3niLBX+RV09DJPyFC0W0Jt3+HSivq0",
+	"pDuYYfXQGrFRDbFht86Cuth6+9+4yNrolDo5r8jXQMl2M8yafKfZhJO9ozrVQiokWw8zS763GYgNJgfk",
+	"N6PQh2dKYzegVaS9Rb/xjp91bIvkgAN7zCbNU3cv5PhxW1sZpKQKGSJ4s4M1RlzpJmnQUMUMy6KBpH+9",
+	"1vkf6cwPumYeoMKECKm4axaKzMlGl3W65ZHh11Evv1av3KKoRQOKSn2NWm92ol8iPzOoTJ0c55DNEsIh",
+	"QlmDQDOtZ633KiU7Ud8+KA2OWvvwc6h09RVUu749Ur40y6GN9Vvl3+fJkwFYmZSO9TcAFjQSf9XhA5+j",
+	"ryPtbcjPOLP8lM5Yhh1GaL0hpPVQW0JQrzZypr0Nk/HpB5u5h6REJZ49Str6a0tAQeqL/t5EI9X2/9CB",
+	"L5Gf7FYpTBgd+TmGg03fZSU3yKFwW1eos62p9cyx9s5vgGSjtBCL94iCgBeQTGXiUWiQSj40nM/AtFoR",
+	"Az+TpWlm419nQG1cfFyZXSzPvYb92x4jRRPyU5u3hsovL+HZ+hNSfyNS8m8BbuA85/HZPiAwUZldRH7L",
+	"+f1p0Dtmzr/5QC5fmR9VL79W5JnKw8HNh+tKYa109756i6x/TR2eUV8NEjZMHA14PWezyVhC0CETw90L",
+	"pTAC/bv+d7gD+aNSH2dCCKefFqa/l6ywX0Rau1ilsEaKP5RmHgEt1/+s5Aax8/G60V0U9fLJ+DlByhyA",
+	"0fGC9GYJjcYnhAHtsVKYxYD2DlilVlyU8G0tqUwt3iyvP3ZWqEd+R/MWZLachTlTaSHZJ/Q3ova0kOwO",
+	"/QMdPHD4wEENCXQbJHbK6jZIAgEInlWHh0p3J2H7kkYs8B4rb0YIT9N/X0HZZEwQz8STfYKUifdg7mND",
+	"AbwIK8TDFH6brwm8KYhP8okBKS7hu3j7fPPeO+xOmlHkX4HquITbs1cWT57JiHHoyHwqeSqpHYcFF+Fv",
+	"sOprr5CojiulK7+Wnj7Q7tKfTGXO8OfO6Y6Bc/F+8INr55fPax4BjSKeShIiqa6sV57PGrQYhq4i1MpF",
+	"hAUvttSly1JsT7gu1xKJgxDnU0k/URHh3eOhLlRLJseQ8+B1ZWk8wBbQSMsDjZM0iZn4OZ5pON5rEU17",
+	"rdrItVtzHCODvB850PteM2Z3FreFkOM4YtIYUzNZ3nj7DkMi5t7ONjOT49uWOYGg/qdT8PTSWZcepi8Z",
+	"O2Cg6I6P19+b2P5wQEv6exPkVakhde5cPCrEUtFsr5DMHJDSosDHpPOCkOlNHMD/bm/Kn+Pp+gfICP2Z",
+	"YFTq2+KbIDBs8dV0go8nt917k+Amornxx1nlrfoLlgJ43fFUAoNETf3FlOQdWuaOdLKsoqzgtrLuLSOq",
+	"rIwkzqmTY6Xb96C+Fum+SiiW3qKT6kButnbH/XENZsfobmsyOE2ax11JcQHvZaR3vkXQzjk/QpJ4qeF3",
+	"g6XT/d5hDTSHv1EtcL6+7rv72iprbGUL7rzDe9M9Wp2Y2Vi7TjSLT9THpD6c78uDn+/YFXhpJayuDyry",
+	"bGV2TB2eAXPo61zpzkodnX0xvu0e6dOaYkR5sSflTvua4ecDiVT0RxzalV8x5C9sgnC15ZCyIqYhxto5",
+	"51TSZlkBSpj+sacRr4bYBki02oIWOFdYM7vY5+ToeSH6o5TtRX7pPH/4y78FsIXjPC+dj+C/zYxliihK",
+	"qawIigP0xJBxhByOiSqs4IWQS7ipRZgNDdp3K68QcqrOTRsWFkW+o8hF0kVbHYQTKd96WfrlEfkGP2ZS",
+	"fH0fpelnlfkJfTdGCJkWpGzpg6IbV9nUNdxLaTj4nvZWu7HRHcrwhfw23YGtBctF6GdSvEm/CiY9iEb8",
+	"FUe4PUOdJ9u6widCZzpD/3My3BlqcatQkgVbYWcqIXhOJTxpvOGlZqilA1N+Su9ah5lhTiaXqzVbsgaT",
+	"2vvh6RhBh5O77CkmDnRmkzbl6RyfTWR8jbg0KOdS7cSN8fVmE5l4mhczQbjehhif4a0kLy0CiOlhtOfi",
+	"CaEaRfBxniRsE8i+J0OeNp5KnTUdjHvdMKXeBlA7GZBTs1sbufojdjAsVoGsj9fX0ZmVMshvwl3AQiW3",
+	"00OlKmPUFfAqzJGyEPYTM2Vp+nLp1qprh6Zt8cxts5BeIcMD0h8wTh9ZHAk6X4sJaSEZE5JRYuNcoJ4Y",
+	"s3I4ouiYxZpQLC4K0UyLPsCADsI0KzRmJ74dXYmhuQB4iRcN9o38OnFAQZTCp88njthZA4eEfpL0c6Ql",
+	"1H2mva31O2Ib1Gexx7kjg2k4TNs5uQ6WJRdJfH7p+WurxLETjflcKMHKNlgOFl2oLkREqdQ7uLonI1kF",
+	"DgM99o3Q4YQr240vb8rw/CcZYhsyRHWmx8dicYKeHZQoQe7ZI+30fZIOPkkHdUgHJiBhIIocbT+xJ/JB",
+	"T6o3FXOXDXpSB3pTMazKarCLtsP9lcIDeAvTa8yOyThP4YJx4wb12gRQuslhJT+BacoKpFPlJzQtt4rO",
+	"3ZNK8Mme2kp3T+oAqNzw3PlDFte/B+U7GETxJBEOSI6YdTuQU2WXHPTuB7ToIArpBI+9fyuOIeAUym+L",
+	"ijxemrilyMNEIlEnxkvX7+tM7yk+gGV8gpdxlc0n2jUUllgj1GjUu1va/HEMW5+0+U+ceOe0eYNeseiU",
+	"F1We8/WkpGwvcwhsiyvflsvXHqkTK4EtWAbI8j6ZBj4x/3qY//EUsvEB5Nc4bxARuNwba0Ev3yck3aWB",
+	"ysLD0vPXZmL513wfj4gSrQeQbUc2sIR/6fomKNWlJ9j/9/YBCeiGlO3hR4RMafGQvX1JZOj7A40JnA8v",
+	"F4kHER44LvKxBAY1RNsFiE/A3yaczSZ4RGYIwKHjx/GvYOyDERhNqa//giP1cLxSOtV7oL83AU1fx6AU",
+	"NLUbzQ5PDgZU9CHg2ZivkxMNuEo1+EKCPWIqmw7yWvjP/zLT2B2yDhYQSBVso7WVoTeimMifyyDjAhz+",
+	"XGt8eU1zBoB4PAHBjmI2mYn3CkeOnmxraQ21nDkabmvq/I5DaTHVFwdThtPKkRGkzJGuUKSLMnEYl7RS",
+	"WhiFdk+T42QZlaWnUNEANyHHU8PbyOgZQZ4+Al9y+locv2rfc0hbNtTcRuWnI0f0RQY8iDQnMIK8R5Hm",
+	"kxDgatK3ECIK/T4Z+D9x8b3g4pg2QGUOQsVocNwL3p1M97pz7jQf/ZHvERqAoeHoaOTXeZtW/AEdDn4e",
+	"oKquDPBiUuOOfYdQEB0VRHEgwCoXsxsedKhxUlOVjyczQo8YzwzgR4kPHQXhw5eHDtt96UH9D/ybvIwr",
+	"2OhngAfQim5hxlF8WFnMOXK4nAYB57kq8oKlvWdhzVmppjJ3uXTtGcyZTMWEM72pGPSyx2HFg+NYdcdZ",
+	"W/I8yVszTf0uZVVOJWNCX4vV3QFsU7MdaHFTeiaY9fqQn7UHkJL6UBD+3655KkhhF90nocUN5Mc21h7h",
+	"YIIVpDP/SPvJzuaQzeRhghM86liuJZAf2yfke7C9nKyt7mu8rvwU0eCJzGWQG7xJUybUdTii56uDi4o8",
+	"b1tdYC/sH23p3k+iwnsWFXQM90ISGcTPm0WBglHXeTSqS+NBkQZgLUw8J2/L/mBs95Pw8kl4qUd4AYbL",
+	"4ARBExX2xgKRHsicT1UxQXTg35ERsIZV6gIOfHtPpgcNpyADQTqQ6c8g/5EjWAoguXIaK79HxxdAJTTL",
+	"r5jRY75XmZcxi8eBfHm8t8IMzutKp4SMOICvAv7siKcB0bW/XcSo9EA6bspR/o5QB/ry4Oc4PdxaH5QI",
+	"KwFXUcviJzGFreouk1NJ/+bguLpasJ7vVXwNy7oQZM24kmJ6KcNLSxCMKM/YrO/q4PDm/ac4NAX3fMa3",
+	"QB+GLr0IiVQa5Afz2PAvem9udASd8sWEvlM+i1yDLGGRtHhDhVnIy+r6b4o8tBdCBAH4T16UT16UXTeg",
+	"OChZkCY6KGihOZ+sKp8Ek70QTDSG768LOPdGVpHIFUp1VomoQkLyU6XpZ5iKkGK5yyQvCfnN9teTy4YP",
+	"H3gfsw6qBcKkD6ikWDwj9Ep14o+xCl4U+YHd60Fb5d4cJR51MKsNQulqkblaAQ1HUO5OGuBw4QXreCA8",
+	"ZsUElFoC6XfZkBWh0+p/Ohxh8gIwe+B4RUR36UJBZBbkRY7q0S5eqhW7l8oqVmmCt3VvRXX4DqQuWtxX",
+	"Kwi3WSIpVDaZjTrKZb2sg55G3IKv4KwgBRA7UNhx+p7ChT/mKNpIOtb/SUL9JKHuRcQtiyZ+Crb9JFPW",
+	"I1NiGNrbOFtp4FzGndHDrzRAI///wV+dyh48+Hk03sv3CPijgBpSCCDj/+y8J27bdgzbasqLRWwqG0N8",
+	"+kfsSjoLkSLpXq1aCxYXIoghfJAG8u0RXGeKouzW6BLSgmIxV1m663BpkRYJxJaIQ6gaiI8NBdEPfB/f",
+	"wIvR8/E+wdKygMzaGj6KZYXhoc3Zu7qowhBB2ExlmeTS4njcOaUwh9fjbJCDf5w1Lq3y+zNg+9gQp0sm",
+	"0PQA6iXgmgjqYMHyCsyQg2+0e36l1/SyTGuTkVAcmgkBfWhL4RI1TvnCeR26+atoTRXa/aSjfSvqAB7v",
+	"G1HH/cI+JQntH5HFTt0/iSufxJV6xBUTfowin0NK4QrNErAgs8tWr9TZhFZnsE6LV3n9sTp6A6QTlxKl",
+	"ziAZR6so7TRwtyFR4GMDx1Jii5CI9wn4yxWjXKLeItJSYVVe3px+AGlEEBNzUx1cxD62hc1bQ5XF4Wq0",
+	"n2rX0k5t//2XSK6v4bS59j3pOm1OV7us8YdZo5iAtF56l5TWwg29ilAJc2VdfXebPALPkgKPdBM5CpR2",
+	"qhAOhZ1BaSAZrVUIzKgDg/ztJ7tITURcgGbzxpwiT+hBY45qzPKiVYADJ7mB4OqzX0jRWFKm3dAhjAdQ",
+	"e0eozayXSsvEe1ACPid7rv+u355W/1199isxU7JKwBt70LdPnPpLikzyFh9CfoC2cf0o5BXSUISc1bGT",
+	"rcfCra2hFuiA1hTuDrVYHtUFdd0iWhx1LVYWGUhG3yvB2hu6AtskTaA+aNpi0AgCOfWQiK1RhgvmH1o/",
+	"IZdmtUyIXLbGXa6QrhVQiLmwplVnpgM5c7IG9Mul4TX7q38Mq7+sGbjj0jLWAcnvgfNax6bPbx93XDFP",
+	"bD/0XqHu79+swYEpBOBSmc52sjuH5lil99R48CR5ck9RaW87G1LdFjzLyBHqHVe5e4ftJlbvIi49BGfr",
+	"ZuDAbkZW50WbQWPvWi/i3e33/otPqR4Ibt0YkYYUu9GTkRzSR9PMC3azHxpBusLePusGSaCv8u6teuV+",
+	"nYBXL/UPXsD/1tMqcq+Bky1Wacv+KDtRWqOIiPKMdb/tAoO3LoIf2QXvLl3bD9LyvuOptCPRrVHhLpGx",
+	"IJbkLHpqLVDHctwnePck6H4CdxYHp8IIId+neHOvgd7WoCl4gf5iAJ7Q2zVZ2bzdVYy7K+WnaEuj3j9p",
+	"iurYRMwzYFDUn7RHIhoWRx/HlCXozvkDTfraPsrO0LphfNHo3gXa/e+XIMLCbODlYPDd1iv1zOHd2kV7",
+	"PPGdw1b2hB+y8dXjTeIInHpvsnrr5w+dMbFHthGpqjPYFLbuUMPhg4cPNXzxxeHDfzfjw2h3tlF1yrgt",
+	"FG5hM810ts6OnVpZhcUa82mPERixtOszrd6aPZv8aYxh+qjouoya14jV6A7cP3qrwBXm0o1laQVvsCMO",
+	"E+9Ro+MvWYUec+5K1BlupI5sFQqz8yIJc673JJZ4JnREKtD8GhgOdjpMxvtScIAiFdJnXdPHaVv3Rr/J",
+	"iWybE7sKbzZZbSsdg0mmsSLfxf9fVuQ5nA4+b3S/JW5frYm5PftmWacTQHqqUzBroysi22L6YhkSdyM1",
+	"X0L+YEoCz6Ek4f2SfB4QSsknxiEEkDUy2LwN5N9xERin6aDaRBSc/zgENiZEsum0KEiSELNHmGnhD+P4",
+	"mK5RpXJJL0qlsCYJfQLUHFIKa+fi/U19fDzBn00IjKbOWlPIqNRnCY1Eh1BldsxgJqg50n0qaVZ955B2",
+	"rnovZsPlwCHKrcEhy4FwiE/EeUmQOKQvkEPRPkmKRFOiwJHz0FKxJA71gjItxI7Ce9oxwrACh37ISpn4",
+	"OY1cBWq1RqOcSna0+ii7yEMtIUo7rGMx2qVUWU5zZ7gr3NzUyqGvwse/qrooakWlP5+rw2vq63lFHttY",
+	"HS9d/0WRl2iEJ+E8XkKLN94VS08fOqtVkRINlv4YcEV6ttQlyy840NbFf0XjS3UvVu0AY0uz5Jron88T",
+	"KHZZmIMs1BdEXKtH7haYfadgaZB7evfNMIzpt9XO9N/KzV+VuVsYNMVW6ahB42s6QSGwc1oniC+YIAbT",
+	"OB/WNUzguJCh0mZ306BAT7OPrH/liXXccsCk8nR/UYc1AO8CadvYgimgilHbfg+7ZAkmM7xXS/A+BQU3",
+	"ICCKH/KXi7PlyaFKbjBQF0DQOEneqhKy0wUP7EVVhS6+Z1drKWz7Lt4p+WeO6A18PDsaswHnsDvY1sX3",
+	"vNewCXzD+y1aglzr29ul4UlP18rkbPBa8EKG7/EU+EBuuLZ2gsf7+CMSyBU4HBZ1XkFWEsTqlOwkfsJx",
+	"8LsXGGg95s3Cojo8RIqOlOZul18+cE2NFET8kaGhuCtPWlOewrLNDuAyiVhPCKEWPbhXoX1wUfsrpG8e",
+	"a6R/KIU3DgZAoKoqn61O7/Fud4fgw9DvleK73eR7DpCjrtNO+D1cp0FtwFooiJ5IvnbJtWk+GfGjJPrb",
+	"1XDNWyO8Avkry9PlyaFgeeRxeXIIrGPFe5WHg6Uns+UnTwL14qibNvp+r+7gruNi+zcfIARUHr8ov3xW",
+	"Nxmupu3u+T3vDr1/r3r0RwVjjqArj7zB7jnS22P0VWlz2dbdgpq7QyTJ/vCBgwhXLH6IJboR5E/2xaJ9",
+	"QsPhAwcbPsN1saGP5M/xNFJvL5RXi1oHKPzLgZ6fEeSLTqxg5wk6hCydoBztL+DrF7hUBsmIL5A6MqVf",
+	"HpX/uKnlp2IvVHnqmfqgoE7+CvXJcPsKdWlqA8yKC6UxWZFn8ai/KfJ9eu305DgB/wGpKK2LqyNU9ipl",
+	"g1xr7o5EUOXxtY31WfirI4RKd2Y31v5Afpt/oLxySb31L6PXkrnpYVwU5gXcZX7FKNJAZf6S0jrkL4ar",
+	"Ul4p3c6Vf88TMCDZhcif4KXMiVQsfi4uxLDjT50bwe1BH9r6QbACLcxtgANBh5OEYDgBNU+GrSJJTm5q",
+	"ayH+vUmtfqF8D5/hY+2+4JAf4/O8b4xfyRVKg0WznwW57d/xU5Mbq1dgIuvlkGQgGkRMbwcB4oZkXwzh",
+	"QaaxYeou9gyNmAnO1UvYtPXFjglCzLfrhYRroJNeWl5HmMCHWku4rS+2tVoq+9ULYiHGcIv0xdFbAb/k",
+	"EvxIEucLL+r2VXgg2Smpz51kt0e6CcouAirk/8RVsIaUwn0oOlbIYcyYQX4+kTjwczztgRhjeo479aHP",
+	"tGYdeA6tDv8bpXC3DurGpKrq8CMooerXfPUBXPfEVv6s6EZgabqpfakVaoVzQIAIQRCRpDQfJZ+AZuGk",
+	"N4dP12isZNRktdUp9UyQe7dGjDFskdoR1vlQuAWXcyEPFLXqZXQ7ALp0naWb8fFwl1Yg5x6hy7hGnn5U",
+	"0FpF2/QyKdBh0mYHDDFq67k2UXa2YEZ+9fm1zelRY1MGPCr5S2bvJx0sq1L8lNS3DYrfLvW18BleEjK7",
+	"T/R1hDRxDyPeh0rb26W+j5i248sqXNZK9pFCWoXhvaPwjnCuag5pS2iCU0e09ailwoORf2N9FFmji42K",
+	"eFsPXt5Ls4F17x+ybmdcDDEeIL9VxyC80VQkdjD2AZYhRLM4/goA5qzAi4LYlM2c9zV+fxquTxLEPjY4",
+	"lW4/KV9bQv7y3Lo6hB29WTHha/Sdz2TSUmMwyKfjB4R+vjdNOg/wCfgm2HeI5YGYHi3feE30OMc4MaHv",
+	"gPtYp43DuKADLF7+Rc74m2jH1BftkYjtT6QHANLfYz8P9bcWDsT6Tk93on6xeLup75uysXiG/iLUT6io",
+	"+U241/5NK2nAKDG+I1PErb/RxTOor+3gcvH0xf87AEdHQ92UFwIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
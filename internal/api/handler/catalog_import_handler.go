@@ -0,0 +1,79 @@
+package handler
+
+// catalog_import_handler.go - /catalog/import に関するハンドラ処理
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+)
+
+func toCatalogImportReport(r *service.CatalogImportReport) gen.CatalogImportReport {
+	res := gen.CatalogImportReport{
+		Committed: r.Committed,
+		Total:     r.Total,
+		Created:   r.Created,
+		Matched:   r.Matched,
+		Rejected:  r.Rejected,
+		Rows:      make([]gen.CatalogImportRow, len(r.Rows)),
+	}
+	uuidPtr := func(s string) *openapi_types.UUID {
+		if s == "" {
+			return nil
+		}
+		id := uuid.MustParse(s)
+		return &id
+	}
+	for i, row := range r.Rows {
+		item := gen.CatalogImportRow{
+			Line:         row.Line,
+			Name:         row.Name,
+			Result:       gen.CatalogImportRowResult(row.Result),
+			OssId:        uuidPtr(row.OssID),
+			OssVersionId: uuidPtr(row.OssVersionID),
+			Errors:       row.Errors,
+		}
+		if row.Version != "" {
+			item.Version = &row.Version
+		}
+		if item.Errors == nil {
+			item.Errors = []string{}
+		}
+		res.Rows[i] = item
+	}
+	return res
+}
+
+// OSS カタログ一括取り込み (CSV)
+// (POST /catalog/import)
+func (h *Handler) ImportCatalog(ctx echo.Context, params gen.ImportCatalogParams) error {
+	f, err := formFile(ctx, "file")
+	if err != nil {
+		return err
+	}
+	if f == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "file is required")
+	}
+	defer f.Close()
+	opts := service.CatalogImportOptions{
+		Strict: params.Strict != nil && *params.Strict,
+		User:   currentUsername(ctx),
+	}
+	report, err := h.CatalogImports.Import(ctx.Request().Context(), f, opts)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCatalogCSV) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+	if !report.Committed {
+		return ctx.JSON(http.StatusUnprocessableEntity, toCatalogImportReport(report))
+	}
+	return ctx.JSON(http.StatusOK, toCatalogImportReport(report))
+}
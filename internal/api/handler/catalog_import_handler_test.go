@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
+)
+
+func newCatalogImportHandler(db *sql.DB) *Handler {
+	return &Handler{
+		CatalogImports: &service.CatalogImportService{
+			OssComponentRepo:      &infrarepo.OssComponentRepository{DB: db},
+			OssComponentLayerRepo: &infrarepo.OssComponentLayerRepository{DB: db},
+			OssComponentTagRepo:   &infrarepo.OssComponentTagRepository{DB: db},
+			TagRepo:               &infrarepo.TagRepository{DB: db},
+			OssVersionRepo:        &infrarepo.OssVersionRepository{DB: db},
+			AuditRepo:             &infrarepo.AuditLogRepository{DB: db},
+			Enums: map[string][]string{
+				"Layer":        {"LIB"},
+				"UsageRole":    {"BUNDLED_BINARY"},
+				"SupplierType": {"UPSTREAM"},
+			},
+		},
+	}
+}
+
+func postCatalogCSV(e *echo.Echo, t *testing.T, query, doc string) *httptest.ResponseRecorder {
+	body, contentType := multipartBody(t, map[string]string{"file": doc})
+	req := httptest.NewRequest(http.MethodPost, "/catalog/import"+query, body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestImportCatalog(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newCatalogImportHandler(db))
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM tags")).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at"}))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_components WHERE normalized_name = ?")).WithArgs("zlib").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO oss_components")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM oss_component_layers")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO oss_component_layers")).WithArgs(sqlmock.AnyArg(), "LIB").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAudit(mock, "OSS_COMPONENT", "CREATE")
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_versions WHERE oss_id = ? AND version = ?")).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO oss_versions")).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAudit(mock, "OSS_VERSION", "CREATE")
+
+	rec := postCatalogCSV(e, t, "", "name,layers,version,license\nzlib,LIB,1.3,Zlib\nbroken,KERNEL,1.0,\n")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.CatalogImportReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.True(t, res.Committed)
+	require.Equal(t, 1, res.Created)
+	require.Equal(t, 1, res.Rejected)
+	require.Equal(t, gen.CatalogImportRowResultCREATED, res.Rows[0].Result)
+	require.NotNil(t, res.Rows[0].OssVersionId)
+	require.Empty(t, res.Rows[0].Errors)
+	require.Equal(t, 3, res.Rows[1].Line)
+	require.Equal(t, []string{`layers: invalid value "KERNEL"`}, res.Rows[1].Errors)
+}
+
+func TestImportCatalog_Strict(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newCatalogImportHandler(db))
+
+	rec := postCatalogCSV(e, t, "?strict=true", "name,version,supplierType\nzlib,1.3,UPSTREAM\nbroken,1.0,VENDOR\n")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.CatalogImportReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.False(t, res.Committed)
+	require.Equal(t, 2, res.Rejected)
+	require.Equal(t, gen.CatalogImportRowResultREJECTED, res.Rows[0].Result)
+
+	rec = postCatalogCSV(e, t, "", "component,version\nzlib,1.3\n")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "unknown column")
+}
@@ -0,0 +1,146 @@
+package handler
+
+// export_jobs_handler.go - /export/jobs に関するハンドラ処理
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+)
+
+func toExportJob(m model.ExportJob) gen.ExportJob {
+	res := gen.ExportJob{
+		Id:        uuid.MustParse(m.ID),
+		ProjectId: uuid.MustParse(m.ProjectID),
+		Format:    gen.ExportFormat(m.Format),
+		Scopes:    []gen.ScopeStatus{},
+		Status:    gen.ExportJobStatus(m.Status),
+		Progress:  m.Progress,
+		FileName:  m.FileName,
+		FileSize:  m.FileSize,
+		Error:     m.Error,
+		CreatedBy: m.CreatedBy,
+		CreatedAt: m.CreatedAt.TimeValue(),
+	}
+	for _, s := range m.Scopes {
+		res.Scopes = append(res.Scopes, gen.ScopeStatus(s))
+	}
+	if m.StartedAt != nil {
+		t := m.StartedAt.TimeValue()
+		res.StartedAt = &t
+	}
+	if m.FinishedAt != nil {
+		t := m.FinishedAt.TimeValue()
+		res.FinishedAt = &t
+	}
+	if m.ExpiresAt != nil {
+		t := m.ExpiresAt.TimeValue()
+		res.ExpiresAt = &t
+	}
+	return res
+}
+
+// エクスポートジョブ登録
+// (POST /projects/{projectId}/export/jobs)
+func (h *Handler) CreateExportJob(ctx echo.Context, projectId openapi_types.UUID) error {
+	var req gen.ExportJobCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	scopes := []string{string(gen.INSCOPE)}
+	if req.Scopes != nil && len(*req.Scopes) > 0 {
+		scopes = scopes[:0]
+		seen := map[gen.ScopeStatus]bool{}
+		for _, s := range *req.Scopes {
+			switch s {
+			case gen.INSCOPE, gen.OUTSCOPE, gen.REVIEWNEEDED:
+			default:
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid scope: %s", s))
+			}
+			if seen[s] {
+				continue
+			}
+			seen[s] = true
+			scopes = append(scopes, string(s))
+		}
+	}
+	if blocked, err := h.checkLicensePolicy(ctx, projectId.String(), scopes); blocked || err != nil {
+		return err
+	}
+
+	j, err := h.ExportJobs.Enqueue(ctx.Request().Context(), projectId.String(), string(req.Format), scopes, currentUsername(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnknownExportFormat):
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid format")
+		case errors.Is(err, sql.ErrNoRows):
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		case errors.Is(err, service.ErrExportQueueFull):
+			return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusAccepted, toExportJob(*j))
+}
+
+// エクスポートジョブ状態取得
+// (GET /export/jobs/{jobId})
+func (h *Handler) GetExportJob(ctx echo.Context, jobId openapi_types.UUID) error {
+	j, err := h.ExportJobRepo.Get(ctx.Request().Context(), jobId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "job not found")
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toExportJob(*j))
+}
+
+// エクスポートジョブ生成物ダウンロード
+// (GET /export/jobs/{jobId}/download)
+func (h *Handler) DownloadExportJob(ctx echo.Context, jobId openapi_types.UUID) error {
+	j, err := h.ExportJobRepo.Get(ctx.Request().Context(), jobId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "job not found")
+		}
+		return err
+	}
+	switch j.Status {
+	case model.ExportJobSucceeded:
+	case model.ExportJobExpired:
+		return echo.NewHTTPError(http.StatusGone, "export expired")
+	default:
+		return echo.NewHTTPError(http.StatusConflict, "export not ready")
+	}
+	if j.FilePath == nil {
+		return echo.NewHTTPError(http.StatusGone, "export expired")
+	}
+	f, err := os.Open(*j.FilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return echo.NewHTTPError(http.StatusGone, "export expired")
+		}
+		return err
+	}
+	defer f.Close()
+
+	contentType := echo.MIMEOctetStream
+	if j.ContentType != nil {
+		contentType = *j.ContentType
+	}
+	if j.FileName != nil {
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", *j.FileName))
+	}
+	return ctx.Stream(http.StatusOK, contentType, f)
+}
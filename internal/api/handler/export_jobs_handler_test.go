@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+var exportJobColumnNames = []string{"id", "project_id", "format", "scopes", "status", "progress", "file_name", "file_path", "content_type", "file_size", "error", "created_by", "created_at", "started_at", "finished_at", "expires_at"}
+
+const exportJobSelect = "SELECT id, project_id, format, scopes, status, progress, file_name, file_path, content_type, file_size, error, created_by, created_at, started_at, finished_at, expires_at FROM export_jobs"
+
+func TestCreateExportJob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	jobRepo := &infrarepo.ExportJobRepository{DB: db}
+	jobs := &service.ExportJobService{
+		JobRepo:  jobRepo,
+		Exporter: &service.ExportService{ProjectRepo: &infrarepo.ProjectRepository{DB: db}, ProjectUsageRepo: &infrarepo.ProjectUsageRepository{DB: db}},
+		Dir:      t.TempDir(),
+	}
+	// ワーカーは起動直後に停止し、登録のみを検証する
+	mock.ExpectQuery(regexp.QuoteMeta(exportJobSelect+" WHERE status IN (?,?) ORDER BY created_at")).
+		WithArgs("QUEUED", "RUNNING").WillReturnRows(sqlmock.NewRows(exportJobColumnNames))
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, jobs.Start(ctx))
+	cancel()
+	jobs.Wait()
+
+	h := &Handler{ExportJobRepo: jobRepo, ExportJobs: jobs}
+	e := setupEcho(h)
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	projQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	mock.ExpectQuery(projQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "PRJ-1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO export_jobs")).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	body := `{"format":"notice","scopes":["IN_SCOPE","REVIEW_NEEDED","IN_SCOPE"]}`
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/export/jobs", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ExportJob
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, gen.ExportJobStatus("QUEUED"), res.Status)
+	require.Equal(t, gen.ExportFormatNotice, res.Format)
+	require.Equal(t, []gen.ScopeStatus{gen.INSCOPE, gen.REVIEWNEEDED}, res.Scopes)
+}
+
+func TestCreateExportJob_BadRequest(t *testing.T) {
+	h := &Handler{ExportJobs: &service.ExportJobService{}}
+	e := setupEcho(h)
+
+	pid := uuid.NewString()
+	for _, body := range []string{`{"format":"csv","scopes":["ALL"]}`, `{"format":"pdf"}`} {
+		req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/export/jobs", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code, body)
+	}
+}
+
+func TestGetExportJob_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{ExportJobRepo: &infrarepo.ExportJobRepository{DB: db}}
+	e := setupEcho(h)
+
+	id := uuid.NewString()
+	mock.ExpectQuery(regexp.QuoteMeta(exportJobSelect + " WHERE id = ?")).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodGet, "/export/jobs/"+id, nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDownloadExportJob(t *testing.T) {
+	dir := t.TempDir()
+	now := dbtime.DBTime{Time: time.Now()}
+	path := filepath.Join(dir, "job")
+	require.NoError(t, os.WriteFile(path, []byte("component,version\n"), 0o644))
+
+	tests := []struct {
+		name   string
+		status string
+		path   any
+		code   int
+	}{
+		{"succeeded", "SUCCEEDED", path, http.StatusOK},
+		{"running", "RUNNING", nil, http.StatusConflict},
+		{"expired", "EXPIRED", nil, http.StatusGone},
+		{"file removed", "SUCCEEDED", filepath.Join(dir, "missing"), http.StatusGone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			h := &Handler{ExportJobRepo: &infrarepo.ExportJobRepository{DB: db}}
+			e := setupEcho(h)
+
+			id := uuid.NewString()
+			mock.ExpectQuery(regexp.QuoteMeta(exportJobSelect + " WHERE id = ?")).WithArgs(id).WillReturnRows(
+				sqlmock.NewRows(exportJobColumnNames).AddRow(id, uuid.NewString(), "csv", "{IN_SCOPE}", tt.status, 100, "PRJ-1-oss-list.csv", tt.path, "text/csv; charset=utf-8", 18, nil, "alice", now, now, now, now))
+
+			req := httptest.NewRequest(http.MethodGet, "/export/jobs/"+id+"/download", nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.code, rec.Code)
+			require.NoError(t, mock.ExpectationsWereMet())
+			if tt.code == http.StatusOK {
+				require.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+				require.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "PRJ-1-oss-list.csv")
+				require.Equal(t, "component,version\n", rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package handler
+
+// export_templates_handler.go - /export/templates に関するハンドラ処理
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/export"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func toExportTemplate(m model.ExportTemplate) gen.ExportTemplate {
+	return gen.ExportTemplate{
+		Id:            uuid.MustParse(m.ID),
+		Name:          m.Name,
+		Engine:        gen.ExportTemplateEngine(m.Engine),
+		FileExtension: m.FileExtension,
+		Description:   m.Description,
+		Body:          m.Body,
+		UpdatedBy:     m.UpdatedBy,
+		CreatedAt:     m.CreatedAt.TimeValue(),
+		UpdatedAt:     m.UpdatedAt.TimeValue(),
+	}
+}
+
+// エクスポートテンプレート一覧
+// (GET /export/templates)
+func (h *Handler) ListExportTemplates(ctx echo.Context) error {
+	list, err := h.ExportTemplateRepo.List(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+	res := make([]gen.ExportTemplate, len(list))
+	for i, t := range list {
+		res[i] = toExportTemplate(t)
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// エクスポートテンプレート登録 (管理者)
+// (POST /export/templates)
+func (h *Handler) CreateExportTemplate(ctx echo.Context) error {
+	var req gen.ExportTemplateCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	now := dbtime.DBTime{Time: time.Now()}
+	t := &model.ExportTemplate{
+		ID:          uuid.NewString(),
+		Name:        req.Name,
+		Engine:      string(req.Engine),
+		Description: req.Description,
+		Body:        req.Body,
+		UpdatedBy:   currentUsername(ctx),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if req.FileExtension != nil {
+		t.FileExtension = *req.FileExtension
+	}
+	if err := h.checkExportTemplate(ctx.Request().Context(), t); err != nil {
+		return err
+	}
+	if err := h.ExportTemplateRepo.Create(ctx.Request().Context(), t); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusCreated, toExportTemplate(*t))
+}
+
+// エクスポートテンプレート取得
+// (GET /export/templates/{templateId})
+func (h *Handler) GetExportTemplate(ctx echo.Context, templateId openapi_types.UUID) error {
+	t, err := h.ExportTemplateRepo.Get(ctx.Request().Context(), templateId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "template not found")
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toExportTemplate(*t))
+}
+
+// エクスポートテンプレート更新 (管理者)
+// (PATCH /export/templates/{templateId})
+func (h *Handler) UpdateExportTemplate(ctx echo.Context, templateId openapi_types.UUID) error {
+	var req gen.ExportTemplateUpdateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	t, err := h.ExportTemplateRepo.Get(ctx.Request().Context(), templateId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "template not found")
+		}
+		return err
+	}
+	if req.Name != nil {
+		t.Name = *req.Name
+	}
+	if req.Engine != nil {
+		t.Engine = string(*req.Engine)
+	}
+	if req.FileExtension != nil {
+		t.FileExtension = *req.FileExtension
+	}
+	if req.Description != nil {
+		t.Description = req.Description
+	}
+	if req.Body != nil {
+		t.Body = *req.Body
+	}
+	t.UpdatedBy = currentUsername(ctx)
+	t.UpdatedAt = dbtime.DBTime{Time: time.Now()}
+	if err := h.checkExportTemplate(ctx.Request().Context(), t); err != nil {
+		return err
+	}
+	if err := h.ExportTemplateRepo.Update(ctx.Request().Context(), t); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toExportTemplate(*t))
+}
+
+// エクスポートテンプレート削除 (管理者)
+// (DELETE /export/templates/{templateId})
+func (h *Handler) DeleteExportTemplate(ctx echo.Context, templateId openapi_types.UUID) error {
+	if err := h.ExportTemplateRepo.Delete(ctx.Request().Context(), templateId.String()); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// checkExportTemplate は拡張子の既定値を補い、サンプルデータでの描画と名前の重複を検証する。
+func (h *Handler) checkExportTemplate(ctx context.Context, t *model.ExportTemplate) error {
+	if t.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "name is required")
+	}
+	if t.FileExtension == "" {
+		t.FileExtension = "txt"
+		if t.Engine == model.ExportTemplateHTML {
+			t.FileExtension = "html"
+		}
+	}
+	if err := export.ValidateTemplate(*t); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid template: %v", err))
+	}
+	existing, err := h.ExportTemplateRepo.FindByName(ctx, t.Name)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if existing != nil && existing.ID != t.ID {
+		return echo.NewHTTPError(http.StatusConflict, "template name already exists")
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+var exportTemplateColumnNames = []string{"id", "name", "engine", "file_extension", "description", "body", "updated_by", "created_at", "updated_at"}
+
+const exportTemplateSelect = "SELECT id, name, engine, file_extension, description, body, updated_by, created_at, updated_at FROM export_templates"
+
+func postExportTemplate(e *echo.Echo, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/export/templates", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestCreateExportTemplate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{ExportTemplateRepo: &infrarepo.ExportTemplateRepository{DB: db}}
+	e := setupEcho(h)
+
+	mock.ExpectQuery(regexp.QuoteMeta(exportTemplateSelect + " WHERE name = ?")).WithArgs("customer-a").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO export_templates")).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	rec := postExportTemplate(e, `{"name":"customer-a","engine":"html","body":"<h1>{{.Project.Name}}</h1>"}`)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ExportTemplate
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, "html", res.FileExtension)
+	require.Equal(t, "api-user", res.UpdatedBy)
+}
+
+func TestCreateExportTemplate_Invalid(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{ExportTemplateRepo: &infrarepo.ExportTemplateRepository{DB: db}}
+	e := setupEcho(h)
+
+	rec := postExportTemplate(e, `{"name":"bad","engine":"text","body":"{{.Project.Unknown}}"}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "invalid template")
+
+	mock.ExpectQuery(regexp.QuoteMeta(exportTemplateSelect + " WHERE name = ?")).WithArgs("dup").WillReturnRows(
+		sqlmock.NewRows(exportTemplateColumnNames).AddRow(uuid.NewString(), "dup", "text", "txt", nil, "x", "admin", time.Now(), time.Now()))
+	rec = postExportTemplate(e, `{"name":"dup","engine":"text","body":"x"}`)
+	require.Equal(t, http.StatusConflict, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportProjectArtifacts_Template(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{
+		ProjectRepo:           &infrarepo.ProjectRepository{DB: db},
+		ProjectUsageRepo:      &infrarepo.ProjectUsageRepository{DB: db},
+		OssComponentLayerRepo: &infrarepo.OssComponentLayerRepository{DB: db},
+		ExportTemplateRepo:    &infrarepo.ExportTemplateRepository{DB: db},
+	}
+	e := setupEcho(h)
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	body := "{{.Project.Code}}\n{{range .Usages}}{{.Component.Name}} {{.Version.Version}} {{.Version.LicenseConcluded}} {{join .Component.Layers \"/\"}}\n{{end}}"
+	mock.ExpectQuery(regexp.QuoteMeta(exportTemplateSelect + " WHERE name = ?")).WithArgs("customer-a").WillReturnRows(
+		sqlmock.NewRows(exportTemplateColumnNames).AddRow(uuid.NewString(), "customer-a", "text", "txt", nil, body, "admin", now, now))
+	getQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	mock.ExpectQuery(getQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 1))
+	listQuery := regexp.QuoteMeta("FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE u.project_id = ? AND u.scope_status IN (?) ORDER BY c.normalized_name, v.version")
+	mock.ExpectQuery(listQuery).WithArgs(pid, "IN_SCOPE").WillReturnRows(sqlmock.NewRows(usageDetailColumns).
+		AddRow(usageDetailRow(pid, "Redis", "7.0.0", "BSD-3-Clause", "pkg:generic/redis@7.0.0", now)...))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT layer FROM oss_component_layers WHERE oss_id = ?")).
+		WillReturnRows(sqlmock.NewRows([]string{"layer"}).AddRow("MIDDLEWARE").AddRow("OS"))
+	expectUsageDependencies(mock, pid)
+
+	req := httptest.NewRequest(http.MethodGet, "/projects/"+pid+"/export?format=template&template=customer-a", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, "text/plain; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+	require.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "P1-customer-a.txt")
+	require.Equal(t, "P1\nRedis 7.0.0 BSD-3-Clause MIDDLEWARE/OS\n", rec.Body.String())
+}
+
+func TestExportProjectArtifacts_TemplateErrors(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{ExportTemplateRepo: &infrarepo.ExportTemplateRepository{DB: db}}
+	e := setupEcho(h)
+	pid := uuid.NewString()
+
+	req := httptest.NewRequest(http.MethodGet, "/projects/"+pid+"/export?format=template", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mock.ExpectQuery(regexp.QuoteMeta(exportTemplateSelect + " WHERE name = ?")).WithArgs("missing").WillReturnError(sql.ErrNoRows)
+	req = httptest.NewRequest(http.MethodGet, "/projects/"+pid+"/export?format=template&template=missing", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -5,6 +5,7 @@ package handler
 
 import (
 	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
 )
 
 type Handler struct {
@@ -18,4 +19,13 @@ type Handler struct {
 	ProjectRepo           domrepo.ProjectRepository
 	ProjectUsageRepo      domrepo.ProjectUsageRepository
 	UserRepo              domrepo.UserRepository
+	ExportJobRepo         domrepo.ExportJobRepository
+	ExportTemplateRepo    domrepo.ExportTemplateRepository
+	LicenseRepo           domrepo.LicenseRepository
+	LicensePolicyRepo     domrepo.LicensePolicyRuleRepository
+	ObligationRepo        domrepo.ProjectObligationRepository
+	ExportJobs            *service.ExportJobService
+	Imports               *service.ImportService
+	CatalogImports        *service.CatalogImportService
+	Vulnerabilities       *service.VulnerabilityService
 }
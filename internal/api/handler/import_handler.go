@@ -0,0 +1,398 @@
+package handler
+
+// import_handler.go - /projects/{projectId}/import, /import/sessions に関するハンドラ処理
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/sbom"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+)
+
+func toImportReport(r *service.ImportReport) gen.ImportReport {
+	res := gen.ImportReport{
+		ProjectId: uuid.MustParse(r.ProjectID),
+		Format:    r.Format,
+		Created:   r.Created,
+		Matched:   r.Matched,
+		Skipped:   r.Skipped,
+		Items:     make([]gen.ImportReportItem, len(r.Items)),
+	}
+	uuidPtr := func(s string) *openapi_types.UUID {
+		if s == "" {
+			return nil
+		}
+		id := uuid.MustParse(s)
+		return &id
+	}
+	for i, it := range r.Items {
+		item := gen.ImportReportItem{
+			Ref:          it.Ref,
+			Name:         it.Name,
+			Version:      it.Version,
+			Result:       gen.ImportResult(it.Result),
+			OssId:        uuidPtr(it.OssID),
+			OssVersionId: uuidPtr(it.OssVersionID),
+			UsageId:      uuidPtr(it.UsageID),
+		}
+		if it.Purl != "" {
+			item.Purl = &it.Purl
+		}
+		if it.Reason != "" {
+			item.Reason = &it.Reason
+		}
+		if it.UsageRole != "" {
+			role := gen.UsageRole(it.UsageRole)
+			item.UsageRole = &role
+		}
+		if it.ScopeStatus != "" {
+			scope := gen.ScopeStatus(it.ScopeStatus)
+			item.ScopeStatus = &scope
+		}
+		res.Items[i] = item
+	}
+	return res
+}
+
+func toImportSession(m model.ImportSession, items []model.ImportSessionItem) gen.ImportSession {
+	res := gen.ImportSession{
+		Id:           uuid.MustParse(m.ID),
+		ProjectId:    uuid.MustParse(m.ProjectID),
+		Format:       m.Format,
+		DocumentName: m.DocumentName,
+		Status:       gen.ImportSessionStatus(m.Status),
+		CreatedBy:    m.CreatedBy,
+		CreatedAt:    m.CreatedAt.TimeValue(),
+		CommittedBy:  m.CommittedBy,
+	}
+	if m.UsageRole != nil {
+		role := gen.UsageRole(*m.UsageRole)
+		res.UsageRole = &role
+	}
+	if m.CommittedAt != nil {
+		t := m.CommittedAt.TimeValue()
+		res.CommittedAt = &t
+	}
+	if items != nil {
+		list := make([]gen.ImportSessionItem, len(items))
+		for i, it := range items {
+			list[i] = toImportSessionItem(it)
+		}
+		res.Items = &list
+	}
+	return res
+}
+
+func toImportSessionItem(m model.ImportSessionItem) gen.ImportSessionItem {
+	uuidPtr := func(s *string) *openapi_types.UUID {
+		if s == nil {
+			return nil
+		}
+		id := uuid.MustParse(*s)
+		return &id
+	}
+	res := gen.ImportSessionItem{
+		Id:               uuid.MustParse(m.ID),
+		Seq:              m.Seq,
+		Ref:              m.Ref,
+		Name:             m.Name,
+		Version:          m.Version,
+		Purl:             m.Purl,
+		LicenseConcluded: m.LicenseConcluded,
+		LicenseDeclared:  m.LicenseDeclared,
+		DirectDependency: m.DirectDependency,
+		InclusionNote:    m.InclusionNote,
+		Proposal:         gen.ImportProposal(m.Proposal),
+		Reason:           m.Reason,
+		OssId:            uuidPtr(m.OssID),
+		OssVersionId:     uuidPtr(m.OssVersionID),
+		Decision:         gen.ImportDecision(m.Decision),
+		UsageId:          uuidPtr(m.UsageID),
+	}
+	if m.UsageRole != nil {
+		role := gen.UsageRole(*m.UsageRole)
+		res.UsageRole = &role
+		res.DetectedUsageRole = &role
+	}
+	if m.UsageRoleOverride != nil {
+		role := gen.UsageRole(*m.UsageRoleOverride)
+		res.UsageRole = &role
+	}
+	if len(m.Layers) > 0 {
+		layers := make([]gen.Layer, len(m.Layers))
+		for i, l := range m.Layers {
+			layers[i] = gen.Layer(l)
+		}
+		res.Layers = &layers
+	}
+	if m.Result != nil {
+		r := gen.ImportResult(*m.Result)
+		res.Result = &r
+	}
+	return res
+}
+
+// importSBOM はリクエストボディを parse で読み込み、プロジェクトに取り込む。
+func (h *Handler) importSBOM(ctx echo.Context, projectId openapi_types.UUID, usageRole *gen.UsageRole, dryRun *bool, parse func(io.Reader) (*sbom.BOM, error)) error {
+	bom, err := parse(ctx.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid SBOM document: %v", err))
+	}
+	return h.importBOM(ctx, projectId, usageRole, dryRun, bom)
+}
+
+// importBOM は bom をプロジェクトに取り込んだ結果を返す。
+// dryRun の場合はカタログを変更せず、取り込みセッションを返す。
+func (h *Handler) importBOM(ctx echo.Context, projectId openapi_types.UUID, usageRole *gen.UsageRole, dryRun *bool, bom *sbom.BOM) error {
+	opts := service.ImportOptions{User: currentUsername(ctx)}
+	if usageRole != nil {
+		opts.UsageRole = string(*usageRole)
+	}
+	if dryRun != nil && *dryRun {
+		sess, items, err := h.Imports.Preview(ctx.Request().Context(), projectId.String(), bom, opts)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return echo.NewHTTPError(http.StatusNotFound, "project not found")
+			}
+			return err
+		}
+		return ctx.JSON(http.StatusCreated, toImportSession(*sess, items))
+	}
+	report, err := h.Imports.Import(ctx.Request().Context(), projectId.String(), bom, opts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toImportReport(report))
+}
+
+// formFile はマルチパートのファイル name を開く。省略されている場合は nil を返す。
+func formFile(ctx echo.Context, name string) (io.ReadCloser, error) {
+	fh, err := ctx.FormFile(name)
+	if errors.Is(err, http.ErrMissingFile) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid multipart body: %v", err))
+	}
+	return fh.Open()
+}
+
+// SPDX JSON SBOM 取り込み
+// (POST /projects/{projectId}/import/spdx)
+func (h *Handler) ImportProjectSpdx(ctx echo.Context, projectId openapi_types.UUID, params gen.ImportProjectSpdxParams) error {
+	return h.importSBOM(ctx, projectId, params.UsageRole, params.DryRun, sbom.ParseSPDXJSON)
+}
+
+// CycloneDX JSON SBOM 取り込み
+// (POST /projects/{projectId}/import/cyclonedx)
+func (h *Handler) ImportProjectCyclonedx(ctx echo.Context, projectId openapi_types.UUID, params gen.ImportProjectCyclonedxParams) error {
+	return h.importSBOM(ctx, projectId, params.UsageRole, params.DryRun, sbom.ParseCycloneDXJSON)
+}
+
+// syft JSON (コンテナイメージ SBOM) 取り込み
+// (POST /projects/{projectId}/import/syft)
+func (h *Handler) ImportProjectSyft(ctx echo.Context, projectId openapi_types.UUID, params gen.ImportProjectSyftParams) error {
+	return h.importSBOM(ctx, projectId, params.UsageRole, params.DryRun, sbom.ParseSyftJSON)
+}
+
+// Go モジュール (go.mod / go.sum) 取り込み
+// (POST /projects/{projectId}/import/gomod)
+func (h *Handler) ImportProjectGomod(ctx echo.Context, projectId openapi_types.UUID, params gen.ImportProjectGomodParams) error {
+	gomod, err := formFile(ctx, "gomod")
+	if err != nil {
+		return err
+	}
+	if gomod == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "gomod is required")
+	}
+	defer gomod.Close()
+	gosum, err := formFile(ctx, "gosum")
+	if err != nil {
+		return err
+	}
+	var sum io.Reader // go.sum は省略可
+	if gosum != nil {
+		defer gosum.Close()
+		sum = gosum
+	}
+	bom, err := sbom.ParseGoMod(gomod, sum)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid go.mod: %v", err))
+	}
+	return h.importBOM(ctx, projectId, params.UsageRole, params.DryRun, bom)
+}
+
+// Maven / Gradle 依存一覧取り込み
+// (POST /projects/{projectId}/import/maven)
+func (h *Handler) ImportProjectMaven(ctx echo.Context, projectId openapi_types.UUID, params gen.ImportProjectMavenParams) error {
+	return h.importFormFile(ctx, projectId, nil, params.DryRun, "dependency list", sbom.ParseMaven)
+}
+
+// Python (requirements.txt / poetry.lock / Pipfile.lock) 取り込み
+// (POST /projects/{projectId}/import/python)
+func (h *Handler) ImportProjectPython(ctx echo.Context, projectId openapi_types.UUID, params gen.ImportProjectPythonParams) error {
+	return h.importFormFile(ctx, projectId, params.UsageRole, params.DryRun, "lockfile", sbom.ParsePython)
+}
+
+// Rust (Cargo.lock) 取り込み
+// (POST /projects/{projectId}/import/cargo)
+func (h *Handler) ImportProjectCargo(ctx echo.Context, projectId openapi_types.UUID, params gen.ImportProjectCargoParams) error {
+	return h.importFormFile(ctx, projectId, params.UsageRole, params.DryRun, "Cargo.lock", sbom.ParseCargoLock)
+}
+
+// importFormFile は multipart の file パートを parse で読み込んで取り込む。
+// what は解析エラー時のメッセージに用いるファイルの呼称。
+func (h *Handler) importFormFile(ctx echo.Context, projectId openapi_types.UUID, usageRole *gen.UsageRole, dryRun *bool, what string, parse func(io.Reader) (*sbom.BOM, error)) error {
+	f, err := formFile(ctx, "file")
+	if err != nil {
+		return err
+	}
+	if f == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "file is required")
+	}
+	defer f.Close()
+	bom, err := parse(f)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid %s: %v", what, err))
+	}
+	return h.importBOM(ctx, projectId, usageRole, dryRun, bom)
+}
+
+// npm (package-lock.json / yarn.lock) 取り込み
+// (POST /projects/{projectId}/import/npm)
+func (h *Handler) ImportProjectNpm(ctx echo.Context, projectId openapi_types.UUID, params gen.ImportProjectNpmParams) error {
+	lock, err := formFile(ctx, "lockfile")
+	if err != nil {
+		return err
+	}
+	if lock == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "lockfile is required")
+	}
+	defer lock.Close()
+	pkgJSON, err := formFile(ctx, "packageJson")
+	if err != nil {
+		return err
+	}
+	var manifest io.Reader // package.json は省略可
+	if pkgJSON != nil {
+		defer pkgJSON.Close()
+		manifest = pkgJSON
+	}
+	bom, err := sbom.ParseNpmLock(lock, manifest)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid lockfile: %v", err))
+	}
+	return h.importBOM(ctx, projectId, nil, params.DryRun, bom)
+}
+
+// 取り込みセッション一覧
+// (GET /projects/{projectId}/import/sessions)
+func (h *Handler) ListImportSessions(ctx echo.Context, projectId openapi_types.UUID) error {
+	list, err := h.Imports.SessionRepo.ListByProject(ctx.Request().Context(), projectId.String())
+	if err != nil {
+		return err
+	}
+	res := make([]gen.ImportSession, len(list))
+	for i, s := range list {
+		res[i] = toImportSession(s, nil)
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// 取り込みセッション取得
+// (GET /import/sessions/{sessionId})
+func (h *Handler) GetImportSession(ctx echo.Context, sessionId openapi_types.UUID) error {
+	sess, err := h.Imports.SessionRepo.Get(ctx.Request().Context(), sessionId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "import session not found")
+		}
+		return err
+	}
+	items, err := h.Imports.SessionRepo.ListItems(ctx.Request().Context(), sessionId.String())
+	if err != nil {
+		return err
+	}
+	if items == nil {
+		items = []model.ImportSessionItem{}
+	}
+	return ctx.JSON(http.StatusOK, toImportSession(*sess, items))
+}
+
+// 取り込みセッション破棄
+// (DELETE /import/sessions/{sessionId})
+func (h *Handler) DeleteImportSession(ctx echo.Context, sessionId openapi_types.UUID) error {
+	if err := h.Imports.Discard(ctx.Request().Context(), sessionId.String()); err != nil {
+		return importSessionError(err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// 取り込み項目のレビュー
+// (PATCH /import/sessions/{sessionId}/items/{itemId})
+func (h *Handler) UpdateImportSessionItem(ctx echo.Context, sessionId openapi_types.UUID, itemId openapi_types.UUID) error {
+	var req gen.ImportSessionItemUpdateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	d := service.ImportDecision{Decision: string(req.Decision)}
+	if req.OssId != nil {
+		id := req.OssId.String()
+		d.OssID = &id
+	}
+	if req.OssVersionId != nil {
+		id := req.OssVersionId.String()
+		d.OssVersionID = &id
+	}
+	reset := req.ResetUsageRole != nil && *req.ResetUsageRole
+	switch {
+	case req.UsageRole != nil && reset:
+		return echo.NewHTTPError(http.StatusBadRequest, "usageRole and resetUsageRole cannot be specified together")
+	case req.UsageRole != nil:
+		role := string(*req.UsageRole)
+		d.UsageRole = &role
+	case reset:
+		d.UsageRole = new(string)
+	}
+	it, err := h.Imports.Decide(ctx.Request().Context(), sessionId.String(), itemId.String(), d)
+	if err != nil {
+		return importSessionError(err)
+	}
+	return ctx.JSON(http.StatusOK, toImportSessionItem(*it))
+}
+
+// 取り込みセッション確定
+// (POST /import/sessions/{sessionId}/commit)
+func (h *Handler) CommitImportSession(ctx echo.Context, sessionId openapi_types.UUID) error {
+	report, err := h.Imports.Commit(ctx.Request().Context(), sessionId.String(), currentUsername(ctx))
+	if err != nil {
+		return importSessionError(err)
+	}
+	return ctx.JSON(http.StatusOK, toImportReport(report))
+}
+
+// importSessionError は取り込みセッション操作のエラーを HTTP エラーに変換する。
+func importSessionError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return echo.NewHTTPError(http.StatusNotFound, "import session not found")
+	case errors.Is(err, service.ErrImportSessionClosed):
+		return echo.NewHTTPError(http.StatusConflict, "import session already committed")
+	case errors.Is(err, service.ErrInvalidImportDecision):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return err
+}
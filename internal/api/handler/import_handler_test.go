@@ -0,0 +1,591 @@
+package handler
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+const importSPDXDoc = `{
+  "spdxVersion": "SPDX-2.3",
+  "SPDXID": "SPDXRef-DOCUMENT",
+  "name": "app",
+  "documentDescribes": ["SPDXRef-app"],
+  "packages": [
+    {"SPDXID": "SPDXRef-app", "name": "app", "versionInfo": "1.0.0"},
+    {"SPDXID": "SPDXRef-left-pad", "name": "left-pad", "versionInfo": "1.3.0", "licenseDeclared": "WTFPL"}
+  ],
+  "relationships": [
+    {"spdxElementId": "SPDXRef-app", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-left-pad"}
+  ]
+}`
+
+func newImportHandler(db *sql.DB) *Handler {
+	return &Handler{
+		Imports: &service.ImportService{
+			ProjectRepo:           &infrarepo.ProjectRepository{DB: db},
+			OssComponentRepo:      &infrarepo.OssComponentRepository{DB: db},
+			OssComponentLayerRepo: &infrarepo.OssComponentLayerRepository{DB: db},
+			OssVersionRepo:        &infrarepo.OssVersionRepository{DB: db},
+			ProjectUsageRepo:      &infrarepo.ProjectUsageRepository{DB: db},
+			ScopePolicyRepo:       &infrarepo.ScopePolicyRepository{DB: db},
+			AuditRepo:             &infrarepo.AuditLogRepository{DB: db},
+			SessionRepo:           &infrarepo.ImportSessionRepository{DB: db},
+		},
+	}
+}
+
+var importComponentColumns = []string{"id", "name", "normalized_name", "homepage_url", "repository_url", "description", "primary_language", "default_usage_role", "deprecated", "created_at", "updated_at"}
+
+var importSessionColumns = []string{"id", "project_id", "format", "document_name", "usage_role", "status", "created_by", "created_at", "committed_by", "committed_at"}
+
+var importSessionItemColumns = []string{"id", "session_id", "seq", "ref", "name", "version", "purl", "license_concluded", "license_declared", "homepage_url", "supplier", "hash_sha256", "hash_sha512", "copyright_text", "cpe_list", "direct_dependency", "usage_role", "usage_role_override", "layers", "inclusion_note", "depends_on", "proposal", "reason", "oss_id", "oss_version_id", "decision", "result", "usage_id"}
+
+// expectAudit は監査ログ 1 件の登録を期待する。
+func expectAudit(mock sqlmock.Sqlmock, entityType, action string) {
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO audit_logs")).
+		WithArgs(sqlmock.AnyArg(), entityType, sqlmock.AnyArg(), action, "api-user", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+func TestImportProjectSpdx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	projQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	mock.ExpectQuery(projQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM scope_policies LIMIT 1")).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_components WHERE normalized_name = ?")).WithArgs("left-pad").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO oss_components")).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAudit(mock, "OSS_COMPONENT", "CREATE")
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO oss_versions")).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAudit(mock, "OSS_VERSION", "CREATE")
+	mock.ExpectQuery(regexp.QuoteMeta("FROM project_usages WHERE project_id = ? AND oss_version_id = ?")).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO project_usages")).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAudit(mock, "PROJECT_USAGE", "CREATE")
+	expectAudit(mock, "PROJECT", "IMPORT")
+
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/spdx?usageRole=BUNDLED_BINARY", strings.NewReader(importSPDXDoc))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ImportReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, "spdx-json", res.Format)
+	require.Equal(t, 1, res.Created)
+	require.Len(t, res.Items, 1)
+	it := res.Items[0]
+	require.Equal(t, "SPDXRef-left-pad", it.Ref)
+	require.Equal(t, gen.CREATED, it.Result)
+	require.Equal(t, gen.BUNDLEDBINARY, *it.UsageRole)
+	require.Equal(t, gen.INSCOPE, *it.ScopeStatus)
+	require.NotNil(t, it.UsageId)
+	require.Nil(t, it.Purl)
+}
+
+func TestImportProjectSpdx_Errors(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+	pid := uuid.NewString()
+
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/spdx", strings.NewReader(`{"bomFormat":"CycloneDX"}`))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM projects WHERE id = ?")).WithArgs(pid).WillReturnError(sql.ErrNoRows)
+	req = httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/spdx", strings.NewReader(importSPDXDoc))
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportProjectCyclonedx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	pid := uuid.NewString()
+	ossID, versionID := uuid.NewString(), uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	doc := `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5",
+  "metadata": {"component": {"bom-ref": "app", "name": "app", "version": "1.0.0"}},
+  "components": [{"bom-ref": "junit", "name": "junit", "version": "4.13.2", "purl": "pkg:maven/junit/junit@4.13.2", "scope": "excluded"}],
+  "dependencies": [{"ref": "app", "dependsOn": ["junit"]}]
+}`
+	mock.ExpectQuery(regexp.QuoteMeta("FROM projects WHERE id = ?")).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM scope_policies LIMIT 1")).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_versions WHERE purl = ?")).WithArgs("pkg:maven/junit/junit@4.13.2").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "oss_id", "version", "release_date", "license_expression_raw", "license_concluded", "purl", "cpe_list", "hash_sha256", "hash_sha512", "modified", "modification_description", "review_status", "last_reviewed_at", "scope_status", "supplier_type", "fork_origin_url", "copyright_text", "created_at", "updated_at"}).
+			AddRow(versionID, ossID, "4.13.2", nil, nil, nil, "pkg:maven/junit/junit@4.13.2", "{}", nil, nil, false, nil, "verified", nil, "IN_SCOPE", nil, nil, nil, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_components WHERE id = ?")).WithArgs(ossID).WillReturnRows(sqlmock.NewRows(importComponentColumns).AddRow(ossID, "junit", "junit", nil, nil, nil, nil, nil, false, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM project_usages WHERE project_id = ? AND oss_version_id = ?")).WithArgs(pid, versionID).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO project_usages")).
+		WithArgs(sqlmock.AnyArg(), pid, ossID, versionID, "DEV_ONLY", "OUT_SCOPE", nil, true, sqlmock.AnyArg(), nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAudit(mock, "PROJECT_USAGE", "CREATE")
+	expectAudit(mock, "PROJECT", "IMPORT")
+
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/cyclonedx?usageRole=BUNDLED_BINARY", strings.NewReader(doc))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ImportReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, "cyclonedx-json", res.Format)
+	require.Equal(t, 1, res.Matched)
+	require.Equal(t, gen.MATCHED, res.Items[0].Result)
+	require.Equal(t, gen.DEVONLY, *res.Items[0].UsageRole)
+}
+
+func TestImportProjectSyft_DryRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	doc := `{
+  "artifacts": [{"id": "a1", "name": "musl", "version": "1.2.4-r2", "type": "apk", "purl": "pkg:apk/alpine/musl@1.2.4-r2",
+    "locations": [{"path": "/lib/apk/db/installed", "layerID": "sha256:aaaa"}]}],
+  "source": {"type": "image", "metadata": {"userInput": "alpine:3.18", "manifestDigest": "sha256:bbbb"}},
+  "schema": {"version": "16.0.0"}
+}`
+	mock.ExpectQuery(regexp.QuoteMeta("FROM projects WHERE id = ?")).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_versions WHERE purl = ?")).WithArgs("pkg:apk/alpine/musl@1.2.4-r2").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_components WHERE normalized_name = ?")).WithArgs("musl").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_sessions")).
+		WithArgs(sqlmock.AnyArg(), pid, "syft-json", "alpine:3.18", nil, "OPEN", "api-user", sqlmock.AnyArg(), nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_session_items")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, "a1", "musl", "1.2.4-r2", "pkg:apk/alpine/musl@1.2.4-r2", nil, nil, nil, nil, nil, nil, nil, sqlmock.AnyArg(), true,
+			"BUNDLED_BINARY", nil, "{\"OS\"}", "image alpine:3.18@sha256:bbbb (layer sha256:aaaa)", sqlmock.AnyArg(), "NEW_COMPONENT", sqlmock.AnyArg(), nil, nil, "PENDING", nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/syft?dryRun=true", strings.NewReader(doc))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ImportSession
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	item := (*res.Items)[0]
+	require.Equal(t, []gen.Layer{gen.OS}, *item.Layers)
+	require.Equal(t, "image alpine:3.18@sha256:bbbb (layer sha256:aaaa)", *item.InclusionNote)
+	require.Equal(t, gen.BUNDLEDBINARY, *item.UsageRole)
+}
+
+func TestImportProjectSpdx_DryRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM projects WHERE id = ?")).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_components WHERE normalized_name = ?")).WithArgs("left-pad").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_sessions")).
+		WithArgs(sqlmock.AnyArg(), pid, "spdx-json", "app", nil, "OPEN", "api-user", sqlmock.AnyArg(), nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_session_items")).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/spdx?dryRun=true", strings.NewReader(importSPDXDoc))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ImportSession
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, gen.ImportSessionStatusOPEN, res.Status)
+	require.Len(t, *res.Items, 1)
+	item := (*res.Items)[0]
+	require.Equal(t, gen.NEWCOMPONENT, item.Proposal)
+	require.Equal(t, gen.ImportDecisionPENDING, item.Decision)
+	require.True(t, item.DirectDependency)
+}
+
+func TestImportSession_Errors(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	sid, itemID := uuid.NewString(), uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	committed := func() *sqlmock.Rows {
+		return sqlmock.NewRows(importSessionColumns).AddRow(sid, uuid.NewString(), "spdx-json", nil, nil, "COMMITTED", "alice", now, "alice", now)
+	}
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		expect func()
+		status int
+	}{
+		{"get not found", http.MethodGet, "/import/sessions/" + sid, "", func() {
+			mock.ExpectQuery(regexp.QuoteMeta("FROM import_sessions WHERE id = ?")).WithArgs(sid).WillReturnError(sql.ErrNoRows)
+		}, http.StatusNotFound},
+		{"commit twice", http.MethodPost, "/import/sessions/" + sid + "/commit", "", func() {
+			mock.ExpectQuery(regexp.QuoteMeta("FROM import_sessions WHERE id = ?")).WithArgs(sid).WillReturnRows(committed())
+		}, http.StatusConflict},
+		{"delete committed", http.MethodDelete, "/import/sessions/" + sid, "", func() {
+			mock.ExpectQuery(regexp.QuoteMeta("FROM import_sessions WHERE id = ?")).WithArgs(sid).WillReturnRows(committed())
+		}, http.StatusConflict},
+		{"invalid decision", http.MethodPatch, "/import/sessions/" + sid + "/items/" + itemID, `{"decision":"REMAPPED"}`, func() {
+			mock.ExpectQuery(regexp.QuoteMeta("FROM import_sessions WHERE id = ?")).WithArgs(sid).WillReturnRows(
+				sqlmock.NewRows(importSessionColumns).AddRow(sid, uuid.NewString(), "spdx-json", nil, nil, "OPEN", "alice", now, nil, nil))
+			mock.ExpectQuery(regexp.QuoteMeta("FROM import_session_items WHERE session_id = ? AND id = ?")).WithArgs(sid, itemID).WillReturnRows(
+				sqlmock.NewRows(importSessionItemColumns).
+					AddRow(itemID, sid, 0, "a", "left-pad", "1.3.0", nil, nil, nil, nil, nil, nil, nil, nil, "{}", false, nil, nil, nil, nil, nil, "NEW_COMPONENT", nil, nil, nil, "PENDING", nil, nil))
+		}, http.StatusBadRequest},
+		{"usage role and reset", http.MethodPatch, "/import/sessions/" + sid + "/items/" + itemID, `{"decision":"ACCEPTED","usageRole":"DEV_ONLY","resetUsageRole":true}`, func() {}, http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expect()
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			require.Equal(t, tc.status, rec.Code, rec.Body.String())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateImportSessionItem_KeepsDetectedUsageRole(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	sid, itemID := uuid.NewString(), uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	for _, tc := range []struct {
+		name, body       string
+		stored, override any
+		want             string
+	}{
+		// decision のみの指定では推定した利用形態 (DEV_ONLY) を上書きしない
+		{"decision only", `{"decision":"ACCEPTED"}`, nil, nil, "DEV_ONLY"},
+		{"keep override", `{"decision":"ACCEPTED"}`, "STATIC_LINK", "STATIC_LINK", "STATIC_LINK"},
+		{"override", `{"decision":"ACCEPTED","usageRole":"BUILD_ONLY"}`, nil, "BUILD_ONLY", "BUILD_ONLY"},
+		{"reset", `{"decision":"ACCEPTED","resetUsageRole":true}`, "STATIC_LINK", nil, "DEV_ONLY"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("FROM import_sessions WHERE id = ?")).WithArgs(sid).WillReturnRows(
+				sqlmock.NewRows(importSessionColumns).AddRow(sid, uuid.NewString(), "npm-lock", nil, nil, "OPEN", "alice", now, nil, nil))
+			mock.ExpectQuery(regexp.QuoteMeta("FROM import_session_items WHERE session_id = ? AND id = ?")).WithArgs(sid, itemID).WillReturnRows(
+				sqlmock.NewRows(importSessionItemColumns).
+					AddRow(itemID, sid, 1, "a", "jest", "29.7.0", nil, nil, nil, nil, nil, nil, nil, nil, "{}", false, "DEV_ONLY", tc.stored, nil, nil, nil, "NEW_COMPONENT", nil, nil, nil, "PENDING", nil, nil))
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE import_session_items SET usage_role_override = ?")).
+				WithArgs(tc.override, nil, nil, nil, "ACCEPTED", nil, nil, itemID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			req := httptest.NewRequest(http.MethodPatch, "/import/sessions/"+sid+"/items/"+itemID, strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			require.NoError(t, mock.ExpectationsWereMet())
+			var res gen.ImportSessionItem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Equal(t, gen.UsageRole(tc.want), *res.UsageRole)
+			require.Equal(t, gen.DEVONLY, *res.DetectedUsageRole)
+		})
+	}
+}
+
+// multipartBody はファイル名をキーとしたマルチパートのリクエストボディを作る。
+func multipartBody(t *testing.T, files map[string]string) (*bytes.Buffer, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for name, content := range files {
+		fw, err := w.CreateFormFile(name, name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return &buf, w.FormDataContentType()
+}
+
+func TestImportProjectGomod(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM projects WHERE id = ?")).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_versions WHERE purl = ?")).WithArgs("pkg:golang/golang.org/x/mod@v0.21.0").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_components WHERE normalized_name = ?")).WithArgs("golang.org/x/mod").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_sessions")).
+		WithArgs(sqlmock.AnyArg(), pid, "gomod", "example.com/app", nil, "OPEN", "api-user", sqlmock.AnyArg(), nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_session_items")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, "golang.org/x/mod@v0.21.0", "golang.org/x/mod", "v0.21.0", "pkg:golang/golang.org/x/mod@v0.21.0", nil, nil, nil, nil,
+			"befac7cd1c117d529288bac6f9de05325fd08b8ba404213a9199535240d8453d", nil, nil, sqlmock.AnyArg(), false, nil, nil, sqlmock.AnyArg(), nil, sqlmock.AnyArg(), "NEW_COMPONENT", sqlmock.AnyArg(), nil, nil, "PENDING", nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	body, contentType := multipartBody(t, map[string]string{
+		"gomod": "module example.com/app\n\nrequire golang.org/x/mod v0.21.0 // indirect\n",
+		"gosum": "golang.org/x/mod v0.21.0 h1:vvrHzRwRfVKSiLrG+d4FMl/Qi4ukBCE6kZlTUkDYRT0=\n",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/gomod?dryRun=true", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ImportSession
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	item := (*res.Items)[0]
+	require.Equal(t, "pkg:golang/golang.org/x/mod@v0.21.0", *item.Purl)
+	require.False(t, item.DirectDependency)
+}
+
+func TestImportProjectGomod_Errors(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+	pid := uuid.NewString()
+
+	for name, files := range map[string]map[string]string{
+		"missing go.mod": {"gosum": ""},
+		"invalid go.mod": {"gomod": "require ("},
+	} {
+		t.Run(name, func(t *testing.T) {
+			body, contentType := multipartBody(t, files)
+			req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/gomod", body)
+			req.Header.Set(echo.HeaderContentType, contentType)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestImportProjectNpm(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM projects WHERE id = ?")).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM scope_policies LIMIT 1")).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_versions WHERE purl = ?")).WithArgs("pkg:npm/%40types/node@20.1.0").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_components WHERE normalized_name = ?")).WithArgs("@types/node").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO oss_components")).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAudit(mock, "OSS_COMPONENT", "CREATE")
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO oss_versions")).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAudit(mock, "OSS_VERSION", "CREATE")
+	mock.ExpectQuery(regexp.QuoteMeta("FROM project_usages WHERE project_id = ? AND oss_version_id = ?")).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO project_usages")).
+		WithArgs(sqlmock.AnyArg(), pid, sqlmock.AnyArg(), sqlmock.AnyArg(), "DEV_ONLY", "OUT_SCOPE", nil, true, sqlmock.AnyArg(), nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAudit(mock, "PROJECT_USAGE", "CREATE")
+	expectAudit(mock, "PROJECT", "IMPORT")
+
+	body, contentType := multipartBody(t, map[string]string{
+		"lockfile": `{"name": "web", "lockfileVersion": 3, "packages": {
+  "": {"devDependencies": {"@types/node": "^20.0.0"}},
+  "node_modules/@types/node": {"version": "20.1.0", "dev": true, "license": "MIT"}
+}}`,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/npm", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ImportReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, "npm-package-lock", res.Format)
+	require.Equal(t, gen.DEVONLY, *res.Items[0].UsageRole)
+	require.Equal(t, gen.OUTSCOPE, *res.Items[0].ScopeStatus)
+}
+
+func TestImportProjectNpm_Errors(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+	pid := uuid.NewString()
+
+	for name, files := range map[string]map[string]string{
+		"missing lockfile":     {"packageJson": "{}"},
+		"unsupported lockfile": {"lockfile": `{"lockfileVersion": 1}`},
+		"invalid package.json": {"lockfile": "react@^18.2.0:\n  version \"18.2.0\"\n", "packageJson": "{"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			body, contentType := multipartBody(t, files)
+			req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/npm", body)
+			req.Header.Set(echo.HeaderContentType, contentType)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestImportProjectMaven(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	pid := uuid.NewString()
+	ossID, versionID := uuid.NewString(), uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	purl := "pkg:maven/junit/junit@4.13.2"
+	mock.ExpectQuery(regexp.QuoteMeta("FROM projects WHERE id = ?")).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_versions WHERE purl = ?")).WithArgs(purl).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "oss_id", "version", "release_date", "license_expression_raw", "license_concluded", "purl", "cpe_list", "hash_sha256", "hash_sha512", "modified", "modification_description", "review_status", "last_reviewed_at", "scope_status", "supplier_type", "fork_origin_url", "copyright_text", "created_at", "updated_at"}).
+			AddRow(versionID, ossID, "4.13.2", nil, nil, nil, purl, "{}", nil, nil, false, nil, "verified", nil, "IN_SCOPE", nil, nil, nil, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_components WHERE id = ?")).WithArgs(ossID).WillReturnRows(sqlmock.NewRows(importComponentColumns).AddRow(ossID, "junit", "junit", nil, nil, nil, nil, nil, false, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM project_usages WHERE project_id = ? AND oss_version_id = ?")).WithArgs(pid, versionID).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_sessions")).
+		WithArgs(sqlmock.AnyArg(), pid, "maven-dependency-list", nil, nil, "OPEN", "api-user", sqlmock.AnyArg(), nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_session_items")).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	body, contentType := multipartBody(t, map[string]string{"file": "[INFO]    junit:junit:jar:4.13.2:test\n"})
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/maven?dryRun=true", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ImportSession
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	item := (*res.Items)[0]
+	require.Equal(t, gen.MATCH, item.Proposal)
+	require.Equal(t, ossID, item.OssId.String())
+	require.Equal(t, gen.TESTONLY, *item.UsageRole)
+}
+
+func TestImportProjectCargo(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+
+	pid := uuid.NewString()
+	ossID, versionID := uuid.NewString(), uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	purl := "pkg:cargo/serde@1.0.188"
+	mock.ExpectQuery(regexp.QuoteMeta("FROM projects WHERE id = ?")).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_versions WHERE purl = ?")).WithArgs(purl).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "oss_id", "version", "release_date", "license_expression_raw", "license_concluded", "purl", "cpe_list", "hash_sha256", "hash_sha512", "modified", "modification_description", "review_status", "last_reviewed_at", "scope_status", "supplier_type", "fork_origin_url", "copyright_text", "created_at", "updated_at"}).
+			AddRow(versionID, ossID, "1.0.188", nil, nil, nil, purl, "{}", nil, nil, false, nil, "verified", nil, "IN_SCOPE", nil, nil, nil, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM oss_components WHERE id = ?")).WithArgs(ossID).WillReturnRows(sqlmock.NewRows(importComponentColumns).AddRow(ossID, "serde", "serde", nil, nil, nil, nil, nil, false, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM project_usages WHERE project_id = ? AND oss_version_id = ?")).WithArgs(pid, versionID).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_sessions")).
+		WithArgs(sqlmock.AnyArg(), pid, "cargo-lock", "app", "BUNDLED_BINARY", "OPEN", "api-user", sqlmock.AnyArg(), nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_session_items")).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	lock := "[[package]]\nname = \"app\"\nversion = \"0.1.0\"\ndependencies = [\"serde\"]\n\n" +
+		"[[package]]\nname = \"serde\"\nversion = \"1.0.188\"\nsource = \"registry+https://github.com/rust-lang/crates.io-index\"\n"
+	body, contentType := multipartBody(t, map[string]string{"file": lock})
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/cargo?dryRun=true&usageRole=BUNDLED_BINARY", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ImportSession
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	item := (*res.Items)[0]
+	require.Equal(t, gen.MATCH, item.Proposal)
+	require.Equal(t, "cargo-lock", res.Format)
+	require.Equal(t, gen.BUNDLEDBINARY, *res.UsageRole)
+}
+
+func TestImportProjectPython_BadRequest(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newImportHandler(db))
+	pid := uuid.NewString()
+
+	body, contentType := multipartBody(t, map[string]string{"other": "x"})
+	req := httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/python", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	body, contentType = multipartBody(t, map[string]string{"file": "{\"default\": "})
+	req = httptest.NewRequest(http.MethodPost, "/projects/"+pid+"/import/python", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "invalid lockfile")
+}
@@ -0,0 +1,330 @@
+package handler
+
+// license_policies_handler.go - /license-policies とプロジェクトのポリシー評価に関するハンドラ処理
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+	problem "github.com/ramsesyok/oss-catalog/pkg/response"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/policy"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+)
+
+func toLicensePolicyRule(m model.LicensePolicyRule) gen.LicensePolicyRule {
+	res := gen.LicensePolicyRule{
+		Id:              uuid.MustParse(m.ID),
+		Name:            m.Name,
+		Description:     m.Description,
+		Severity:        gen.PolicySeverity(m.Severity),
+		Licenses:        []string{},
+		Categories:      []gen.LicenseCategory{},
+		UsageRoles:      []gen.UsageRole{},
+		ScopeStatuses:   []gen.ScopeStatus{},
+		ProjectStatuses: []gen.ProjectStatus{},
+		Enabled:         m.Enabled,
+		UpdatedBy:       m.UpdatedBy,
+		CreatedAt:       m.CreatedAt.TimeValue(),
+		UpdatedAt:       m.UpdatedAt.TimeValue(),
+	}
+	res.Licenses = append(res.Licenses, m.Licenses...)
+	for _, c := range m.Categories {
+		res.Categories = append(res.Categories, gen.LicenseCategory(c))
+	}
+	for _, r := range m.UsageRoles {
+		res.UsageRoles = append(res.UsageRoles, gen.UsageRole(r))
+	}
+	for _, s := range m.ScopeStatuses {
+		res.ScopeStatuses = append(res.ScopeStatuses, gen.ScopeStatus(s))
+	}
+	for _, s := range m.ProjectStatuses {
+		res.ProjectStatuses = append(res.ProjectStatuses, gen.ProjectStatus(s))
+	}
+	return res
+}
+
+// enumStrings は列挙値の配列を文字列の配列に変換する。
+func enumStrings[T ~string](vals *[]T) []string {
+	if vals == nil {
+		return nil
+	}
+	res := make([]string, 0, len(*vals))
+	for _, v := range *vals {
+		res = append(res, string(v))
+	}
+	return res
+}
+
+// trimLicensePatterns は空白のみのパターンを除いて前後の空白を取り除く。
+func trimLicensePatterns(patterns []string) []string {
+	var res []string
+	for _, p := range patterns {
+		if p = strings.TrimSpace(p); p != "" {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// ライセンスポリシールール一覧
+// (GET /license-policies)
+func (h *Handler) ListLicensePolicyRules(ctx echo.Context) error {
+	list, err := h.LicensePolicyRepo.List(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+	res := make([]gen.LicensePolicyRule, len(list))
+	for i, r := range list {
+		res[i] = toLicensePolicyRule(r)
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// ライセンスポリシールール登録 (管理者)
+// (POST /license-policies)
+func (h *Handler) CreateLicensePolicyRule(ctx echo.Context) error {
+	var req gen.LicensePolicyRuleCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	now := dbtime.DBTime{Time: time.Now()}
+	rule := &model.LicensePolicyRule{
+		ID:              uuid.NewString(),
+		Name:            strings.TrimSpace(req.Name),
+		Description:     req.Description,
+		Severity:        string(req.Severity),
+		Categories:      enumStrings(req.Categories),
+		UsageRoles:      enumStrings(req.UsageRoles),
+		ScopeStatuses:   enumStrings(req.ScopeStatuses),
+		ProjectStatuses: enumStrings(req.ProjectStatuses),
+		Enabled:         true,
+		UpdatedBy:       currentUsername(ctx),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if req.Licenses != nil {
+		rule.Licenses = trimLicensePatterns(*req.Licenses)
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if err := h.checkLicensePolicyRule(ctx.Request().Context(), rule); err != nil {
+		return err
+	}
+	if err := h.LicensePolicyRepo.Create(ctx.Request().Context(), rule); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusCreated, toLicensePolicyRule(*rule))
+}
+
+// ライセンスポリシールール取得
+// (GET /license-policies/{ruleId})
+func (h *Handler) GetLicensePolicyRule(ctx echo.Context, ruleId openapi_types.UUID) error {
+	rule, err := h.LicensePolicyRepo.Get(ctx.Request().Context(), ruleId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "rule not found")
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toLicensePolicyRule(*rule))
+}
+
+// ライセンスポリシールール更新 (管理者)
+// (PATCH /license-policies/{ruleId})
+func (h *Handler) UpdateLicensePolicyRule(ctx echo.Context, ruleId openapi_types.UUID) error {
+	var req gen.LicensePolicyRuleUpdateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	rule, err := h.LicensePolicyRepo.Get(ctx.Request().Context(), ruleId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "rule not found")
+		}
+		return err
+	}
+	if req.Name != nil {
+		rule.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		rule.Description = req.Description
+	}
+	if req.Severity != nil {
+		rule.Severity = string(*req.Severity)
+	}
+	if req.Licenses != nil {
+		rule.Licenses = trimLicensePatterns(*req.Licenses)
+	}
+	if req.Categories != nil {
+		rule.Categories = enumStrings(req.Categories)
+	}
+	if req.UsageRoles != nil {
+		rule.UsageRoles = enumStrings(req.UsageRoles)
+	}
+	if req.ScopeStatuses != nil {
+		rule.ScopeStatuses = enumStrings(req.ScopeStatuses)
+	}
+	if req.ProjectStatuses != nil {
+		rule.ProjectStatuses = enumStrings(req.ProjectStatuses)
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	rule.UpdatedBy = currentUsername(ctx)
+	rule.UpdatedAt = dbtime.DBTime{Time: time.Now()}
+	if err := h.checkLicensePolicyRule(ctx.Request().Context(), rule); err != nil {
+		return err
+	}
+	if err := h.LicensePolicyRepo.Update(ctx.Request().Context(), rule); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toLicensePolicyRule(*rule))
+}
+
+// ライセンスポリシールール削除 (管理者)
+// (DELETE /license-policies/{ruleId})
+func (h *Handler) DeleteLicensePolicyRule(ctx echo.Context, ruleId openapi_types.UUID) error {
+	if _, err := h.LicensePolicyRepo.Get(ctx.Request().Context(), ruleId.String()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "rule not found")
+		}
+		return err
+	}
+	if err := h.LicensePolicyRepo.Delete(ctx.Request().Context(), ruleId.String()); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// checkLicensePolicyRule は必須項目と名前の重複を検証する。
+func (h *Handler) checkLicensePolicyRule(ctx context.Context, rule *model.LicensePolicyRule) error {
+	if rule.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "name is required")
+	}
+	switch rule.Severity {
+	case model.PolicySeverityDeny, model.PolicySeverityWarn, model.PolicySeverityReview:
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid severity")
+	}
+	if len(rule.Licenses) == 0 && len(rule.Categories) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "licenses or categories is required")
+	}
+	existing, err := h.LicensePolicyRepo.FindByName(ctx, rule.Name)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if existing != nil && existing.ID != rule.ID {
+		return echo.NewHTTPError(http.StatusConflict, "rule name already exists")
+	}
+	return nil
+}
+
+// プロジェクトのライセンスポリシー評価
+// (GET /projects/{projectId}/compliance)
+func (h *Handler) GetProjectCompliance(ctx echo.Context, projectId openapi_types.UUID, params gen.GetProjectComplianceParams) error {
+	scopes, err := parseScopes(params.Scopes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	report, err := h.complianceService().Evaluate(ctx.Request().Context(), projectId.String(), scopes)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return err
+	}
+	res := gen.ComplianceReport{
+		ProjectId:   projectId,
+		EvaluatedAt: time.Now(),
+		Scopes:      make([]gen.ScopeStatus, len(scopes)),
+		Compliant:   report.Compliant(),
+		Violations:  make([]gen.PolicyViolation, len(report.Violations)),
+	}
+	for i, s := range scopes {
+		res.Scopes[i] = gen.ScopeStatus(s)
+	}
+	res.Summary.Deny = report.Deny
+	res.Summary.Warn = report.Warn
+	res.Summary.Review = report.Review
+	for i, v := range report.Violations {
+		res.Violations[i] = toPolicyViolation(v)
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func toPolicyViolation(v policy.Violation) gen.PolicyViolation {
+	res := gen.PolicyViolation{
+		UsageId:           uuid.MustParse(v.Usage.ID),
+		OssId:             uuid.MustParse(v.Usage.OssID),
+		OssVersionId:      uuid.MustParse(v.Usage.OssVersionID),
+		ComponentName:     v.Component.Name,
+		Version:           v.Version.Version,
+		UsageRole:         gen.UsageRole(v.Usage.UsageRole),
+		ScopeStatus:       gen.ScopeStatus(v.Usage.ScopeStatus),
+		LicenseExpression: v.Expression,
+		Severity:          gen.PolicySeverity(v.Severity),
+		Message:           v.Message,
+	}
+	if v.License != "" {
+		res.License = &v.License
+	}
+	if v.Rule != nil {
+		id := uuid.MustParse(v.Rule.ID)
+		res.RuleId = &id
+		res.RuleName = &v.Rule.Name
+	}
+	return res
+}
+
+func (h *Handler) complianceService() *service.ComplianceService {
+	return &service.ComplianceService{
+		ProjectRepo:       h.ProjectRepo,
+		ProjectUsageRepo:  h.ProjectUsageRepo,
+		LicensePolicyRepo: h.LicensePolicyRepo,
+		LicenseRepo:       h.LicenseRepo,
+	}
+}
+
+// checkLicensePolicy はエクスポート前に指定スコープの利用をライセンスポリシーで評価し、
+// DENY の違反がある場合は違反を列挙した 409 を応答する。応答した場合は true を返す。
+// ライセンスポリシーのリポジトリが未設定の場合は評価しない。
+func (h *Handler) checkLicensePolicy(ctx echo.Context, projectID string, scopes []string) (bool, error) {
+	if h.LicensePolicyRepo == nil {
+		return false, nil
+	}
+	report, err := h.complianceService().Evaluate(ctx.Request().Context(), projectID, scopes)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return false, err
+	}
+	if report.Compliant() {
+		return false, nil
+	}
+	var errs []gen.ProblemFieldError
+	for _, v := range report.Violations {
+		if v.Severity != model.PolicySeverityDeny {
+			continue
+		}
+		field := fmt.Sprintf("%s@%s", v.Component.Name, v.Version.Version)
+		msg := v.Message
+		errs = append(errs, gen.ProblemFieldError{Field: &field, Message: &msg})
+	}
+	detail := fmt.Sprintf("%d license policy violation(s) block the export", report.Deny)
+	return true, problem.Conflict(ctx, "LICENSE_POLICY_VIOLATION", detail, errs)
+}
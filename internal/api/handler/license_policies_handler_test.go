@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+var licensePolicyRuleColumnNames = []string{"id", "name", "description", "severity", "licenses", "categories", "usage_roles", "scope_statuses", "project_statuses", "enabled", "updated_by", "created_at", "updated_at"}
+
+const licensePolicyRuleSelect = "SELECT id, name, description, severity, licenses, categories, usage_roles, scope_statuses, project_statuses, enabled, updated_by, created_at, updated_at FROM license_policy_rules"
+
+func TestCreateLicensePolicyRule(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{LicensePolicyRepo: &infrarepo.LicensePolicyRuleRepository{DB: db}}
+	e := setupEcho(h)
+
+	mock.ExpectQuery(regexp.QuoteMeta(licensePolicyRuleSelect + " WHERE name = ?")).WithArgs("deny AGPL").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO license_policy_rules")).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	body := `{"name":"deny AGPL","severity":"DENY","licenses":["AGPL-3.0*"," "],"scopeStatuses":["IN_SCOPE"],"projectStatuses":["DELIVERED"]}`
+	rec := doLicenseRequest(e, http.MethodPost, "/license-policies", body)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.LicensePolicyRule
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, gen.DENY, res.Severity)
+	require.Equal(t, []string{"AGPL-3.0*"}, res.Licenses)
+	require.Equal(t, []gen.ScopeStatus{gen.INSCOPE}, res.ScopeStatuses)
+	require.Equal(t, []gen.ProjectStatus{gen.DELIVERED}, res.ProjectStatuses)
+	require.Empty(t, res.UsageRoles)
+	require.True(t, res.Enabled)
+}
+
+func TestCreateLicensePolicyRule_Invalid(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{LicensePolicyRepo: &infrarepo.LicensePolicyRuleRepository{DB: db}}
+	e := setupEcho(h)
+
+	rec := doLicenseRequest(e, http.MethodPost, "/license-policies", `{"name":"empty","severity":"WARN"}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(licensePolicyRuleSelect + " WHERE name = ?")).WithArgs("dup").WillReturnRows(
+		sqlmock.NewRows(licensePolicyRuleColumnNames).AddRow(uuid.NewString(), "dup", nil, "WARN", "{GPL-*}", "{}", "{}", "{}", nil, true, "admin", now, now))
+	rec = doLicenseRequest(e, http.MethodPost, "/license-policies", `{"name":"dup","severity":"WARN","categories":["STRONG_COPYLEFT"]}`)
+	require.Equal(t, http.StatusConflict, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetLicensePolicyRule_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{LicensePolicyRepo: &infrarepo.LicensePolicyRuleRepository{DB: db}}
+	e := setupEcho(h)
+
+	id := uuid.NewString()
+	mock.ExpectQuery(regexp.QuoteMeta(licensePolicyRuleSelect + " WHERE id = ?")).WithArgs(id).WillReturnError(sql.ErrNoRows)
+	rec := doLicenseRequest(e, http.MethodGet, "/license-policies/"+id, "")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectCompliance は IN_SCOPE の AGPL 利用 1 件と DENY ルール 1 件でのポリシー評価を期待する。
+func expectCompliance(mock sqlmock.Sqlmock, pid string) {
+	now := dbtime.DBTime{Time: time.Now()}
+	projQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	mock.ExpectQuery(projQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 2))
+	listQuery := regexp.QuoteMeta("FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE u.project_id = ? AND u.scope_status IN (?) ORDER BY c.normalized_name, v.version")
+	mock.ExpectQuery(listQuery).WithArgs(pid, "IN_SCOPE").WillReturnRows(sqlmock.NewRows(usageDetailColumns).
+		AddRow(usageDetailRow(pid, "Ghost", "1.0.0", "AGPL-3.0-only", "pkg:generic/ghost@1.0.0", now)...).
+		AddRow(usageDetailRow(pid, "Redis", "7.0.0", "BSD-3-Clause", "pkg:generic/redis@7.0.0", now)...))
+	mock.ExpectQuery(regexp.QuoteMeta(licensePolicyRuleSelect + " ORDER BY name")).WillReturnRows(
+		sqlmock.NewRows(licensePolicyRuleColumnNames).AddRow(uuid.NewString(), "deny AGPL", nil, "DENY", "{AGPL-3.0*}", "{}", "{}", "{IN_SCOPE}", nil, true, "admin", now, now))
+}
+
+func TestGetProjectCompliance(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{
+		ProjectRepo:       &infrarepo.ProjectRepository{DB: db},
+		ProjectUsageRepo:  &infrarepo.ProjectUsageRepository{DB: db},
+		LicensePolicyRepo: &infrarepo.LicensePolicyRuleRepository{DB: db},
+	}
+	e := setupEcho(h)
+
+	pid := uuid.NewString()
+	expectCompliance(mock, pid)
+	rec := doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/compliance", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ComplianceReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.False(t, res.Compliant)
+	require.Equal(t, []gen.ScopeStatus{gen.INSCOPE}, res.Scopes)
+	require.Equal(t, 1, res.Summary.Deny)
+	require.Len(t, res.Violations, 1)
+	v := res.Violations[0]
+	require.Equal(t, "Ghost", v.ComponentName)
+	require.Equal(t, "AGPL-3.0-only", *v.License)
+	require.Equal(t, "deny AGPL", *v.RuleName)
+	require.Equal(t, gen.DENY, v.Severity)
+}
+
+func TestExportProjectArtifacts_PolicyViolation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{
+		ProjectRepo:       &infrarepo.ProjectRepository{DB: db},
+		ProjectUsageRepo:  &infrarepo.ProjectUsageRepository{DB: db},
+		LicensePolicyRepo: &infrarepo.LicensePolicyRuleRepository{DB: db},
+	}
+	e := setupEcho(h)
+
+	pid := uuid.NewString()
+	expectCompliance(mock, pid)
+	rec := doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/export?format=csv", "")
+	require.Equal(t, http.StatusConflict, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	var p gen.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+	require.Equal(t, "LICENSE_POLICY_VIOLATION", *p.Code)
+	require.Len(t, *p.Errors, 1)
+	require.Equal(t, "Ghost@1.0.0", *(*p.Errors)[0].Field)
+}
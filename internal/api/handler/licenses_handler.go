@@ -0,0 +1,234 @@
+package handler
+
+// licenses_handler.go - /licenses に関するハンドラ処理
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+	"github.com/labstack/echo/v4"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+func toLicense(m model.License) gen.License {
+	res := gen.License{
+		Id:          m.ID,
+		Name:        m.Name,
+		OsiApproved: m.OsiApproved,
+		FsfLibre:    m.FsfLibre,
+		Text:        m.Text,
+		HasText:     m.HasText,
+		Custom:      m.Custom,
+		UpdatedBy:   m.UpdatedBy,
+		CreatedAt:   m.CreatedAt.TimeValue(),
+		UpdatedAt:   m.UpdatedAt.TimeValue(),
+	}
+	if m.Category != nil {
+		c := gen.LicenseCategory(*m.Category)
+		res.Category = &c
+	}
+	return res
+}
+
+// categoryPtr は分類を文字列に変換する。
+func categoryPtr(c *gen.LicenseCategory) *string {
+	if c == nil {
+		return nil
+	}
+	s := string(*c)
+	return &s
+}
+
+// ライセンス一覧
+// (GET /licenses)
+func (h *Handler) ListLicenses(ctx echo.Context, params gen.ListLicensesParams) error {
+	page := 1
+	if params.Page != nil {
+		page = int(*params.Page)
+	}
+	size := 50
+	if params.Size != nil {
+		size = int(*params.Size)
+	}
+	f := domrepo.LicenseFilter{Page: page, Size: size, Custom: params.Custom, HasText: params.HasText}
+	if params.Q != nil {
+		f.Query = *params.Q
+	}
+	if params.Category != nil {
+		f.Category = string(*params.Category)
+	}
+
+	list, total, err := h.LicenseRepo.Search(ctx.Request().Context(), f)
+	if err != nil {
+		return err
+	}
+	items := make([]gen.License, len(list))
+	for i, l := range list {
+		items[i] = toLicense(l)
+	}
+	res := gen.PagedResultLicense{
+		Items: &items,
+		Page:  &page,
+		Size:  &size,
+		Total: &total,
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// 独自ライセンス登録 (管理者)
+// (POST /licenses)
+func (h *Handler) CreateLicense(ctx echo.Context) error {
+	var req gen.LicenseCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	if !strings.HasPrefix(req.Id, "LicenseRef-") || !license.IsLicenseRef(req.Id) {
+		return echo.NewHTTPError(http.StatusBadRequest, "id must be LicenseRef-[A-Za-z0-9.-]+")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "name is required")
+	}
+	_, err := h.LicenseRepo.Get(ctx.Request().Context(), req.Id)
+	if err == nil {
+		return echo.NewHTTPError(http.StatusConflict, "license already exists")
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	now := dbtime.DBTime{Time: time.Now()}
+	user := currentUsername(ctx)
+	l := &model.License{
+		ID:        req.Id,
+		Name:      req.Name,
+		Category:  categoryPtr(req.Category),
+		Text:      req.Text,
+		Custom:    true,
+		UpdatedBy: &user,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if req.OsiApproved != nil {
+		l.OsiApproved = *req.OsiApproved
+	}
+	if req.FsfLibre != nil {
+		l.FsfLibre = *req.FsfLibre
+	}
+	if err := h.LicenseRepo.Create(ctx.Request().Context(), l); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusCreated, toLicense(*l))
+}
+
+// ライセンス取得 (全文を含む)
+// (GET /licenses/{licenseId})
+func (h *Handler) GetLicense(ctx echo.Context, licenseId string) error {
+	l, err := h.LicenseRepo.Get(ctx.Request().Context(), licenseId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "license not found")
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toLicense(*l))
+}
+
+// ライセンス更新 (管理者)
+// (PATCH /licenses/{licenseId})
+func (h *Handler) UpdateLicense(ctx echo.Context, licenseId string) error {
+	var req gen.LicenseUpdateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	l, err := h.LicenseRepo.Get(ctx.Request().Context(), licenseId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "license not found")
+		}
+		return err
+	}
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "name is required")
+		}
+		l.Name = *req.Name
+	}
+	if req.OsiApproved != nil {
+		l.OsiApproved = *req.OsiApproved
+	}
+	if req.FsfLibre != nil {
+		l.FsfLibre = *req.FsfLibre
+	}
+	if req.Category != nil {
+		l.Category = categoryPtr(req.Category)
+	}
+	if req.Text != nil {
+		l.Text = req.Text
+	}
+	user := currentUsername(ctx)
+	l.UpdatedBy = &user
+	l.UpdatedAt = dbtime.DBTime{Time: time.Now()}
+	if err := h.LicenseRepo.Update(ctx.Request().Context(), l); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toLicense(*l))
+}
+
+// 独自ライセンス削除 (管理者)
+// (DELETE /licenses/{licenseId})
+func (h *Handler) DeleteLicense(ctx echo.Context, licenseId string) error {
+	l, err := h.LicenseRepo.Get(ctx.Request().Context(), licenseId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "license not found")
+		}
+		return err
+	}
+	if !l.Custom {
+		return echo.NewHTTPError(http.StatusConflict, "SPDX license list entries cannot be deleted")
+	}
+	if err := h.LicenseRepo.Delete(ctx.Request().Context(), licenseId); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// unregisteredLicenseRefs はライセンス式に含まれる LicenseRef- の独自ライセンスのうち、
+// ライセンスカタログに未登録のものを項目毎のエラーとして返す。
+// 式は正規化済みであること。DocumentRef- で外部文書を参照するライセンスは検証しない。
+func (h *Handler) unregisteredLicenseRefs(ctx context.Context, field string, expr *string) ([]gen.ProblemFieldError, error) {
+	if h.LicenseRepo == nil || expr == nil || strings.TrimSpace(*expr) == "" {
+		return nil, nil
+	}
+	e, err := license.Parse(*expr)
+	if err != nil {
+		return nil, err
+	}
+	var errs []gen.ProblemFieldError
+	for _, l := range e.Licenses() {
+		if !strings.HasPrefix(l.License, "LicenseRef-") {
+			continue
+		}
+		_, err := h.LicenseRepo.Get(ctx, l.License)
+		if errors.Is(err, sql.ErrNoRows) {
+			msg := fmt.Sprintf("license %q is not registered in the license catalog", l.License)
+			errs = append(errs, gen.ProblemFieldError{Field: &field, Message: &msg})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return errs, nil
+}
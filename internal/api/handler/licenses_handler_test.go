@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
+)
+
+var licenseColumnNames = []string{"id", "name", "osi_approved", "fsf_libre", "category", "custom", "updated_by", "created_at", "updated_at"}
+
+const licenseSelect = "SELECT id, name, osi_approved, fsf_libre, category, custom, updated_by, created_at, updated_at"
+
+func expectLicenseGet(mock sqlmock.Sqlmock, id string, custom bool) {
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(licenseSelect + ", text FROM licenses WHERE id = ?")).WithArgs(id).WillReturnRows(
+		sqlmock.NewRows(append(licenseColumnNames, "text")).AddRow(id, id+" License", !custom, !custom, "PERMISSIVE", custom, nil, now, now, "full text"))
+}
+
+func doLicenseRequest(e *echo.Echo, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestListLicenses(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{LicenseRepo: &infrarepo.LicenseRepository{DB: db}}
+	e := setupEcho(h)
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM licenses WHERE (LOWER(id) LIKE ? OR LOWER(name) LIKE ?) AND category = ? AND (COALESCE(text, '') <> '') = ?")).
+		WithArgs("%agpl%", "%agpl%", "NETWORK_COPYLEFT", false).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(licenseSelect+", COALESCE(text, '') <> '' FROM licenses WHERE")).
+		WithArgs("%agpl%", "%agpl%", "NETWORK_COPYLEFT", false, 20, 0).WillReturnRows(
+		sqlmock.NewRows(append(licenseColumnNames, "has_text")).AddRow("AGPL-3.0-only", "GNU Affero General Public License v3.0 only", true, true, "NETWORK_COPYLEFT", false, nil, now, now, false))
+
+	rec := doLicenseRequest(e, http.MethodGet, "/licenses?q=AGPL&category=NETWORK_COPYLEFT&hasText=false&size=20", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.PagedResultLicense
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, 1, *res.Total)
+	items := *res.Items
+	require.Equal(t, "AGPL-3.0-only", items[0].Id)
+	require.Equal(t, gen.NETWORKCOPYLEFT, *items[0].Category)
+	require.Nil(t, items[0].Text)
+	require.False(t, items[0].HasText)
+}
+
+func TestGetLicense(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{LicenseRepo: &infrarepo.LicenseRepository{DB: db}}
+	e := setupEcho(h)
+
+	expectLicenseGet(mock, "MIT", false)
+	rec := doLicenseRequest(e, http.MethodGet, "/licenses/MIT", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var res gen.License
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, "full text", *res.Text)
+	require.True(t, res.OsiApproved)
+
+	mock.ExpectQuery(regexp.QuoteMeta(licenseSelect)).WithArgs("Unknown").WillReturnError(sql.ErrNoRows)
+	rec = doLicenseRequest(e, http.MethodGet, "/licenses/Unknown", "")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateLicense(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{LicenseRepo: &infrarepo.LicenseRepository{DB: db}}
+	e := setupEcho(h)
+
+	mock.ExpectQuery(regexp.QuoteMeta(licenseSelect)).WithArgs("LicenseRef-acme").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO licenses")).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	rec := doLicenseRequest(e, http.MethodPost, "/licenses", `{"id":"LicenseRef-acme","name":"ACME License","category":"WEAK_COPYLEFT","text":"ACME"}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.License
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.True(t, res.Custom)
+	require.Equal(t, gen.WEAKCOPYLEFT, *res.Category)
+	require.Equal(t, "api-user", *res.UpdatedBy)
+}
+
+func TestCreateLicense_Invalid(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{LicenseRepo: &infrarepo.LicenseRepository{DB: db}}
+	e := setupEcho(h)
+
+	// SPDX ライセンスリストの ID は登録できない
+	rec := doLicenseRequest(e, http.MethodPost, "/licenses", `{"id":"MIT","name":"MIT"}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	expectLicenseGet(mock, "LicenseRef-acme", true)
+	rec = doLicenseRequest(e, http.MethodPost, "/licenses", `{"id":"LicenseRef-acme","name":"ACME License"}`)
+	require.Equal(t, http.StatusConflict, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateLicense(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{LicenseRepo: &infrarepo.LicenseRepository{DB: db}}
+	e := setupEcho(h)
+
+	expectLicenseGet(mock, "GPL-3.0-only", false)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE licenses SET")).
+		WithArgs("GPL-3.0-only License", true, true, "STRONG_COPYLEFT", "GPL text", "api-user", sqlmock.AnyArg(), "GPL-3.0-only").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	rec := doLicenseRequest(e, http.MethodPatch, "/licenses/GPL-3.0-only", `{"category":"STRONG_COPYLEFT","text":"GPL text"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteLicense(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{LicenseRepo: &infrarepo.LicenseRepository{DB: db}}
+	e := setupEcho(h)
+
+	expectLicenseGet(mock, "MIT", false)
+	rec := doLicenseRequest(e, http.MethodDelete, "/licenses/MIT", "")
+	require.Equal(t, http.StatusConflict, rec.Code)
+
+	expectLicenseGet(mock, "LicenseRef-acme", true)
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM licenses WHERE id = ?")).WithArgs("LicenseRef-acme").WillReturnResult(sqlmock.NewResult(1, 1))
+	rec = doLicenseRequest(e, http.MethodDelete, "/licenses/LicenseRef-acme", "")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateOssVersion_UnregisteredLicenseRef(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{OssVersionRepo: &stubOssVersionRepo{}, LicenseRepo: &infrarepo.LicenseRepository{DB: db}}
+	e := setupEcho(h)
+
+	expectLicenseGet(mock, "LicenseRef-acme", true)
+	mock.ExpectQuery(regexp.QuoteMeta(licenseSelect)).WithArgs("LicenseRef-unknown").WillReturnError(sql.ErrNoRows)
+
+	body := `{"licenseConcluded":"MIT OR LicenseRef-acme OR LicenseRef-unknown"}`
+	rec := doLicenseRequest(e, http.MethodPatch, "/oss/"+uuid.NewString()+"/versions/"+uuid.NewString(), body)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	var p gen.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+	require.Len(t, *p.Errors, 1)
+	require.Equal(t, "licenseConcluded", *(*p.Errors)[0].Field)
+	require.Equal(t, `license "LicenseRef-unknown" is not registered in the license catalog`, *(*p.Errors)[0].Message)
+}
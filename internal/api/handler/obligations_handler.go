@@ -0,0 +1,145 @@
+package handler
+
+// obligations_handler.go - /projects/{projectId}/obligations に関するハンドラ処理
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+)
+
+func toProjectObligation(d model.ProjectObligationDetail) gen.ProjectObligation {
+	o := d.Obligation
+	res := gen.ProjectObligation{
+		Id:            uuid.MustParse(o.ID),
+		UsageId:       uuid.MustParse(o.UsageID),
+		ComponentName: d.ComponentName,
+		Version:       d.Version,
+		UsageRole:     gen.UsageRole(d.UsageRole),
+		ScopeStatus:   gen.ScopeStatus(d.ScopeStatus),
+		License:       o.License,
+		Obligation:    o.Obligation,
+		Description:   o.Description,
+		Status:        gen.ObligationStatus(o.Status),
+		EvidenceNote:  o.EvidenceNote,
+		ClosedBy:      o.ClosedBy,
+		CreatedAt:     o.CreatedAt.TimeValue(),
+		UpdatedAt:     o.UpdatedAt.TimeValue(),
+	}
+	if o.ClosedAt != nil {
+		t := o.ClosedAt.TimeValue()
+		res.ClosedAt = &t
+	}
+	return res
+}
+
+// プロジェクトの義務一覧
+// (GET /projects/{projectId}/obligations)
+func (h *Handler) ListProjectObligations(ctx echo.Context, projectId openapi_types.UUID, params gen.ListProjectObligationsParams) error {
+	if _, err := h.ProjectRepo.Get(ctx.Request().Context(), projectId.String()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return err
+	}
+	list, err := h.ObligationRepo.ListDetails(ctx.Request().Context(), projectId.String())
+	if err != nil {
+		return err
+	}
+	res := gen.ObligationReport{ProjectId: projectId, Items: []gen.ProjectObligation{}}
+	for _, d := range list {
+		switch d.Obligation.Status {
+		case model.ObligationOpen:
+			res.Summary.Open++
+		case model.ObligationFulfilled:
+			res.Summary.Fulfilled++
+		case model.ObligationWaived:
+			res.Summary.Waived++
+		}
+		if params.Status != nil && string(*params.Status) != d.Obligation.Status {
+			continue
+		}
+		res.Items = append(res.Items, toProjectObligation(d))
+	}
+	unsynced, err := h.obligationService().Unsynced(ctx.Request().Context(), projectId.String(), list)
+	if err != nil {
+		return err
+	}
+	res.Summary.Unsynced = unsynced
+	res.ReadyForDelivery = res.Summary.Open == 0 && unsynced == 0
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// 義務の導出
+// (POST /projects/{projectId}/obligations/sync)
+func (h *Handler) SyncProjectObligations(ctx echo.Context, projectId openapi_types.UUID) error {
+	added, removed, err := h.obligationService().Sync(ctx.Request().Context(), projectId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, gen.ObligationSyncResult{Added: added, Removed: removed})
+}
+
+// 義務の状態更新
+// (PATCH /projects/{projectId}/obligations/{obligationId})
+func (h *Handler) UpdateProjectObligation(ctx echo.Context, projectId openapi_types.UUID, obligationId openapi_types.UUID) error {
+	var req gen.ProjectObligationUpdateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	d, err := h.ObligationRepo.Get(ctx.Request().Context(), obligationId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "obligation not found")
+		}
+		return err
+	}
+	o := &d.Obligation
+	if o.ProjectID != projectId.String() {
+		return echo.NewHTTPError(http.StatusNotFound, "obligation not found")
+	}
+
+	now := dbtime.DBTime{Time: time.Now()}
+	switch req.Status {
+	case gen.ObligationStatusOPEN:
+		o.ClosedBy = nil
+		o.ClosedAt = nil
+	case gen.ObligationStatusFULFILLED, gen.ObligationStatusWAIVED:
+		if req.Status == gen.ObligationStatusWAIVED && (req.EvidenceNote == nil || strings.TrimSpace(*req.EvidenceNote) == "") {
+			return echo.NewHTTPError(http.StatusBadRequest, "evidenceNote is required to waive an obligation")
+		}
+		user := currentUsername(ctx)
+		o.ClosedBy = &user
+		o.ClosedAt = &now
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid status")
+	}
+	o.Status = string(req.Status)
+	if req.EvidenceNote != nil {
+		o.EvidenceNote = req.EvidenceNote
+	}
+	o.UpdatedAt = now
+	if err := h.ObligationRepo.Update(ctx.Request().Context(), o); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toProjectObligation(*d))
+}
+
+func (h *Handler) obligationService() *service.ObligationService {
+	return &service.ObligationService{ProjectRepo: h.ProjectRepo, ProjectUsageRepo: h.ProjectUsageRepo, ObligationRepo: h.ObligationRepo}
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+var obligationDetailColumnNames = []string{"id", "project_id", "usage_id", "license", "obligation", "description", "status", "evidence_note", "closed_by", "closed_at", "created_at", "updated_at", "name", "version", "usage_role", "scope_status"}
+
+const obligationDetailSelect = "SELECT o.id, o.project_id, o.usage_id, o.license, o.obligation, o.description, o.status, o.evidence_note, o.closed_by, o.closed_at, o.created_at, o.updated_at, c.name, v.version, u.usage_role, u.scope_status FROM project_obligations o"
+
+const projectGetQuery = "SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?"
+
+// usageDetailsQuery は義務の導出に用いる利用一覧 (IN_SCOPE・REVIEW_NEEDED) の検索条件。
+const usageDetailsQuery = "WHERE u.project_id = ? AND u.scope_status IN (?,?) ORDER BY c.normalized_name, v.version"
+
+var projectColumnNames = []string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}
+
+func TestListProjectObligations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{
+		ProjectRepo:      &infrarepo.ProjectRepository{DB: db},
+		ProjectUsageRepo: &infrarepo.ProjectUsageRepository{DB: db},
+		ObligationRepo:   &infrarepo.ProjectObligationRepository{DB: db},
+	}
+	e := setupEcho(h)
+
+	pid, uid := uuid.NewString(), uuid.NewString()
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(projectGetQuery)).WithArgs(pid).WillReturnRows(sqlmock.NewRows(projectColumnNames).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(obligationDetailSelect)).WithArgs(pid).WillReturnRows(sqlmock.NewRows(obligationDetailColumnNames).
+		AddRow(uuid.NewString(), pid, uid, "LGPL-2.1-only", "LICENSE_TEXT", "d", "FULFILLED", "NOTICE に掲載", "alice", now, now, now, "libfoo", "1.0", "STATIC_LINK", "IN_SCOPE").
+		AddRow(uuid.NewString(), pid, uid, "LGPL-2.1-only", "RELINKABLE_OBJECTS", "d", "OPEN", nil, nil, nil, now, now, "libfoo", "1.0", "STATIC_LINK", "IN_SCOPE"))
+	mock.ExpectQuery(regexp.QuoteMeta(usageDetailsQuery)).WithArgs(pid, "IN_SCOPE", "REVIEW_NEEDED").WillReturnRows(sqlmock.NewRows(usageDetailColumns))
+
+	rec := doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/obligations?status=OPEN", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ObligationReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.False(t, res.ReadyForDelivery)
+	require.Equal(t, 1, res.Summary.Open)
+	require.Equal(t, 1, res.Summary.Fulfilled)
+	require.Len(t, res.Items, 1)
+	require.Equal(t, "RELINKABLE_OBJECTS", res.Items[0].Obligation)
+	require.Equal(t, "libfoo", res.Items[0].ComponentName)
+	require.Equal(t, gen.STATICLINK, res.Items[0].UsageRole)
+}
+
+func TestListProjectObligations_Unsynced(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{
+		ProjectRepo:      &infrarepo.ProjectRepository{DB: db},
+		ProjectUsageRepo: &infrarepo.ProjectUsageRepository{DB: db},
+		ObligationRepo:   &infrarepo.ProjectObligationRepository{DB: db},
+	}
+	e := setupEcho(h)
+
+	// 義務を一度も導出していないプロジェクトは未対応の義務が無くても納品可とはしない
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	mock.ExpectQuery(regexp.QuoteMeta(projectGetQuery)).WithArgs(pid).WillReturnRows(sqlmock.NewRows(projectColumnNames).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(obligationDetailSelect)).WithArgs(pid).WillReturnRows(sqlmock.NewRows(obligationDetailColumnNames))
+	mock.ExpectQuery(regexp.QuoteMeta(usageDetailsQuery)).WithArgs(pid, "IN_SCOPE", "REVIEW_NEEDED").WillReturnRows(sqlmock.NewRows(usageDetailColumns).
+		AddRow(usageDetailRow(pid, "Commons", "2.0", "Apache-2.0", "pkg:maven/org.example/commons@2.0", now)...))
+
+	rec := doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/obligations", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ObligationReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, 0, res.Summary.Open)
+	require.Equal(t, 2, res.Summary.Unsynced)
+	require.False(t, res.ReadyForDelivery)
+}
+
+func TestSyncProjectObligations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{
+		ProjectRepo:      &infrarepo.ProjectRepository{DB: db},
+		ProjectUsageRepo: &infrarepo.ProjectUsageRepository{DB: db},
+		ObligationRepo:   &infrarepo.ProjectObligationRepository{DB: db},
+	}
+	e := setupEcho(h)
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	mock.ExpectQuery(regexp.QuoteMeta(projectGetQuery)).WithArgs(pid).WillReturnRows(sqlmock.NewRows(projectColumnNames).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(usageDetailsQuery)).WithArgs(pid, "IN_SCOPE", "REVIEW_NEEDED").WillReturnRows(sqlmock.NewRows(usageDetailColumns).
+		AddRow(usageDetailRow(pid, "Commons", "2.0", "Apache-2.0", "pkg:maven/org.example/commons@2.0", now)...))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, usage_id, license, obligation, status FROM project_obligations WHERE project_id = ?")).WithArgs(pid).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "usage_id", "license", "obligation", "status"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO project_obligations")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO project_obligations")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	rec := doLicenseRequest(e, http.MethodPost, "/projects/"+pid+"/obligations/sync", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.ObligationSyncResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	// Apache-2.0 の BUNDLED_BINARY はライセンス本文と NOTICE の義務を負う
+	require.Equal(t, 2, res.Added)
+	require.Equal(t, 0, res.Removed)
+}
+
+func TestUpdateProjectObligation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{ObligationRepo: &infrarepo.ProjectObligationRepository{DB: db}}
+	e := setupEcho(h)
+
+	pid, oid := uuid.NewString(), uuid.NewString()
+	now := time.Now()
+	expectGet := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(obligationDetailSelect + " JOIN project_usages u ON u.id = o.usage_id JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE o.id = ?")).WithArgs(oid).
+			WillReturnRows(sqlmock.NewRows(obligationDetailColumnNames).
+				AddRow(oid, pid, uuid.NewString(), "GPL-2.0-only", "SOURCE_OFFER", "d", "OPEN", nil, nil, nil, now, now, "busybox", "1.36", "BUNDLED_BINARY", "IN_SCOPE"))
+	}
+
+	// 免除には理由が必要
+	expectGet()
+	rec := doLicenseRequest(e, http.MethodPatch, "/projects/"+pid+"/obligations/"+oid, `{"status":"WAIVED"}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	expectGet()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE project_obligations SET status = ?, evidence_note = ?, closed_by = ?, closed_at = ?, updated_at = ? WHERE id = ?")).
+		WithArgs("FULFILLED", "ソース提供書面を同梱", "api-user", sqlmock.AnyArg(), sqlmock.AnyArg(), oid).WillReturnResult(sqlmock.NewResult(0, 1))
+	rec = doLicenseRequest(e, http.MethodPatch, "/projects/"+pid+"/obligations/"+oid, `{"status":"FULFILLED","evidenceNote":"ソース提供書面を同梱"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var res gen.ProjectObligation
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, gen.ObligationStatusFULFILLED, res.Status)
+	require.Equal(t, "api-user", *res.ClosedBy)
+	require.NotNil(t, res.ClosedAt)
+
+	// 別プロジェクトの義務は更新できない
+	expectGet()
+	rec = doLicenseRequest(e, http.MethodPatch, "/projects/"+uuid.NewString()+"/obligations/"+oid, `{"status":"FULFILLED"}`)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	mock.ExpectQuery(regexp.QuoteMeta(obligationDetailSelect)).WithArgs(oid).WillReturnError(sql.ErrNoRows)
+	rec = doLicenseRequest(e, http.MethodPatch, "/projects/"+pid+"/obligations/"+oid, `{"status":"OPEN"}`)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
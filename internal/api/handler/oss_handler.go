@@ -16,8 +16,10 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
 	"github.com/ramsesyok/oss-catalog/internal/domain/model"
 	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	problem "github.com/ramsesyok/oss-catalog/pkg/response"
 )
 
 func toOssComponent(m model.OssComponent) gen.OssComponent {
@@ -98,6 +100,9 @@ func toOssVersion(m model.OssVersion) gen.OssVersion {
 	if m.HashSha256 != nil {
 		res.HashSha256 = m.HashSha256
 	}
+	if m.HashSha512 != nil {
+		res.HashSha512 = m.HashSha512
+	}
 	if m.ModificationDescription != nil {
 		res.ModificationDescription = m.ModificationDescription
 	}
@@ -112,6 +117,9 @@ func toOssVersion(m model.OssVersion) gen.OssVersion {
 	if m.ForkOriginURL != nil {
 		res.ForkOriginUrl = m.ForkOriginURL
 	}
+	if m.CopyrightText != nil {
+		res.CopyrightText = m.CopyrightText
+	}
 	return res
 }
 
@@ -287,6 +295,33 @@ func (h *Handler) ListOssVersions(ctx echo.Context, ossId openapi_types.UUID, pa
 	return ctx.JSON(http.StatusOK, res)
 }
 
+// licenseField はリクエスト中のライセンス式の項目名と値。
+type licenseField struct {
+	name  string
+	value *string
+}
+
+// normalizeLicenses は各項目を SPDX ライセンス式として検証し、値を正規化した表記に置き換える。
+// 未指定・空文字の項目は検証しない。誤りがあった場合は項目毎のエラーを返す。
+func normalizeLicenses(fields ...licenseField) []gen.ProblemFieldError {
+	var errs []gen.ProblemFieldError
+	for _, f := range fields {
+		if f.value == nil || strings.TrimSpace(*f.value) == "" {
+			continue
+		}
+		norm, err := license.Normalize(*f.value)
+		var exprErr *license.ExpressionError
+		if errors.As(err, &exprErr) {
+			for _, msg := range exprErr.Errors {
+				errs = append(errs, gen.ProblemFieldError{Field: &f.name, Message: &msg})
+			}
+			continue
+		}
+		*f.value = norm
+	}
+	return errs
+}
+
 // バージョン追加
 // (POST /oss/{ossId}/versions)
 func (h *Handler) CreateOssVersion(ctx echo.Context, ossId openapi_types.UUID) error {
@@ -294,6 +329,9 @@ func (h *Handler) CreateOssVersion(ctx echo.Context, ossId openapi_types.UUID) e
 	if err := ctx.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
 	}
+	if errs := normalizeLicenses(licenseField{"licenseExpressionRaw", req.LicenseExpressionRaw}); len(errs) > 0 {
+		return problem.BadRequest(ctx, "INVALID_LICENSE_EXPRESSION", "invalid license expression", errs)
+	}
 	now := dbtime.DBTime{Time: time.Now()}
 	v := &model.OssVersion{
 		ID:           uuid.NewString(),
@@ -320,6 +358,9 @@ func (h *Handler) CreateOssVersion(ctx echo.Context, ossId openapi_types.UUID) e
 	if req.HashSha256 != nil {
 		v.HashSha256 = req.HashSha256
 	}
+	if req.HashSha512 != nil {
+		v.HashSha512 = req.HashSha512
+	}
 	if req.Modified != nil {
 		v.Modified = *req.Modified
 	}
@@ -333,6 +374,9 @@ func (h *Handler) CreateOssVersion(ctx echo.Context, ossId openapi_types.UUID) e
 	if req.ForkOriginUrl != nil {
 		v.ForkOriginURL = req.ForkOriginUrl
 	}
+	if req.CopyrightText != nil {
+		v.CopyrightText = req.CopyrightText
+	}
 	if err := h.OssVersionRepo.Create(ctx.Request().Context(), v); err != nil {
 		return err
 	}
@@ -370,6 +414,20 @@ func (h *Handler) UpdateOssVersion(ctx echo.Context, ossId openapi_types.UUID, v
 	if err := ctx.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
 	}
+	errs := normalizeLicenses(
+		licenseField{"licenseExpressionRaw", req.LicenseExpressionRaw},
+		licenseField{"licenseConcluded", req.LicenseConcluded},
+	)
+	if len(errs) > 0 {
+		return problem.BadRequest(ctx, "INVALID_LICENSE_EXPRESSION", "invalid license expression", errs)
+	}
+	errs, err := h.unregisteredLicenseRefs(ctx.Request().Context(), "licenseConcluded", req.LicenseConcluded)
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return problem.BadRequest(ctx, "UNKNOWN_LICENSE_REF", "license is not registered", errs)
+	}
 	v, err := h.OssVersionRepo.Get(ctx.Request().Context(), versionId.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -396,6 +454,9 @@ func (h *Handler) UpdateOssVersion(ctx echo.Context, ossId openapi_types.UUID, v
 	if req.HashSha256 != nil {
 		v.HashSha256 = req.HashSha256
 	}
+	if req.HashSha512 != nil {
+		v.HashSha512 = req.HashSha512
+	}
 	if req.Modified != nil {
 		v.Modified = *req.Modified
 	}
@@ -415,6 +476,9 @@ func (h *Handler) UpdateOssVersion(ctx echo.Context, ossId openapi_types.UUID, v
 	if req.ForkOriginUrl != nil {
 		v.ForkOriginURL = req.ForkOriginUrl
 	}
+	if req.CopyrightText != nil {
+		v.CopyrightText = req.CopyrightText
+	}
 	v.UpdatedAt = dbtime.DBTime{Time: time.Now()}
 	if err := h.OssVersionRepo.Update(ctx.Request().Context(), v); err != nil {
 		return err
@@ -35,6 +35,12 @@ func (s *stubOssComponentRepo) Create(ctx context.Context, c *model.OssComponent
 	}
 	return nil
 }
+func (s *stubOssComponentRepo) Get(ctx context.Context, id string) (*model.OssComponent, error) {
+	return nil, sql.ErrNoRows
+}
+func (s *stubOssComponentRepo) FindByNormalizedName(ctx context.Context, name string) (*model.OssComponent, error) {
+	return nil, sql.ErrNoRows
+}
 
 type stubOssComponentLayerRepo struct {
 	replaceFn func(context.Context, string, []string) error
@@ -106,6 +112,12 @@ func (s *stubOssVersionRepo) Update(ctx context.Context, v *model.OssVersion) er
 	}
 	return nil
 }
+func (s *stubOssVersionRepo) FindByPurl(ctx context.Context, purl string) (*model.OssVersion, error) {
+	return nil, sql.ErrNoRows
+}
+func (s *stubOssVersionRepo) FindByVersion(ctx context.Context, ossID, version string) (*model.OssVersion, error) {
+	return nil, sql.ErrNoRows
+}
 
 // --- tests ---
 func TestToOssComponent_AllFields(t *testing.T) {
@@ -266,6 +278,37 @@ func TestCreateOssVersion_InvalidBody(t *testing.T) {
 	require.Equal(t, http.StatusBadRequest, rec.Code)
 }
 
+func TestCreateOssVersion_License(t *testing.T) {
+	ossID := uuid.NewString()
+	var created *model.OssVersion
+	repo := &stubOssVersionRepo{createFn: func(ctx context.Context, v *model.OssVersion) error { created = v; return nil }}
+	h := &Handler{OssVersionRepo: repo}
+	e := setupEcho(h)
+
+	body := `{"version":"1.0.0","licenseExpressionRaw":"apache-2.0 OR (mit)"}`
+	req := httptest.NewRequest(http.MethodPost, "/oss/"+ossID+"/versions", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.Equal(t, "Apache-2.0 OR MIT", *created.LicenseExpressionRaw)
+
+	created = nil
+	body = `{"version":"1.0.0","licenseExpressionRaw":"Apache2 and MIT"}`
+	req = httptest.NewRequest(http.MethodPost, "/oss/"+ossID+"/versions", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Nil(t, created)
+	var p gen.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+	require.Equal(t, "INVALID_LICENSE_EXPRESSION", *p.Code)
+	require.Len(t, *p.Errors, 2)
+	require.Equal(t, "licenseExpressionRaw", *(*p.Errors)[0].Field)
+	require.Equal(t, `unknown license ID "Apache2"`, *(*p.Errors)[1].Message)
+}
+
 func TestDeleteOssVersion(t *testing.T) {
 	ossID := uuid.NewString()
 	vid := uuid.NewString()
@@ -355,3 +398,22 @@ func TestUpdateOssVersion_WithFields(t *testing.T) {
 	require.Equal(t, "MIT", *updated.LicenseExpressionRaw)
 	require.Equal(t, "OUT_SCOPE", updated.ScopeStatus)
 }
+
+func TestUpdateOssVersion_InvalidLicense(t *testing.T) {
+	ossID := uuid.NewString()
+	vid := uuid.NewString()
+	repo := &stubOssVersionRepo{}
+	h := &Handler{OssVersionRepo: repo}
+	e := setupEcho(h)
+	body := `{"licenseExpressionRaw":"GPL-2.0+","licenseConcluded":"GPL-2.0-or-later WITH Foo"}`
+	req := httptest.NewRequest(http.MethodPatch, "/oss/"+ossID+"/versions/"+vid, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	var p gen.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+	require.Len(t, *p.Errors, 1)
+	require.Equal(t, "licenseConcluded", *(*p.Errors)[0].Field)
+	require.Equal(t, `unknown license exception ID "Foo"`, *(*p.Errors)[0].Message)
+}
@@ -3,9 +3,12 @@ package handler
 // projects_handler.go - /projects に関するハンドラ処理
 
 import (
+	"bytes"
 	"database/sql"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
@@ -15,8 +18,11 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/export"
 	"github.com/ramsesyok/oss-catalog/internal/domain/model"
 	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+	"github.com/ramsesyok/oss-catalog/pkg/auth"
 )
 
 func toProject(m model.Project) gen.Project {
@@ -25,6 +31,7 @@ func toProject(m model.Project) gen.Project {
 		Id:          uid,
 		ProjectCode: m.ProjectCode,
 		Name:        m.Name,
+		Status:      gen.ProjectStatus(m.Status),
 		CreatedAt:   m.CreatedAt.TimeValue(),
 		UpdatedAt:   m.UpdatedAt.TimeValue(),
 	}
@@ -74,25 +81,6 @@ func toProjectUsage(m model.ProjectUsage) gen.ProjectUsage {
 	return res
 }
 
-func initialScopeStatus(policy *model.ScopePolicy, role string) string {
-	switch role {
-	case "BUILD_ONLY", "DEV_ONLY", "TEST_ONLY":
-		return string(gen.OUTSCOPE)
-	case "SERVER_ENV":
-		if policy != nil && policy.ServerEnvIncluded {
-			return string(gen.INSCOPE)
-		}
-		return string(gen.OUTSCOPE)
-	case "RUNTIME_REQUIRED":
-		if policy != nil && policy.RuntimeRequiredDefaultInScope {
-			return string(gen.INSCOPE)
-		}
-		return string(gen.REVIEWNEEDED)
-	default:
-		return string(gen.INSCOPE)
-	}
-}
-
 // プロジェクト一覧
 // (GET /projects)
 func (h *Handler) ListProjects(ctx echo.Context, params gen.ListProjectsParams) error {
@@ -143,6 +131,7 @@ func (h *Handler) CreateProject(ctx echo.Context) error {
 		ID:          uuid.NewString(),
 		ProjectCode: req.ProjectCode,
 		Name:        req.Name,
+		Status:      model.ProjectStatusActive,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
@@ -156,6 +145,9 @@ func (h *Handler) CreateProject(ctx echo.Context) error {
 		t := dbtime.DBTime{Time: req.DeliveryDate.Time}
 		p.DeliveryDate = &t
 	}
+	if req.Status != nil {
+		p.Status = string(*req.Status)
+	}
 	if req.Description != nil {
 		p.Description = req.Description
 	}
@@ -220,6 +212,9 @@ func (h *Handler) UpdateProject(ctx echo.Context, projectId openapi_types.UUID)
 		t := dbtime.DBTime{Time: req.DeliveryDate.Time}
 		p.DeliveryDate = &t
 	}
+	if req.Status != nil {
+		p.Status = string(*req.Status)
+	}
 	if req.Description != nil {
 		p.Description = req.Description
 	}
@@ -233,11 +228,103 @@ func (h *Handler) UpdateProject(ctx echo.Context, projectId openapi_types.UUID)
 	return ctx.JSON(http.StatusOK, res)
 }
 
-// プロジェクト納品用エクスポート (プレーホルダ)
+// プロジェクト納品用エクスポート
 // (GET /projects/{projectId}/export)
-func (*Handler) ExportProjectArtifacts(ctx echo.Context, projectId openapi_types.UUID, params gen.ExportProjectArtifactsParams) error {
-	// 一時的な実装
-	return ctx.JSON(http.StatusOK, map[string]any{"placeholder": "todo"})
+func (h *Handler) ExportProjectArtifacts(ctx echo.Context, projectId openapi_types.UUID, params gen.ExportProjectArtifactsParams) error {
+	scopes, err := parseScopes(params.Scopes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	var f export.Format
+	if params.Format == gen.ExportFormatTemplate {
+		if f, err = h.lookupExportTemplate(ctx, params.Template); err != nil {
+			return err
+		}
+	} else {
+		var ok bool
+		if f, ok = export.LookupFormat(string(params.Format)); !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid format")
+		}
+	}
+	if blocked, err := h.checkLicensePolicy(ctx, projectId.String(), scopes); blocked || err != nil {
+		return err
+	}
+	svc := service.ExportService{ProjectRepo: h.ProjectRepo, ProjectUsageRepo: h.ProjectUsageRepo, LicenseRepo: h.LicenseRepo, OssComponentLayerRepo: h.OssComponentLayerRepo, Vulnerabilities: h.Vulnerabilities}
+	doc, err := svc.BuildDocument(ctx.Request().Context(), projectId.String(), scopes, currentUsername(ctx))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return err
+	}
+	if f.NeedsFindings() {
+		if err := svc.AddFindings(ctx.Request().Context(), doc); err != nil {
+			return err
+		}
+	}
+
+	// 生成途中で失敗した場合に 200 の途中までの応答とならないよう、生成完了後に応答する
+	var buf bytes.Buffer
+	if err := f.Write(&buf, doc); err != nil {
+		if params.Format == gen.ExportFormatTemplate {
+			// ユーザ定義テンプレートは実データで失敗し得るため、利用者の誤りとして返す
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("template execution failed: %v", err))
+		}
+		return err
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", f.FileName(doc.Project.ProjectCode)))
+	return ctx.Blob(http.StatusOK, f.ContentType, buf.Bytes())
+}
+
+// lookupExportTemplate は名前で登録済みテンプレートを取得し、出力形式として返す。
+func (h *Handler) lookupExportTemplate(ctx echo.Context, name *string) (export.Format, error) {
+	if name == nil || *name == "" {
+		return export.Format{}, echo.NewHTTPError(http.StatusBadRequest, "template is required")
+	}
+	t, err := h.ExportTemplateRepo.FindByName(ctx.Request().Context(), *name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return export.Format{}, echo.NewHTTPError(http.StatusNotFound, "template not found")
+		}
+		return export.Format{}, err
+	}
+	tpl, err := export.ParseTemplate(*t)
+	if err != nil {
+		return export.Format{}, err
+	}
+	return tpl.Format(), nil
+}
+
+// currentUsername は認証済みユーザ名を返す。認証情報が無い場合は "api-user" とする。
+func currentUsername(ctx echo.Context) string {
+	if claims := auth.GetClaims(ctx); claims != nil && claims.Username != "" {
+		return claims.Username
+	}
+	return "api-user"
+}
+
+// parseScopes はカンマ区切りのスコープ指定を検証して分解する。
+// 未指定の場合は納品対象 (IN_SCOPE) のみとする。
+func parseScopes(param *string) ([]string, error) {
+	if param == nil || strings.TrimSpace(*param) == "" {
+		return []string{string(gen.INSCOPE)}, nil
+	}
+	var scopes []string
+	seen := map[string]bool{}
+	for _, s := range strings.Split(*param, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" || seen[s] {
+			continue
+		}
+		switch gen.ScopeStatus(s) {
+		case gen.INSCOPE, gen.OUTSCOPE, gen.REVIEWNEEDED:
+		default:
+			return nil, fmt.Errorf("invalid scope: %s", s)
+		}
+		seen[s] = true
+		scopes = append(scopes, s)
+	}
+	return scopes, nil
 }
 
 // プロジェクト中利用 OSS 一覧
@@ -291,7 +378,7 @@ func (h *Handler) CreateProjectUsage(ctx echo.Context, projectId openapi_types.U
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return err
 	}
-	scope := initialScopeStatus(policy, string(req.UsageRole))
+	scope := service.InitialScopeStatus(policy, string(req.UsageRole))
 	direct := true
 	if req.DirectDependency != nil {
 		direct = *req.DirectDependency
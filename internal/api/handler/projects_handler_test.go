@@ -2,6 +2,7 @@ package handler
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -13,13 +14,18 @@ import (
 	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 
 	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/export"
 	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
 	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/auth"
 )
 
 func TestListProjects(t *testing.T) {
@@ -36,8 +42,8 @@ func TestListProjects(t *testing.T) {
 	countQuery := regexp.QuoteMeta("SELECT COUNT(*) FROM projects WHERE project_code LIKE ?")
 	mock.ExpectQuery(countQuery).WithArgs("%PRJ%").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 
-	listQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE project_code LIKE ? ORDER BY created_at DESC LIMIT ? OFFSET ?")
-	mock.ExpectQuery(listQuery).WithArgs("%PRJ%", 50, 0).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "description", "created_at", "updated_at", "count"}).AddRow(id, "PRJ-1", "Proj", nil, nil, nil, nil, now, now, 0))
+	listQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE project_code LIKE ? ORDER BY created_at DESC LIMIT ? OFFSET ?")
+	mock.ExpectQuery(listQuery).WithArgs("%PRJ%", 50, 0).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(id, "PRJ-1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
 
 	req := httptest.NewRequest(http.MethodGet, "/projects?code=PRJ", nil)
 	rec := httptest.NewRecorder()
@@ -61,7 +67,7 @@ func TestGetProject_NotFound(t *testing.T) {
 	e := setupEcho(h)
 
 	pid := uuid.NewString()
-	query := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	query := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
 	mock.ExpectQuery(query).WithArgs(pid).WillReturnError(sql.ErrNoRows)
 
 	req := httptest.NewRequest(http.MethodGet, "/projects/"+pid, nil)
@@ -83,8 +89,8 @@ func TestGetProject_OK(t *testing.T) {
 
 	pid := uuid.NewString()
 	now := dbtime.DBTime{Time: time.Now()}
-	query := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
-	mock.ExpectQuery(query).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, nil, now, now, 0))
+	query := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	mock.ExpectQuery(query).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
 
 	req := httptest.NewRequest(http.MethodGet, "/projects/"+pid, nil)
 	rec := httptest.NewRecorder()
@@ -108,8 +114,8 @@ func TestCreateProject(t *testing.T) {
 
 	reqBody := `{"projectCode":"P1","name":"Proj"}`
 	// We don't check ID/time exactly; just expect exec with any args
-	query := regexp.QuoteMeta("INSERT INTO projects (id, project_code, name, department, manager, delivery_date, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)")
-	mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	query := regexp.QuoteMeta("INSERT INTO projects (id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	mock.ExpectExec(query).WithArgs(sqlmock.AnyArg(), "P1", "Proj", nil, nil, nil, "ACTIVE", nil, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	req := httptest.NewRequest(http.MethodPost, "/projects", strings.NewReader(reqBody))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
@@ -194,12 +200,12 @@ func TestUpdateProject(t *testing.T) {
 
 	pid := uuid.NewString()
 	now := dbtime.DBTime{Time: time.Now()}
-	getQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
-	mock.ExpectQuery(getQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, nil, now, now, 0))
-	updateQuery := regexp.QuoteMeta("UPDATE projects SET name = ?, department = ?, manager = ?, delivery_date = ?, description = ?, updated_at = ? WHERE id = ?")
-	mock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(1, 1))
+	getQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	mock.ExpectQuery(getQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
+	updateQuery := regexp.QuoteMeta("UPDATE projects SET name = ?, department = ?, manager = ?, delivery_date = ?, status = ?, description = ?, updated_at = ? WHERE id = ?")
+	mock.ExpectExec(updateQuery).WithArgs("Upd", nil, nil, nil, "DELIVERED", nil, sqlmock.AnyArg(), pid).WillReturnResult(sqlmock.NewResult(1, 1))
 
-	body := `{"name":"Upd"}`
+	body := `{"name":"Upd","status":"DELIVERED"}`
 	req := httptest.NewRequest(http.MethodPatch, "/projects/"+pid, strings.NewReader(body))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
@@ -391,25 +397,241 @@ func TestDeleteProjectUsage_Error(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+// expectUsageDependencies は Document の組み立てで依存グラフを取得するクエリを登録する。
+func expectUsageDependencies(mock sqlmock.Sqlmock, projectID string) {
+	mock.ExpectQuery(regexp.QuoteMeta("FROM project_usage_dependencies d")).WithArgs(projectID).
+		WillReturnRows(sqlmock.NewRows([]string{"usage_id", "depends_on_usage_id"}))
+}
+
 func TestExportProjectArtifacts(t *testing.T) {
-	h := &Handler{}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{ProjectRepo: &infrarepo.ProjectRepository{DB: db}, ProjectUsageRepo: &infrarepo.ProjectUsageRepository{DB: db}}
 	e := setupEcho(h)
+
 	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	getQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	mock.ExpectQuery(getQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 1))
+	listQuery := regexp.QuoteMeta("FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE u.project_id = ? AND u.scope_status IN (?) ORDER BY c.normalized_name, v.version")
+	mock.ExpectQuery(listQuery).WithArgs(pid, "IN_SCOPE").WillReturnRows(sqlmock.NewRows(usageDetailColumns).
+		AddRow(usageDetailRow(pid, "Redis", "7.0.0", "BSD-3-Clause", "pkg:generic/redis@7.0.0", now)...))
+	expectUsageDependencies(mock, pid)
+
 	req := httptest.NewRequest(http.MethodGet, "/projects/"+pid+"/export?format=csv", nil)
 	rec := httptest.NewRecorder()
 	e.ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Contains(t, rec.Header().Get(echo.HeaderContentType), "text/csv")
+	require.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "P1-oss-list.csv")
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Equal(t, "Redis,7.0.0,BSD-3-Clause,BSD-3-Clause,pkg:generic/redis@7.0.0,BUNDLED_BINARY,IN_SCOPE,false,UPSTREAM", lines[1])
+}
+
+func TestExportProjectArtifacts_OpenVEX(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := newVulnerabilityHandler(db)
+	h.ProjectRepo, h.ProjectUsageRepo = &infrarepo.ProjectRepository{DB: db}, &infrarepo.ProjectUsageRepository{DB: db}
+	e := setupEcho(h)
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	row := usageDetailRow(pid, "lodash", "4.17.20", "MIT", "pkg:npm/lodash@4.17.20", now)
+	getQuery := regexp.QuoteMeta("FROM projects WHERE id = ?")
+	listQuery := regexp.QuoteMeta("FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE u.project_id = ? AND u.scope_status IN (?) ORDER BY c.normalized_name, v.version")
+	// Document の組み立てと脆弱性の該当でそれぞれプロジェクトと利用を取得する
+	for i := range 2 {
+		mock.ExpectQuery(getQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 1))
+		mock.ExpectQuery(listQuery).WithArgs(pid, "IN_SCOPE").WillReturnRows(sqlmock.NewRows(usageDetailColumns).AddRow(row...))
+		if i == 0 {
+			expectUsageDependencies(mock, pid)
+		}
+	}
+	// 影響を受けないとした該当も VEX には含める
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_analyses WHERE project_id = ?")).WithArgs(pid).
+		WillReturnRows(sqlmock.NewRows(vulnerabilityAnalysisColumnNames).
+			AddRow(uuid.NewString(), pid, row[0], "GHSA-35jh-r3h4-6jhm", "not_affected", "vulnerable_code_not_in_execute_path", nil, nil, "alice", now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_package_ranges WHERE package_key = ?")).WithArgs("npm/lodash").
+		WillReturnRows(sqlmock.NewRows([]string{"vulnerability_id", "ecosystem", "package_name", "package_key", "range_type", "introduced", "fixed", "last_affected", "versions"}).
+			AddRow("GHSA-35jh-r3h4-6jhm", "npm", "lodash", "npm/lodash", "SEMVER", nil, "4.17.21", nil, nil))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_aliases")).WillReturnRows(sqlmock.NewRows([]string{"vulnerability_id", "alias"}))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerabilities WHERE id IN (?)")).WithArgs("GHSA-35jh-r3h4-6jhm").
+		WillReturnRows(sqlmock.NewRows(vulnerabilityColumnNames).
+			AddRow("GHSA-35jh-r3h4-6jhm", "OSV", nil, nil, "HIGH", 7.2, "3.1", nil, pq.StringArray{}, pq.StringArray{}, nil, nil, now, now))
+
+	req := httptest.NewRequest(http.MethodGet, "/projects/"+pid+"/export?format=openvex", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "P1.openvex.json")
+	var doc export.OpenVEXDocument
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	require.Len(t, doc.Statements, 1)
+	require.Equal(t, "not_affected", doc.Statements[0].Status)
+	require.Equal(t, "pkg:npm/lodash@4.17.20", doc.Statements[0].Products[0].Subcomponents[0].ID)
+}
+
+func TestExportProjectArtifacts_SPDX(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{ProjectRepo: &infrarepo.ProjectRepository{DB: db}, ProjectUsageRepo: &infrarepo.ProjectUsageRepository{DB: db}}
+	e := setupEcho(h)
+
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	getQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	mock.ExpectQuery(getQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 1))
+	listQuery := regexp.QuoteMeta("FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE u.project_id = ? AND u.scope_status IN (?) ORDER BY c.normalized_name, v.version")
+	mock.ExpectQuery(listQuery).WithArgs(pid, "IN_SCOPE").WillReturnRows(sqlmock.NewRows(usageDetailColumns).
+		AddRow(usageDetailRow(pid, "Redis", "7.0.0", "BSD-3-Clause", "pkg:generic/redis@7.0.0", now)...))
+	expectUsageDependencies(mock, pid)
+
+	req := httptest.NewRequest(http.MethodGet, "/projects/"+pid+"/export?format=spdx-json", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, "application/spdx+json", rec.Header().Get(echo.HeaderContentType))
+	require.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "P1.spdx.json")
+	var doc export.SPDXDocument
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	require.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	require.Len(t, doc.Packages, 2)
+	require.Equal(t, "Redis", doc.Packages[1].Name)
+	require.Equal(t, "BSD-3-Clause", doc.Packages[1].LicenseConcluded)
+}
+
+func TestExportProjectArtifacts_Formats(t *testing.T) {
+	cases := []struct {
+		format      string
+		contentType string
+		filename    string
+		contains    string
+	}{
+		{"cyclonedx-json", "application/vnd.cyclonedx+json", "P1.cdx.json", `"bomFormat": "CycloneDX"`},
+		{"cyclonedx-xml", "application/vnd.cyclonedx+xml", "P1.cdx.xml", `<bom xmlns="http://cyclonedx.org/schema/bom/1.5"`},
+		{"notice", "text/plain; charset=utf-8", "P1-NOTICE.txt", "License: BSD-3-Clause"},
+		{"notice-html", "text/html; charset=utf-8", "P1-NOTICE.html", "<h2>BSD-3-Clause</h2>"},
+		{"xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "P1-oss-list.xlsx", "xl/workbook.xml"},
+		{"bundle", "application/zip", "P1-delivery.zip", "manifest.json"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.format, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			h := &Handler{ProjectRepo: &infrarepo.ProjectRepository{DB: db}, ProjectUsageRepo: &infrarepo.ProjectUsageRepository{DB: db}}
+			e := setupEcho(h)
+
+			pid := uuid.NewString()
+			now := dbtime.DBTime{Time: time.Now()}
+			getQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+			mock.ExpectQuery(getQuery).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 1))
+			listQuery := regexp.QuoteMeta("FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE u.project_id = ? AND u.scope_status IN (?) ORDER BY c.normalized_name, v.version")
+			mock.ExpectQuery(listQuery).WithArgs(pid, "IN_SCOPE").WillReturnRows(sqlmock.NewRows(usageDetailColumns).
+				AddRow(usageDetailRow(pid, "Redis", "7.0.0", "BSD-3-Clause", "pkg:generic/redis@7.0.0", now)...))
+			expectUsageDependencies(mock, pid)
+
+			req := httptest.NewRequest(http.MethodGet, "/projects/"+pid+"/export?format="+tc.format, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.NoError(t, mock.ExpectationsWereMet())
+			require.Equal(t, tc.contentType, rec.Header().Get(echo.HeaderContentType))
+			require.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), tc.filename)
+			require.Contains(t, rec.Body.String(), tc.contains)
+		})
+	}
+}
+
+func TestExportProjectArtifacts_InvalidScope(t *testing.T) {
+	h := &Handler{}
+	e := setupEcho(h)
+	pid := uuid.NewString()
+	req := httptest.NewRequest(http.MethodGet, "/projects/"+pid+"/export?format=csv&scopes=IN_SCOPE,BOGUS", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestExportProjectArtifacts_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	h := &Handler{ProjectRepo: &infrarepo.ProjectRepository{DB: db}}
+	e := setupEcho(h)
+
+	pid := uuid.NewString()
+	getQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	mock.ExpectQuery(getQuery).WithArgs(pid).WillReturnError(sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodGet, "/projects/"+pid+"/export?format=csv", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCurrentUsername(t *testing.T) {
+	e := echo.New()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	require.Equal(t, "api-user", currentUsername(ctx))
+
+	ctx.Set("authUser", &jwt.Token{Claims: &auth.Claims{Username: "alice"}})
+	require.Equal(t, "alice", currentUsername(ctx))
+}
+
+func TestParseScopes(t *testing.T) {
+	scopes, err := parseScopes(nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"IN_SCOPE"}, scopes)
+
+	param := "IN_SCOPE, REVIEW_NEEDED,IN_SCOPE"
+	scopes, err = parseScopes(&param)
+	require.NoError(t, err)
+	require.Equal(t, []string{"IN_SCOPE", "REVIEW_NEEDED"}, scopes)
+
+	bad := "DELIVERED"
+	_, err = parseScopes(&bad)
+	require.Error(t, err)
+}
+
+// usageDetailColumns は ListDetails が返す列名。
+var usageDetailColumns = []string{"id", "project_id", "oss_id", "oss_version_id", "usage_role", "scope_status", "inclusion_note", "direct_dependency", "added_at", "evaluated_at", "evaluated_by", "name", "normalized_name", "homepage_url", "repository_url", "description", "primary_language", "version", "release_date", "license_expression_raw", "license_concluded", "purl", "cpe_list", "hash_sha256", "hash_sha512", "modified", "modification_description", "review_status", "last_reviewed_at", "v_scope_status", "supplier_type", "fork_origin_url", "copyright_text", "created_at", "updated_at"}
+
+// usageDetailRow は ListDetails 用のテスト行を生成する。
+func usageDetailRow(projectID, name, version, license, purl string, now dbtime.DBTime) []driver.Value {
+	return []driver.Value{uuid.NewString(), projectID, uuid.NewString(), uuid.NewString(), "BUNDLED_BINARY", "IN_SCOPE", nil, true, now, nil, nil,
+		name, strings.ToLower(name), nil, nil, nil, nil,
+		version, nil, license, license, purl, nil, nil, nil, false, nil, "verified", nil, "IN_SCOPE", "UPSTREAM", nil, nil, now, now}
 }
 
 func TestInitialScopeStatus(t *testing.T) {
 	policy := &model.ScopePolicy{RuntimeRequiredDefaultInScope: true, ServerEnvIncluded: false}
-	require.Equal(t, string(gen.INSCOPE), initialScopeStatus(policy, "RUNTIME_REQUIRED"))
-	require.Equal(t, string(gen.OUTSCOPE), initialScopeStatus(policy, "BUILD_ONLY"))
-	require.Equal(t, string(gen.OUTSCOPE), initialScopeStatus(policy, "SERVER_ENV"))
+	require.Equal(t, string(gen.INSCOPE), service.InitialScopeStatus(policy, "RUNTIME_REQUIRED"))
+	require.Equal(t, string(gen.OUTSCOPE), service.InitialScopeStatus(policy, "BUILD_ONLY"))
+	require.Equal(t, string(gen.OUTSCOPE), service.InitialScopeStatus(policy, "SERVER_ENV"))
 
 	// nil policy should result in REVIEW_NEEDED for runtime role
-	require.Equal(t, string(gen.REVIEWNEEDED), initialScopeStatus(nil, "RUNTIME_REQUIRED"))
+	require.Equal(t, string(gen.REVIEWNEEDED), service.InitialScopeStatus(nil, "RUNTIME_REQUIRED"))
 }
 
 func TestToProjectUsage(t *testing.T) {
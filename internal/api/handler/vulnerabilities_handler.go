@@ -0,0 +1,404 @@
+package handler
+
+// vulnerabilities_handler.go - /vulnerabilities, /oss/{ossId}/versions/{versionId}/vulnerabilities, /projects/{projectId}/vulnerabilities
+// および /projects/{projectId}/usages/{usageId}/vulnerabilities/{vulnerabilityId}/analysis に関するハンドラ処理
+
+import (
+	"archive/zip"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+	"github.com/ramsesyok/oss-catalog/internal/domain/vuln"
+)
+
+func toVulnerability(v model.Vulnerability) gen.Vulnerability {
+	res := gen.Vulnerability{
+		Id:          v.ID,
+		Source:      v.Source,
+		Description: v.Description,
+		Status:      v.Status,
+		CvssScore:   v.CvssScore,
+		CvssVersion: v.CvssVersion,
+		CvssVector:  v.CvssVector,
+		Weaknesses:  v.Weaknesses,
+		References:  v.ReferenceURLs,
+	}
+	if res.Weaknesses == nil {
+		res.Weaknesses = []string{}
+	}
+	if res.References == nil {
+		res.References = []string{}
+	}
+	if v.Severity != nil {
+		s := gen.VulnerabilitySeverity(*v.Severity)
+		res.Severity = &s
+	}
+	if v.PublishedAt != nil {
+		t := v.PublishedAt.TimeValue()
+		res.PublishedAt = &t
+	}
+	if v.LastModifiedAt != nil {
+		t := v.LastModifiedAt.TimeValue()
+		res.LastModifiedAt = &t
+	}
+	return res
+}
+
+func toVulnerabilityMatch(m model.VulnerabilityMatch) gen.VulnerabilityMatch {
+	res := gen.VulnerabilityMatch{
+		VulnerabilityId: m.VulnerabilityID,
+		MatchedBy:       gen.VulnerabilityMatchedBy(m.MatchedBy),
+		Identifier:      m.Identifier,
+	}
+	if c := m.Cpe; c != nil {
+		res.Criteria = &c.Criteria
+		res.VersionStartIncluding = c.VersionStartIncluding
+		res.VersionStartExcluding = c.VersionStartExcluding
+		res.VersionEndIncluding = c.VersionEndIncluding
+		res.VersionEndExcluding = c.VersionEndExcluding
+	}
+	if p := m.Package; p != nil {
+		res.Ecosystem = &p.Ecosystem
+		res.PackageName = &p.PackageName
+		res.RangeType = &p.RangeType
+		res.Introduced = p.Introduced
+		res.Fixed = p.Fixed
+		res.LastAffected = p.LastAffected
+	}
+	return res
+}
+
+func toVulnerabilityMatches(list []model.VulnerabilityMatch) []gen.VulnerabilityMatch {
+	res := make([]gen.VulnerabilityMatch, 0, len(list))
+	for _, m := range list {
+		res = append(res, toVulnerabilityMatch(m))
+	}
+	return res
+}
+
+func toVersionVulnerability(vv model.VersionVulnerability) gen.VersionVulnerability {
+	res := gen.VersionVulnerability{
+		Vulnerability: toVulnerability(vv.Vulnerability),
+		Aliases:       vv.Aliases,
+		Matches:       toVulnerabilityMatches(vv.Matches),
+		FixedVersions: vv.FixedVersions,
+	}
+	if res.Aliases == nil {
+		res.Aliases = []string{}
+	}
+	if res.FixedVersions == nil {
+		res.FixedVersions = []string{}
+	}
+	return res
+}
+
+func toProjectVulnerability(pv model.ProjectVulnerability) gen.ProjectVulnerability {
+	u, f := pv.Usage, toVersionVulnerability(pv.Finding)
+	return gen.ProjectVulnerability{
+		UsageId:       uuid.MustParse(u.Usage.ID),
+		OssId:         uuid.MustParse(u.Usage.OssID),
+		OssVersionId:  uuid.MustParse(u.Usage.OssVersionID),
+		ComponentName: u.Component.Name,
+		Version:       u.Version.Version,
+		UsageRole:     gen.UsageRole(u.Usage.UsageRole),
+		ScopeStatus:   gen.ScopeStatus(u.Usage.ScopeStatus),
+		Vulnerability: f.Vulnerability,
+		Aliases:       f.Aliases,
+		Matches:       f.Matches,
+		FixedVersions: f.FixedVersions,
+		Analysis:      toVulnerabilityAnalysisPtr(pv.Analysis),
+	}
+}
+
+func toVulnerabilityAnalysis(a model.VulnerabilityAnalysis) gen.VulnerabilityAnalysis {
+	return gen.VulnerabilityAnalysis{
+		Id:              uuid.MustParse(a.ID),
+		ProjectId:       uuid.MustParse(a.ProjectID),
+		UsageId:         uuid.MustParse(a.UsageID),
+		VulnerabilityId: a.VulnerabilityID,
+		State:           gen.VulnerabilityAnalysisState(a.State),
+		Justification:   (*gen.VulnerabilityAnalysisJustification)(a.Justification),
+		Response:        (*gen.VulnerabilityAnalysisResponse)(a.Response),
+		Note:            a.Note,
+		UpdatedBy:       a.UpdatedBy,
+		CreatedAt:       a.CreatedAt.TimeValue(),
+		UpdatedAt:       a.UpdatedAt.TimeValue(),
+	}
+}
+
+func toVulnerabilityAnalysisPtr(a *model.VulnerabilityAnalysis) *gen.VulnerabilityAnalysis {
+	if a == nil {
+		return nil
+	}
+	res := toVulnerabilityAnalysis(*a)
+	return &res
+}
+
+// NVD フィード取り込み
+// (POST /vulnerabilities/import/nvd)
+func (h *Handler) ImportNvdFeed(ctx echo.Context) error {
+	fh, err := ctx.FormFile("file")
+	if errors.Is(err, http.ErrMissingFile) {
+		return echo.NewHTTPError(http.StatusBadRequest, "file is required")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid multipart body: %v", err))
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	report, err := h.Vulnerabilities.ImportNVD(ctx.Request().Context(), f, fh.Filename, currentUsername(ctx))
+	if err != nil {
+		if errors.Is(err, vuln.ErrInvalidFeed) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, gen.NvdImportReport{
+		File:      fh.Filename,
+		Total:     report.Total,
+		Created:   report.Created,
+		Updated:   report.Updated,
+		Unchanged: report.Unchanged,
+	})
+}
+
+// OSV データセット取り込み
+// (POST /vulnerabilities/import/osv)
+func (h *Handler) ImportOsvDataset(ctx echo.Context) error {
+	fh, err := ctx.FormFile("file")
+	if errors.Is(err, http.ErrMissingFile) {
+		return echo.NewHTTPError(http.StatusBadRequest, "file is required")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid multipart body: %v", err))
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zr, err := zip.NewReader(f, fh.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("file must be a zip archive: %v", err))
+	}
+	report, err := h.Vulnerabilities.ImportOSV(ctx.Request().Context(), zr, fh.Filename, currentUsername(ctx))
+	if err != nil {
+		if errors.Is(err, vuln.ErrInvalidFeed) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, gen.OsvImportReport{
+		File:      fh.Filename,
+		Total:     report.Total,
+		Created:   report.Created,
+		Updated:   report.Updated,
+		Unchanged: report.Unchanged,
+		Skipped:   report.Skipped,
+	})
+}
+
+// 脆弱性詳細
+// (GET /vulnerabilities/{vulnerabilityId})
+func (h *Handler) GetVulnerability(ctx echo.Context, vulnerabilityId string) error {
+	repo := h.Vulnerabilities.VulnerabilityRepo
+	v, err := repo.Get(ctx.Request().Context(), vulnerabilityId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "vulnerability not found")
+		}
+		return err
+	}
+	metrics, err := repo.ListCvss(ctx.Request().Context(), v.ID)
+	if err != nil {
+		return err
+	}
+	res := toVulnerability(*v)
+	cvss := make([]gen.CvssMetric, 0, len(metrics))
+	for _, m := range metrics {
+		cvss = append(cvss, gen.CvssMetric{
+			Version:      m.Version,
+			Source:       m.Source,
+			Type:         m.Type,
+			BaseScore:    m.BaseScore,
+			BaseSeverity: m.BaseSeverity,
+			Vector:       m.Vector,
+		})
+	}
+	res.Cvss = &cvss
+	pairs, err := repo.ListAliases(ctx.Request().Context(), []string{v.ID})
+	if err != nil {
+		return err
+	}
+	aliases := []string{}
+	for _, a := range pairs {
+		if a.VulnerabilityID == v.ID {
+			aliases = append(aliases, a.Alias)
+		} else {
+			aliases = append(aliases, a.VulnerabilityID)
+		}
+	}
+	res.Aliases = &aliases
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// バージョンに該当する脆弱性
+// (GET /oss/{ossId}/versions/{versionId}/vulnerabilities)
+func (h *Handler) ListOssVersionVulnerabilities(ctx echo.Context, ossId openapi_types.UUID, versionId openapi_types.UUID) error {
+	v, err := h.OssVersionRepo.Get(ctx.Request().Context(), versionId.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "version not found")
+		}
+		return err
+	}
+	if v.OssID != ossId.String() {
+		return echo.NewHTTPError(http.StatusNotFound, "version not found")
+	}
+	list, err := h.Vulnerabilities.Match(ctx.Request().Context(), *v)
+	if err != nil {
+		return err
+	}
+	res := gen.VersionVulnerabilityList{OssVersionId: versionId, Items: make([]gen.VersionVulnerability, 0, len(list))}
+	for _, vv := range list {
+		res.Items = append(res.Items, toVersionVulnerability(vv))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// プロジェクトの利用に該当する脆弱性
+// (GET /projects/{projectId}/vulnerabilities)
+func (h *Handler) ListProjectVulnerabilities(ctx echo.Context, projectId openapi_types.UUID, params gen.ListProjectVulnerabilitiesParams) error {
+	f := service.ProjectVulnerabilityFilter{FixAvailable: params.FixAvailable}
+	if params.IncludeSuppressed != nil {
+		f.IncludeSuppressed = *params.IncludeSuppressed
+	}
+	if params.Scopes != nil {
+		scopes, err := parseScopes(params.Scopes)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		f.Scopes = scopes
+	}
+	if params.Severity != nil {
+		severities, err := parseSeverities(*params.Severity)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		f.Severities = severities
+	}
+	format := gen.VulnerabilityReportFormatJson
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != gen.VulnerabilityReportFormatJson && format != gen.VulnerabilityReportFormatCsv {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid format")
+	}
+
+	p, list, err := h.Vulnerabilities.ProjectFindings(ctx.Request().Context(), projectId.String(), f)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return err
+	}
+	if format == gen.VulnerabilityReportFormatCsv {
+		res := ctx.Response()
+		res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", p.ProjectCode+"-vulnerabilities.csv"))
+		res.WriteHeader(http.StatusOK)
+		return vuln.WriteReportCSV(res, list)
+	}
+	res := gen.ProjectVulnerabilityReport{ProjectId: projectId, Items: make([]gen.ProjectVulnerability, 0, len(list))}
+	for _, pv := range list {
+		res.Items = append(res.Items, toProjectVulnerability(pv))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// parseSeverities はカンマ区切りの深刻度指定を検証して分解する。
+func parseSeverities(param string) ([]string, error) {
+	var severities []string
+	for _, s := range strings.Split(param, ",") {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if s == "" || slices.Contains(severities, s) {
+			continue
+		}
+		switch gen.VulnerabilitySeverity(s) {
+		case gen.CRITICAL, gen.HIGH, gen.MEDIUM, gen.LOW, gen.NONE:
+		default:
+			return nil, fmt.Errorf("invalid severity: %s", s)
+		}
+		severities = append(severities, s)
+	}
+	return severities, nil
+}
+
+// 利用と脆弱性の組の VEX 分析取得
+// (GET /projects/{projectId}/usages/{usageId}/vulnerabilities/{vulnerabilityId}/analysis)
+func (h *Handler) GetVulnerabilityAnalysis(ctx echo.Context, projectId openapi_types.UUID, usageId openapi_types.UUID, vulnerabilityId string) error {
+	a, err := h.Vulnerabilities.GetAnalysis(ctx.Request().Context(), projectId.String(), usageId.String(), vulnerabilityId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "analysis not found")
+		}
+		return err
+	}
+	return ctx.JSON(http.StatusOK, toVulnerabilityAnalysis(*a))
+}
+
+// 利用と脆弱性の組の VEX 分析記録
+// (PUT /projects/{projectId}/usages/{usageId}/vulnerabilities/{vulnerabilityId}/analysis)
+func (h *Handler) PutVulnerabilityAnalysis(ctx echo.Context, projectId openapi_types.UUID, usageId openapi_types.UUID, vulnerabilityId string) error {
+	var req gen.VulnerabilityAnalysisRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid body")
+	}
+	in := service.AnalysisInput{
+		State:         string(req.State),
+		Justification: (*string)(req.Justification),
+		Response:      (*string)(req.Response),
+		Note:          req.Note,
+	}
+	a, created, err := h.Vulnerabilities.RecordAnalysis(ctx.Request().Context(), projectId.String(), usageId.String(), vulnerabilityId, in, currentUsername(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidAnalysis):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, sql.ErrNoRows):
+			return echo.NewHTTPError(http.StatusNotFound, "usage or vulnerability not found")
+		}
+		return err
+	}
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	return ctx.JSON(status, toVulnerabilityAnalysis(*a))
+}
+
+// 利用と脆弱性の組の VEX 分析削除
+// (DELETE /projects/{projectId}/usages/{usageId}/vulnerabilities/{vulnerabilityId}/analysis)
+func (h *Handler) DeleteVulnerabilityAnalysis(ctx echo.Context, projectId openapi_types.UUID, usageId openapi_types.UUID, vulnerabilityId string) error {
+	if err := h.Vulnerabilities.DeleteAnalysis(ctx.Request().Context(), projectId.String(), usageId.String(), vulnerabilityId, currentUsername(ctx)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "analysis not found")
+		}
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
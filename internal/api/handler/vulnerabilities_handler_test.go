@@ -0,0 +1,332 @@
+package handler
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
+	"github.com/ramsesyok/oss-catalog/internal/domain/service"
+	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+var vulnerabilityColumnNames = []string{"id", "source", "description", "status", "severity", "cvss_score", "cvss_version", "cvss_vector", "weaknesses", "reference_urls", "published_at", "last_modified_at", "created_at", "updated_at"}
+
+var cpeMatchColumnNames = []string{"vulnerability_id", "criteria", "vendor", "product", "version_start_including", "version_start_excluding", "version_end_including", "version_end_excluding", "match_criteria_id"}
+
+const ossVersionGetQuery = "SELECT id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, hash_sha512, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, copyright_text, created_at, updated_at FROM oss_versions WHERE id = ?"
+
+var ossVersionColumnNames = []string{"id", "oss_id", "version", "release_date", "license_expression_raw", "license_concluded", "purl", "cpe_list", "hash_sha256", "hash_sha512", "modified", "modification_description", "review_status", "last_reviewed_at", "scope_status", "supplier_type", "fork_origin_url", "copyright_text", "created_at", "updated_at"}
+
+func newVulnerabilityHandler(db *sql.DB) *Handler {
+	return &Handler{
+		OssVersionRepo: &infrarepo.OssVersionRepository{DB: db},
+		Vulnerabilities: &service.VulnerabilityService{
+			VulnerabilityRepo: &infrarepo.VulnerabilityRepository{DB: db},
+			ProjectRepo:       &infrarepo.ProjectRepository{DB: db},
+			ProjectUsageRepo:  &infrarepo.ProjectUsageRepository{DB: db},
+			AnalysisRepo:      &infrarepo.VulnerabilityAnalysisRepository{DB: db},
+			AuditRepo:         &infrarepo.AuditLogRepository{DB: db},
+		},
+	}
+}
+
+var vulnerabilityAnalysisColumnNames = []string{"id", "project_id", "usage_id", "vulnerability_id", "state", "justification", "response", "note", "updated_by", "created_at", "updated_at"}
+
+func postNvdFeed(e *echo.Echo, t *testing.T, doc string) *httptest.ResponseRecorder {
+	body, contentType := multipartBody(t, map[string]string{"file": doc})
+	req := httptest.NewRequest(http.MethodPost, "/vulnerabilities/import/nvd", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestImportNvdFeed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newVulnerabilityHandler(db))
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerabilities WHERE id = ?")).WithArgs("CVE-2022-37434").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE vulnerabilities SET")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerabilities")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerability_cpe_matches")).
+		WithArgs("CVE-2022-37434", "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", "zlib", "zlib", nil, nil, "1.2.12", nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAudit(mock, "VULNERABILITY_FEED", "IMPORT")
+
+	rec := postNvdFeed(e, t, `{"vulnerabilities": [{"cve": {"id": "CVE-2022-37434", "lastModified": "2023-07-19T00:00:00.000",
+		"configurations": [{"nodes": [{"cpeMatch": [{"vulnerable": true, "criteria": "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", "versionEndIncluding": "1.2.12"}]}]}]}}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.NvdImportReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, gen.NvdImportReport{File: "file", Total: 1, Created: 1}, res)
+
+	rec = postNvdFeed(e, t, `{"CVE_Items": []}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestImportOsvDataset(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newVulnerabilityHandler(db))
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	fw, err := zw.Create("GHSA-35jh-r3h4-6jhm.json")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte(`{"id": "GHSA-35jh-r3h4-6jhm", "modified": "2024-01-01T00:00:00Z", "aliases": ["CVE-2021-23337"],
+		"affected": [{"package": {"ecosystem": "npm", "name": "lodash"}, "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]}]}`))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerabilities WHERE id = ?")).WithArgs("GHSA-35jh-r3h4-6jhm").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE vulnerabilities SET")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerabilities")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerability_package_ranges")).
+		WithArgs("GHSA-35jh-r3h4-6jhm", "npm", "lodash", "npm/lodash", "SEMVER", nil, "4.17.21", nil, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerability_aliases")).WithArgs("GHSA-35jh-r3h4-6jhm", "CVE-2021-23337").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAudit(mock, "VULNERABILITY_FEED", "IMPORT")
+
+	body, contentType := multipartBody(t, map[string]string{"file": buf.String()})
+	req := httptest.NewRequest(http.MethodPost, "/vulnerabilities/import/osv", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+	var res gen.OsvImportReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, gen.OsvImportReport{File: "file", Total: 1, Created: 1}, res)
+
+	// zip 以外は 400
+	body, contentType = multipartBody(t, map[string]string{"file": "{}"})
+	req = httptest.NewRequest(http.MethodPost, "/vulnerabilities/import/osv", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetVulnerability(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newVulnerabilityHandler(db))
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerabilities WHERE id = ?")).WithArgs("CVE-2021-44228").WillReturnRows(sqlmock.NewRows(vulnerabilityColumnNames).
+		AddRow("CVE-2021-44228", "NVD", "Log4Shell", "Analyzed", "CRITICAL", 10.0, "3.1", "CVSS:3.1/AV:N", pq.StringArray{"CWE-917"}, pq.StringArray{}, now, now, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_cvss WHERE vulnerability_id = ?")).WithArgs("CVE-2021-44228").
+		WillReturnRows(sqlmock.NewRows([]string{"vulnerability_id", "version", "source", "type", "base_score", "base_severity", "vector"}).
+			AddRow("CVE-2021-44228", "3.1", "nvd@nist.gov", "Primary", 10.0, "CRITICAL", "CVSS:3.1/AV:N"))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_aliases WHERE vulnerability_id IN (?) OR alias IN (?)")).WithArgs("CVE-2021-44228", "CVE-2021-44228").
+		WillReturnRows(sqlmock.NewRows([]string{"vulnerability_id", "alias"}).AddRow("GHSA-jfh8-c2jp-5v3q", "CVE-2021-44228"))
+
+	rec := doLicenseRequest(e, http.MethodGet, "/vulnerabilities/CVE-2021-44228", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var res gen.Vulnerability
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, gen.CRITICAL, *res.Severity)
+	require.Equal(t, []string{"CWE-917"}, res.Weaknesses)
+	require.Len(t, *res.Cvss, 1)
+	require.Equal(t, []string{"GHSA-jfh8-c2jp-5v3q"}, *res.Aliases)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerabilities WHERE id = ?")).WithArgs("CVE-2000-0000").WillReturnError(sql.ErrNoRows)
+	rec = doLicenseRequest(e, http.MethodGet, "/vulnerabilities/CVE-2000-0000", "")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListOssVersionVulnerabilities(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newVulnerabilityHandler(db))
+	oid, vid := uuid.NewString(), uuid.NewString()
+	now := time.Now()
+	expectVersion := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(ossVersionGetQuery)).WithArgs(vid).WillReturnRows(sqlmock.NewRows(ossVersionColumnNames).
+			AddRow(vid, oid, "1.2.11", nil, nil, nil, nil, pq.StringArray{"cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*"}, nil, nil, false, nil, "draft", nil, "IN_SCOPE", nil, nil, nil, now, now))
+	}
+
+	expectVersion()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_cpe_matches WHERE vendor = ? AND product = ?")).WithArgs("zlib", "zlib").
+		WillReturnRows(sqlmock.NewRows(cpeMatchColumnNames).
+			AddRow("CVE-2022-37434", "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", "zlib", "zlib", nil, nil, "1.2.12", nil, nil).
+			AddRow("CVE-2023-45853", "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", "zlib", "zlib", nil, nil, "1.3", nil, nil).
+			AddRow("CVE-2016-9840", "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", "zlib", "zlib", nil, nil, "1.2.8", nil, nil))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_aliases")).WillReturnRows(sqlmock.NewRows([]string{"vulnerability_id", "alias"}))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerabilities WHERE id IN (?,?)")).WithArgs("CVE-2022-37434", "CVE-2023-45853").
+		WillReturnRows(sqlmock.NewRows(vulnerabilityColumnNames).
+			AddRow("CVE-2022-37434", "NVD", nil, "Modified", "CRITICAL", 9.8, "3.1", nil, pq.StringArray{}, pq.StringArray{}, nil, nil, now, now).
+			AddRow("CVE-2023-45853", "NVD", nil, "Modified", "CRITICAL", 9.8, "3.1", nil, pq.StringArray{}, pq.StringArray{}, nil, nil, now, now))
+
+	rec := doLicenseRequest(e, http.MethodGet, "/oss/"+oid+"/versions/"+vid+"/vulnerabilities", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var res gen.VersionVulnerabilityList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Len(t, res.Items, 2)
+	require.Equal(t, "CVE-2022-37434", res.Items[0].Vulnerability.Id)
+	require.Len(t, res.Items[0].Matches, 1)
+	require.Equal(t, gen.CPE, res.Items[0].Matches[0].MatchedBy)
+	require.Equal(t, "1.2.12", *res.Items[0].Matches[0].VersionEndIncluding)
+
+	// 別コンポーネントのバージョンは 404
+	expectVersion()
+	rec = doLicenseRequest(e, http.MethodGet, "/oss/"+uuid.NewString()+"/versions/"+vid+"/vulnerabilities", "")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListProjectVulnerabilities(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newVulnerabilityHandler(db))
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	row := usageDetailRow(pid, "lodash", "4.17.20", "MIT", "pkg:npm/lodash@4.17.20", now)
+	usageID := row[0].(string)
+	expectFindings := func(analyses *sqlmock.Rows) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM projects WHERE id = ?")).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).
+			AddRow(pid, "P1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 1))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE u.project_id = ? AND u.scope_status IN (?) ORDER BY c.normalized_name, v.version")).
+			WithArgs(pid, "IN_SCOPE").WillReturnRows(sqlmock.NewRows(usageDetailColumns).AddRow(row...))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_analyses WHERE project_id = ?")).WithArgs(pid).WillReturnRows(analyses)
+		mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_package_ranges WHERE package_key = ?")).WithArgs("npm/lodash").
+			WillReturnRows(sqlmock.NewRows([]string{"vulnerability_id", "ecosystem", "package_name", "package_key", "range_type", "introduced", "fixed", "last_affected", "versions"}).
+				AddRow("GHSA-35jh-r3h4-6jhm", "npm", "lodash", "npm/lodash", "SEMVER", nil, "4.17.21", nil, nil))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_aliases")).WillReturnRows(sqlmock.NewRows([]string{"vulnerability_id", "alias"}))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerabilities WHERE id IN (?)")).WithArgs("GHSA-35jh-r3h4-6jhm").
+			WillReturnRows(sqlmock.NewRows(vulnerabilityColumnNames).
+				AddRow("GHSA-35jh-r3h4-6jhm", "OSV", nil, nil, "HIGH", 7.2, "3.1", nil, pq.StringArray{}, pq.StringArray{}, nil, nil, now, now))
+	}
+	noAnalyses := func() *sqlmock.Rows { return sqlmock.NewRows(vulnerabilityAnalysisColumnNames) }
+	notAffected := func() *sqlmock.Rows {
+		return sqlmock.NewRows(vulnerabilityAnalysisColumnNames).
+			AddRow(uuid.NewString(), pid, usageID, "GHSA-35jh-r3h4-6jhm", "not_affected", "vulnerable_code_not_present", nil, nil, "alice", now, now)
+	}
+
+	expectFindings(noAnalyses())
+	rec := doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/vulnerabilities?scopes=IN_SCOPE&severity=high,critical&fixAvailable=true", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var res gen.ProjectVulnerabilityReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Len(t, res.Items, 1)
+	require.Equal(t, "lodash", res.Items[0].ComponentName)
+	require.Equal(t, "GHSA-35jh-r3h4-6jhm", res.Items[0].Vulnerability.Id)
+	require.Equal(t, []string{"4.17.21"}, res.Items[0].FixedVersions)
+
+	expectFindings(noAnalyses())
+	rec = doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/vulnerabilities?scopes=IN_SCOPE&format=csv", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+	require.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "P1-vulnerabilities.csv")
+	require.Contains(t, rec.Body.String(), "lodash,4.17.20,pkg:npm/lodash@4.17.20,BUNDLED_BINARY,IN_SCOPE,GHSA-35jh-r3h4-6jhm,,HIGH,7.2,4.17.21,PURL,,\n")
+
+	// VEX 分析で影響を受けないとした該当は includeSuppressed=true の場合のみ返す
+	expectFindings(notAffected())
+	rec = doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/vulnerabilities?scopes=IN_SCOPE", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Empty(t, res.Items)
+	expectFindings(notAffected())
+	rec = doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/vulnerabilities?scopes=IN_SCOPE&includeSuppressed=true", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Len(t, res.Items, 1)
+	require.Equal(t, gen.NotAffected, res.Items[0].Analysis.State)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	rec = doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/vulnerabilities?severity=URGENT", "")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = doLicenseRequest(e, http.MethodGet, "/projects/"+pid+"/vulnerabilities?scopes=ALL", "")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestVulnerabilityAnalysis(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	e := setupEcho(newVulnerabilityHandler(db))
+	pid := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	row := usageDetailRow(pid, "zlib", "1.2.11", "Zlib", "", now)
+	usageID := row[0].(string)
+	path := "/projects/" + pid + "/usages/" + usageID + "/vulnerabilities/CVE-2022-37434/analysis"
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE u.project_id = ? ORDER BY")).
+		WithArgs(pid).WillReturnRows(sqlmock.NewRows(usageDetailColumns).AddRow(row...))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerabilities WHERE id = ?")).WithArgs("CVE-2022-37434").
+		WillReturnRows(sqlmock.NewRows(vulnerabilityColumnNames).
+			AddRow("CVE-2022-37434", "NVD", nil, "Modified", "CRITICAL", 9.8, "3.1", nil, pq.StringArray{}, pq.StringArray{}, nil, nil, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_analyses WHERE usage_id = ? AND vulnerability_id = ?")).WithArgs(usageID, "CVE-2022-37434").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerability_analyses")).
+		WithArgs(sqlmock.AnyArg(), pid, usageID, "CVE-2022-37434", "not_affected", "vulnerable_code_not_in_execute_path", nil, "inflateGetHeader は使用していない", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO audit_logs")).
+		WithArgs(sqlmock.AnyArg(), "VULNERABILITY_ANALYSIS", sqlmock.AnyArg(), "CREATE", sqlmock.AnyArg(), "CVE-2022-37434 zlib 1.2.11: state=not_affected justification=vulnerable_code_not_in_execute_path", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	rec := doLicenseRequest(e, http.MethodPut, path, `{"state":"not_affected","justification":"vulnerable_code_not_in_execute_path","note":"inflateGetHeader は使用していない"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var a gen.VulnerabilityAnalysis
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &a))
+	require.Equal(t, gen.NotAffected, a.State)
+	require.Equal(t, gen.VulnerableCodeNotInExecutePath, *a.Justification)
+
+	// not_affected には根拠が必須
+	rec = doLicenseRequest(e, http.MethodPut, path, `{"state":"not_affected"}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	analysisID := uuid.NewString()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_analyses WHERE usage_id = ? AND vulnerability_id = ?")).WithArgs(usageID, "CVE-2022-37434").
+		WillReturnRows(sqlmock.NewRows(vulnerabilityAnalysisColumnNames).
+			AddRow(analysisID, pid, usageID, "CVE-2022-37434", "affected", nil, "update", nil, "alice", now, now))
+	rec = doLicenseRequest(e, http.MethodGet, path, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &a))
+	require.Equal(t, gen.Update, *a.Response)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_analyses WHERE usage_id = ? AND vulnerability_id = ?")).WithArgs(usageID, "CVE-2022-37434").
+		WillReturnRows(sqlmock.NewRows(vulnerabilityAnalysisColumnNames).
+			AddRow(analysisID, pid, usageID, "CVE-2022-37434", "affected", nil, "update", nil, "alice", now, now))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM vulnerability_analyses WHERE id = ?")).WithArgs(analysisID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO audit_logs")).
+		WithArgs(sqlmock.AnyArg(), "VULNERABILITY_ANALYSIS", analysisID, "DELETE", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	rec = doLicenseRequest(e, http.MethodDelete, path, "")
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_analyses WHERE usage_id = ? AND vulnerability_id = ?")).WithArgs(usageID, "CVE-2022-37434").WillReturnError(sql.ErrNoRows)
+	rec = doLicenseRequest(e, http.MethodGet, path, "")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -30,6 +30,28 @@ func RegisterRoutes(e *echo.Echo, h *handler.Handler) {
 
 	g := e.Group("", authRequired)
 	g.GET("/audit", wrapper.SearchAuditLogs, auth.RolesRequired("ADMIN"))
+	g.POST("/catalog/import", wrapper.ImportCatalog, auth.RolesRequired("ADMIN"))
+	g.GET("/export/jobs/:jobId", wrapper.GetExportJob, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.GET("/export/jobs/:jobId/download", wrapper.DownloadExportJob, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.GET("/export/templates", wrapper.ListExportTemplates, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.POST("/export/templates", wrapper.CreateExportTemplate, auth.RolesRequired("ADMIN"))
+	g.DELETE("/export/templates/:templateId", wrapper.DeleteExportTemplate, auth.RolesRequired("ADMIN"))
+	g.GET("/export/templates/:templateId", wrapper.GetExportTemplate, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.PATCH("/export/templates/:templateId", wrapper.UpdateExportTemplate, auth.RolesRequired("ADMIN"))
+	g.GET("/licenses", wrapper.ListLicenses, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.POST("/licenses", wrapper.CreateLicense, auth.RolesRequired("ADMIN"))
+	g.DELETE("/licenses/:licenseId", wrapper.DeleteLicense, auth.RolesRequired("ADMIN"))
+	g.GET("/licenses/:licenseId", wrapper.GetLicense, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.PATCH("/licenses/:licenseId", wrapper.UpdateLicense, auth.RolesRequired("ADMIN"))
+	g.GET("/license-policies", wrapper.ListLicensePolicyRules, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.POST("/license-policies", wrapper.CreateLicensePolicyRule, auth.RolesRequired("ADMIN"))
+	g.DELETE("/license-policies/:ruleId", wrapper.DeleteLicensePolicyRule, auth.RolesRequired("ADMIN"))
+	g.GET("/license-policies/:ruleId", wrapper.GetLicensePolicyRule, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.PATCH("/license-policies/:ruleId", wrapper.UpdateLicensePolicyRule, auth.RolesRequired("ADMIN"))
+	g.DELETE("/import/sessions/:sessionId", wrapper.DeleteImportSession, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.GET("/import/sessions/:sessionId", wrapper.GetImportSession, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.POST("/import/sessions/:sessionId/commit", wrapper.CommitImportSession, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.PATCH("/import/sessions/:sessionId/items/:itemId", wrapper.UpdateImportSessionItem, auth.RolesRequired("EDITOR", "ADMIN"))
 	g.GET("/me", wrapper.GetCurrentUser, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.GET("/oss", wrapper.ListOssComponents, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.POST("/oss", wrapper.CreateOssComponent, auth.RolesRequired("EDITOR", "ADMIN"))
@@ -41,17 +63,36 @@ func RegisterRoutes(e *echo.Echo, h *handler.Handler) {
 	g.DELETE("/oss/:ossId/versions/:versionId", wrapper.DeleteOssVersion, auth.RolesRequired("ADMIN"))
 	g.GET("/oss/:ossId/versions/:versionId", wrapper.GetOssVersion, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.PATCH("/oss/:ossId/versions/:versionId", wrapper.UpdateOssVersion, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.GET("/oss/:ossId/versions/:versionId/vulnerabilities", wrapper.ListOssVersionVulnerabilities, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.GET("/projects", wrapper.ListProjects, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.POST("/projects", wrapper.CreateProject, auth.RolesRequired("EDITOR", "ADMIN"))
 	g.DELETE("/projects/:projectId", wrapper.DeleteProject, auth.RolesRequired("ADMIN"))
 	g.GET("/projects/:projectId", wrapper.GetProject, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.PATCH("/projects/:projectId", wrapper.UpdateProject, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.GET("/projects/:projectId/compliance", wrapper.GetProjectCompliance, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.GET("/projects/:projectId/export", wrapper.ExportProjectArtifacts, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/export/jobs", wrapper.CreateExportJob, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.GET("/projects/:projectId/obligations", wrapper.ListProjectObligations, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/obligations/sync", wrapper.SyncProjectObligations, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.PATCH("/projects/:projectId/obligations/:obligationId", wrapper.UpdateProjectObligation, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/import/cargo", wrapper.ImportProjectCargo, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/import/cyclonedx", wrapper.ImportProjectCyclonedx, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/import/syft", wrapper.ImportProjectSyft, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/import/gomod", wrapper.ImportProjectGomod, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/import/maven", wrapper.ImportProjectMaven, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/import/npm", wrapper.ImportProjectNpm, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/import/python", wrapper.ImportProjectPython, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.GET("/projects/:projectId/import/sessions", wrapper.ListImportSessions, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.POST("/projects/:projectId/import/spdx", wrapper.ImportProjectSpdx, auth.RolesRequired("EDITOR", "ADMIN"))
 	g.GET("/projects/:projectId/usages", wrapper.ListProjectUsages, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.POST("/projects/:projectId/usages", wrapper.CreateProjectUsage, auth.RolesRequired("EDITOR", "ADMIN"))
 	g.DELETE("/projects/:projectId/usages/:usageId", wrapper.DeleteProjectUsage, auth.RolesRequired("EDITOR", "ADMIN"))
 	g.PATCH("/projects/:projectId/usages/:usageId", wrapper.UpdateProjectUsage, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.GET("/projects/:projectId/vulnerabilities", wrapper.ListProjectVulnerabilities, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.PATCH("/projects/:projectId/usages/:usageId/scope", wrapper.UpdateProjectUsageScope, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.DELETE("/projects/:projectId/usages/:usageId/vulnerabilities/:vulnerabilityId/analysis", wrapper.DeleteVulnerabilityAnalysis, auth.RolesRequired("EDITOR", "ADMIN"))
+	g.GET("/projects/:projectId/usages/:usageId/vulnerabilities/:vulnerabilityId/analysis", wrapper.GetVulnerabilityAnalysis, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
+	g.PUT("/projects/:projectId/usages/:usageId/vulnerabilities/:vulnerabilityId/analysis", wrapper.PutVulnerabilityAnalysis, auth.RolesRequired("EDITOR", "ADMIN"))
 	g.GET("/scope/policy", wrapper.GetScopePolicy, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 	g.PATCH("/scope/policy", wrapper.UpdateScopePolicy, auth.RolesRequired("ADMIN"))
 	g.GET("/tags", wrapper.ListTags, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
@@ -62,4 +103,7 @@ func RegisterRoutes(e *echo.Echo, h *handler.Handler) {
 	g.DELETE("/users/:userId", wrapper.DeleteUser, auth.RolesRequired("ADMIN"))
 	g.GET("/users/:userId", wrapper.GetUser, auth.RolesRequired("ADMIN"))
 	g.PATCH("/users/:userId", wrapper.UpdateUser, auth.RolesRequired("ADMIN"))
+	g.POST("/vulnerabilities/import/nvd", wrapper.ImportNvdFeed, auth.RolesRequired("ADMIN"))
+	g.POST("/vulnerabilities/import/osv", wrapper.ImportOsvDataset, auth.RolesRequired("ADMIN"))
+	g.GET("/vulnerabilities/:vulnerabilityId", wrapper.GetVulnerability, auth.RolesRequired("VIEWER", "EDITOR", "ADMIN"))
 }
@@ -3,12 +3,14 @@ package config
 import (
 	"gopkg.in/yaml.v3"
 	"os"
+	"time"
 )
 
 // Config represents application configuration loaded from a YAML file.
 type Config struct {
 	Server ServerConfig `yaml:"server"`
 	DB     DBConfig     `yaml:"db"`
+	Export ExportConfig `yaml:"export"`
 }
 
 // DBConfig holds database connection settings.
@@ -16,6 +18,13 @@ type DBConfig struct {
 	DSN string `yaml:"dsn"`
 }
 
+// ExportConfig holds asynchronous export job settings.
+type ExportConfig struct {
+	Dir       string        `yaml:"dir"`
+	Workers   int           `yaml:"workers"`
+	Retention time.Duration `yaml:"retention"`
+}
+
 // ServerConfig holds HTTP server related settings.
 type ServerConfig struct {
 	Host           string   `yaml:"host"`
@@ -29,6 +38,7 @@ func Load(path string) (*Config, error) {
 	cfg := &Config{
 		Server: ServerConfig{Host: "0.0.0.0", Port: "8080", AllowedOrigins: []string{"*"}},
 		DB:     DBConfig{DSN: "file:oss-catalog.db?mode=memory&cache=shared"},
+		Export: ExportConfig{Dir: "exports", Workers: 2, Retention: 24 * time.Hour},
 	}
 	if path == "" {
 		return cfg, nil
@@ -52,5 +62,14 @@ func Load(path string) (*Config, error) {
 	if cfg.DB.DSN == "" {
 		cfg.DB.DSN = "file:oss-catalog.db?mode=memory&cache=shared"
 	}
+	if cfg.Export.Dir == "" {
+		cfg.Export.Dir = "exports"
+	}
+	if cfg.Export.Workers <= 0 {
+		cfg.Export.Workers = 2
+	}
+	if cfg.Export.Retention <= 0 {
+		cfg.Export.Retention = 24 * time.Hour
+	}
 	return cfg, nil
 }
@@ -0,0 +1,84 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// BundleManifestName はバンドル内のマニフェストファイル名。
+const BundleManifestName = "manifest.json"
+
+// BundleManifest は納品バンドルに同梱したファイルの一覧と生成情報を表す。
+type BundleManifest struct {
+	Project     BundleProject `json:"project"`
+	Scopes      []string      `json:"scopes"`
+	GeneratedAt string        `json:"generatedAt"`
+	GeneratedBy string        `json:"generatedBy"`
+	Files       []BundleFile  `json:"files"`
+}
+
+// BundleProject はマニフェストに記録するプロジェクト情報。
+type BundleProject struct {
+	ID          string `json:"id"`
+	ProjectCode string `json:"projectCode"`
+	Name        string `json:"name"`
+}
+
+// BundleFile はバンドル内の 1 ファイルを表す。
+type BundleFile struct {
+	Name   string `json:"name"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// bundleFormats はバンドルに同梱する形式 (同梱順)。
+var bundleFormats = []string{"csv", "spdx-json", "notice"}
+
+// WriteBundle は CSV 一覧・SPDX・NOTICE と manifest.json を 1 つの ZIP として w に書き出す。
+// マニフェストには各ファイルの SHA-256 と生成日時・生成者を記録する。
+func WriteBundle(w io.Writer, d *Document) error {
+	zw := zip.NewWriter(w)
+	m := BundleManifest{
+		Project:     BundleProject{ID: d.Project.ID, ProjectCode: d.Project.ProjectCode, Name: d.Project.Name},
+		Scopes:      d.Scopes,
+		GeneratedAt: d.GeneratedAt.UTC().Format(time.RFC3339),
+		GeneratedBy: d.GeneratedBy,
+	}
+	for _, name := range bundleFormats {
+		f, _ := LookupFormat(name)
+		fileName := f.FileName(d.Project.ProjectCode)
+		var buf bytes.Buffer
+		if err := f.Write(&buf, d); err != nil {
+			return err
+		}
+		if err := writeZipFile(zw, fileName, d.GeneratedAt, buf.Bytes()); err != nil {
+			return err
+		}
+		sum := sha256.Sum256(buf.Bytes())
+		m.Files = append(m.Files, BundleFile{Name: fileName, Size: buf.Len(), SHA256: hex.EncodeToString(sum[:])})
+	}
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeZipFile(zw, BundleManifestName, d.GeneratedAt, append(b, '\n')); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// writeZipFile は ZIP にファイルを 1 件追加する。
+func writeZipFile(zw *zip.Writer, name string, modified time.Time, data []byte) error {
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	return err
+}
@@ -0,0 +1,92 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func TestWriteBundle(t *testing.T) {
+	mit := "MIT"
+	d := &Document{
+		Project: model.Project{ID: "p1", ProjectCode: "P1", Name: "Proj"},
+		Items: []model.ProjectUsageDetail{
+			{Component: model.OssComponent{Name: "lodash"}, Version: model.OssVersion{ID: "v1", Version: "4.17.21", LicenseConcluded: &mit}},
+		},
+		Scopes:      []string{"IN_SCOPE"},
+		GeneratedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		GeneratedBy: "alice",
+	}
+	var buf bytes.Buffer
+	require.NoError(t, WriteBundle(&buf, d))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	files := map[string][]byte{}
+	var names []string
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = b
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"P1-oss-list.csv", "P1.spdx.json", "P1-NOTICE.txt", "manifest.json"}, names)
+
+	var m BundleManifest
+	require.NoError(t, json.Unmarshal(files["manifest.json"], &m))
+	require.Equal(t, "P1", m.Project.ProjectCode)
+	require.Equal(t, "alice", m.GeneratedBy)
+	require.Equal(t, "2024-01-02T03:04:05Z", m.GeneratedAt)
+	require.Equal(t, []string{"IN_SCOPE"}, m.Scopes)
+	require.Len(t, m.Files, 3)
+	for _, f := range m.Files {
+		sum := sha256.Sum256(files[f.Name])
+		require.Equal(t, hex.EncodeToString(sum[:]), f.SHA256, f.Name)
+		require.Equal(t, len(files[f.Name]), f.Size)
+	}
+}
+
+func TestWriteBundle_UnsafeProjectCode(t *testing.T) {
+	d := &Document{
+		Project:     model.Project{ID: "p1", ProjectCode: "../../etc/x", Name: "Proj"},
+		GeneratedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	var buf bytes.Buffer
+	require.NoError(t, WriteBundle(&buf, d))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	for _, f := range zr.File {
+		require.NotContains(t, f.Name, "/", f.Name)
+		require.NotContains(t, f.Name, "..", f.Name)
+	}
+	require.Equal(t, "____etc_x-oss-list.csv", zr.File[0].Name)
+
+	csv, _ := LookupFormat("csv")
+	for code, want := range map[string]string{
+		"P1":          "P1-oss-list.csv",
+		`a\b:c`:       "a_b_c-oss-list.csv",
+		".hidden":     "hidden-oss-list.csv",
+		"..":          "_-oss-list.csv",
+		"":            "project-oss-list.csv",
+		"案件-01":       "案件-01-oss-list.csv",
+		"v1.2 (beta)": "v1.2__beta_-oss-list.csv",
+	} {
+		name := csv.FileName(code)
+		require.Equal(t, want, name, code)
+		require.False(t, strings.ContainsAny(name, `/\`), code)
+	}
+}
@@ -0,0 +1,46 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// csvHeader は納品一覧 CSV のヘッダ行。
+var csvHeader = []string{
+	"component",
+	"version",
+	"licenseConcluded",
+	"licenseExpressionRaw",
+	"purl",
+	"usageRole",
+	"scopeStatus",
+	"modified",
+	"supplierType",
+}
+
+// WriteCSV は利用一覧を納品一覧 CSV として w に書き出す。
+func WriteCSV(w io.Writer, d *Document) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, it := range d.Items {
+		rec := []string{
+			it.Component.Name,
+			it.Version.Version,
+			deref(it.Version.LicenseConcluded),
+			deref(it.Version.LicenseExpressionRaw),
+			deref(it.Version.Purl),
+			it.Usage.UsageRole,
+			it.Usage.ScopeStatus,
+			strconv.FormatBool(it.Version.Modified),
+			deref(it.Version.SupplierType),
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
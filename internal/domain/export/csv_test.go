@@ -0,0 +1,32 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func TestWriteCSV(t *testing.T) {
+	lic := "MIT"
+	raw := "MIT OR Apache-2.0"
+	purl := "pkg:npm/lodash@4.17.21"
+	items := []model.ProjectUsageDetail{
+		{
+			Usage:     model.ProjectUsage{UsageRole: "BUNDLED_SOURCE", ScopeStatus: "IN_SCOPE"},
+			Component: model.OssComponent{Name: "lodash, utils"},
+			Version:   model.OssVersion{Version: "4.17.21", LicenseConcluded: &lic, LicenseExpressionRaw: &raw, Purl: &purl, Modified: true},
+		},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, &Document{Items: items}))
+
+	recs, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, recs, 2)
+	require.Equal(t, csvHeader, recs[0])
+	require.Equal(t, []string{"lodash, utils", "4.17.21", "MIT", "MIT OR Apache-2.0", "pkg:npm/lodash@4.17.21", "BUNDLED_SOURCE", "IN_SCOPE", "true", ""}, recs[1])
+}
@@ -0,0 +1,238 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+const (
+	cdxSpecVersion = "1.5"
+	// CycloneDXNamespace は CycloneDX 1.5 XML の名前空間。
+	CycloneDXNamespace = "http://cyclonedx.org/schema/bom/1.5"
+	// cdxPropSupplierType は供給形態を保持するプロパティ名。
+	cdxPropSupplierType = "oss-catalog:supplierType"
+)
+
+// CycloneDXBOM は CycloneDX 1.5 の BOM を表す (JSON 表現)。
+type CycloneDXBOM struct {
+	BOMFormat    string          `json:"bomFormat"`
+	SpecVersion  string          `json:"specVersion"`
+	SerialNumber string          `json:"serialNumber"`
+	Version      int             `json:"version"`
+	Metadata     CDXMetadata     `json:"metadata"`
+	Components   []CDXComponent  `json:"components"`
+	Dependencies []CDXDependency `json:"dependencies"`
+}
+
+// CDXMetadata は BOM のメタデータを表す。
+type CDXMetadata struct {
+	Timestamp string       `json:"timestamp"`
+	Tools     CDXTools     `json:"tools"`
+	Component CDXComponent `json:"component"`
+}
+
+// CDXTools は BOM を生成したツールを表す。
+type CDXTools struct {
+	Components []CDXComponent `json:"components"`
+}
+
+// CDXComponent は CycloneDX コンポーネントを表す。
+type CDXComponent struct {
+	Type               string                 `json:"type"`
+	BOMRef             string                 `json:"bom-ref,omitempty"`
+	Supplier           *CDXOrganizationalRef  `json:"supplier,omitempty"`
+	Name               string                 `json:"name"`
+	Version            string                 `json:"version,omitempty"`
+	Description        string                 `json:"description,omitempty"`
+	Hashes             []CDXHash              `json:"hashes,omitempty"`
+	Licenses           []CDXLicenseChoice     `json:"licenses,omitempty"`
+	Purl               string                 `json:"purl,omitempty"`
+	CPE                string                 `json:"cpe,omitempty"`
+	Modified           *bool                  `json:"modified,omitempty"`
+	Pedigree           *CDXPedigree           `json:"pedigree,omitempty"`
+	ExternalReferences []CDXExternalReference `json:"externalReferences,omitempty"`
+	Properties         []CDXProperty          `json:"properties,omitempty"`
+}
+
+// CDXOrganizationalRef は供給者などの組織情報を表す。
+type CDXOrganizationalRef struct {
+	Name string   `json:"name"`
+	URL  []string `json:"url,omitempty"`
+}
+
+// CDXHash はコンポーネントのハッシュ値を表す。
+type CDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+// CDXLicenseChoice はライセンス (SPDX 式) を表す。
+type CDXLicenseChoice struct {
+	Expression string `json:"expression"`
+}
+
+// CDXPedigree は改変元・改変内容を表す。
+type CDXPedigree struct {
+	Ancestors []CDXComponent `json:"ancestors,omitempty"`
+	Notes     string         `json:"notes,omitempty"`
+}
+
+// CDXExternalReference は外部参照を表す。
+type CDXExternalReference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// CDXProperty は名前付きの任意プロパティを表す。
+type CDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CDXDependency はコンポーネント間の依存関係を表す。
+type CDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// BuildCycloneDX は Document から CycloneDX 1.5 BOM を組み立てる。
+// プロジェクトを metadata.component とし、各利用 OSS バージョンを library コンポーネントとする。
+// 同一バージョンを複数の利用が参照する場合は 1 件にまとめ、ルートからは直接依存のみを dependsOn に列挙する。
+// 間接依存は取り込み時に記録した依存元コンポーネントの dependsOn に列挙する。
+func BuildCycloneDX(d *Document, serial string) CycloneDXBOM {
+	root := "project-" + d.Project.ID
+	bom := CycloneDXBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  cdxSpecVersion,
+		SerialNumber: serial,
+		Version:      1,
+		Metadata: CDXMetadata{
+			Timestamp: d.GeneratedAt.UTC().Format(time.RFC3339),
+			Tools:     CDXTools{Components: []CDXComponent{{Type: "application", Name: "oss-catalog"}}},
+			Component: CDXComponent{Type: "application", BOMRef: root, Name: d.Project.Name, Version: d.Project.ProjectCode},
+		},
+		Components: []CDXComponent{},
+	}
+
+	rootDep := CDXDependency{Ref: root}
+	seen := map[string]bool{}
+	direct := map[string]bool{}
+	var versions []string
+	for _, it := range d.Items {
+		ref := "oss-version-" + it.Version.ID
+		if it.Usage.DirectDependency && !direct[ref] {
+			direct[ref] = true
+			rootDep.DependsOn = append(rootDep.DependsOn, ref)
+		}
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		versions = append(versions, it.Version.ID)
+		bom.Components = append(bom.Components, toCDXComponent(d, ref, it))
+	}
+	bom.Dependencies = append(bom.Dependencies, rootDep)
+	deps := d.versionDependencies()
+	for _, versionID := range versions {
+		dep := CDXDependency{Ref: "oss-version-" + versionID}
+		for _, to := range deps[versionID] {
+			dep.DependsOn = append(dep.DependsOn, "oss-version-"+to)
+		}
+		bom.Dependencies = append(bom.Dependencies, dep)
+	}
+	return bom
+}
+
+// cdxLicense は licenses[].expression に出力するライセンス式を返す (確定ライセンスを優先)。
+// NOASSERTION / NONE は SPDX ライセンス式ではないため出力しない。
+func cdxLicense(v model.OssVersion) string {
+	for _, lic := range []string{deref(v.LicenseConcluded), deref(v.LicenseExpressionRaw)} {
+		if lic != "" && lic != license.NoAssertion && lic != license.None {
+			return lic
+		}
+	}
+	return ""
+}
+
+func toCDXComponent(d *Document, ref string, it model.ProjectUsageDetail) CDXComponent {
+	v := it.Version
+	supplierType := deref(v.SupplierType)
+	c := CDXComponent{
+		Type:     "library",
+		BOMRef:   ref,
+		Supplier: cdxSupplier(d, supplierType, it.Component),
+		Name:     it.Component.Name,
+		Version:  v.Version,
+		Purl:     deref(v.Purl),
+	}
+	if len(v.CpeList) > 0 {
+		c.CPE = v.CpeList[0]
+	}
+	if h := deref(v.HashSha256); h != "" {
+		c.Hashes = append(c.Hashes, CDXHash{Alg: "SHA-256", Content: strings.ToLower(h)})
+	}
+	if h := deref(v.HashSha512); h != "" {
+		c.Hashes = append(c.Hashes, CDXHash{Alg: "SHA-512", Content: strings.ToLower(h)})
+	}
+	if lic := cdxLicense(v); lic != "" {
+		c.Licenses = []CDXLicenseChoice{{Expression: lic}}
+	}
+	if supplierType != "" {
+		c.Properties = []CDXProperty{{Name: cdxPropSupplierType, Value: supplierType}}
+	}
+	if v.Modified {
+		m := true
+		c.Modified = &m
+	}
+	if supplierType == "INTERNAL_FORK" {
+		p := &CDXPedigree{Notes: deref(v.ModificationDescription)}
+		if origin := deref(v.ForkOriginURL); origin != "" {
+			p.Ancestors = []CDXComponent{{
+				Type:               "library",
+				Name:               it.Component.Name,
+				Version:            v.Version,
+				ExternalReferences: []CDXExternalReference{{Type: "vcs", URL: origin}},
+			}}
+		}
+		if p.Notes != "" || len(p.Ancestors) > 0 {
+			c.Pedigree = p
+		}
+	}
+	return c
+}
+
+// cdxSupplier は供給形態に応じて供給者を決定する。
+// 社内フォーク・再パッケージはプロジェクトの所管部署 (未設定ならプロジェクト名) を、
+// それ以外は OSS コンポーネント自体を供給者とする。
+func cdxSupplier(d *Document, supplierType string, comp model.OssComponent) *CDXOrganizationalRef {
+	switch supplierType {
+	case "INTERNAL_FORK", "REPACKAGE":
+		org := deref(d.Project.Department)
+		if org == "" {
+			org = d.Project.Name
+		}
+		return &CDXOrganizationalRef{Name: org}
+	default:
+		s := &CDXOrganizationalRef{Name: comp.Name}
+		for _, u := range []string{deref(comp.HomepageURL), deref(comp.RepositoryURL)} {
+			if u != "" {
+				s.URL = append(s.URL, u)
+			}
+		}
+		return s
+	}
+}
+
+// WriteCycloneDXJSON は Document を CycloneDX 1.5 JSON として w に書き出す。
+func WriteCycloneDXJSON(w io.Writer, d *Document) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(BuildCycloneDX(d, "urn:uuid:"+uuid.NewString()))
+}
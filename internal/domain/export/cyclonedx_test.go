@@ -0,0 +1,123 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func cdxTestDocument() *Document {
+	mit := "MIT"
+	apache := "Apache-2.0"
+	purl := "pkg:npm/lodash@4.17.21"
+	hash := "ABCDEF"
+	hash512 := "FEDCBA"
+	upstream := "UPSTREAM"
+	fork := "INTERNAL_FORK"
+	origin := "https://github.com/example/zlib"
+	note := "patched for embedded build"
+	home := "https://lodash.com"
+	dept := "R&D"
+	return &Document{
+		Project: model.Project{ID: "p1", ProjectCode: "P1", Name: "Proj", Department: &dept},
+		Items: []model.ProjectUsageDetail{
+			{
+				Usage:     model.ProjectUsage{DirectDependency: true},
+				Component: model.OssComponent{Name: "lodash", HomepageURL: &home},
+				Version:   model.OssVersion{ID: "v1", Version: "4.17.21", LicenseConcluded: &mit, Purl: &purl, HashSha256: &hash, HashSha512: &hash512, SupplierType: &upstream},
+			},
+			{
+				Usage:     model.ProjectUsage{DirectDependency: false},
+				Component: model.OssComponent{Name: "zlib"},
+				Version:   model.OssVersion{ID: "v2", Version: "1.3", LicenseExpressionRaw: &apache, Modified: true, ModificationDescription: &note, ForkOriginURL: &origin, SupplierType: &fork},
+			},
+		},
+		GeneratedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestBuildCycloneDX(t *testing.T) {
+	bom := BuildCycloneDX(cdxTestDocument(), "urn:uuid:test")
+
+	require.Equal(t, "CycloneDX", bom.BOMFormat)
+	require.Equal(t, "1.5", bom.SpecVersion)
+	require.Equal(t, "project-p1", bom.Metadata.Component.BOMRef)
+	require.Len(t, bom.Components, 2)
+
+	lodash := bom.Components[0]
+	require.Equal(t, "pkg:npm/lodash@4.17.21", lodash.Purl)
+	require.Equal(t, []CDXHash{{Alg: "SHA-256", Content: "abcdef"}, {Alg: "SHA-512", Content: "fedcba"}}, lodash.Hashes)
+	require.Equal(t, "MIT", lodash.Licenses[0].Expression)
+	require.Equal(t, &CDXOrganizationalRef{Name: "lodash", URL: []string{"https://lodash.com"}}, lodash.Supplier)
+	require.Nil(t, lodash.Pedigree)
+
+	zlib := bom.Components[1]
+	require.Equal(t, "Apache-2.0", zlib.Licenses[0].Expression)
+	require.Equal(t, "R&D", zlib.Supplier.Name)
+	require.NotNil(t, zlib.Modified)
+	require.True(t, *zlib.Modified)
+	require.Equal(t, "patched for embedded build", zlib.Pedigree.Notes)
+	require.Equal(t, "https://github.com/example/zlib", zlib.Pedigree.Ancestors[0].ExternalReferences[0].URL)
+	require.Equal(t, []CDXProperty{{Name: cdxPropSupplierType, Value: "INTERNAL_FORK"}}, zlib.Properties)
+
+	require.Len(t, bom.Dependencies, 3)
+	require.Equal(t, []string{"oss-version-v1"}, bom.Dependencies[0].DependsOn)
+	require.Empty(t, bom.Dependencies[1].DependsOn)
+
+	// 記録済みの依存グラフは依存元コンポーネントの dependsOn に列挙する
+	d := cdxTestDocument()
+	d.Items[0].Usage.ID, d.Items[1].Usage.ID = "u1", "u2"
+	d.Dependencies = map[string][]string{"u1": {"u2", "u1", "u9"}}
+	bom = BuildCycloneDX(d, "urn:uuid:test")
+	require.Equal(t, []string{"oss-version-v1"}, bom.Dependencies[0].DependsOn)
+	require.Equal(t, CDXDependency{Ref: "oss-version-v1", DependsOn: []string{"oss-version-v2"}}, bom.Dependencies[1])
+	require.Empty(t, bom.Dependencies[2].DependsOn)
+
+	// NOASSERTION / NONE はライセンス式として出力せず、確定ライセンスが無ければ記載時のライセンスを用いる
+	noAssertion, none, apache := "NOASSERTION", "NONE", "Apache-2.0"
+	d = cdxTestDocument()
+	d.Items[0].Version.LicenseConcluded = &noAssertion
+	d.Items[1].Version.LicenseConcluded = &none
+	d.Items[1].Version.LicenseExpressionRaw = &noAssertion
+	bom = BuildCycloneDX(d, "urn:uuid:test")
+	require.Empty(t, bom.Components[0].Licenses)
+	require.Empty(t, bom.Components[1].Licenses)
+	d.Items[0].Version.LicenseExpressionRaw = &apache
+	bom = BuildCycloneDX(d, "urn:uuid:test")
+	require.Equal(t, "Apache-2.0", bom.Components[0].Licenses[0].Expression)
+}
+
+func TestWriteCycloneDXJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCycloneDXJSON(&buf, cdxTestDocument()))
+
+	var bom CycloneDXBOM
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &bom))
+	require.True(t, strings.HasPrefix(bom.SerialNumber, "urn:uuid:"))
+	require.Len(t, bom.Components, 2)
+}
+
+func TestWriteCycloneDXXML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCycloneDXXML(&buf, cdxTestDocument()))
+
+	out := buf.String()
+	require.True(t, strings.HasPrefix(out, xml.Header))
+	require.Contains(t, out, `<bom xmlns="http://cyclonedx.org/schema/bom/1.5"`)
+	require.Contains(t, out, `<hash alg="SHA-256">abcdef</hash>`)
+	require.Contains(t, out, `<expression>MIT</expression>`)
+	require.Contains(t, out, `<notes>patched for embedded build</notes>`)
+	require.Contains(t, out, `<reference type="vcs">`)
+
+	var x cdxXMLBOM
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &x))
+	require.Len(t, x.Components, 2)
+	require.Equal(t, "oss-version-v1", x.Dependencies[0].DependsOn[0].Ref)
+}
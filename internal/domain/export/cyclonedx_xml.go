@@ -0,0 +1,149 @@
+package export
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// cdxXMLBOM は CycloneDX 1.5 XML の BOM を表す。
+// 要素の並びは XSD の sequence 順に合わせている。
+type cdxXMLBOM struct {
+	XMLName      xml.Name           `xml:"bom"`
+	XMLNS        string             `xml:"xmlns,attr"`
+	SerialNumber string             `xml:"serialNumber,attr"`
+	Version      int                `xml:"version,attr"`
+	Metadata     cdxXMLMetadata     `xml:"metadata"`
+	Components   []cdxXMLComponent  `xml:"components>component"`
+	Dependencies []cdxXMLDependency `xml:"dependencies>dependency"`
+}
+
+type cdxXMLMetadata struct {
+	Timestamp string            `xml:"timestamp"`
+	Tools     []cdxXMLComponent `xml:"tools>components>component"`
+	Component cdxXMLComponent   `xml:"component"`
+}
+
+type cdxXMLComponent struct {
+	Type               string              `xml:"type,attr"`
+	BOMRef             string              `xml:"bom-ref,attr,omitempty"`
+	Supplier           *cdxXMLOrganization `xml:"supplier,omitempty"`
+	Name               string              `xml:"name"`
+	Version            string              `xml:"version,omitempty"`
+	Description        string              `xml:"description,omitempty"`
+	Hashes             []cdxXMLHash        `xml:"hashes>hash,omitempty"`
+	Licenses           []string            `xml:"licenses>expression,omitempty"`
+	CPE                string              `xml:"cpe,omitempty"`
+	Purl               string              `xml:"purl,omitempty"`
+	Modified           *bool               `xml:"modified,omitempty"`
+	Pedigree           *cdxXMLPedigree     `xml:"pedigree,omitempty"`
+	ExternalReferences []cdxXMLExternalRef `xml:"externalReferences>reference,omitempty"`
+	Properties         []cdxXMLProperty    `xml:"properties>property,omitempty"`
+}
+
+type cdxXMLOrganization struct {
+	Name string   `xml:"name"`
+	URL  []string `xml:"url,omitempty"`
+}
+
+type cdxXMLHash struct {
+	Alg     string `xml:"alg,attr"`
+	Content string `xml:",chardata"`
+}
+
+type cdxXMLPedigree struct {
+	Ancestors []cdxXMLComponent `xml:"ancestors>component,omitempty"`
+	Notes     string            `xml:"notes,omitempty"`
+}
+
+type cdxXMLExternalRef struct {
+	Type string `xml:"type,attr"`
+	URL  string `xml:"url"`
+}
+
+type cdxXMLProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+type cdxXMLDependency struct {
+	Ref       string             `xml:"ref,attr"`
+	DependsOn []cdxXMLDependency `xml:"dependency,omitempty"`
+}
+
+// toCDXXML は JSON 表現の BOM を XML 表現へ変換する。
+func toCDXXML(b CycloneDXBOM) cdxXMLBOM {
+	x := cdxXMLBOM{
+		XMLNS:        CycloneDXNamespace,
+		SerialNumber: b.SerialNumber,
+		Version:      b.Version,
+		Metadata: cdxXMLMetadata{
+			Timestamp: b.Metadata.Timestamp,
+			Component: toCDXXMLComponent(b.Metadata.Component),
+		},
+	}
+	for _, t := range b.Metadata.Tools.Components {
+		x.Metadata.Tools = append(x.Metadata.Tools, toCDXXMLComponent(t))
+	}
+	for _, c := range b.Components {
+		x.Components = append(x.Components, toCDXXMLComponent(c))
+	}
+	for _, d := range b.Dependencies {
+		dep := cdxXMLDependency{Ref: d.Ref}
+		for _, ref := range d.DependsOn {
+			dep.DependsOn = append(dep.DependsOn, cdxXMLDependency{Ref: ref})
+		}
+		x.Dependencies = append(x.Dependencies, dep)
+	}
+	return x
+}
+
+func toCDXXMLComponent(c CDXComponent) cdxXMLComponent {
+	x := cdxXMLComponent{
+		Type:        c.Type,
+		BOMRef:      c.BOMRef,
+		Name:        c.Name,
+		Version:     c.Version,
+		Description: c.Description,
+		Purl:        c.Purl,
+		CPE:         c.CPE,
+		Modified:    c.Modified,
+	}
+	if c.Supplier != nil {
+		x.Supplier = &cdxXMLOrganization{Name: c.Supplier.Name, URL: c.Supplier.URL}
+	}
+	for _, h := range c.Hashes {
+		x.Hashes = append(x.Hashes, cdxXMLHash(h))
+	}
+	for _, l := range c.Licenses {
+		x.Licenses = append(x.Licenses, l.Expression)
+	}
+	if c.Pedigree != nil {
+		p := &cdxXMLPedigree{Notes: c.Pedigree.Notes}
+		for _, a := range c.Pedigree.Ancestors {
+			p.Ancestors = append(p.Ancestors, toCDXXMLComponent(a))
+		}
+		x.Pedigree = p
+	}
+	for _, r := range c.ExternalReferences {
+		x.ExternalReferences = append(x.ExternalReferences, cdxXMLExternalRef(r))
+	}
+	for _, p := range c.Properties {
+		x.Properties = append(x.Properties, cdxXMLProperty(p))
+	}
+	return x
+}
+
+// WriteCycloneDXXML は Document を CycloneDX 1.5 XML として w に書き出す。
+func WriteCycloneDXXML(w io.Writer, d *Document) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(toCDXXML(BuildCycloneDX(d, "urn:uuid:"+uuid.NewString()))); err != nil {
+		return err
+	}
+	return enc.Close()
+}
@@ -0,0 +1,57 @@
+// Package export はプロジェクトの利用 OSS 一覧から納品用ファイルを生成する。
+package export
+
+import (
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// Document はエクスポート対象のプロジェクトと利用一覧をまとめたもの。
+// GeneratedBy・Scopes はバンドルのマニフェストに記録する。
+type Document struct {
+	Project     model.Project
+	Items       []model.ProjectUsageDetail
+	Scopes      []string
+	GeneratedAt time.Time
+	GeneratedBy string
+	// LicenseTexts はライセンスカタログから取得したライセンス ID ごとの本文。
+	// 含まれないライセンスは同梱の本文を用いる。
+	LicenseTexts map[string]string
+	// Findings は利用に該当した脆弱性と記録済みの VEX 分析。VEX 形式 (NeedsFindings) の場合のみ設定する。
+	Findings []model.ProjectVulnerability
+	// Dependencies は利用 ID ごとの依存先の利用 ID。SBOM 取り込み時に記録した依存グラフで、
+	// 親の分からない間接依存は含まれない。
+	Dependencies map[string][]string
+}
+
+// versionDependencies は Dependencies を OSS バージョン ID 間の依存関係に変換する。
+// 出力対象外の利用・自己参照は除き、依存先は Items の順に重複なく並べる。
+func (d *Document) versionDependencies() map[string][]string {
+	versions := map[string]string{}
+	for _, it := range d.Items {
+		versions[it.Usage.ID] = it.Version.ID
+	}
+	res := map[string][]string{}
+	seen := map[[2]string]bool{}
+	for _, it := range d.Items {
+		from := it.Version.ID
+		for _, usageID := range d.Dependencies[it.Usage.ID] {
+			to, ok := versions[usageID]
+			if !ok || to == from || seen[[2]string{from, to}] {
+				continue
+			}
+			seen[[2]string{from, to}] = true
+			res[from] = append(res[from], to)
+		}
+	}
+	return res
+}
+
+// deref は nil の場合に空文字を返す。
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
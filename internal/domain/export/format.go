@@ -0,0 +1,72 @@
+package export
+
+import (
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Format は出力形式ごとの Content-Type・ファイル名・生成処理をまとめたもの。
+type Format struct {
+	Name        string
+	ContentType string
+	fileSuffix  string
+	Write       func(io.Writer, *Document) error
+}
+
+// FileName はプロジェクトコードから出力ファイル名を生成する。
+// プロジェクトコードは任意の文字列のため、ZIP の展開先や Content-Disposition でパスとして解釈されないよう
+// 英数字・"-"・"_"・"." 以外の文字と ".." を "_" に置き換え、先頭の "." を取り除く。
+func (f Format) FileName(projectCode string) string {
+	return safeFileName(projectCode) + f.fileSuffix
+}
+
+func safeFileName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.' {
+			return r
+		}
+		return '_'
+	}, s)
+	for strings.Contains(s, "..") {
+		s = strings.ReplaceAll(s, "..", "_")
+	}
+	s = strings.TrimLeft(s, ".")
+	if s == "" {
+		return "project"
+	}
+	return s
+}
+
+// NeedsFindings は出力に Document.Findings (脆弱性の該当と VEX 分析) が必要かを返す。
+func (f Format) NeedsFindings() bool {
+	return f.Name == "openvex" || f.Name == "cyclonedx-vex"
+}
+
+// LookupFormat は形式名 (csv, spdx-json など) に対応する Format を返す。
+func LookupFormat(name string) (Format, bool) {
+	switch name {
+	case "csv":
+		return Format{name, "text/csv; charset=utf-8", "-oss-list.csv", WriteCSV}, true
+	case "spdx-json":
+		return Format{name, "application/spdx+json", ".spdx.json", WriteSPDX}, true
+	case "cyclonedx-json":
+		return Format{name, "application/vnd.cyclonedx+json", ".cdx.json", WriteCycloneDXJSON}, true
+	case "cyclonedx-xml":
+		return Format{name, "application/vnd.cyclonedx+xml", ".cdx.xml", WriteCycloneDXXML}, true
+	case "notice":
+		return Format{name, "text/plain; charset=utf-8", "-NOTICE.txt", WriteNoticeText}, true
+	case "notice-html":
+		return Format{name, "text/html; charset=utf-8", "-NOTICE.html", WriteNoticeHTML}, true
+	case "xlsx":
+		return Format{name, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "-oss-list.xlsx", WriteXLSX}, true
+	case "bundle":
+		return Format{name, "application/zip", "-delivery.zip", WriteBundle}, true
+	case "openvex":
+		return Format{name, "application/json", ".openvex.json", WriteOpenVEX}, true
+	case "cyclonedx-vex":
+		return Format{name, "application/vnd.cyclonedx+json", ".vex.cdx.json", WriteCycloneDXVEX}, true
+	default:
+		return Format{}, false
+	}
+}
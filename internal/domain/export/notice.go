@@ -0,0 +1,184 @@
+package export
+
+import (
+	htmltemplate "html/template"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// noticeUnknownLicense はライセンス未確定コンポーネントをまとめるグループ名。
+const noticeUnknownLicense = "NOASSERTION"
+
+// Notice は NOTICE (第三者ライセンス表記) ファイルの内容を表す。
+type Notice struct {
+	Project     model.Project
+	GeneratedAt time.Time
+	Groups      []NoticeGroup
+}
+
+// NoticeGroup は 1 ライセンス分の本文と対象コンポーネントを表す。
+type NoticeGroup struct {
+	LicenseID  string
+	Text       string
+	HasText    bool
+	Components []NoticeComponent
+}
+
+// NoticeComponent は NOTICE に掲載するコンポーネントを表す。
+// Expression はライセンス式がグループのライセンス ID と異なる場合のみ設定する。
+type NoticeComponent struct {
+	Name       string
+	Version    string
+	Homepage   string
+	Expression string
+	Copyright  []string
+}
+
+// BuildNotice は Document から NOTICE の内容を組み立てる。
+// 確定ライセンス (未設定なら生のライセンス式) に含まれるライセンス ID ごとにグループ化し、
+// 複合ライセンスのコンポーネントは該当する全グループに掲載する。
+func BuildNotice(d *Document) Notice {
+	n := Notice{Project: d.Project, GeneratedAt: d.GeneratedAt}
+	groups := map[string]*NoticeGroup{}
+	seen := map[string]bool{}
+	for _, it := range d.Items {
+		if seen[it.Version.ID] {
+			continue
+		}
+		seen[it.Version.ID] = true
+
+		expr := strings.TrimSpace(deref(it.Version.LicenseConcluded))
+		if expr == "" {
+			expr = strings.TrimSpace(deref(it.Version.LicenseExpressionRaw))
+		}
+		ids := license.IDs(expr)
+		if len(ids) == 0 || (len(ids) == 1 && ids[0] == noticeUnknownLicense) {
+			ids = []string{noticeUnknownLicense}
+		}
+		for _, id := range ids {
+			g, ok := groups[id]
+			if !ok {
+				g = &NoticeGroup{LicenseID: id}
+				g.Text, g.HasText = d.LicenseTexts[id]
+				if !g.HasText {
+					g.Text, g.HasText = license.Text(id)
+				}
+				groups[id] = g
+			}
+			c := NoticeComponent{
+				Name:      it.Component.Name,
+				Version:   it.Version.Version,
+				Homepage:  deref(it.Component.HomepageURL),
+				Copyright: copyrightLines(deref(it.Version.CopyrightText)),
+			}
+			if expr != id {
+				c.Expression = expr
+			}
+			g.Components = append(g.Components, c)
+		}
+	}
+
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if (keys[i] == noticeUnknownLicense) != (keys[j] == noticeUnknownLicense) {
+			return keys[j] == noticeUnknownLicense
+		}
+		return keys[i] < keys[j]
+	})
+	for _, k := range keys {
+		n.Groups = append(n.Groups, *groups[k])
+	}
+	return n
+}
+
+// copyrightLines は著作権表示を行単位に分割し空行を除去する。
+func copyrightLines(s string) []string {
+	var lines []string
+	for _, l := range strings.Split(s, "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+var noticeFuncs = template.FuncMap{
+	"rfc3339": func(t time.Time) string { return t.UTC().Format(time.RFC3339) },
+	"spdxURL": spdxLicenseURL,
+}
+
+// spdxLicenseURL は SPDX License List 上のライセンスページ URL を返す。
+// LicenseRef- などリスト外の識別子の場合は空文字を返す。
+func spdxLicenseURL(id string) string {
+	if id == noticeUnknownLicense || strings.HasPrefix(id, "LicenseRef-") || strings.HasPrefix(id, "DocumentRef-") {
+		return ""
+	}
+	return "https://spdx.org/licenses/" + id + ".html"
+}
+
+var noticeTextTmpl = template.Must(template.New("notice").Funcs(noticeFuncs).Parse(`THIRD-PARTY SOFTWARE NOTICES
+{{.Project.Name}} ({{.Project.ProjectCode}})
+Generated: {{rfc3339 .GeneratedAt}}
+
+This product includes the following open source software components.
+{{range .Groups}}
+================================================================================
+License: {{.LicenseID}}
+================================================================================
+{{range .Components}}
+* {{.Name}} {{.Version}}{{if .Homepage}} <{{.Homepage}}>{{end}}
+{{- if .Expression}}
+  License expression: {{.Expression}}{{end}}
+{{- range .Copyright}}
+  {{.}}{{end}}
+{{end}}
+{{if .HasText}}--------------------------------------------------------------------------------
+{{.Text}}{{else if spdxURL .LicenseID}}License text: {{spdxURL .LicenseID}}
+{{end}}{{end}}`))
+
+var noticeHTMLTmpl = htmltemplate.Must(htmltemplate.New("notice").Funcs(htmltemplate.FuncMap(noticeFuncs)).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Third-Party Software Notices - {{.Project.Name}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+pre { background: #f6f8fa; padding: 1em; white-space: pre-wrap; }
+</style>
+</head>
+<body>
+<h1>Third-Party Software Notices</h1>
+<p>{{.Project.Name}} ({{.Project.ProjectCode}})<br>Generated: {{rfc3339 .GeneratedAt}}</p>
+<p>This product includes the following open source software components.</p>
+{{range .Groups}}<section>
+<h2>{{.LicenseID}}</h2>
+<ul>
+{{range .Components}}<li>{{if .Homepage}}<a href="{{.Homepage}}">{{.Name}}</a>{{else}}{{.Name}}{{end}} {{.Version}}
+{{- if .Expression}}<br>License expression: {{.Expression}}{{end}}
+{{- range .Copyright}}<br>{{.}}{{end}}</li>
+{{end}}</ul>
+{{if .HasText}}<pre>{{.Text}}</pre>
+{{else if spdxURL .LicenseID}}<p>License text: <a href="{{spdxURL .LicenseID}}">{{spdxURL .LicenseID}}</a></p>
+{{end}}</section>
+{{end}}</body>
+</html>
+`))
+
+// WriteNoticeText は Document を NOTICE テキストとして w に書き出す。
+func WriteNoticeText(w io.Writer, d *Document) error {
+	return noticeTextTmpl.Execute(w, BuildNotice(d))
+}
+
+// WriteNoticeHTML は Document を NOTICE HTML として w に書き出す。
+func WriteNoticeHTML(w io.Writer, d *Document) error {
+	return noticeHTMLTmpl.Execute(w, BuildNotice(d))
+}
@@ -0,0 +1,87 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func noticeTestDocument() *Document {
+	mit := "MIT"
+	dual := "MIT OR Apache-2.0"
+	custom := "LicenseRef-Custom"
+	copyLodash := "Copyright OpenJS Foundation and other contributors"
+	copyServe := "Copyright (c) 2014 <serde authors>\n\nCopyright (c) 2015 Example"
+	return &Document{
+		Project: model.Project{ProjectCode: "P1", Name: "Proj"},
+		Items: []model.ProjectUsageDetail{
+			{Component: model.OssComponent{Name: "lodash"}, Version: model.OssVersion{ID: "v1", Version: "4.17.21", LicenseConcluded: &mit, CopyrightText: &copyLodash}},
+			{Component: model.OssComponent{Name: "lodash"}, Version: model.OssVersion{ID: "v1", Version: "4.17.21", LicenseConcluded: &mit, CopyrightText: &copyLodash}},
+			{Component: model.OssComponent{Name: "serde"}, Version: model.OssVersion{ID: "v2", Version: "1.0.0", LicenseExpressionRaw: &dual, CopyrightText: &copyServe}},
+			{Component: model.OssComponent{Name: "inhouse"}, Version: model.OssVersion{ID: "v3", Version: "2.0", LicenseConcluded: &custom}},
+			{Component: model.OssComponent{Name: "mystery"}, Version: model.OssVersion{ID: "v4", Version: "0.1"}},
+		},
+		GeneratedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestBuildNotice(t *testing.T) {
+	n := BuildNotice(noticeTestDocument())
+
+	var ids []string
+	for _, g := range n.Groups {
+		ids = append(ids, g.LicenseID)
+	}
+	require.Equal(t, []string{"Apache-2.0", "LicenseRef-Custom", "MIT", "NOASSERTION"}, ids)
+
+	mit := n.Groups[2]
+	require.True(t, mit.HasText)
+	require.Len(t, mit.Components, 2)
+	require.Equal(t, "lodash", mit.Components[0].Name)
+	require.Empty(t, mit.Components[0].Expression)
+	require.Equal(t, "serde", mit.Components[1].Name)
+	require.Equal(t, "MIT OR Apache-2.0", mit.Components[1].Expression)
+	require.Equal(t, []string{"Copyright (c) 2014 <serde authors>", "Copyright (c) 2015 Example"}, mit.Components[1].Copyright)
+
+	require.False(t, n.Groups[1].HasText)
+	require.Equal(t, "mystery", n.Groups[3].Components[0].Name)
+}
+
+func TestBuildNotice_LicenseTexts(t *testing.T) {
+	d := noticeTestDocument()
+	d.LicenseTexts = map[string]string{"LicenseRef-Custom": "ACME License", "MIT": "MIT (catalog)"}
+	n := BuildNotice(d)
+
+	// ライセンスカタログの本文を優先し、無い場合は同梱の本文を用いる
+	require.True(t, n.Groups[1].HasText)
+	require.Equal(t, "ACME License", n.Groups[1].Text)
+	require.Equal(t, "MIT (catalog)", n.Groups[2].Text)
+	require.Contains(t, n.Groups[0].Text, "TERMS AND CONDITIONS FOR USE")
+}
+
+func TestWriteNoticeText(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteNoticeText(&buf, noticeTestDocument()))
+	out := buf.String()
+
+	require.Contains(t, out, "License: MIT\n")
+	require.Contains(t, out, "* lodash 4.17.21\n  Copyright OpenJS Foundation and other contributors\n")
+	require.NotContains(t, out, "https://spdx.org/licenses/LicenseRef-Custom.html")
+	require.Equal(t, 1, strings.Count(out, "Permission is hereby granted, free of charge"))
+	require.Equal(t, 1, strings.Count(out, "TERMS AND CONDITIONS FOR USE"))
+}
+
+func TestWriteNoticeHTML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteNoticeHTML(&buf, noticeTestDocument()))
+	out := buf.String()
+
+	require.Contains(t, out, "<h2>MIT</h2>")
+	require.Contains(t, out, "&lt;serde authors&gt;")
+	require.Equal(t, 1, strings.Count(out, "Permission is hereby granted, free of charge"))
+}
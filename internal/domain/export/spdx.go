@@ -0,0 +1,268 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+)
+
+const (
+	spdxNoAssertion = "NOASSERTION"
+	spdxDocumentID  = "SPDXRef-DOCUMENT"
+	spdxProjectID   = "SPDXRef-Project"
+	// SPDXNamespaceBase は documentNamespace の接頭辞。
+	SPDXNamespaceBase = "https://oss-catalog.local/spdxdocs/"
+)
+
+// SPDXDocument は SPDX 2.3 JSON ドキュメントを表す。
+type SPDXDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      SPDXCreationInfo   `json:"creationInfo"`
+	Packages          []SPDXPackage      `json:"packages"`
+	Relationships     []SPDXRelationship `json:"relationships"`
+	// HasExtractedLicensingInfos はライセンス式で参照する LicenseRef- の本文。
+	HasExtractedLicensingInfos []SPDXExtractedLicense `json:"hasExtractedLicensingInfos,omitempty"`
+}
+
+// SPDXExtractedLicense は SPDX ライセンスリストに無いライセンス (LicenseRef-) の本文を表す。
+type SPDXExtractedLicense struct {
+	LicenseID     string `json:"licenseId"`
+	ExtractedText string `json:"extractedText"`
+	Name          string `json:"name,omitempty"`
+}
+
+// SPDXCreationInfo は作成者・作成日時を表す。
+type SPDXCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+// SPDXPackage は SPDX パッケージ情報を表す。
+type SPDXPackage struct {
+	Name                  string            `json:"name"`
+	SPDXID                string            `json:"SPDXID"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	DownloadLocation      string            `json:"downloadLocation"`
+	FilesAnalyzed         bool              `json:"filesAnalyzed"`
+	Homepage              string            `json:"homepage,omitempty"`
+	Checksums             []SPDXChecksum    `json:"checksums,omitempty"`
+	LicenseConcluded      string            `json:"licenseConcluded"`
+	LicenseDeclared       string            `json:"licenseDeclared"`
+	LicenseComments       string            `json:"licenseComments,omitempty"`
+	CopyrightText         string            `json:"copyrightText"`
+	ExternalRefs          []SPDXExternalRef `json:"externalRefs,omitempty"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose,omitempty"`
+	Comment               string            `json:"comment,omitempty"`
+}
+
+// SPDXChecksum はパッケージのチェックサムを表す。
+type SPDXChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+// SPDXExternalRef は purl / CPE などの外部参照を表す。
+type SPDXExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+// SPDXRelationship は SPDX 要素間の関係を表す。
+type SPDXRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+	Comment            string `json:"comment,omitempty"`
+}
+
+// BuildSPDX は Document から SPDX 2.3 ドキュメントを組み立てる。
+// プロジェクト自身をルートパッケージとし、各利用 OSS バージョンを 1 パッケージとして
+// DESCRIBES の関係を付与する。プロジェクトからの DEPENDS_ON は直接依存のみとし、
+// 間接依存は取り込み時に記録した依存元パッケージからの DEPENDS_ON で表す。
+// 同一バージョンを複数の利用が参照する場合は 1 パッケージにまとめ、いずれかが直接依存であれば直接依存として扱う。
+// ライセンス式は SPDX ライセンス式として解釈できるもののみ正規化して記載し、
+// 参照する LicenseRef- はライセンスカタログの本文とともに hasExtractedLicensingInfos に列挙する。
+func BuildSPDX(d *Document, namespace string) SPDXDocument {
+	doc := SPDXDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            spdxDocumentID,
+		Name:              d.Project.ProjectCode + " " + d.Project.Name,
+		DocumentNamespace: namespace,
+		CreationInfo: SPDXCreationInfo{
+			Created:  d.GeneratedAt.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: oss-catalog"},
+		},
+	}
+	doc.Packages = append(doc.Packages, SPDXPackage{
+		Name:                  d.Project.Name,
+		SPDXID:                spdxProjectID,
+		DownloadLocation:      spdxNoAssertion,
+		LicenseConcluded:      spdxNoAssertion,
+		LicenseDeclared:       spdxNoAssertion,
+		CopyrightText:         spdxNoAssertion,
+		PrimaryPackagePurpose: "APPLICATION",
+	})
+	doc.Relationships = append(doc.Relationships, SPDXRelationship{
+		SPDXElementID:      spdxDocumentID,
+		RelationshipType:   "DESCRIBES",
+		RelatedSPDXElement: spdxProjectID,
+	})
+
+	refs := &spdxLicenseRefs{texts: d.LicenseTexts, seen: map[string]bool{}}
+	direct := map[string]bool{}
+	var order []string
+	pkgs := map[string]SPDXPackage{}
+	for _, it := range d.Items {
+		id := spdxPackageID(it.Version.ID)
+		if _, ok := pkgs[id]; !ok {
+			pkgs[id] = toSPDXPackage(id, it.Component.Name, it.Component.HomepageURL, it.Version.Version,
+				it.Version.LicenseConcluded, it.Version.LicenseExpressionRaw, it.Version.Purl, it.Version.CpeList, it.Version.HashSha256, it.Version.HashSha512, refs)
+			order = append(order, it.Version.ID)
+		}
+		direct[id] = direct[id] || it.Usage.DirectDependency
+	}
+	deps := d.versionDependencies()
+	parented := map[string]bool{}
+	for _, tos := range deps {
+		for _, to := range tos {
+			parented[spdxPackageID(to)] = true
+		}
+	}
+	for _, versionID := range order {
+		id := spdxPackageID(versionID)
+		doc.Packages = append(doc.Packages, pkgs[id])
+		switch {
+		case direct[id]:
+			doc.Relationships = append(doc.Relationships, SPDXRelationship{
+				SPDXElementID:      spdxProjectID,
+				RelationshipType:   "DEPENDS_ON",
+				RelatedSPDXElement: id,
+			})
+		case !parented[id]:
+			// 依存元の分からない間接依存はプロジェクトとの関係のみ記録する
+			doc.Relationships = append(doc.Relationships, SPDXRelationship{
+				SPDXElementID:      spdxProjectID,
+				RelationshipType:   "OTHER",
+				RelatedSPDXElement: id,
+				Comment:            "indirect dependency (parent unknown)",
+			})
+		}
+	}
+	for _, versionID := range order {
+		for _, to := range deps[versionID] {
+			doc.Relationships = append(doc.Relationships, SPDXRelationship{
+				SPDXElementID:      spdxPackageID(versionID),
+				RelationshipType:   "DEPENDS_ON",
+				RelatedSPDXElement: spdxPackageID(to),
+			})
+		}
+	}
+	doc.HasExtractedLicensingInfos = refs.infos
+	return doc
+}
+
+// WriteSPDX は Document を SPDX 2.3 JSON として w に書き出す。
+func WriteSPDX(w io.Writer, d *Document) error {
+	ns := SPDXNamespaceBase + d.Project.ProjectCode + "-" + uuid.NewString()
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(BuildSPDX(d, ns))
+}
+
+func toSPDXPackage(id, name string, homepage *string, version string, concluded, declared, purl *string, cpes []string, hash, hash512 *string, refs *spdxLicenseRefs) SPDXPackage {
+	pkg := SPDXPackage{
+		Name:                  name,
+		SPDXID:                id,
+		VersionInfo:           version,
+		DownloadLocation:      spdxNoAssertion,
+		CopyrightText:         spdxNoAssertion,
+		PrimaryPackagePurpose: "LIBRARY",
+	}
+	var invalid []string
+	pkg.LicenseConcluded, invalid = refs.expression(concluded, invalid)
+	pkg.LicenseDeclared, invalid = refs.expression(declared, invalid)
+	if len(invalid) > 0 {
+		pkg.LicenseComments = "unparsed license: " + strings.Join(slices.Compact(invalid), "; ")
+	}
+	if homepage != nil && *homepage != "" {
+		pkg.Homepage = *homepage
+	}
+	if hash != nil && *hash != "" {
+		pkg.Checksums = append(pkg.Checksums, SPDXChecksum{Algorithm: "SHA256", ChecksumValue: strings.ToLower(*hash)})
+	}
+	if hash512 != nil && *hash512 != "" {
+		pkg.Checksums = append(pkg.Checksums, SPDXChecksum{Algorithm: "SHA512", ChecksumValue: strings.ToLower(*hash512)})
+	}
+	if purl != nil && *purl != "" {
+		pkg.ExternalRefs = append(pkg.ExternalRefs, SPDXExternalRef{
+			ReferenceCategory: "PACKAGE-MANAGER",
+			ReferenceType:     "purl",
+			ReferenceLocator:  *purl,
+		})
+	}
+	for _, cpe := range cpes {
+		refType := "cpe22Type"
+		if strings.HasPrefix(cpe, "cpe:2.3:") {
+			refType = "cpe23Type"
+		}
+		pkg.ExternalRefs = append(pkg.ExternalRefs, SPDXExternalRef{
+			ReferenceCategory: "SECURITY",
+			ReferenceType:     refType,
+			ReferenceLocator:  cpe,
+		})
+	}
+	return pkg
+}
+
+// spdxPackageID はバージョン ID から SPDX 識別子を生成する。
+func spdxPackageID(versionID string) string {
+	return "SPDXRef-Package-" + versionID
+}
+
+// spdxLicenseRefs はライセンス式を SPDX の表記に揃え、参照された LicenseRef- を集める。
+type spdxLicenseRefs struct {
+	texts map[string]string
+	seen  map[string]bool
+	infos []SPDXExtractedLicense
+}
+
+// expression はライセンス式を正規化して返す。値が空の場合は NOASSERTION を返す。
+// SPDX ライセンス式として解釈できない場合・外部文書の DocumentRef- を参照する場合も NOASSERTION とし、
+// 元の値を invalid に追加する。
+func (r *spdxLicenseRefs) expression(s *string, invalid []string) (string, []string) {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return spdxNoAssertion, invalid
+	}
+	e, err := license.Parse(*s)
+	if err != nil {
+		return spdxNoAssertion, append(invalid, *s)
+	}
+	for _, l := range e.Licenses() {
+		if strings.HasPrefix(l.License, "DocumentRef-") {
+			return spdxNoAssertion, append(invalid, *s)
+		}
+	}
+	for _, l := range e.Licenses() {
+		if license.IsLicenseRef(l.License) && !r.seen[l.License] {
+			r.seen[l.License] = true
+			text, ok := r.texts[l.License]
+			if !ok {
+				text = spdxNoAssertion
+			}
+			r.infos = append(r.infos, SPDXExtractedLicense{LicenseID: l.License, ExtractedText: text, Name: strings.TrimPrefix(l.License, "LicenseRef-")})
+		}
+	}
+	return e.String(), invalid
+}
@@ -0,0 +1,132 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func TestBuildSPDX(t *testing.T) {
+	lic := "MIT"
+	purl := "pkg:npm/lodash@4.17.21"
+	hash := "ABCDEF"
+	hash512 := "FEDCBA"
+	items := []model.ProjectUsageDetail{
+		{
+			Usage:     model.ProjectUsage{DirectDependency: false},
+			Component: model.OssComponent{Name: "lodash"},
+			Version:   model.OssVersion{ID: "v1", Version: "4.17.21", LicenseConcluded: &lic, Purl: &purl, HashSha256: &hash, HashSha512: &hash512, CpeList: []string{"cpe:2.3:a:lodash:lodash:4.17.21:*:*:*:*:*:*:*"}},
+		},
+		{
+			Usage:     model.ProjectUsage{DirectDependency: true},
+			Component: model.OssComponent{Name: "lodash"},
+			Version:   model.OssVersion{ID: "v1", Version: "4.17.21", LicenseConcluded: &lic, Purl: &purl, HashSha256: &hash},
+		},
+		{
+			Usage:     model.ProjectUsage{DirectDependency: false},
+			Component: model.OssComponent{Name: "left-pad"},
+			Version:   model.OssVersion{ID: "v2", Version: "1.3.0"},
+		},
+	}
+	d := &Document{
+		Project:     model.Project{ProjectCode: "P1", Name: "Proj"},
+		Items:       items,
+		GeneratedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	doc := BuildSPDX(d, "https://example.com/ns")
+
+	require.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	require.Equal(t, "2024-01-02T03:04:05Z", doc.CreationInfo.Created)
+	require.Len(t, doc.Packages, 3)
+	require.Equal(t, spdxProjectID, doc.Packages[0].SPDXID)
+
+	lodash := doc.Packages[1]
+	require.Equal(t, "SPDXRef-Package-v1", lodash.SPDXID)
+	require.Equal(t, "MIT", lodash.LicenseConcluded)
+	require.Equal(t, spdxNoAssertion, lodash.LicenseDeclared)
+	require.Equal(t, []SPDXChecksum{{Algorithm: "SHA256", ChecksumValue: "abcdef"}, {Algorithm: "SHA512", ChecksumValue: "fedcba"}}, lodash.Checksums)
+	require.Len(t, lodash.ExternalRefs, 2)
+	require.Equal(t, "purl", lodash.ExternalRefs[0].ReferenceType)
+	require.Equal(t, "cpe23Type", lodash.ExternalRefs[1].ReferenceType)
+
+	leftPad := doc.Packages[2]
+	require.Equal(t, spdxNoAssertion, leftPad.LicenseConcluded)
+	require.Empty(t, leftPad.Checksums)
+
+	require.Len(t, doc.Relationships, 3)
+	require.Equal(t, "DESCRIBES", doc.Relationships[0].RelationshipType)
+	require.Equal(t, SPDXRelationship{SPDXElementID: spdxProjectID, RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-Package-v1"}, doc.Relationships[1])
+	// 依存元の分からない間接依存はプロジェクトの直接依存にしない
+	require.Equal(t, "OTHER", doc.Relationships[2].RelationshipType)
+	require.Equal(t, "indirect dependency (parent unknown)", doc.Relationships[2].Comment)
+}
+
+func TestBuildSPDX_Dependencies(t *testing.T) {
+	d := &Document{
+		Project: model.Project{ProjectCode: "P1", Name: "Proj"},
+		Items: []model.ProjectUsageDetail{
+			{Usage: model.ProjectUsage{ID: "u1", DirectDependency: true}, Component: model.OssComponent{Name: "express"}, Version: model.OssVersion{ID: "v1"}},
+			{Usage: model.ProjectUsage{ID: "u2"}, Component: model.OssComponent{Name: "body-parser"}, Version: model.OssVersion{ID: "v2"}},
+			{Usage: model.ProjectUsage{ID: "u3"}, Component: model.OssComponent{Name: "bytes"}, Version: model.OssVersion{ID: "v3"}},
+			// 同一バージョンを参照する別の利用の依存も 1 件にまとめる
+			{Usage: model.ProjectUsage{ID: "u4"}, Component: model.OssComponent{Name: "bytes"}, Version: model.OssVersion{ID: "v3"}},
+		},
+		// u9 はスコープ外で出力対象に含まれない
+		Dependencies: map[string][]string{"u1": {"u2", "u9"}, "u2": {"u3", "u4"}},
+	}
+	doc := BuildSPDX(d, "https://example.com/ns")
+
+	require.Len(t, doc.Packages, 4)
+	require.Equal(t, []SPDXRelationship{
+		{SPDXElementID: spdxDocumentID, RelationshipType: "DESCRIBES", RelatedSPDXElement: spdxProjectID},
+		{SPDXElementID: spdxProjectID, RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-Package-v1"},
+		{SPDXElementID: "SPDXRef-Package-v1", RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-Package-v2"},
+		{SPDXElementID: "SPDXRef-Package-v2", RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-Package-v3"},
+	}, doc.Relationships)
+}
+
+func TestBuildSPDX_Licenses(t *testing.T) {
+	concluded := "mit AND LicenseRef-Acme-EULA"
+	declared := "GPL-2.0+"
+	freeText := "see LICENSE file"
+	d := &Document{
+		Project: model.Project{ProjectCode: "P1", Name: "Proj"},
+		Items: []model.ProjectUsageDetail{
+			{Component: model.OssComponent{Name: "acme"}, Version: model.OssVersion{ID: "v1", LicenseConcluded: &concluded, LicenseExpressionRaw: &declared}},
+			{Component: model.OssComponent{Name: "other"}, Version: model.OssVersion{ID: "v2", LicenseConcluded: &freeText, LicenseExpressionRaw: &freeText}},
+			{Component: model.OssComponent{Name: "again"}, Version: model.OssVersion{ID: "v3", LicenseConcluded: &concluded}},
+		},
+		LicenseTexts: map[string]string{"LicenseRef-Acme-EULA": "Acme End User License"},
+	}
+	doc := BuildSPDX(d, "https://example.com/ns")
+
+	acme := doc.Packages[1]
+	require.Equal(t, "MIT AND LicenseRef-Acme-EULA", acme.LicenseConcluded)
+	require.Equal(t, "GPL-2.0-or-later", acme.LicenseDeclared)
+	require.Empty(t, acme.LicenseComments)
+
+	// 解釈できないライセンスは NOASSERTION とし、元の値はコメントに残す
+	other := doc.Packages[2]
+	require.Equal(t, spdxNoAssertion, other.LicenseConcluded)
+	require.Equal(t, spdxNoAssertion, other.LicenseDeclared)
+	require.Equal(t, "unparsed license: see LICENSE file", other.LicenseComments)
+
+	require.Equal(t, []SPDXExtractedLicense{{LicenseID: "LicenseRef-Acme-EULA", ExtractedText: "Acme End User License", Name: "Acme-EULA"}}, doc.HasExtractedLicensingInfos)
+}
+
+func TestWriteSPDX(t *testing.T) {
+	d := &Document{Project: model.Project{ProjectCode: "P1", Name: "Proj"}, GeneratedAt: time.Now()}
+	var buf bytes.Buffer
+	require.NoError(t, WriteSPDX(&buf, d))
+
+	var doc SPDXDocument
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	require.True(t, strings.HasPrefix(doc.DocumentNamespace, SPDXNamespaceBase+"P1-"))
+	require.Len(t, doc.Packages, 1)
+}
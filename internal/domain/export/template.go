@@ -0,0 +1,258 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+// ErrUnknownTemplateEngine はテンプレートエンジン指定が text / html 以外の場合に返す。
+var ErrUnknownTemplateEngine = errors.New("unknown template engine")
+
+// TemplateView はユーザ定義テンプレートに渡すデータ (ビューモデル)。
+// テンプレートからは {{.Project.Code}}、{{range .Usages}}{{.Component.Name}}{{end}} のように参照する。
+// 未設定の項目は空文字・ゼロ値となるため、nil 判定は不要。
+type TemplateView struct {
+	Project     TemplateProject
+	GeneratedAt time.Time
+	GeneratedBy string
+	// Scopes は出力対象とした ProjectUsage.scopeStatus の一覧。
+	Scopes []string
+	// Usages は利用 OSS 一覧 (コンポーネント名・バージョン順)。
+	Usages []TemplateUsage
+	// Licenses はライセンス ID ごとの本文と対象コンポーネント (NOTICE と同じ単位)。
+	Licenses []NoticeGroup
+}
+
+// TemplateProject はテンプレートに渡すプロジェクト情報。
+type TemplateProject struct {
+	Code       string
+	Name       string
+	Department string
+	Manager    string
+	// DeliveryDate は納品日 (YYYY-MM-DD)。
+	DeliveryDate string
+	Description  string
+}
+
+// TemplateUsage はテンプレートに渡す 1 件分の利用情報。
+type TemplateUsage struct {
+	UsageRole        string
+	ScopeStatus      string
+	DirectDependency bool
+	InclusionNote    string
+	Component        TemplateComponent
+	Version          TemplateVersion
+}
+
+// TemplateComponent はテンプレートに渡す OSS コンポーネント情報。
+type TemplateComponent struct {
+	Name            string
+	NormalizedName  string
+	HomepageURL     string
+	RepositoryURL   string
+	Description     string
+	PrimaryLanguage string
+	Layers          []string
+}
+
+// TemplateVersion はテンプレートに渡す OSS バージョン情報。
+type TemplateVersion struct {
+	Version string
+	// ReleaseDate はリリース日 (YYYY-MM-DD)。
+	ReleaseDate             string
+	LicenseConcluded        string
+	LicenseExpressionRaw    string
+	Purl                    string
+	HashSha256              string
+	HashSha512              string
+	Modified                bool
+	ModificationDescription string
+	SupplierType            string
+	ForkOriginURL           string
+	CopyrightText           string
+}
+
+// NewTemplateView は Document からテンプレート用のビューモデルを組み立てる。
+func NewTemplateView(d *Document) TemplateView {
+	v := TemplateView{
+		Project: TemplateProject{
+			Code:         d.Project.ProjectCode,
+			Name:         d.Project.Name,
+			Department:   deref(d.Project.Department),
+			Manager:      deref(d.Project.Manager),
+			DeliveryDate: dateString(d.Project.DeliveryDate),
+			Description:  deref(d.Project.Description),
+		},
+		GeneratedAt: d.GeneratedAt,
+		GeneratedBy: d.GeneratedBy,
+		Scopes:      d.Scopes,
+		Licenses:    BuildNotice(d).Groups,
+	}
+	for _, it := range d.Items {
+		v.Usages = append(v.Usages, TemplateUsage{
+			UsageRole:        it.Usage.UsageRole,
+			ScopeStatus:      it.Usage.ScopeStatus,
+			DirectDependency: it.Usage.DirectDependency,
+			InclusionNote:    deref(it.Usage.InclusionNote),
+			Component: TemplateComponent{
+				Name:            it.Component.Name,
+				NormalizedName:  it.Component.NormalizedName,
+				HomepageURL:     deref(it.Component.HomepageURL),
+				RepositoryURL:   deref(it.Component.RepositoryURL),
+				Description:     deref(it.Component.Description),
+				PrimaryLanguage: deref(it.Component.PrimaryLanguage),
+				Layers:          it.Component.Layers,
+			},
+			Version: TemplateVersion{
+				Version:                 it.Version.Version,
+				ReleaseDate:             dateString(it.Version.ReleaseDate),
+				LicenseConcluded:        deref(it.Version.LicenseConcluded),
+				LicenseExpressionRaw:    deref(it.Version.LicenseExpressionRaw),
+				Purl:                    deref(it.Version.Purl),
+				HashSha256:              deref(it.Version.HashSha256),
+				HashSha512:              deref(it.Version.HashSha512),
+				Modified:                it.Version.Modified,
+				ModificationDescription: deref(it.Version.ModificationDescription),
+				SupplierType:            deref(it.Version.SupplierType),
+				ForkOriginURL:           deref(it.Version.ForkOriginURL),
+				CopyrightText:           deref(it.Version.CopyrightText),
+			},
+		})
+	}
+	return v
+}
+
+// templateFuncs はユーザ定義テンプレートで利用できる関数。
+//   - join: 文字列スライスを区切り文字で連結する ({{join .Component.Layers ", "}})
+//   - upper / lower: 大文字・小文字に変換する
+//   - date: 日時を Go のレイアウトで整形する ({{date .GeneratedAt "2006/01/02"}})
+//   - csv: 値を CSV の 1 フィールドとしてエスケープする
+var templateFuncs = map[string]any{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"date":  func(t time.Time, layout string) string { return t.Format(layout) },
+	"csv":   csvField,
+}
+
+// Template はパース済みのユーザ定義テンプレート。
+type Template struct {
+	Name          string
+	ContentType   string
+	FileExtension string
+	exec          interface {
+		Execute(io.Writer, any) error
+	}
+}
+
+// ParseTemplate は登録済みテンプレートをエンジンに応じてパースする。
+func ParseTemplate(t model.ExportTemplate) (*Template, error) {
+	res := &Template{Name: t.Name, FileExtension: t.FileExtension}
+	switch t.Engine {
+	case model.ExportTemplateText:
+		tpl, err := template.New(t.Name).Funcs(templateFuncs).Parse(t.Body)
+		if err != nil {
+			return nil, err
+		}
+		res.ContentType = "text/plain; charset=utf-8"
+		res.exec = tpl
+	case model.ExportTemplateHTML:
+		tpl, err := htmltemplate.New(t.Name).Funcs(templateFuncs).Parse(t.Body)
+		if err != nil {
+			return nil, err
+		}
+		res.ContentType = "text/html; charset=utf-8"
+		res.exec = tpl
+	default:
+		return nil, ErrUnknownTemplateEngine
+	}
+	return res, nil
+}
+
+// Format はテンプレートを組み込み形式と同じ Format として返す。
+// 出力ファイル名は "<プロジェクトコード>-<テンプレート名>.<拡張子>" とする。
+func (t *Template) Format() Format {
+	return Format{"template", t.ContentType, "-" + t.Name + "." + t.FileExtension, t.Write}
+}
+
+// Write は Document をビューモデルに変換してテンプレートを適用する。
+func (t *Template) Write(w io.Writer, d *Document) error {
+	return t.exec.Execute(w, NewTemplateView(d))
+}
+
+// ValidateTemplate はテンプレートをパースし、サンプルデータで描画できることを確認する。
+func ValidateTemplate(t model.ExportTemplate) error {
+	tpl, err := ParseTemplate(t)
+	if err != nil {
+		return err
+	}
+	if err := tpl.Write(io.Discard, sampleDocument()); err != nil {
+		return fmt.Errorf("render sample: %w", err)
+	}
+	return nil
+}
+
+// sampleDocument はテンプレート検証用のサンプルデータを返す。
+// 省略可能な項目を一通り設定し、単一・複合ライセンスと改変ありのコンポーネントを含める。
+func sampleDocument() *Document {
+	str := func(s string) *string { return &s }
+	delivery := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	return &Document{
+		Project: model.Project{
+			ID:           "00000000-0000-0000-0000-000000000001",
+			ProjectCode:  "SAMPLE",
+			Name:         "サンプルプロジェクト",
+			Department:   str("開発部"),
+			Manager:      str("山田"),
+			DeliveryDate: dbtimePtr(delivery),
+			Status:       model.ProjectStatusDelivered,
+			Description:  str("テンプレート検証用"),
+		},
+		Items: []model.ProjectUsageDetail{
+			{
+				Usage:     model.ProjectUsage{UsageRole: "RUNTIME_REQUIRED", ScopeStatus: "IN_SCOPE", DirectDependency: true, InclusionNote: str("直接依存")},
+				Component: model.OssComponent{Name: "lodash", NormalizedName: "lodash", HomepageURL: str("https://lodash.com/"), Layers: []string{"LIB"}},
+				Version:   model.OssVersion{ID: "v1", Version: "4.17.21", ReleaseDate: dbtimePtr(delivery), LicenseConcluded: str("MIT"), LicenseExpressionRaw: str("MIT"), Purl: str("pkg:npm/lodash@4.17.21"), SupplierType: str("UPSTREAM"), CopyrightText: str("Copyright OpenJS Foundation and other contributors")},
+			},
+			{
+				Usage:     model.ProjectUsage{UsageRole: "BUNDLED_SOURCE", ScopeStatus: "IN_SCOPE"},
+				Component: model.OssComponent{Name: "example-fork", NormalizedName: "example-fork", RepositoryURL: str("https://example.com/fork.git"), Layers: []string{"LIB", "TOOL"}},
+				Version:   model.OssVersion{ID: "v2", Version: "1.0.0-internal", LicenseExpressionRaw: str("MIT OR Apache-2.0"), Modified: true, ModificationDescription: str("社内向け修正"), SupplierType: str("INTERNAL_FORK"), ForkOriginURL: str("https://example.com/upstream.git")},
+			},
+		},
+		Scopes:      []string{"IN_SCOPE"},
+		GeneratedAt: delivery,
+		GeneratedBy: "sample-user",
+	}
+}
+
+// dateString は日付を YYYY-MM-DD 形式にする。nil の場合は空文字を返す。
+func dateString(t *dbtime.DBTime) string {
+	if t == nil {
+		return ""
+	}
+	return t.TimeValue().Format("2006-01-02")
+}
+
+func dbtimePtr(t time.Time) *dbtime.DBTime {
+	return &dbtime.DBTime{Time: t}
+}
+
+// csvField は値を CSV の 1 フィールドとしてエスケープする。
+func csvField(s string) string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{s})
+	w.Flush()
+	return strings.TrimSuffix(buf.String(), "\n")
+}
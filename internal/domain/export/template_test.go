@@ -0,0 +1,76 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func TestNewTemplateView(t *testing.T) {
+	v := NewTemplateView(sampleDocument())
+	require.Equal(t, "SAMPLE", v.Project.Code)
+	require.Equal(t, "2024-04-01", v.Project.DeliveryDate)
+	require.Len(t, v.Usages, 2)
+	require.Equal(t, "lodash", v.Usages[0].Component.Name)
+	require.Equal(t, "MIT", v.Usages[0].Version.LicenseConcluded)
+	require.Equal(t, "", v.Usages[1].Version.LicenseConcluded)
+	require.True(t, v.Usages[1].Version.Modified)
+
+	var ids []string
+	for _, g := range v.Licenses {
+		ids = append(ids, g.LicenseID)
+	}
+	require.Equal(t, []string{"Apache-2.0", "MIT"}, ids)
+}
+
+func TestTemplate_WriteText(t *testing.T) {
+	tpl, err := ParseTemplate(model.ExportTemplate{
+		Name:          "customer-a",
+		Engine:        model.ExportTemplateText,
+		FileExtension: "csv",
+		Body:          "{{range .Usages}}{{csv .Component.Name}},{{.Version.Version}},{{join .Component.Layers \"/\"}}\n{{end}}",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "text/plain; charset=utf-8", tpl.ContentType)
+	require.Equal(t, "P1-customer-a.csv", tpl.Format().FileName("P1"))
+
+	d := sampleDocument()
+	d.Items[0].Component.Name = "lodash, utils"
+	var buf bytes.Buffer
+	require.NoError(t, tpl.Write(&buf, d))
+	require.Equal(t, "\"lodash, utils\",4.17.21,LIB\nexample-fork,1.0.0-internal,LIB/TOOL\n", buf.String())
+}
+
+func TestTemplate_WriteHTML(t *testing.T) {
+	tpl, err := ParseTemplate(model.ExportTemplate{
+		Name:          "customer-b",
+		Engine:        model.ExportTemplateHTML,
+		FileExtension: "html",
+		Body:          "<h1>{{.Project.Name}}</h1>{{range .Licenses}}<p>{{.LicenseID}}</p>{{end}}",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "text/html; charset=utf-8", tpl.ContentType)
+
+	d := sampleDocument()
+	d.Project.Name = "<script>"
+	var buf bytes.Buffer
+	require.NoError(t, tpl.Write(&buf, d))
+	require.Equal(t, "<h1>&lt;script&gt;</h1><p>Apache-2.0</p><p>MIT</p>", buf.String())
+}
+
+func TestValidateTemplate(t *testing.T) {
+	require.NoError(t, ValidateTemplate(model.ExportTemplate{Name: "ok", Engine: model.ExportTemplateText, Body: "{{date .GeneratedAt \"2006/01/02\"}} {{upper .Project.Code}}"}))
+
+	cases := map[string]model.ExportTemplate{
+		"syntax":        {Name: "bad", Engine: model.ExportTemplateText, Body: "{{range .Usages}}"},
+		"unknown field": {Name: "bad", Engine: model.ExportTemplateHTML, Body: "{{.Project.Unknown}}"},
+		"unknown func":  {Name: "bad", Engine: model.ExportTemplateText, Body: "{{lookup .Project}}"},
+	}
+	for name, tc := range cases {
+		require.Error(t, ValidateTemplate(tc), name)
+	}
+	require.ErrorIs(t, ValidateTemplate(model.ExportTemplate{Name: "bad", Engine: "pdf"}), ErrUnknownTemplateEngine)
+}
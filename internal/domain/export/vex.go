@@ -0,0 +1,304 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// openVEXContext は OpenVEX 0.2.0 の @context。
+const openVEXContext = "https://openvex.dev/ns/v0.2.0"
+
+// OpenVEXDocument は OpenVEX 0.2.0 の文書を表す。
+type OpenVEXDocument struct {
+	Context    string             `json:"@context"`
+	ID         string             `json:"@id"`
+	Author     string             `json:"author"`
+	Timestamp  string             `json:"timestamp"`
+	Version    int                `json:"version"`
+	Tooling    string             `json:"tooling,omitempty"`
+	Statements []OpenVEXStatement `json:"statements"`
+}
+
+// OpenVEXStatement は脆弱性 1 件と製品の組に対する VEX 文を表す。
+type OpenVEXStatement struct {
+	Vulnerability   OpenVEXVulnerability `json:"vulnerability"`
+	Timestamp       string               `json:"timestamp,omitempty"`
+	Products        []OpenVEXProduct     `json:"products"`
+	Status          string               `json:"status"`
+	StatusNotes     string               `json:"status_notes,omitempty"`
+	Justification   string               `json:"justification,omitempty"`
+	ImpactStatement string               `json:"impact_statement,omitempty"`
+	ActionStatement string               `json:"action_statement,omitempty"`
+}
+
+// OpenVEXVulnerability は VEX 文の対象の脆弱性を表す。
+type OpenVEXVulnerability struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// OpenVEXProduct は VEX 文の対象の製品 (またはその構成要素) を表す。
+type OpenVEXProduct struct {
+	ID            string            `json:"@id"`
+	Identifiers   map[string]string `json:"identifiers,omitempty"`
+	Subcomponents []OpenVEXProduct  `json:"subcomponents,omitempty"`
+}
+
+// vexStatement は出力する VEX 文 1 件分の該当。同じバージョン・同じ分析の利用は 1 件にまとめる。
+type vexStatement struct {
+	finding  model.ProjectVulnerability
+	versions []model.ProjectUsageDetail
+}
+
+// vexStatements は Document.Findings を脆弱性・分析の組でまとめる。
+func vexStatements(d *Document) []*vexStatement {
+	var res []*vexStatement
+	index := map[string]*vexStatement{}
+	versions := map[string]map[string]bool{}
+	for _, f := range d.Findings {
+		key := f.Finding.Vulnerability.ID
+		if a := f.Analysis; a != nil {
+			key += "\x00" + a.State + "\x00" + deref(a.Justification) + "\x00" + deref(a.Response) + "\x00" + deref(a.Note)
+		}
+		st, ok := index[key]
+		if !ok {
+			st = &vexStatement{finding: f}
+			index[key] = st
+			versions[key] = map[string]bool{}
+			res = append(res, st)
+		}
+		if !versions[key][f.Usage.Version.ID] {
+			versions[key][f.Usage.Version.ID] = true
+			st.versions = append(st.versions, f.Usage)
+		}
+	}
+	return res
+}
+
+// BuildOpenVEX は Document.Findings から OpenVEX 文書を組み立てる。
+// プロジェクトを製品とし、該当したバージョンを subcomponents とする。未分析の該当は under_investigation とする。
+func BuildOpenVEX(d *Document, id string) OpenVEXDocument {
+	doc := OpenVEXDocument{
+		Context:    openVEXContext,
+		ID:         id,
+		Author:     d.GeneratedBy,
+		Timestamp:  d.GeneratedAt.UTC().Format(time.RFC3339),
+		Version:    1,
+		Tooling:    "oss-catalog",
+		Statements: []OpenVEXStatement{},
+	}
+	for _, st := range vexStatements(d) {
+		f := st.finding
+		product := OpenVEXProduct{ID: "urn:oss-catalog:project:" + d.Project.ProjectCode}
+		for _, u := range st.versions {
+			sub := OpenVEXProduct{ID: "urn:oss-catalog:oss-version:" + u.Version.ID}
+			if purl := deref(u.Version.Purl); purl != "" {
+				sub.ID = purl
+				sub.Identifiers = map[string]string{"purl": purl}
+			}
+			product.Subcomponents = append(product.Subcomponents, sub)
+		}
+		s := OpenVEXStatement{
+			Vulnerability: OpenVEXVulnerability{Name: f.Finding.Vulnerability.ID, Aliases: f.Finding.Aliases},
+			Products:      []OpenVEXProduct{product},
+			Status:        model.AnalysisUnderInvestigation,
+		}
+		if a := f.Analysis; a != nil {
+			s.Status = a.State
+			s.Timestamp = a.UpdatedAt.UTC().Format(time.RFC3339)
+			switch a.State {
+			case model.AnalysisNotAffected:
+				s.Justification = deref(a.Justification)
+				s.ImpactStatement = deref(a.Note)
+			case model.AnalysisAffected:
+				// affected の文には対応の記述が必須
+				s.ActionStatement = actionStatement(a)
+			default:
+				s.StatusNotes = deref(a.Note)
+			}
+		}
+		doc.Statements = append(doc.Statements, s)
+	}
+	return doc
+}
+
+// actionStatement は対応方針とメモから affected の対応の記述を組み立てる。
+func actionStatement(a *model.VulnerabilityAnalysis) string {
+	var parts []string
+	for _, s := range []*string{a.Response, a.Note} {
+		if s != nil {
+			parts = append(parts, *s)
+		}
+	}
+	if len(parts) == 0 {
+		return "no remediation recorded"
+	}
+	return strings.Join(parts, ": ")
+}
+
+// WriteOpenVEX は Document を OpenVEX JSON として w に書き出す。
+func WriteOpenVEX(w io.Writer, d *Document) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(BuildOpenVEX(d, "urn:uuid:"+uuid.NewString()))
+}
+
+// CycloneDXVEX は CycloneDX 1.5 の VEX (vulnerabilities を持つ BOM) を表す。
+type CycloneDXVEX struct {
+	BOMFormat       string             `json:"bomFormat"`
+	SpecVersion     string             `json:"specVersion"`
+	SerialNumber    string             `json:"serialNumber"`
+	Version         int                `json:"version"`
+	Metadata        CDXMetadata        `json:"metadata"`
+	Components      []CDXComponent     `json:"components"`
+	Vulnerabilities []CDXVulnerability `json:"vulnerabilities"`
+}
+
+// CDXVulnerability は CycloneDX の脆弱性と、その分析・影響を受けるコンポーネントを表す。
+type CDXVulnerability struct {
+	ID          string                      `json:"id"`
+	Source      *CDXVulnerabilitySource     `json:"source,omitempty"`
+	References  []CDXVulnerabilityReference `json:"references,omitempty"`
+	Ratings     []CDXRating                 `json:"ratings,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Analysis    CDXAnalysis                 `json:"analysis"`
+	Affects     []CDXAffect                 `json:"affects"`
+}
+
+// CDXVulnerabilitySource は脆弱性情報の提供元を表す。
+type CDXVulnerabilitySource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// CDXVulnerabilityReference は同じ脆弱性を指す別の ID を表す。
+type CDXVulnerabilityReference struct {
+	ID     string                 `json:"id"`
+	Source CDXVulnerabilitySource `json:"source"`
+}
+
+// CDXRating は脆弱性の深刻度評価を表す。
+type CDXRating struct {
+	Score    *float64 `json:"score,omitempty"`
+	Severity string   `json:"severity,omitempty"`
+	Method   string   `json:"method,omitempty"`
+	Vector   string   `json:"vector,omitempty"`
+}
+
+// CDXAnalysis は脆弱性の VEX 分析を表す。
+type CDXAnalysis struct {
+	State         string   `json:"state"`
+	Justification string   `json:"justification,omitempty"`
+	Response      []string `json:"response,omitempty"`
+	Detail        string   `json:"detail,omitempty"`
+	LastUpdated   string   `json:"lastUpdated,omitempty"`
+}
+
+// CDXAffect は脆弱性の影響を受けるコンポーネントの参照を表す。
+type CDXAffect struct {
+	Ref string `json:"ref"`
+}
+
+// cdxAnalysisStates は OpenVEX の status と CycloneDX の analysis.state の対応。
+var cdxAnalysisStates = map[string]string{
+	model.AnalysisNotAffected:        "not_affected",
+	model.AnalysisAffected:           "exploitable",
+	model.AnalysisFixed:              "resolved",
+	model.AnalysisUnderInvestigation: "in_triage",
+}
+
+// cdxJustifications は OpenVEX の justification と CycloneDX の analysis.justification の対応。
+var cdxJustifications = map[string]string{
+	model.JustificationComponentNotPresent:                         "code_not_present",
+	model.JustificationVulnerableCodeNotPresent:                    "code_not_present",
+	model.JustificationVulnerableCodeNotInExecutePath:              "code_not_reachable",
+	model.JustificationVulnerableCodeCannotBeControlledByAdversary: "requires_environment",
+	model.JustificationInlineMitigationsAlreadyExist:               "protected_by_mitigating_control",
+}
+
+// cdxRatingMethods は CVSS バージョンと CycloneDX の rating.method の対応。
+var cdxRatingMethods = map[string]string{"2.0": "CVSSv2", "3.0": "CVSSv3", "3.1": "CVSSv31", "4.0": "CVSSv4"}
+
+// BuildCycloneDXVEX は Document.Findings から CycloneDX 1.5 の VEX を組み立てる。
+// 該当したバージョンを components とし、脆弱性毎の分析を vulnerabilities に列挙する。未分析の該当は in_triage とする。
+func BuildCycloneDXVEX(d *Document, serial string) CycloneDXVEX {
+	bom := CycloneDXVEX{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  cdxSpecVersion,
+		SerialNumber: serial,
+		Version:      1,
+		Metadata: CDXMetadata{
+			Timestamp: d.GeneratedAt.UTC().Format(time.RFC3339),
+			Tools:     CDXTools{Components: []CDXComponent{{Type: "application", Name: "oss-catalog"}}},
+			Component: CDXComponent{Type: "application", BOMRef: "project-" + d.Project.ID, Name: d.Project.Name, Version: d.Project.ProjectCode},
+		},
+		Components:      []CDXComponent{},
+		Vulnerabilities: []CDXVulnerability{},
+	}
+	seen := map[string]bool{}
+	for _, f := range d.Findings {
+		ref := "oss-version-" + f.Usage.Version.ID
+		if !seen[ref] {
+			seen[ref] = true
+			bom.Components = append(bom.Components, toCDXComponent(d, ref, f.Usage))
+		}
+	}
+	for _, st := range vexStatements(d) {
+		f := st.finding
+		v := f.Finding.Vulnerability
+		cv := CDXVulnerability{
+			ID:          v.ID,
+			Source:      vulnerabilitySource(v.ID),
+			Description: deref(v.Description),
+			Analysis:    CDXAnalysis{State: cdxAnalysisStates[model.AnalysisUnderInvestigation]},
+		}
+		for _, alias := range f.Finding.Aliases {
+			cv.References = append(cv.References, CDXVulnerabilityReference{ID: alias, Source: *vulnerabilitySource(alias)})
+		}
+		if v.CvssScore != nil || v.Severity != nil {
+			cv.Ratings = []CDXRating{{
+				Score:    v.CvssScore,
+				Severity: strings.ToLower(deref(v.Severity)),
+				Method:   cdxRatingMethods[deref(v.CvssVersion)],
+				Vector:   deref(v.CvssVector),
+			}}
+		}
+		if a := f.Analysis; a != nil {
+			cv.Analysis = CDXAnalysis{
+				State:         cdxAnalysisStates[a.State],
+				Justification: cdxJustifications[deref(a.Justification)],
+				Detail:        deref(a.Note),
+				LastUpdated:   a.UpdatedAt.UTC().Format(time.RFC3339),
+			}
+			if a.Response != nil {
+				cv.Analysis.Response = []string{*a.Response}
+			}
+		}
+		for _, u := range st.versions {
+			cv.Affects = append(cv.Affects, CDXAffect{Ref: "oss-version-" + u.Version.ID})
+		}
+		bom.Vulnerabilities = append(bom.Vulnerabilities, cv)
+	}
+	return bom
+}
+
+// vulnerabilitySource は脆弱性 ID の形式から提供元を推定する。CVE は NVD、それ以外は OSV とする。
+func vulnerabilitySource(id string) *CDXVulnerabilitySource {
+	if strings.HasPrefix(id, "CVE-") {
+		return &CDXVulnerabilitySource{Name: "NVD", URL: "https://nvd.nist.gov/vuln/detail/" + id}
+	}
+	return &CDXVulnerabilitySource{Name: "OSV", URL: "https://osv.dev/vulnerability/" + id}
+}
+
+// WriteCycloneDXVEX は Document を CycloneDX 1.5 VEX JSON として w に書き出す。
+func WriteCycloneDXVEX(w io.Writer, d *Document) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(BuildCycloneDXVEX(d, "urn:uuid:"+uuid.NewString()))
+}
@@ -0,0 +1,126 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+func vexTestDocument() *Document {
+	d := cdxTestDocument()
+	d.GeneratedBy = "alice"
+	score := 9.8
+	severity, cvss := "CRITICAL", "3.1"
+	justification := model.JustificationVulnerableCodeNotInExecutePath
+	note := "inflateGetHeader は使用していない"
+	response := model.ResponseUpdate
+	updated := dbtime.DBTime{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	lodash, zlib := d.Items[0], d.Items[1]
+	zlib2 := zlib
+	zlib2.Usage.ID = "u3"
+	d.Findings = []model.ProjectVulnerability{
+		{
+			Usage:    zlib,
+			Finding:  model.VersionVulnerability{Vulnerability: model.Vulnerability{ID: "CVE-2022-37434", Severity: &severity, CvssScore: &score, CvssVersion: &cvss}},
+			Analysis: &model.VulnerabilityAnalysis{State: model.AnalysisNotAffected, Justification: &justification, Note: &note, UpdatedAt: updated},
+		},
+		// 同じバージョン・同じ分析の利用は 1 件にまとめる
+		{
+			Usage:    zlib2,
+			Finding:  model.VersionVulnerability{Vulnerability: model.Vulnerability{ID: "CVE-2022-37434", Severity: &severity, CvssScore: &score, CvssVersion: &cvss}},
+			Analysis: &model.VulnerabilityAnalysis{State: model.AnalysisNotAffected, Justification: &justification, Note: &note, UpdatedAt: updated},
+		},
+		{
+			Usage:    lodash,
+			Finding:  model.VersionVulnerability{Vulnerability: model.Vulnerability{ID: "GHSA-35jh-r3h4-6jhm"}, Aliases: []string{"CVE-2021-23337"}},
+			Analysis: &model.VulnerabilityAnalysis{State: model.AnalysisAffected, Response: &response, UpdatedAt: updated},
+		},
+		{
+			Usage:   lodash,
+			Finding: model.VersionVulnerability{Vulnerability: model.Vulnerability{ID: "CVE-2020-8203"}},
+		},
+	}
+	return d
+}
+
+func TestBuildOpenVEX(t *testing.T) {
+	doc := BuildOpenVEX(vexTestDocument(), "urn:uuid:test")
+
+	require.Equal(t, "https://openvex.dev/ns/v0.2.0", doc.Context)
+	require.Equal(t, "alice", doc.Author)
+	require.Equal(t, "2024-01-02T03:04:05Z", doc.Timestamp)
+	require.Len(t, doc.Statements, 3)
+
+	zlib := doc.Statements[0]
+	require.Equal(t, "CVE-2022-37434", zlib.Vulnerability.Name)
+	require.Equal(t, "not_affected", zlib.Status)
+	require.Equal(t, "vulnerable_code_not_in_execute_path", zlib.Justification)
+	require.Equal(t, "inflateGetHeader は使用していない", zlib.ImpactStatement)
+	require.Equal(t, "urn:oss-catalog:project:P1", zlib.Products[0].ID)
+	require.Equal(t, []OpenVEXProduct{{ID: "urn:oss-catalog:oss-version:v2"}}, zlib.Products[0].Subcomponents)
+
+	lodash := doc.Statements[1]
+	require.Equal(t, []string{"CVE-2021-23337"}, lodash.Vulnerability.Aliases)
+	require.Equal(t, "affected", lodash.Status)
+	require.Equal(t, "update", lodash.ActionStatement)
+	require.Equal(t, map[string]string{"purl": "pkg:npm/lodash@4.17.21"}, lodash.Products[0].Subcomponents[0].Identifiers)
+
+	// 未分析の該当は調査中とする
+	require.Equal(t, "under_investigation", doc.Statements[2].Status)
+	require.Empty(t, doc.Statements[2].Timestamp)
+}
+
+func TestBuildCycloneDXVEX(t *testing.T) {
+	bom := BuildCycloneDXVEX(vexTestDocument(), "urn:uuid:test")
+
+	require.Equal(t, "1.5", bom.SpecVersion)
+	require.Len(t, bom.Components, 2)
+	require.Equal(t, "oss-version-v2", bom.Components[0].BOMRef)
+	require.Len(t, bom.Vulnerabilities, 3)
+
+	zlib := bom.Vulnerabilities[0]
+	require.Equal(t, "NVD", zlib.Source.Name)
+	require.Equal(t, []CDXRating{{Score: zlib.Ratings[0].Score, Severity: "critical", Method: "CVSSv31"}}, zlib.Ratings)
+	require.Equal(t, CDXAnalysis{State: "not_affected", Justification: "code_not_reachable", Detail: "inflateGetHeader は使用していない", LastUpdated: "2024-01-01T00:00:00Z"}, zlib.Analysis)
+	require.Equal(t, []CDXAffect{{Ref: "oss-version-v2"}}, zlib.Affects)
+
+	lodash := bom.Vulnerabilities[1]
+	require.Equal(t, "OSV", lodash.Source.Name)
+	require.Equal(t, "CVE-2021-23337", lodash.References[0].ID)
+	require.Equal(t, "exploitable", lodash.Analysis.State)
+	require.Equal(t, []string{"update"}, lodash.Analysis.Response)
+
+	require.Equal(t, "in_triage", bom.Vulnerabilities[2].Analysis.State)
+}
+
+func TestWriteVEX(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteOpenVEX(&buf, vexTestDocument()))
+	var doc OpenVEXDocument
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	require.True(t, strings.HasPrefix(doc.ID, "urn:uuid:"))
+	require.Len(t, doc.Statements, 3)
+
+	buf.Reset()
+	require.NoError(t, WriteCycloneDXVEX(&buf, vexTestDocument()))
+	var bom CycloneDXVEX
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &bom))
+	require.Len(t, bom.Vulnerabilities, 3)
+
+	f, ok := LookupFormat("openvex")
+	require.True(t, ok)
+	require.True(t, f.NeedsFindings())
+	require.Equal(t, "P1.openvex.json", f.FileName("P1"))
+	f, ok = LookupFormat("cyclonedx-vex")
+	require.True(t, ok)
+	require.Equal(t, "P1.vex.cdx.json", f.FileName("P1"))
+	f, _ = LookupFormat("cyclonedx-json")
+	require.False(t, f.NeedsFindings())
+}
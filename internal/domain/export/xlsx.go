@@ -0,0 +1,183 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	xlsxUsageSheet   = "ソフトウェア一覧"
+	xlsxLicenseSheet = "ライセンス一覧"
+	// xlsxHeaderRow は見出し行の行番号。上の行にはプロジェクト情報を記載する。
+	xlsxHeaderRow = 4
+)
+
+// xlsxColumn は一覧シートの列見出しと列幅。
+type xlsxColumn struct {
+	Title string
+	Width float64
+}
+
+var xlsxUsageColumns = []xlsxColumn{
+	{"No.", 6},
+	{"コンポーネント", 28},
+	{"バージョン", 14},
+	{"ライセンス", 24},
+	{"ライセンス表記 (原文)", 28},
+	{"著作権表示", 40},
+	{"purl", 40},
+	{"利用形態", 18},
+	{"スコープ", 14},
+	{"改変有無", 10},
+	{"供給元種別", 14},
+}
+
+var xlsxLicenseColumns = []xlsxColumn{
+	{"No.", 6},
+	{"ライセンス", 24},
+	{"参照 URL", 48},
+	{"件数", 8},
+	{"対象コンポーネント", 60},
+}
+
+// WriteXLSX は利用一覧とライセンス一覧の 2 シートからなるソフトウェア一覧表を
+// xlsx 形式で w に書き出す。ライセンス一覧は NOTICE と同じ単位でまとめる。
+func WriteXLSX(w io.Writer, d *Document) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName("Sheet1", xlsxUsageSheet); err != nil {
+		return err
+	}
+	if _, err := f.NewSheet(xlsxLicenseSheet); err != nil {
+		return err
+	}
+	header, err := f.NewStyle(&excelize.Style{
+		Font:      &excelize.Font{Bold: true, Color: "FFFFFF"},
+		Fill:      excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"305496"}},
+		Alignment: &excelize.Alignment{Horizontal: "center", Vertical: "center", WrapText: true},
+		Border:    xlsxBorders(),
+	})
+	if err != nil {
+		return err
+	}
+	body, err := f.NewStyle(&excelize.Style{
+		Alignment: &excelize.Alignment{Vertical: "top", WrapText: true},
+		Border:    xlsxBorders(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var usageRows [][]any
+	for i, it := range d.Items {
+		modified := "なし"
+		if it.Version.Modified {
+			modified = "あり"
+		}
+		usageRows = append(usageRows, []any{
+			i + 1,
+			it.Component.Name,
+			it.Version.Version,
+			deref(it.Version.LicenseConcluded),
+			deref(it.Version.LicenseExpressionRaw),
+			strings.TrimSpace(deref(it.Version.CopyrightText)),
+			deref(it.Version.Purl),
+			it.Usage.UsageRole,
+			it.Usage.ScopeStatus,
+			modified,
+			deref(it.Version.SupplierType),
+		})
+	}
+	if err := writeXLSXSheet(f, xlsxUsageSheet, d, xlsxUsageColumns, usageRows, header, body); err != nil {
+		return err
+	}
+
+	var licenseRows [][]any
+	for i, g := range BuildNotice(d).Groups {
+		names := make([]string, 0, len(g.Components))
+		for _, c := range g.Components {
+			names = append(names, c.Name+" "+c.Version)
+		}
+		licenseRows = append(licenseRows, []any{
+			i + 1,
+			g.LicenseID,
+			spdxLicenseURL(g.LicenseID),
+			len(g.Components),
+			strings.Join(names, "\n"),
+		})
+	}
+	if err := writeXLSXSheet(f, xlsxLicenseSheet, d, xlsxLicenseColumns, licenseRows, header, body); err != nil {
+		return err
+	}
+
+	f.SetActiveSheet(0)
+	return f.Write(w)
+}
+
+// writeXLSXSheet はプロジェクト情報・見出し・明細を書き込み、列幅と枠固定を設定する。
+func writeXLSXSheet(f *excelize.File, sheet string, d *Document, cols []xlsxColumn, rows [][]any, header, body int) error {
+	info := [][]any{
+		{"プロジェクト", d.Project.ProjectCode + " " + d.Project.Name},
+		{"出力日時", d.GeneratedAt.Format("2006-01-02 15:04:05")},
+	}
+	for i, r := range info {
+		if err := f.SetSheetRow(sheet, fmt.Sprintf("A%d", i+1), &r); err != nil {
+			return err
+		}
+	}
+
+	titles := make([]any, len(cols))
+	for i, c := range cols {
+		titles[i] = c.Title
+		name, err := excelize.ColumnNumberToName(i + 1)
+		if err != nil {
+			return err
+		}
+		if err := f.SetColWidth(sheet, name, name, c.Width); err != nil {
+			return err
+		}
+	}
+	last, err := excelize.ColumnNumberToName(len(cols))
+	if err != nil {
+		return err
+	}
+	if err := f.SetSheetRow(sheet, fmt.Sprintf("A%d", xlsxHeaderRow), &titles); err != nil {
+		return err
+	}
+	if err := f.SetCellStyle(sheet, fmt.Sprintf("A%d", xlsxHeaderRow), fmt.Sprintf("%s%d", last, xlsxHeaderRow), header); err != nil {
+		return err
+	}
+
+	for i, r := range rows {
+		row := xlsxHeaderRow + 1 + i
+		if err := f.SetSheetRow(sheet, fmt.Sprintf("A%d", row), &r); err != nil {
+			return err
+		}
+	}
+	if len(rows) > 0 {
+		if err := f.SetCellStyle(sheet, fmt.Sprintf("A%d", xlsxHeaderRow+1), fmt.Sprintf("%s%d", last, xlsxHeaderRow+len(rows)), body); err != nil {
+			return err
+		}
+	}
+
+	// 見出し行と No.・名称列を固定する
+	return f.SetPanes(sheet, &excelize.Panes{
+		Freeze:      true,
+		XSplit:      2,
+		YSplit:      xlsxHeaderRow,
+		TopLeftCell: fmt.Sprintf("C%d", xlsxHeaderRow+1),
+		ActivePane:  "bottomRight",
+	})
+}
+
+func xlsxBorders() []excelize.Border {
+	var b []excelize.Border
+	for _, side := range []string{"left", "top", "right", "bottom"} {
+		b = append(b, excelize.Border{Type: side, Color: "808080", Style: 1})
+	}
+	return b
+}
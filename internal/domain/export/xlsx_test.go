@@ -0,0 +1,45 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestWriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteXLSX(&buf, noticeTestDocument()))
+
+	f, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer f.Close()
+	require.Equal(t, []string{xlsxUsageSheet, xlsxLicenseSheet}, f.GetSheetList())
+
+	rows, err := f.GetRows(xlsxUsageSheet)
+	require.NoError(t, err)
+	require.Equal(t, []string{"プロジェクト", "P1 Proj"}, rows[0])
+	require.Equal(t, "No.", rows[xlsxHeaderRow-1][0])
+	require.Len(t, rows, xlsxHeaderRow+5)
+	require.Equal(t, []string{"1", "lodash", "4.17.21", "MIT", "", "Copyright OpenJS Foundation and other contributors", "", "", "", "なし"}, rows[xlsxHeaderRow])
+
+	panes, err := f.GetPanes(xlsxUsageSheet)
+	require.NoError(t, err)
+	require.True(t, panes.Freeze)
+	require.Equal(t, xlsxHeaderRow, panes.YSplit)
+	width, err := f.GetColWidth(xlsxUsageSheet, "B")
+	require.NoError(t, err)
+	require.Equal(t, 28.0, width)
+	style, err := f.GetCellStyle(xlsxUsageSheet, "A4")
+	require.NoError(t, err)
+	s, err := f.GetStyle(style)
+	require.NoError(t, err)
+	require.True(t, s.Font.Bold)
+
+	rows, err = f.GetRows(xlsxLicenseSheet)
+	require.NoError(t, err)
+	require.Len(t, rows, xlsxHeaderRow+4)
+	require.Equal(t, []string{"1", "Apache-2.0", "https://spdx.org/licenses/Apache-2.0.html", "1", "serde 1.0.0"}, rows[xlsxHeaderRow])
+	require.Equal(t, []string{"3", "MIT", "https://spdx.org/licenses/MIT.html", "2", "lodash 4.17.21\nserde 1.0.0"}, rows[xlsxHeaderRow+2])
+}
@@ -0,0 +1,63 @@
+package license
+
+import (
+	"bufio"
+	"strings"
+)
+
+// ライセンスの分類 (コピーレフトの強さ)。
+const (
+	CategoryPermissive      = "PERMISSIVE"
+	CategoryWeakCopyleft    = "WEAK_COPYLEFT"
+	CategoryStrongCopyleft  = "STRONG_COPYLEFT"
+	CategoryNetworkCopyleft = "NETWORK_COPYLEFT"
+)
+
+// Info は SPDX ライセンスリストに記載されたライセンスの情報。
+type Info struct {
+	ID          string
+	Name        string
+	OsiApproved bool
+	FsfLibre    bool
+	// Category は分類が定まらないライセンスでは空文字。
+	Category string
+	// Text は同梱の本文。本文が同梱されていない場合は空文字。
+	Text string
+}
+
+// Builtin は埋め込みの SPDX ライセンスリストに記載されたライセンスを記載順で返す。
+// ライセンスカタログの初期データとして用いる。
+func Builtin() []Info {
+	f, err := spdxLists.Open("spdx/licenses.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	var res []Info
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "|")
+		for len(fields) < 4 {
+			fields = append(fields, "-")
+		}
+		info := Info{ID: fields[0], Name: fields[1]}
+		for _, flag := range strings.Split(fields[2], ",") {
+			switch flag {
+			case "osi":
+				info.OsiApproved = true
+			case "fsf":
+				info.FsfLibre = true
+			}
+		}
+		if fields[3] != "-" {
+			info.Category = strings.ToUpper(strings.ReplaceAll(fields[3], "-", "_"))
+		}
+		info.Text, _ = Text(info.ID)
+		res = append(res, info)
+	}
+	return res
+}
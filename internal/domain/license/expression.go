@@ -0,0 +1,324 @@
+package license
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//go:embed spdx/*.txt
+var spdxLists embed.FS
+
+// licenseIDs / exceptionIDs は SPDX ライセンスリストの ID を小文字化した値から正式表記を引く。
+var (
+	licenseIDs   = loadList("spdx/licenses.txt")
+	exceptionIDs = loadList("spdx/exceptions.txt")
+)
+
+func loadList(name string) map[string]string {
+	f, err := spdxLists.Open(name)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	ids := map[string]string{}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// licenses.txt は "ID|名称|フラグ|分類" の形式のため先頭の ID のみを用いる
+		id, _, _ := strings.Cut(line, "|")
+		ids[strings.ToLower(id)] = id
+	}
+	return ids
+}
+
+// LookupID は SPDX ライセンス ID を大文字小文字を区別せずに検索し、正式表記を返す。
+func LookupID(id string) (string, bool) {
+	canonical, ok := licenseIDs[strings.ToLower(id)]
+	return canonical, ok
+}
+
+// LookupException は SPDX ライセンス例外 ID を大文字小文字を区別せずに検索し、正式表記を返す。
+func LookupException(id string) (string, bool) {
+	canonical, ok := exceptionIDs[strings.ToLower(id)]
+	return canonical, ok
+}
+
+// ErrInvalidExpression は SPDX ライセンス式として解釈できない場合に返す。
+var ErrInvalidExpression = errors.New("invalid license expression")
+
+// ExpressionError はライセンス式の誤りを表す。Errors には検出したすべての誤りを保持する。
+type ExpressionError struct {
+	Expr   string
+	Errors []string
+}
+
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("%v %q: %s", ErrInvalidExpression, e.Expr, strings.Join(e.Errors, "; "))
+}
+
+func (e *ExpressionError) Unwrap() error { return ErrInvalidExpression }
+
+// 式全体としてのみ記載できる値。
+const (
+	NoAssertion = "NOASSERTION"
+	None        = "NONE"
+)
+
+// Expression は SPDX ライセンス式の構文木。
+// Op が AND / OR の場合は Args に 2 つ以上の被演算子を持ち (同じ演算子の入れ子は平坦化する)、
+// Op が空の場合は License・OrLater・Exception で単一のライセンスを表す。
+type Expression struct {
+	Op        string
+	Args      []*Expression
+	License   string
+	OrLater   bool
+	Exception string
+}
+
+// Parse は SPDX ライセンス式を構文解析する。
+// ライセンス ID・例外 ID は埋め込みの SPDX ライセンスリストで検証して正式表記に揃え、
+// LicenseRef- / DocumentRef- で始まる独自ライセンスはそのまま受け付ける。
+// 非推奨の GNU 系 ID (GPL-2.0 / GPL-2.0+ など) は -only / -or-later の ID に置き換える。
+// 演算子 (AND / OR / WITH) は大文字のみを受け付ける。
+// 誤りがある場合は検出したすべての誤りを持つ *ExpressionError を返す。
+func Parse(expr string) (*Expression, error) {
+	p := &parser{}
+	p.tokenize(expr)
+	var e *Expression
+	if len(p.tokens) == 0 {
+		p.errorf("expression is empty")
+	} else {
+		e = p.parse()
+	}
+	if len(p.errs) > 0 {
+		return nil, &ExpressionError{Expr: expr, Errors: p.errs}
+	}
+	return e, nil
+}
+
+// Normalize はライセンス式を検証し、正規化した表記を返す。
+func Normalize(expr string) (string, error) {
+	e, err := Parse(expr)
+	if err != nil {
+		return "", err
+	}
+	return e.String(), nil
+}
+
+// String は正規化したライセンス式を返す。
+// 演算子の前後は空白 1 つとし、入れ子の複合式のみ括弧で囲む。
+func (e *Expression) String() string {
+	if e.Op == "" {
+		s := e.License
+		if e.OrLater {
+			s += "+"
+		}
+		if e.Exception != "" {
+			s += " WITH " + e.Exception
+		}
+		return s
+	}
+	parts := make([]string, len(e.Args))
+	for i, a := range e.Args {
+		parts[i] = a.String()
+		if a.Op != "" {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, " "+e.Op+" ")
+}
+
+// Licenses は式に含まれる単一ライセンスを出現順で返す。
+func (e *Expression) Licenses() []*Expression {
+	if e.Op == "" {
+		return []*Expression{e}
+	}
+	var res []*Expression
+	for _, a := range e.Args {
+		res = append(res, a.Licenses()...)
+	}
+	return res
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+	errs   []string
+}
+
+func (p *parser) errorf(format string, args ...any) {
+	p.errs = append(p.errs, fmt.Sprintf(format, args...))
+}
+
+// tokenize は式を括弧と空白区切りの語に分割する。演算子の大文字小文字の誤りはここで検出する。
+func (p *parser) tokenize(expr string) {
+	r := strings.NewReplacer("(", " ( ", ")", " ) ")
+	for _, tok := range strings.Fields(r.Replace(expr)) {
+		if upper := strings.ToUpper(tok); upper != tok && (upper == "AND" || upper == "OR" || upper == "WITH") {
+			p.errorf("operator %q must be upper case %q", tok, upper)
+			tok = upper
+		}
+		p.tokens = append(p.tokens, tok)
+	}
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) next() string {
+	tok := p.peek()
+	if tok != "" {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) parse() *Expression {
+	if len(p.tokens) == 1 && (p.tokens[0] == NoAssertion || p.tokens[0] == None) {
+		return &Expression{License: p.tokens[0]}
+	}
+	e := p.compound("OR")
+	if e != nil && p.pos < len(p.tokens) {
+		p.errorf("unexpected %q", p.peek())
+	}
+	return e
+}
+
+// compound は op (OR / AND) で連結された式を読む。AND は OR より強く結合する。
+func (p *parser) compound(op string) *Expression {
+	operand := func() *Expression {
+		if op == "OR" {
+			return p.compound("AND")
+		}
+		return p.primary()
+	}
+	e := operand()
+	if e == nil {
+		return nil
+	}
+	res := &Expression{Op: op}
+	res.add(e)
+	for p.peek() == op {
+		p.next()
+		e := operand()
+		if e == nil {
+			return nil
+		}
+		res.add(e)
+	}
+	if len(res.Args) == 1 {
+		return res.Args[0]
+	}
+	return res
+}
+
+// add は被演算子を追加する。同じ演算子の式は平坦化する。
+func (e *Expression) add(a *Expression) {
+	if a.Op == e.Op {
+		e.Args = append(e.Args, a.Args...)
+		return
+	}
+	e.Args = append(e.Args, a)
+}
+
+// primary は括弧で囲まれた式、または WITH を伴う単一ライセンスを読む。
+func (p *parser) primary() *Expression {
+	tok := p.next()
+	switch tok {
+	case "":
+		p.errorf("unexpected end of expression")
+		return nil
+	case "(":
+		e := p.compound("OR")
+		if e == nil {
+			return nil
+		}
+		if p.next() != ")" {
+			p.errorf("missing closing parenthesis")
+			return nil
+		}
+		return e
+	case ")", "AND", "OR", "WITH":
+		p.errorf("unexpected %q", tok)
+		return nil
+	}
+	e := p.license(tok)
+	if p.peek() == "WITH" {
+		p.next()
+		exc := p.next()
+		switch exc {
+		case "", "(", ")", "AND", "OR", "WITH":
+			p.errorf("WITH requires a license exception ID")
+			return nil
+		}
+		if canonical, ok := LookupException(exc); ok {
+			e.Exception = canonical
+		} else {
+			p.errorf("unknown license exception ID %q", exc)
+			e.Exception = exc
+		}
+	}
+	return e
+}
+
+// license は単一ライセンスの ID を検証し、正式表記に揃える。
+func (p *parser) license(tok string) *Expression {
+	e := &Expression{}
+	id := tok
+	if strings.HasSuffix(id, "+") {
+		e.OrLater = true
+		id = strings.TrimSuffix(id, "+")
+	}
+	if IsLicenseRef(id) {
+		e.License = id
+		return e
+	}
+	canonical, ok := LookupID(id)
+	if !ok {
+		p.errorf("unknown license ID %q", tok)
+		e.License = id
+		return e
+	}
+	e.License = canonical
+	// 非推奨の GNU 系 ID は -only / -or-later に置き換える
+	if only, ok := LookupID(canonical + "-only"); ok {
+		e.License = only
+		if e.OrLater {
+			e.License, _ = LookupID(canonical + "-or-later")
+			e.OrLater = false
+		}
+	}
+	return e
+}
+
+// IsLicenseRef は LicenseRef-xxx または DocumentRef-xxx:LicenseRef-yyy 形式かどうかを返す。
+func IsLicenseRef(id string) bool {
+	if doc, ref, ok := strings.Cut(id, ":"); ok {
+		return strings.HasPrefix(doc, "DocumentRef-") && idstring(doc[len("DocumentRef-"):]) &&
+			strings.HasPrefix(ref, "LicenseRef-") && idstring(ref[len("LicenseRef-"):])
+	}
+	return strings.HasPrefix(id, "LicenseRef-") && idstring(id[len("LicenseRef-"):])
+}
+
+// idstring は SPDX の idstring (英数字・"-"・"." の 1 文字以上) かどうかを返す。
+func idstring(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '.') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		"MIT":                                  "MIT",
+		"  mit  ":                              "MIT",
+		"apache-2.0 OR MIT":                    "Apache-2.0 OR MIT",
+		"MIT AND (BSD-2-Clause AND Zlib)":      "MIT AND BSD-2-Clause AND Zlib",
+		"MIT OR Apache-2.0 AND BSD-3-Clause":   "MIT OR (Apache-2.0 AND BSD-3-Clause)",
+		"(MIT OR Apache-2.0) AND BSD-3-Clause": "(MIT OR Apache-2.0) AND BSD-3-Clause",
+		"GPL-2.0-or-later WITH classpath-exception-2.0": "GPL-2.0-or-later WITH Classpath-exception-2.0",
+		"GPL-2.0+":               "GPL-2.0-or-later",
+		"LGPL-2.1":               "LGPL-2.1-only",
+		"EPL-1.0+":               "EPL-1.0+",
+		"LicenseRef-Proprietary": "LicenseRef-Proprietary",
+		"DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2 OR MIT": "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2 OR MIT",
+		"NOASSERTION": "NOASSERTION",
+	}
+	for in, want := range cases {
+		got, err := Normalize(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got, in)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	cases := map[string][]string{
+		"":                      {"expression is empty"},
+		"Apache2":               {`unknown license ID "Apache2"`},
+		"GPL-2.0+ and MIT":      {`operator "and" must be upper case "AND"`},
+		"Apache2 OR MIT2":       {`unknown license ID "Apache2"`, `unknown license ID "MIT2"`},
+		"MIT AND":               {"unexpected end of expression"},
+		"(MIT OR Apache-2.0":    {"missing closing parenthesis"},
+		"MIT Apache-2.0":        {`unexpected "Apache-2.0"`},
+		"MIT)":                  {`unexpected ")"`},
+		"GPL-2.0-only WITH":     {"WITH requires a license exception ID"},
+		"GPL-2.0-only WITH Foo": {`unknown license exception ID "Foo"`},
+		"LicenseRef-":           {`unknown license ID "LicenseRef-"`},
+		"MIT OR NOASSERTION":    {`unknown license ID "NOASSERTION"`},
+	}
+	for in, want := range cases {
+		_, err := Parse(in)
+		require.ErrorIs(t, err, ErrInvalidExpression, in)
+		var exprErr *ExpressionError
+		require.ErrorAs(t, err, &exprErr)
+		require.Equal(t, want, exprErr.Errors, in)
+	}
+}
+
+func TestExpression_Licenses(t *testing.T) {
+	e, err := Parse("MIT OR (GPL-2.0+ WITH Classpath-exception-2.0 AND BSD-3-Clause)")
+	require.NoError(t, err)
+	ls := e.Licenses()
+	require.Len(t, ls, 3)
+	require.Equal(t, "GPL-2.0-or-later", ls[1].License)
+	require.Equal(t, "Classpath-exception-2.0", ls[1].Exception)
+}
@@ -0,0 +1,76 @@
+// Package license は SPDX ライセンス識別子・ライセンス本文を扱う。
+package license
+
+import (
+	"embed"
+	"strings"
+)
+
+//go:embed texts/*.txt
+var texts embed.FS
+
+// textIncludes は本文に続けて添付すべき別のライセンス本文。
+// LGPL-3.0 は GPL-3.0 への追加許可として書かれているため、頒布時に両方の本文が必要となる。
+var textIncludes = map[string]string{
+	"LGPL-3.0": "GPL-3.0",
+}
+
+// Text は SPDX ライセンス ID に対応するライセンス本文を返す。
+// GNU 系の -only / -or-later / "+" の ID はバージョン共通の本文を返す。
+// 本文が同梱されていない場合は ok が false となる。
+// 同梱の本文は主要な permissive ライセンスと GPL / LGPL に限られ、AGPL・EPL・CDDL などは
+// ライセンスカタログに管理者が登録する必要がある。
+func Text(id string) (string, bool) {
+	base := textBase(id)
+	b, err := texts.ReadFile("texts/" + base + ".txt")
+	if err != nil {
+		return "", false
+	}
+	text := string(b)
+	if inc, ok := textIncludes[base]; ok {
+		if more, ok := Text(inc); ok {
+			text += "\n\n" + more
+		}
+	}
+	return text, true
+}
+
+// textBase は本文ファイルの名前となる ID を返す。
+func textBase(id string) string {
+	id = strings.TrimSuffix(id, "+")
+	if strings.Contains(id, "GPL-") {
+		for _, suffix := range []string{"-only", "-or-later"} {
+			id = strings.TrimSuffix(id, suffix)
+		}
+	}
+	return id
+}
+
+// IDs はライセンス式に含まれるライセンス ID を出現順 (重複除去) で返す。
+// AND / OR 演算子と WITH に続く例外 ID は含めない。末尾の "+" は除去する。
+func IDs(expr string) []string {
+	r := strings.NewReplacer("(", " ", ")", " ")
+	var ids []string
+	seen := map[string]bool{}
+	skipNext := false
+	for _, tok := range strings.Fields(r.Replace(expr)) {
+		if skipNext {
+			skipNext = false
+			continue
+		}
+		switch strings.ToUpper(tok) {
+		case "AND", "OR":
+			continue
+		case "WITH":
+			skipNext = true
+			continue
+		}
+		id := strings.TrimSuffix(tok, "+")
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,67 @@
+package license
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestText(t *testing.T) {
+	txt, ok := Text("MIT")
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(txt, "MIT License"))
+
+	_, ok = Text("Unknown-License")
+	require.False(t, ok)
+
+	// GNU 系はバージョン共通の本文を返す
+	for _, id := range []string{"GPL-2.0", "GPL-2.0-only", "GPL-2.0-or-later", "GPL-2.0+"} {
+		txt, ok = Text(id)
+		require.True(t, ok, id)
+		require.Contains(t, txt, "Version 2, June 1991", id)
+	}
+	// LGPL-3.0 は GPL-3.0 の本文を続けて含める
+	txt, ok = Text("LGPL-3.0-only")
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(strings.TrimSpace(txt), "GNU LESSER GENERAL PUBLIC LICENSE"))
+	require.Contains(t, txt, "GNU GENERAL PUBLIC LICENSE")
+
+	_, ok = Text("AGPL-3.0-only")
+	require.False(t, ok)
+}
+
+func TestIDs(t *testing.T) {
+	require.Equal(t, []string{"MIT"}, IDs("MIT"))
+	require.Equal(t, []string{"MIT", "Apache-2.0"}, IDs("MIT OR Apache-2.0"))
+	require.Equal(t, []string{"GPL-2.0-or-later", "BSD-3-Clause"}, IDs("(GPL-2.0-or-later WITH Classpath-exception-2.0) AND (BSD-3-Clause OR GPL-2.0-or-later)"))
+	require.Equal(t, []string{"LGPL-2.1"}, IDs("LGPL-2.1+"))
+	require.Nil(t, IDs(""))
+}
+
+func TestBuiltin(t *testing.T) {
+	infos := Builtin()
+	require.Len(t, infos, len(licenseIDs))
+	byID := map[string]Info{}
+	for _, info := range infos {
+		byID[info.ID] = info
+	}
+
+	mit := byID["MIT"]
+	require.Equal(t, "MIT License", mit.Name)
+	require.True(t, mit.OsiApproved)
+	require.True(t, mit.FsfLibre)
+	require.Equal(t, CategoryPermissive, mit.Category)
+	require.True(t, strings.HasPrefix(mit.Text, "MIT License"))
+
+	require.Equal(t, CategoryWeakCopyleft, byID["LGPL-2.1-only"].Category)
+	require.Equal(t, CategoryStrongCopyleft, byID["GPL-3.0-only"].Category)
+	require.Equal(t, CategoryNetworkCopyleft, byID["AGPL-3.0-or-later"].Category)
+	require.Contains(t, byID["GPL-3.0-only"].Text, "Version 3, 29 June 2007")
+	require.Empty(t, byID["AGPL-3.0-only"].Text)
+
+	// 分類が定まらないライセンス
+	nc := byID["CC-BY-NC-4.0"]
+	require.False(t, nc.OsiApproved)
+	require.Empty(t, nc.Category)
+}
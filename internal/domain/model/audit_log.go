@@ -12,3 +12,23 @@ type AuditLog struct {
 	Summary    *string
 	CreatedAt  dbtime.DBTime
 }
+
+// 監査ログの対象種別。
+const (
+	AuditEntityProject      = "PROJECT"
+	AuditEntityOssComponent = "OSS_COMPONENT"
+	AuditEntityOssVersion   = "OSS_VERSION"
+	AuditEntityProjectUsage = "PROJECT_USAGE"
+	// AuditEntityVulnerabilityFeed は脆弱性フィードの取り込み。EntityID はフィードのファイル名。
+	AuditEntityVulnerabilityFeed = "VULNERABILITY_FEED"
+	// AuditEntityVulnerabilityAnalysis は利用毎の脆弱性の VEX 分析。EntityID は分析の ID。
+	AuditEntityVulnerabilityAnalysis = "VULNERABILITY_ANALYSIS"
+)
+
+// 監査ログの操作種別。
+const (
+	AuditActionCreate = "CREATE"
+	AuditActionUpdate = "UPDATE"
+	AuditActionDelete = "DELETE"
+	AuditActionImport = "IMPORT"
+)
@@ -0,0 +1,33 @@
+package model
+
+import "github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+// ExportJob は非同期エクスポートジョブを表す。
+// 生成結果はローカルディスク (FilePath) に保存され、ExpiresAt 経過後に削除される。
+type ExportJob struct {
+	ID          string
+	ProjectID   string
+	Format      string
+	Scopes      []string
+	Status      string
+	Progress    int
+	FileName    *string
+	FilePath    *string
+	ContentType *string
+	FileSize    *int64
+	Error       *string
+	CreatedBy   string
+	CreatedAt   dbtime.DBTime
+	StartedAt   *dbtime.DBTime
+	FinishedAt  *dbtime.DBTime
+	ExpiresAt   *dbtime.DBTime
+}
+
+// ExportJob のステータス値。
+const (
+	ExportJobQueued    = "QUEUED"
+	ExportJobRunning   = "RUNNING"
+	ExportJobSucceeded = "SUCCEEDED"
+	ExportJobFailed    = "FAILED"
+	ExportJobExpired   = "EXPIRED"
+)
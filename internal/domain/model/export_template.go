@@ -0,0 +1,23 @@
+package model
+
+import "github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+// ExportTemplate は管理者が登録するユーザ定義エクスポートテンプレートを表す。
+// Engine が "text" の場合は text/template、"html" の場合は html/template で処理する。
+type ExportTemplate struct {
+	ID            string
+	Name          string
+	Engine        string
+	FileExtension string
+	Description   *string
+	Body          string
+	UpdatedBy     string
+	CreatedAt     dbtime.DBTime
+	UpdatedAt     dbtime.DBTime
+}
+
+// ExportTemplate のテンプレートエンジン値。
+const (
+	ExportTemplateText = "text"
+	ExportTemplateHTML = "html"
+)
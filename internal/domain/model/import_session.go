@@ -0,0 +1,82 @@
+package model
+
+import "github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+// ImportSession は SBOM 取り込みの試行 (dry-run) 結果を表す。
+// カタログには反映せず、レビュー後に確定 (COMMITTED) した時点で一括登録する。
+type ImportSession struct {
+	ID           string
+	ProjectID    string
+	Format       string
+	DocumentName *string
+	// UsageRole は取り込み時に指定された利用形態。
+	UsageRole   *string
+	Status      string
+	CreatedBy   string
+	CreatedAt   dbtime.DBTime
+	CommittedBy *string
+	CommittedAt *dbtime.DBTime
+}
+
+// ImportSession のステータス値。
+const (
+	ImportSessionOpen      = "OPEN"
+	ImportSessionCommitted = "COMMITTED"
+)
+
+// ImportSessionItem は取り込みセッション中の 1 パッケージと照合結果の提案・レビュー結果を表す。
+type ImportSessionItem struct {
+	ID               string
+	SessionID        string
+	Seq              int
+	Ref              string
+	Name             string
+	Version          string
+	Purl             *string
+	LicenseConcluded *string
+	LicenseDeclared  *string
+	HomepageURL      *string
+	Supplier         *string
+	HashSha256       *string
+	HashSha512       *string
+	CopyrightText    *string
+	CpeList          []string
+	DirectDependency bool
+	// UsageRole は形式から推定した利用形態。
+	UsageRole *string
+	// UsageRoleOverride はレビューで指定した利用形態。指定がある場合は UsageRole より優先する。
+	UsageRoleOverride *string
+	// Layers はコンポーネントを新規登録する際に設定するレイヤー。
+	Layers []string
+	// InclusionNote は利用情報の組み込み経緯に記録する注記。
+	InclusionNote *string
+	// DependsOn は文書内の依存先 (Ref)。確定時に利用情報間の依存関係として登録する。
+	DependsOn []string
+	// Proposal は照合結果に基づく提案 (ImportProposal*)。
+	Proposal string
+	Reason   *string
+	// OssID / OssVersionID は照合した (またはレビューで付け替えた) コンポーネント・バージョン。
+	OssID        *string
+	OssVersionID *string
+	// Decision はレビュー結果 (ImportDecision*)。
+	Decision string
+	// Result / UsageID は確定時の取り込み結果。
+	Result  *string
+	UsageID *string
+}
+
+// ImportSessionItem の提案値。
+const (
+	ImportProposalMatch        = "MATCH"
+	ImportProposalNewVersion   = "NEW_VERSION"
+	ImportProposalNewComponent = "NEW_COMPONENT"
+	ImportProposalSkip         = "SKIP"
+)
+
+// ImportSessionItem のレビュー結果。PENDING は確定時に提案どおり取り込む。
+const (
+	ImportDecisionPending  = "PENDING"
+	ImportDecisionAccepted = "ACCEPTED"
+	ImportDecisionRejected = "REJECTED"
+	ImportDecisionRemapped = "REMAPPED"
+)
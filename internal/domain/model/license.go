@@ -0,0 +1,22 @@
+package model
+
+import "github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+// License はライセンスカタログの 1 件を表す。
+// SPDX ライセンスリストから登録した項目に加え、管理者が LicenseRef- で始まる独自ライセンスを登録できる。
+type License struct {
+	ID          string
+	Name        string
+	OsiApproved bool
+	FsfLibre    bool
+	// Category は PERMISSIVE / WEAK_COPYLEFT / STRONG_COPYLEFT / NETWORK_COPYLEFT のいずれか。
+	Category *string
+	Text     *string
+	// HasText は本文が登録されている場合 true。一覧では本文を読み込まずにこの値のみ設定する。
+	HasText bool
+	// Custom は管理者が登録した独自ライセンス (LicenseRef-) の場合 true。
+	Custom    bool
+	UpdatedBy *string
+	CreatedAt dbtime.DBTime
+	UpdatedAt dbtime.DBTime
+}
@@ -0,0 +1,33 @@
+package model
+
+import "github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+// LicensePolicyRule は管理者が定義するライセンスポリシーのルールを表す。
+// Licenses (ID パターン) または Categories (ライセンスカタログの分類) に該当するライセンスを、
+// UsageRoles・ScopeStatuses に該当する利用で用いた場合に Severity の違反とする。
+// UsageRoles・ScopeStatuses が空の場合はすべての利用を対象とする。
+// ProjectStatuses を指定した場合は該当する状態のプロジェクトのみを対象とする。
+type LicensePolicyRule struct {
+	ID          string
+	Name        string
+	Description *string
+	Severity    string
+	// Licenses はライセンス ID のパターン。末尾の "*" は前方一致 (例 "GPL-*", "LicenseRef-*")。
+	Licenses      []string
+	Categories    []string
+	UsageRoles    []string
+	ScopeStatuses []string
+	// ProjectStatuses は対象とするプロジェクトの状態 (ProjectStatus*)。
+	ProjectStatuses []string
+	Enabled         bool
+	UpdatedBy       string
+	CreatedAt       dbtime.DBTime
+	UpdatedAt       dbtime.DBTime
+}
+
+// LicensePolicyRule の重大度。
+const (
+	PolicySeverityDeny   = "DENY"
+	PolicySeverityWarn   = "WARN"
+	PolicySeverityReview = "REVIEW"
+)
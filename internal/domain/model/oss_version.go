@@ -13,6 +13,7 @@ type OssVersion struct {
 	Purl                    *string
 	CpeList                 []string
 	HashSha256              *string
+	HashSha512              *string
 	Modified                bool
 	ModificationDescription *string
 	ReviewStatus            string
@@ -20,6 +21,7 @@ type OssVersion struct {
 	ScopeStatus             string
 	SupplierType            *string
 	ForkOriginURL           *string
+	CopyrightText           *string
 	CreatedAt               dbtime.DBTime
 	UpdatedAt               dbtime.DBTime
 }
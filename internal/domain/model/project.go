@@ -4,14 +4,23 @@ import "github.com/ramsesyok/oss-catalog/pkg/dbtime"
 
 // Project はデリバリーユニットのプロジェクトを表すモデル。
 type Project struct {
-	ID            string
-	ProjectCode   string
-	Name          string
-	Department    *string
-	Manager       *string
-	DeliveryDate  *dbtime.DBTime
+	ID           string
+	ProjectCode  string
+	Name         string
+	Department   *string
+	Manager      *string
+	DeliveryDate *dbtime.DBTime
+	// Status はプロジェクトの状態 (ProjectStatus*)。
+	Status        string
 	Description   *string
 	OssUsageCount int
 	CreatedAt     dbtime.DBTime
 	UpdatedAt     dbtime.DBTime
 }
+
+// Project の状態。
+const (
+	ProjectStatusActive    = "ACTIVE"
+	ProjectStatusDelivered = "DELIVERED"
+	ProjectStatusArchived  = "ARCHIVED"
+)
@@ -0,0 +1,39 @@
+package model
+
+import "github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+// ProjectObligation はプロジェクトの利用 1 件がライセンスから負う義務 1 件を表す。
+// 義務はライセンスと利用形態の組み合わせから導出し、履行状況を記録する。
+type ProjectObligation struct {
+	ID        string
+	ProjectID string
+	UsageID   string
+	// License は義務の根拠となったライセンス ID。
+	License string
+	// Obligation は義務の種類 (例 SOURCE_OFFER, NOTICE_FILE)。
+	Obligation  string
+	Description string
+	Status      string
+	// EvidenceNote は履行の証跡または免除の理由。
+	EvidenceNote *string
+	ClosedBy     *string
+	ClosedAt     *dbtime.DBTime
+	CreatedAt    dbtime.DBTime
+	UpdatedAt    dbtime.DBTime
+}
+
+// ProjectObligation の状態。
+const (
+	ObligationOpen      = "OPEN"
+	ObligationFulfilled = "FULFILLED"
+	ObligationWaived    = "WAIVED"
+)
+
+// ProjectObligationDetail は義務に利用情報とコンポーネント名・バージョンを結合したもの。
+type ProjectObligationDetail struct {
+	Obligation    ProjectObligation
+	ComponentName string
+	Version       string
+	UsageRole     string
+	ScopeStatus   string
+}
@@ -17,4 +17,12 @@ type ProjectUsage struct {
 	EvaluatedBy      *string
 }
 
+// ProjectUsageDetail は ProjectUsage に OSS コンポーネント・バージョン情報を結合したもの。
+// 納品用エクスポートの生成に利用する。
+type ProjectUsageDetail struct {
+	Usage     ProjectUsage
+	Component OssComponent
+	Version   OssVersion
+}
+
 // ProjectUsageFilter は repository パッケージで定義される。
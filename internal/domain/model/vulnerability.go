@@ -0,0 +1,125 @@
+package model
+
+import "github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+// Vulnerability は脆弱性データベースから取り込んだ脆弱性 1 件を表す。
+// ID は CVE・GHSA などの脆弱性 ID。Severity・CvssScore は代表の CVSS 評価 (新しい CVSS バージョンの NVD 評価を優先)。
+type Vulnerability struct {
+	ID string
+	// Source は取り込み元 (例 NVD, OSV)。
+	Source      string
+	Description *string
+	// Status は取り込み元での状態 (NVD の vulnStatus 例 Analyzed, Rejected。OSV は取り下げ時のみ Withdrawn)。
+	Status      *string
+	Severity    *string
+	CvssScore   *float64
+	CvssVersion *string
+	CvssVector  *string
+	// Weaknesses は CWE ID の一覧。
+	Weaknesses    []string
+	ReferenceURLs []string
+	// Aliases は同じ脆弱性を指す別の ID (OSV の aliases)。登録時にのみ用い、読み込みは ListAliases で行う。
+	Aliases        []string
+	PublishedAt    *dbtime.DBTime
+	LastModifiedAt *dbtime.DBTime
+	CreatedAt      dbtime.DBTime
+	UpdatedAt      dbtime.DBTime
+}
+
+// 脆弱性の取り込み元。
+const (
+	VulnerabilitySourceNVD = "NVD"
+	VulnerabilitySourceOSV = "OSV"
+)
+
+// CvssMetric は脆弱性の CVSS 評価 1 件を表す。評価元・バージョンごとに複数存在する。
+type CvssMetric struct {
+	VulnerabilityID string
+	// Version は CVSS のバージョン (例 3.1, 2.0)。
+	Version string
+	// Source は評価元 (例 nvd@nist.gov)。
+	Source string
+	// Type は Primary または Secondary。
+	Type         string
+	BaseScore    float64
+	BaseSeverity string
+	Vector       string
+}
+
+// CpeMatch は脆弱性の影響を受ける CPE の条件 1 件を表す。
+// Criteria の version が * の場合は Version* のバージョン範囲で影響を受けるバージョンを示す。
+type CpeMatch struct {
+	VulnerabilityID string
+	// Criteria は CPE 2.3 形式の文字列。
+	Criteria              string
+	Vendor                string
+	Product               string
+	VersionStartIncluding *string
+	VersionStartExcluding *string
+	VersionEndIncluding   *string
+	VersionEndExcluding   *string
+	MatchCriteriaID       *string
+}
+
+// PackageRange は OSV の脆弱性の影響を受けるパッケージのバージョン範囲 1 件を表す。
+// RangeType が VERSIONS の場合は Versions に列挙したバージョンのみが影響を受ける。
+type PackageRange struct {
+	VulnerabilityID string
+	// Ecosystem は OSV のエコシステム名 (例 npm, PyPI, Maven, Debian:12)。
+	Ecosystem   string
+	PackageName string
+	// PackageKey は purl の type/namespace/name で、OssVersion.Purl との照合に用いる。
+	PackageKey string
+	// RangeType は SEMVER, ECOSYSTEM または VERSIONS。
+	RangeType string
+	// Introduced が nil の場合は下限無し。Fixed・LastAffected が共に nil の場合は上限無し。
+	Introduced   *string
+	Fixed        *string
+	LastAffected *string
+	Versions     []string
+}
+
+// VulnerabilityAlias は脆弱性とその別名の組を表す。
+type VulnerabilityAlias struct {
+	VulnerabilityID string
+	Alias           string
+}
+
+// VersionVulnerability は OSS バージョンに該当した脆弱性と、その根拠を表す。
+// 別名で結び付く NVD と OSV の脆弱性は 1 件にまとめ、Vulnerability には NVD のものを優先して用いる。
+type VersionVulnerability struct {
+	Vulnerability Vulnerability
+	// Aliases は Vulnerability 以外の同じ脆弱性を指す ID。
+	Aliases []string
+	// Matches は該当と判定した根拠。まとめた脆弱性それぞれの根拠を含む。
+	Matches []VulnerabilityMatch
+	// FixedVersions は該当した範囲の修正バージョン (OSV の fixed と CPE 条件の versionEndExcluding)。空の場合は修正版が無い。
+	FixedVersions []string
+}
+
+// VulnerabilityMatch は脆弱性が OSS バージョンに該当すると判定した根拠 1 件を表す。
+type VulnerabilityMatch struct {
+	VulnerabilityID string
+	// MatchedBy は該当と判定した方法 (CPE または PURL)。
+	MatchedBy string
+	// Identifier は該当したバージョン側の識別子 (CPE または purl)。
+	Identifier string
+	// Cpe は MatchedBy が CPE の場合に該当した条件。
+	Cpe *CpeMatch
+	// Package は MatchedBy が PURL の場合に該当したバージョン範囲。
+	Package *PackageRange
+}
+
+// ProjectVulnerability はプロジェクトの利用 1 件に該当した脆弱性を表す。
+type ProjectVulnerability struct {
+	Usage   ProjectUsageDetail
+	Finding VersionVulnerability
+	// Analysis は記録済みの VEX 分析。未分析の場合は nil。
+	Analysis *VulnerabilityAnalysis
+}
+
+// 脆弱性の該当判定の方法。
+const (
+	VulnerabilityMatchedByCPE  = "CPE"
+	VulnerabilityMatchedByPurl = "PURL"
+)
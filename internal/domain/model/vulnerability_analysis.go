@@ -0,0 +1,48 @@
+package model
+
+import "github.com/ramsesyok/oss-catalog/pkg/dbtime"
+
+// VulnerabilityAnalysis はプロジェクトの利用 1 件に該当した脆弱性 1 件の VEX 分析を表す。
+// VulnerabilityID は分析時の代表の ID で、別名で結び付く脆弱性の該当にも適用する。
+type VulnerabilityAnalysis struct {
+	ID              string
+	ProjectID       string
+	UsageID         string
+	VulnerabilityID string
+	State           string
+	// Justification は State が not_affected の場合の根拠 (OpenVEX の justification)。
+	Justification *string
+	// Response は対応方針 (CycloneDX の analysis.response)。
+	Response *string
+	Note     *string
+	// UpdatedBy は最後に分析を記録したユーザ名。
+	UpdatedBy string
+	CreatedAt dbtime.DBTime
+	UpdatedAt dbtime.DBTime
+}
+
+// VulnerabilityAnalysis の状態 (OpenVEX の status)。
+const (
+	AnalysisNotAffected        = "not_affected"
+	AnalysisAffected           = "affected"
+	AnalysisFixed              = "fixed"
+	AnalysisUnderInvestigation = "under_investigation"
+)
+
+// VulnerabilityAnalysis の根拠 (OpenVEX の justification)。
+const (
+	JustificationComponentNotPresent                         = "component_not_present"
+	JustificationVulnerableCodeNotPresent                    = "vulnerable_code_not_present"
+	JustificationVulnerableCodeNotInExecutePath              = "vulnerable_code_not_in_execute_path"
+	JustificationVulnerableCodeCannotBeControlledByAdversary = "vulnerable_code_cannot_be_controlled_by_adversary"
+	JustificationInlineMitigationsAlreadyExist               = "inline_mitigations_already_exist"
+)
+
+// VulnerabilityAnalysis の対応方針 (CycloneDX の analysis.response)。
+const (
+	ResponseCanNotFix           = "can_not_fix"
+	ResponseWillNotFix          = "will_not_fix"
+	ResponseUpdate              = "update"
+	ResponseRollback            = "rollback"
+	ResponseWorkaroundAvailable = "workaround_available"
+)
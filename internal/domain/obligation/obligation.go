@@ -0,0 +1,149 @@
+// Package obligation はライセンスと利用形態の組み合わせから利用者が負う義務を導出する。
+package obligation
+
+import (
+	"slices"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/policy"
+)
+
+// 義務の種類。
+const (
+	SourceOffer        = "SOURCE_OFFER"
+	RelinkableObjects  = "RELINKABLE_OBJECTS"
+	NetworkSourceOffer = "NETWORK_SOURCE_OFFER"
+	NoticeFile         = "NOTICE_FILE"
+	LicenseText        = "LICENSE_TEXT"
+	StateChanges       = "STATE_CHANGES"
+)
+
+// Rule は義務の導出ルール。Licenses のいずれかに一致するライセンスを UsageRoles の利用形態で用いた場合に義務を負う。
+type Rule struct {
+	Obligation string
+	// Licenses はライセンス ID のパターン。末尾の "*" は前方一致。
+	Licenses   []string
+	UsageRoles []string
+	// ModifiedOnly は改変したバージョンのみを対象とする。
+	ModifiedOnly bool
+	Description  string
+}
+
+// distributed は成果物と共に頒布する利用形態。
+var distributed = []string{"BUNDLED_BINARY", "BUNDLED_SOURCE", "STATIC_LINK", "DYNAMIC_LINK"}
+
+// Rules は既定の導出ルール表。
+var Rules = []Rule{
+	{
+		Obligation:  LicenseText,
+		Licenses:    []string{"*"},
+		UsageRoles:  distributed,
+		Description: "著作権表示とライセンス本文を成果物に同梱する",
+	},
+	{
+		Obligation:  NoticeFile,
+		Licenses:    []string{"Apache-2.0"},
+		UsageRoles:  distributed,
+		Description: "NOTICE ファイルの内容を成果物の NOTICE に引き継ぐ",
+	},
+	{
+		Obligation:  SourceOffer,
+		Licenses:    []string{"GPL-*", "AGPL-*", "LGPL-*", "MPL-*", "EPL-*", "CDDL-*"},
+		UsageRoles:  []string{"BUNDLED_BINARY", "STATIC_LINK", "DYNAMIC_LINK"},
+		Description: "対応するソースコードを提供する (または書面によるソースコード提供の申し出を同梱する)",
+	},
+	{
+		Obligation:  RelinkableObjects,
+		Licenses:    []string{"LGPL-*"},
+		UsageRoles:  []string{"STATIC_LINK"},
+		Description: "利用者がライブラリを差し替えて再リンクできるオブジェクトファイルを提供する",
+	},
+	{
+		Obligation:  NetworkSourceOffer,
+		Licenses:    []string{"AGPL-*"},
+		UsageRoles:  []string{"SERVER_ENV", "RUNTIME_REQUIRED"},
+		Description: "ネットワーク経由の利用者にソースコードの入手手段を提示する",
+	},
+	{
+		Obligation:   StateChanges,
+		Licenses:     []string{"GPL-*", "AGPL-*", "LGPL-*", "Apache-2.0", "MPL-*", "EPL-*"},
+		UsageRoles:   distributed,
+		ModifiedOnly: true,
+		Description:  "改変したファイルに改変した旨と日付を記載する",
+	},
+}
+
+// Item は導出した義務 1 件。
+type Item struct {
+	License     string
+	Obligation  string
+	Description string
+}
+
+// Derive は利用形態とバージョンのライセンス式から義務を導出する。
+// ライセンス式は確定ライセンス、未設定の場合は生のライセンス式を用いる。
+// AND で結合したライセンスはすべての義務を負い、OR で選択できるライセンスは義務が最も少ない選択肢を採用する。
+// ライセンス式が未設定・不正な場合は義務を導出しない (ライセンスポリシーの評価で要確認となる)。
+func Derive(rules []Rule, usageRole string, v model.OssVersion) []Item {
+	expr := policy.Expression(v)
+	if expr == "" || expr == license.NoAssertion || expr == license.None {
+		return nil
+	}
+	e, err := license.Parse(expr)
+	if err != nil {
+		return nil
+	}
+	var applicable []*Rule
+	for i := range rules {
+		r := &rules[i]
+		if slices.Contains(r.UsageRoles, usageRole) && (!r.ModifiedOnly || v.Modified) {
+			applicable = append(applicable, r)
+		}
+	}
+	return dedupe(derive(applicable, e))
+}
+
+func derive(rules []*Rule, e *license.Expression) []Item {
+	switch e.Op {
+	case "":
+		var items []Item
+		for _, r := range rules {
+			for _, p := range r.Licenses {
+				if policy.MatchLicense(p, e.License) {
+					items = append(items, Item{License: e.License, Obligation: r.Obligation, Description: r.Description})
+					break
+				}
+			}
+		}
+		return items
+	case "AND":
+		var items []Item
+		for _, a := range e.Args {
+			items = append(items, derive(rules, a)...)
+		}
+		return items
+	}
+	// OR は義務が最も少ない選択肢を採用する
+	var best []Item
+	for i, a := range e.Args {
+		items := dedupe(derive(rules, a))
+		if i == 0 || len(items) < len(best) {
+			best = items
+		}
+	}
+	return best
+}
+
+// dedupe は同じライセンス・種類の義務を 1 件にまとめる。
+func dedupe(items []Item) []Item {
+	var res []Item
+	seen := map[Item]bool{}
+	for _, it := range items {
+		if !seen[it] {
+			seen[it] = true
+			res = append(res, it)
+		}
+	}
+	return res
+}
@@ -0,0 +1,48 @@
+package obligation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func version(concluded string, modified bool) model.OssVersion {
+	return model.OssVersion{Version: "1.0", LicenseConcluded: &concluded, Modified: modified}
+}
+
+func obligations(items []Item) []string {
+	var res []string
+	for _, it := range items {
+		res = append(res, it.License+":"+it.Obligation)
+	}
+	return res
+}
+
+func TestDerive(t *testing.T) {
+	require.Equal(t, []string{"LGPL-2.1-only:LICENSE_TEXT", "LGPL-2.1-only:SOURCE_OFFER", "LGPL-2.1-only:RELINKABLE_OBJECTS"},
+		obligations(Derive(Rules, "STATIC_LINK", version("LGPL-2.1-only", false))))
+	require.Equal(t, []string{"GPL-2.0-only:LICENSE_TEXT", "GPL-2.0-only:SOURCE_OFFER"},
+		obligations(Derive(Rules, "BUNDLED_BINARY", version("GPL-2.0-only", false))))
+	require.Equal(t, []string{"Apache-2.0:LICENSE_TEXT", "Apache-2.0:NOTICE_FILE", "Apache-2.0:STATE_CHANGES"},
+		obligations(Derive(Rules, "BUNDLED_SOURCE", version("Apache-2.0", true))))
+	require.Equal(t, []string{"AGPL-3.0-only:NETWORK_SOURCE_OFFER"},
+		obligations(Derive(Rules, "SERVER_ENV", version("AGPL-3.0-only", false))))
+	require.Empty(t, Derive(Rules, "BUILD_ONLY", version("GPL-3.0-only", false)))
+	require.Empty(t, Derive(Rules, "BUNDLED_BINARY", version("NOASSERTION", false)))
+}
+
+func TestDerive_Expression(t *testing.T) {
+	// AND はすべてのライセンスの義務を負う
+	require.Equal(t, []string{"MIT:LICENSE_TEXT", "Apache-2.0:LICENSE_TEXT", "Apache-2.0:NOTICE_FILE"},
+		obligations(Derive(Rules, "BUNDLED_BINARY", version("MIT AND Apache-2.0", false))))
+	// OR は義務が最も少ない選択肢を採用する
+	require.Equal(t, []string{"MIT:LICENSE_TEXT"},
+		obligations(Derive(Rules, "STATIC_LINK", version("LGPL-2.1-or-later OR MIT", false))))
+	// 確定ライセンスが無い場合は生のライセンス式を用いる
+	raw := "GPL-2.0+"
+	v := model.OssVersion{LicenseExpressionRaw: &raw}
+	require.Equal(t, []string{"GPL-2.0-or-later:LICENSE_TEXT", "GPL-2.0-or-later:SOURCE_OFFER"},
+		obligations(Derive(Rules, "DYNAMIC_LINK", v)))
+}
@@ -0,0 +1,209 @@
+// Package policy はライセンスポリシーのルールでプロジェクトの OSS 利用を評価する。
+package policy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// Violation は利用 1 件のポリシー違反。
+// ライセンス式を判定できない場合は Rule を持たない REVIEW の違反とする。
+type Violation struct {
+	Usage      model.ProjectUsage
+	Component  model.OssComponent
+	Version    model.OssVersion
+	Expression string
+	// License は違反となったライセンス ID。ライセンス式を判定できない場合は空文字。
+	License  string
+	Rule     *model.LicensePolicyRule
+	Severity string
+	Message  string
+}
+
+// Report はプロジェクトの評価結果。
+type Report struct {
+	Violations []Violation
+	Deny       int
+	Warn       int
+	Review     int
+}
+
+// Compliant は DENY の違反が無い場合に true を返す。
+func (r *Report) Compliant() bool { return r.Deny == 0 }
+
+// CategoryFunc はライセンス ID の分類を返す。分類が無い場合は空文字を返す。
+type CategoryFunc func(id string) string
+
+// severityRank は重大度の順位 (大きいほど重い)。
+var severityRank = map[string]int{
+	model.PolicySeverityWarn:   1,
+	model.PolicySeverityReview: 2,
+	model.PolicySeverityDeny:   3,
+}
+
+// Evaluate は有効なルールでプロジェクトの各利用のライセンス式を評価する。
+// ライセンス式は確定ライセンス、未設定の場合は生のライセンス式を用いる。
+// OR で選択できるライセンスは違反が最も軽い選択肢を採用し、AND で結合したライセンスはすべてを評価する。
+// ライセンス式が未設定・NOASSERTION・不正な場合は REVIEW の違反とする。
+func Evaluate(rules []model.LicensePolicyRule, project model.Project, items []model.ProjectUsageDetail, category CategoryFunc) *Report {
+	r := &Report{}
+	for _, it := range items {
+		var applicable []*model.LicensePolicyRule
+		for i := range rules {
+			if rules[i].Enabled && applies(&rules[i], project, it.Usage) {
+				applicable = append(applicable, &rules[i])
+			}
+		}
+		for _, v := range evaluateItem(applicable, it, category) {
+			r.add(v)
+		}
+	}
+	return r
+}
+
+func (r *Report) add(v Violation) {
+	r.Violations = append(r.Violations, v)
+	switch v.Severity {
+	case model.PolicySeverityDeny:
+		r.Deny++
+	case model.PolicySeverityWarn:
+		r.Warn++
+	case model.PolicySeverityReview:
+		r.Review++
+	}
+}
+
+// applies はルールがプロジェクトの状態・利用形態・スコープの条件に該当するかを返す。
+func applies(rule *model.LicensePolicyRule, p model.Project, u model.ProjectUsage) bool {
+	if len(rule.ProjectStatuses) > 0 && !slices.Contains(rule.ProjectStatuses, p.Status) {
+		return false
+	}
+	if len(rule.UsageRoles) > 0 && !slices.Contains(rule.UsageRoles, u.UsageRole) {
+		return false
+	}
+	if len(rule.ScopeStatuses) > 0 && !slices.Contains(rule.ScopeStatuses, u.ScopeStatus) {
+		return false
+	}
+	return true
+}
+
+// Expression は評価に用いるライセンス式を返す。確定ライセンス、未設定の場合は生のライセンス式を用いる。
+func Expression(v model.OssVersion) string {
+	if v.LicenseConcluded != nil && strings.TrimSpace(*v.LicenseConcluded) != "" {
+		return strings.TrimSpace(*v.LicenseConcluded)
+	}
+	if v.LicenseExpressionRaw != nil {
+		return strings.TrimSpace(*v.LicenseExpressionRaw)
+	}
+	return ""
+}
+
+func evaluateItem(rules []*model.LicensePolicyRule, it model.ProjectUsageDetail, category CategoryFunc) []Violation {
+	expr := Expression(it.Version)
+	base := Violation{Usage: it.Usage, Component: it.Component, Version: it.Version, Expression: expr}
+
+	review := func(msg string) []Violation {
+		v := base
+		v.Severity = model.PolicySeverityReview
+		v.Message = msg
+		return []Violation{v}
+	}
+	if expr == "" || expr == license.NoAssertion || expr == license.None {
+		return review("license is not determined")
+	}
+	e, err := license.Parse(expr)
+	if err != nil {
+		return review(fmt.Sprintf("invalid license expression: %v", err))
+	}
+
+	var res []Violation
+	for _, h := range evaluateExpr(rules, e, category) {
+		v := base
+		v.License = h.license
+		v.Rule = h.rule
+		v.Severity = h.rule.Severity
+		v.Message = fmt.Sprintf("license %s matches rule %q", h.license, h.rule.Name)
+		res = append(res, v)
+	}
+	return res
+}
+
+// hit はライセンスとそれに該当したルールの組。
+type hit struct {
+	license string
+	rule    *model.LicensePolicyRule
+}
+
+func evaluateExpr(rules []*model.LicensePolicyRule, e *license.Expression, category CategoryFunc) []hit {
+	switch e.Op {
+	case "":
+		var hits []hit
+		for _, rule := range rules {
+			if matches(rule, e.License, category) {
+				hits = append(hits, hit{license: e.License, rule: rule})
+			}
+		}
+		return hits
+	case "AND":
+		var hits []hit
+		for _, a := range e.Args {
+			hits = append(hits, evaluateExpr(rules, a, category)...)
+		}
+		return hits
+	}
+	// OR は違反が最も軽い選択肢を採用する
+	var best []hit
+	for i, a := range e.Args {
+		hits := evaluateExpr(rules, a, category)
+		if i == 0 || lighter(hits, best) {
+			best = hits
+		}
+	}
+	return best
+}
+
+// lighter は a の違反が b より軽い場合に true を返す。最も重い違反、次に違反の件数で比べる。
+func lighter(a, b []hit) bool {
+	wa, wb := worst(a), worst(b)
+	if wa != wb {
+		return wa < wb
+	}
+	return len(a) < len(b)
+}
+
+func worst(hits []hit) int {
+	w := 0
+	for _, h := range hits {
+		w = max(w, severityRank[h.rule.Severity])
+	}
+	return w
+}
+
+// matches はライセンスがルールの ID パターンまたは分類に該当するかを返す。
+func matches(rule *model.LicensePolicyRule, id string, category CategoryFunc) bool {
+	for _, p := range rule.Licenses {
+		if MatchLicense(p, id) {
+			return true
+		}
+	}
+	if len(rule.Categories) > 0 && category != nil {
+		if c := category(id); c != "" && slices.Contains(rule.Categories, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchLicense はライセンス ID がパターンに一致するかを大文字小文字を区別せずに返す。
+// パターン末尾の "*" は前方一致とする。
+func MatchLicense(pattern, id string) bool {
+	pattern, id = strings.ToLower(strings.TrimSpace(pattern)), strings.ToLower(id)
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(id, prefix)
+	}
+	return pattern == id
+}
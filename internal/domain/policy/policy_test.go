@@ -0,0 +1,115 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func usage(name, role, scope, concluded string) model.ProjectUsageDetail {
+	it := model.ProjectUsageDetail{
+		Usage:     model.ProjectUsage{ID: "u-" + name, UsageRole: role, ScopeStatus: scope},
+		Component: model.OssComponent{Name: name},
+		Version:   model.OssVersion{Version: "1.0"},
+	}
+	if concluded != "" {
+		it.Version.LicenseConcluded = &concluded
+	}
+	return it
+}
+
+var testRules = []model.LicensePolicyRule{
+	{ID: "r1", Name: "deny AGPL in delivery", Severity: model.PolicySeverityDeny, Licenses: []string{"AGPL-3.0*"}, ScopeStatuses: []string{"IN_SCOPE"}, Enabled: true},
+	{ID: "r2", Name: "warn GPL static link", Severity: model.PolicySeverityWarn, Licenses: []string{"GPL-*"}, UsageRoles: []string{"STATIC_LINK"}, Enabled: true},
+	{ID: "r3", Name: "review custom", Severity: model.PolicySeverityReview, Licenses: []string{"LicenseRef-*"}, Enabled: true},
+	{ID: "r4", Name: "deny network copyleft", Severity: model.PolicySeverityDeny, Categories: []string{"NETWORK_COPYLEFT"}, Enabled: false},
+}
+
+var activeProject = model.Project{ID: "p1", Status: model.ProjectStatusActive}
+
+func TestEvaluate(t *testing.T) {
+	items := []model.ProjectUsageDetail{
+		usage("agpl", "SERVER_ENV", "IN_SCOPE", "AGPL-3.0-only"),
+		usage("agpl-out", "SERVER_ENV", "OUT_SCOPE", "AGPL-3.0-only"),
+		usage("gpl-static", "STATIC_LINK", "IN_SCOPE", "GPL-2.0-only AND LicenseRef-acme"),
+		usage("gpl-dynamic", "DYNAMIC_LINK", "IN_SCOPE", "GPL-2.0-only"),
+		usage("dual", "STATIC_LINK", "IN_SCOPE", "GPL-2.0-only OR MIT"),
+		usage("undetermined", "BUNDLED_BINARY", "IN_SCOPE", ""),
+	}
+	r := Evaluate(testRules, activeProject, items, nil)
+
+	require.False(t, r.Compliant())
+	require.Equal(t, 1, r.Deny)
+	require.Equal(t, 1, r.Warn)
+	require.Equal(t, 2, r.Review)
+	require.Len(t, r.Violations, 4)
+
+	deny := r.Violations[0]
+	require.Equal(t, "agpl", deny.Component.Name)
+	require.Equal(t, "AGPL-3.0-only", deny.License)
+	require.Equal(t, "r1", deny.Rule.ID)
+	require.Equal(t, `license AGPL-3.0-only matches rule "deny AGPL in delivery"`, deny.Message)
+
+	// AND で結合したライセンスはそれぞれ評価する
+	require.Equal(t, "GPL-2.0-only", r.Violations[1].License)
+	require.Equal(t, model.PolicySeverityWarn, r.Violations[1].Severity)
+	require.Equal(t, "LicenseRef-acme", r.Violations[2].License)
+
+	// ライセンス式を判定できない利用は要確認
+	undetermined := r.Violations[3]
+	require.Equal(t, "undetermined", undetermined.Component.Name)
+	require.Nil(t, undetermined.Rule)
+	require.Equal(t, "license is not determined", undetermined.Message)
+}
+
+func TestEvaluate_OrChoosesLightest(t *testing.T) {
+	items := []model.ProjectUsageDetail{
+		usage("a", "STATIC_LINK", "IN_SCOPE", "AGPL-3.0-only OR GPL-3.0-only"),
+		usage("b", "STATIC_LINK", "IN_SCOPE", "AGPL-3.0-only OR (GPL-3.0-only AND LicenseRef-x)"),
+	}
+	r := Evaluate(testRules, activeProject, items, nil)
+	require.True(t, r.Compliant())
+	require.Len(t, r.Violations, 3)
+	require.Equal(t, "GPL-3.0-only", r.Violations[0].License)
+	require.Equal(t, "GPL-3.0-only", r.Violations[1].License)
+	require.Equal(t, "LicenseRef-x", r.Violations[2].License)
+}
+
+func TestEvaluate_Category(t *testing.T) {
+	rules := []model.LicensePolicyRule{{ID: "r", Name: "network", Severity: model.PolicySeverityDeny, Categories: []string{"NETWORK_COPYLEFT"}, Enabled: true}}
+	category := func(id string) string {
+		if id == "SSPL-1.0" {
+			return "NETWORK_COPYLEFT"
+		}
+		return ""
+	}
+	r := Evaluate(rules, activeProject, []model.ProjectUsageDetail{
+		usage("mongo", "SERVER_ENV", "IN_SCOPE", "SSPL-1.0"),
+		usage("lodash", "BUNDLED_SOURCE", "IN_SCOPE", "MIT"),
+		usage("broken", "BUNDLED_SOURCE", "IN_SCOPE", "MIT and"),
+	}, category)
+	require.Equal(t, 1, r.Deny)
+	require.Equal(t, 1, r.Review)
+	require.Contains(t, r.Violations[1].Message, "invalid license expression")
+}
+
+func TestEvaluate_ProjectStatus(t *testing.T) {
+	rules := []model.LicensePolicyRule{{ID: "r", Name: "deny AGPL after delivery", Severity: model.PolicySeverityDeny, Licenses: []string{"AGPL-3.0*"}, ProjectStatuses: []string{model.ProjectStatusDelivered}, Enabled: true}}
+	items := []model.ProjectUsageDetail{usage("agpl", "SERVER_ENV", "IN_SCOPE", "AGPL-3.0-only")}
+
+	require.Empty(t, Evaluate(rules, activeProject, items, nil).Violations)
+	delivered := model.Project{ID: "p2", Status: model.ProjectStatusDelivered}
+	r := Evaluate(rules, delivered, items, nil)
+	require.Equal(t, 1, r.Deny)
+	require.Equal(t, "r", r.Violations[0].Rule.ID)
+}
+
+func TestMatchLicense(t *testing.T) {
+	require.True(t, MatchLicense("GPL-*", "GPL-2.0-or-later"))
+	require.False(t, MatchLicense("GPL-*", "LGPL-2.1-only"))
+	require.True(t, MatchLicense("agpl-3.0-only", "AGPL-3.0-only"))
+	require.False(t, MatchLicense("AGPL-3.0-only", "AGPL-3.0-or-later"))
+	require.True(t, MatchLicense("*", "MIT"))
+}
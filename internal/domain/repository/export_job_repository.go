@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// ExportJobRepository は ExportJob の永続化処理を定義する。
+type ExportJobRepository interface {
+	Create(ctx context.Context, j *model.ExportJob) error
+	Get(ctx context.Context, id string) (*model.ExportJob, error)
+	Update(ctx context.Context, j *model.ExportJob) error
+	// ListByStatus は指定ステータスのジョブを作成日時順に返す。
+	ListByStatus(ctx context.Context, statuses []string) ([]model.ExportJob, error)
+	// ListExpired は before 時点で保持期限を過ぎた完了済みジョブを返す。
+	ListExpired(ctx context.Context, before time.Time) ([]model.ExportJob, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// ExportTemplateRepository は ExportTemplate の永続化処理を定義する。
+type ExportTemplateRepository interface {
+	// List は全テンプレートを名前順で返す。
+	List(ctx context.Context) ([]model.ExportTemplate, error)
+	Get(ctx context.Context, id string) (*model.ExportTemplate, error)
+	// FindByName は名前でテンプレートを取得する。存在しない場合は sql.ErrNoRows を返す。
+	FindByName(ctx context.Context, name string) (*model.ExportTemplate, error)
+	Create(ctx context.Context, t *model.ExportTemplate) error
+	Update(ctx context.Context, t *model.ExportTemplate) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// ImportSessionRepository は ImportSession と ImportSessionItem の永続化処理を定義する。
+type ImportSessionRepository interface {
+	// Create はセッションと項目を登録する。
+	Create(ctx context.Context, s *model.ImportSession, items []model.ImportSessionItem) error
+	Get(ctx context.Context, id string) (*model.ImportSession, error)
+	// ListByProject はプロジェクトのセッションを新しい順に返す。
+	ListByProject(ctx context.Context, projectID string) ([]model.ImportSession, error)
+	Update(ctx context.Context, s *model.ImportSession) error
+	Delete(ctx context.Context, id string) error
+	// ListItems はセッションの項目を文書内の順序で返す。
+	ListItems(ctx context.Context, sessionID string) ([]model.ImportSessionItem, error)
+	GetItem(ctx context.Context, sessionID, itemID string) (*model.ImportSessionItem, error)
+	UpdateItem(ctx context.Context, it *model.ImportSessionItem) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// LicensePolicyRuleRepository はライセンスポリシーのルールの永続化処理を定義する。
+type LicensePolicyRuleRepository interface {
+	// List は全ルールを名前順で返す。
+	List(ctx context.Context) ([]model.LicensePolicyRule, error)
+	Get(ctx context.Context, id string) (*model.LicensePolicyRule, error)
+	// FindByName は名前でルールを取得する。存在しない場合は sql.ErrNoRows を返す。
+	FindByName(ctx context.Context, name string) (*model.LicensePolicyRule, error)
+	Create(ctx context.Context, r *model.LicensePolicyRule) error
+	Update(ctx context.Context, r *model.LicensePolicyRule) error
+	Delete(ctx context.Context, id string) error
+}
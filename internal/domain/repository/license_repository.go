@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// LicenseFilter はライセンス検索の条件を表す。
+type LicenseFilter struct {
+	Query    string // ID・名称への部分一致 (大文字小文字を区別しない)
+	Category string // 分類の完全一致
+	Custom   *bool
+	HasText  *bool // 本文の登録有無
+	Page     int
+	Size     int
+}
+
+// LicenseRepository はライセンスカタログの永続化処理を定義する。
+type LicenseRepository interface {
+	// Search はフィルタに合致するライセンスを ID 順で返す。本文 (Text) は読み込まない。
+	Search(ctx context.Context, f LicenseFilter) ([]model.License, int, error)
+	// Get は ID でライセンスを取得する。存在しない場合は sql.ErrNoRows を返す。
+	Get(ctx context.Context, id string) (*model.License, error)
+	Create(ctx context.Context, l *model.License) error
+	Update(ctx context.Context, l *model.License) error
+	Delete(ctx context.Context, id string) error
+	// Seed は未登録のライセンスを登録する。登録済みの項目は本文が未登録の場合に補う以外は変更しない。
+	Seed(ctx context.Context, ls []model.License) error
+}
@@ -20,4 +20,7 @@ type OssComponentFilter struct {
 type OssComponentRepository interface {
 	Search(ctx context.Context, f OssComponentFilter) ([]model.OssComponent, int, error)
 	Create(ctx context.Context, c *model.OssComponent) error
+	Get(ctx context.Context, id string) (*model.OssComponent, error)
+	// FindByNormalizedName は正規化名が完全一致するコンポーネントを返す。存在しない場合は sql.ErrNoRows を返す。
+	FindByNormalizedName(ctx context.Context, name string) (*model.OssComponent, error)
 }
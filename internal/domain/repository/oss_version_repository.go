@@ -22,4 +22,9 @@ type OssVersionRepository interface {
 	Create(ctx context.Context, v *model.OssVersion) error
 	Update(ctx context.Context, v *model.OssVersion) error
 	Delete(ctx context.Context, id string) error
+	// FindByPurl は purl が一致するバージョンを返す。複数ある場合は最も古いものを返し、
+	// 存在しない場合は sql.ErrNoRows を返す。
+	FindByPurl(ctx context.Context, purl string) (*model.OssVersion, error)
+	// FindByVersion はコンポーネント内でバージョン文字列が一致するものを返す。存在しない場合は sql.ErrNoRows を返す。
+	FindByVersion(ctx context.Context, ossID, version string) (*model.OssVersion, error)
 }
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// ProjectObligationRepository はプロジェクトの義務の永続化処理を定義する。
+type ProjectObligationRepository interface {
+	// ListDetails はプロジェクトの義務をコンポーネント名・バージョン順で返す。
+	ListDetails(ctx context.Context, projectID string) ([]model.ProjectObligationDetail, error)
+	// Get は ID で義務を取得する。存在しない場合は sql.ErrNoRows を返す。
+	Get(ctx context.Context, id string) (*model.ProjectObligationDetail, error)
+	// Update は状態・証跡・完了者を更新する。
+	Update(ctx context.Context, o *model.ProjectObligation) error
+	// Sync は導出した義務をプロジェクトに反映する。
+	// 未登録の義務を登録し、導出されなくなった OPEN の義務を削除する。履行済み・免除済みの義務は記録として残す。
+	// 登録・削除した件数を返す。
+	Sync(ctx context.Context, projectID string, derived []model.ProjectObligation) (added, removed int, err error)
+}
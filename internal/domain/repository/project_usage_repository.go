@@ -25,4 +25,13 @@ type ProjectUsageRepository interface {
 	Update(ctx context.Context, u *model.ProjectUsage) error
 	Delete(ctx context.Context, id string) error
 	UpdateScope(ctx context.Context, id string, scopeStatus string, inclusionNote *string, evaluatedAt dbtime.DBTime, evaluatedBy *string) error
+	// ListDetails はプロジェクトの利用情報をコンポーネント・バージョンと結合して取得する。
+	// scopes が空の場合はスコープで絞り込まない。
+	ListDetails(ctx context.Context, projectID string, scopes []string) ([]model.ProjectUsageDetail, error)
+	// FindByVersion はプロジェクト内で指定バージョンを参照する利用情報を返す。存在しない場合は sql.ErrNoRows を返す。
+	FindByVersion(ctx context.Context, projectID, ossVersionID string) (*model.ProjectUsage, error)
+	// AddDependency は利用情報 usageID が dependsOnUsageID に依存することを登録する。登録済みの場合は何もしない。
+	AddDependency(ctx context.Context, usageID, dependsOnUsageID string) error
+	// ListDependencies はプロジェクトの利用情報間の依存関係を、利用 ID ごとの依存先の利用 ID として返す。
+	ListDependencies(ctx context.Context, projectID string) (map[string][]string, error)
 }
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// VulnerabilityAnalysisRepository は利用毎の脆弱性の VEX 分析の永続化処理を定義する。
+type VulnerabilityAnalysisRepository interface {
+	// ListByProject はプロジェクトの分析をすべて返す。
+	ListByProject(ctx context.Context, projectID string) ([]model.VulnerabilityAnalysis, error)
+	// Find は利用と脆弱性の組で分析を取得する。存在しない場合は sql.ErrNoRows を返す。
+	Find(ctx context.Context, usageID, vulnerabilityID string) (*model.VulnerabilityAnalysis, error)
+	Create(ctx context.Context, a *model.VulnerabilityAnalysis) error
+	// Update は状態・根拠・対応方針・メモ・更新者を更新する。
+	Update(ctx context.Context, a *model.VulnerabilityAnalysis) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// VulnerabilityRepository は脆弱性と CVSS 評価・CPE 条件・パッケージのバージョン範囲・別名の永続化処理を定義する。
+type VulnerabilityRepository interface {
+	// Get は ID で脆弱性を取得する。存在しない場合は sql.ErrNoRows を返す。
+	Get(ctx context.Context, id string) (*model.Vulnerability, error)
+	// ListByIDs は ID が一致する脆弱性を返す。存在しない ID は無視する。
+	ListByIDs(ctx context.Context, ids []string) ([]model.Vulnerability, error)
+	// ListCvss は脆弱性の CVSS 評価を返す。
+	ListCvss(ctx context.Context, id string) ([]model.CvssMetric, error)
+	// FindCpeMatches はベンダ名・製品名 (小文字) が一致する CPE 条件を返す。
+	FindCpeMatches(ctx context.Context, vendor, product string) ([]model.CpeMatch, error)
+	// FindPackageRanges は purl のキー (type/namespace/name) が一致するバージョン範囲を返す。
+	FindPackageRanges(ctx context.Context, key string) ([]model.PackageRange, error)
+	// ListAliases は ids の脆弱性の別名と、ids を別名に持つ脆弱性の組を返す。
+	ListAliases(ctx context.Context, ids []string) ([]model.VulnerabilityAlias, error)
+	// Upsert は脆弱性を登録し、既に存在する場合は置き換える。CVSS 評価・CPE 条件・バージョン範囲・別名 (v.Aliases) も置き換える。
+	// 新規に登録した場合は created に true を返す。
+	Upsert(ctx context.Context, v *model.Vulnerability, metrics []model.CvssMetric, matches []model.CpeMatch, packages []model.PackageRange) (created bool, err error)
+}
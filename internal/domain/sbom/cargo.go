@@ -0,0 +1,98 @@
+package sbom
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseCargoLock は Rust の Cargo.lock を読み込む。
+// source を持たないパッケージはワークスペース内のクレートとして除外し、その依存先を直接依存とする。
+// 各クレートを pkg:cargo の purl で表し、checksum (.crate の SHA-256) を SHA256 に設定する。
+func ParseCargoLock(r io.Reader) (*BOM, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read Cargo.lock: %w", err)
+	}
+	lock, err := parseTOMLLock(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode Cargo.lock: %w", err)
+	}
+
+	// 依存先は "name" / "name version" / "name version (source)" のいずれかで記録される
+	byName := map[string][]string{}
+	for _, p := range lock.Packages {
+		name := p.tomlString("name")
+		byName[name] = append(byName[name], cargoRef(name, p.tomlString("version")))
+	}
+	resolve := func(dep string) string {
+		fields := strings.Fields(dep)
+		if len(fields) == 0 {
+			return ""
+		}
+		if len(fields) >= 2 {
+			return cargoRef(fields[0], fields[1])
+		}
+		if refs := byName[fields[0]]; len(refs) == 1 {
+			return refs[0]
+		}
+		return ""
+	}
+
+	bom := &BOM{Format: "cargo-lock", Dependencies: map[string][]string{}}
+	direct := map[string]bool{}
+	workspace := map[string]bool{}
+	for _, p := range lock.Packages {
+		if p.tomlString("source") != "" {
+			continue
+		}
+		ref := cargoRef(p.tomlString("name"), p.tomlString("version"))
+		workspace[ref] = true
+		if bom.Name == "" {
+			bom.Name = p.tomlString("name")
+		}
+		for _, d := range tomlStrings(p["dependencies"]) {
+			if dep := resolve(d); dep != "" {
+				direct[dep] = true
+			}
+		}
+	}
+	for _, p := range lock.Packages {
+		if p.tomlString("source") == "" {
+			continue
+		}
+		name, version := p.tomlString("name"), p.tomlString("version")
+		ref := cargoRef(name, version)
+		bom.Packages = append(bom.Packages, Package{
+			Ref:     ref,
+			Name:    name,
+			Version: version,
+			Purl:    purl("cargo", "", name, version),
+			SHA256:  strings.ToLower(p.tomlString("checksum")),
+			// ワークスペースが無い (依存先のみの) ロックファイルでは全て直接依存とみなす
+			Direct: direct[ref] || len(workspace) == 0,
+		})
+		for _, d := range tomlStrings(p["dependencies"]) {
+			if dep := resolve(d); dep != "" && !workspace[dep] {
+				bom.Dependencies[ref] = append(bom.Dependencies[ref], dep)
+			}
+		}
+	}
+	return bom, nil
+}
+
+func cargoRef(name, version string) string {
+	return name + "@" + version
+}
+
+// tomlStrings は文字列の配列を []string として返す。
+func tomlStrings(v any) []string {
+	arr, _ := v.([]any)
+	out := make([]string, 0, len(arr))
+	for _, e := range arr {
+		if s, ok := e.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
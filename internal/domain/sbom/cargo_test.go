@@ -0,0 +1,77 @@
+package sbom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const cargoLockTestDoc = `# This file is automatically @generated by Cargo.
+# It is not intended for manual editing.
+version = 3
+
+[[package]]
+name = "app"
+version = "0.1.0"
+dependencies = [
+ "serde",
+ "syn 2.0.37",
+]
+
+[[package]]
+name = "serde"
+version = "1.0.188"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "cf9e0fcba69a370eed61bcf2b728575f726b50b55cba78064753d708ddc7549e"
+dependencies = [
+ "serde_derive",
+]
+
+[[package]]
+name = "serde_derive"
+version = "1.0.188"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "4eca7ac642d82aa35b60049a6eccb4be6be75e599bd2e9adb5f875a737654af2"
+dependencies = [
+ "syn 2.0.37",
+]
+
+[[package]]
+name = "syn"
+version = "1.0.109"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+
+[[package]]
+name = "syn"
+version = "2.0.37"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "7303ef2c05cd654186cb250d29049a24840ca25d2747c25c0381c8d9e2f582e8"
+`
+
+func TestParseCargoLock(t *testing.T) {
+	bom, err := ParseCargoLock(strings.NewReader(cargoLockTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "cargo-lock", bom.Format)
+	require.Equal(t, "app", bom.Name)
+	require.Len(t, bom.Packages, 4)
+
+	serde := bom.Packages[0]
+	require.Equal(t, "serde@1.0.188", serde.Ref)
+	require.Equal(t, "pkg:cargo/serde@1.0.188", serde.Purl)
+	require.Equal(t, "cf9e0fcba69a370eed61bcf2b728575f726b50b55cba78064753d708ddc7549e", serde.SHA256)
+	require.True(t, serde.Direct)
+
+	require.False(t, bom.Packages[1].Direct)
+	require.False(t, bom.Packages[2].Direct)
+	require.Empty(t, bom.Packages[2].SHA256)
+	require.True(t, bom.Packages[3].Direct)
+
+	require.Equal(t, []string{"serde_derive@1.0.188"}, bom.Dependencies["serde@1.0.188"])
+	require.Equal(t, []string{"syn@2.0.37"}, bom.Dependencies["serde_derive@1.0.188"])
+}
+
+func TestParseCargoLock_Invalid(t *testing.T) {
+	_, err := ParseCargoLock(strings.NewReader("[[bin]]\nname = \"x\"\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,196 @@
+package sbom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrUnsupportedCycloneDX は CycloneDX 1.x JSON 以外の文書を読み込んだ場合に返す。
+var ErrUnsupportedCycloneDX = errors.New("unsupported CycloneDX document")
+
+// cdxDoc は取り込みに必要な CycloneDX 1.x JSON の項目のみを表す。
+type cdxDoc struct {
+	BOMFormat   string `json:"bomFormat"`
+	SpecVersion string `json:"specVersion"`
+	Metadata    struct {
+		Component *cdxComponent `json:"component"`
+	} `json:"metadata"`
+	Components   []cdxComponent `json:"components"`
+	Dependencies []struct {
+		Ref       string   `json:"ref"`
+		DependsOn []string `json:"dependsOn"`
+	} `json:"dependencies"`
+}
+
+type cdxComponent struct {
+	BOMRef    string `json:"bom-ref"`
+	Type      string `json:"type"`
+	Group     string `json:"group"`
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Purl      string `json:"purl"`
+	CPE       string `json:"cpe"`
+	Scope     string `json:"scope"`
+	Copyright string `json:"copyright"`
+	Publisher string `json:"publisher"`
+	Supplier  *struct {
+		Name string `json:"name"`
+	} `json:"supplier"`
+	Hashes []struct {
+		Alg     string `json:"alg"`
+		Content string `json:"content"`
+	} `json:"hashes"`
+	Licenses []struct {
+		Expression string `json:"expression"`
+		License    *struct {
+			ID              string `json:"id"`
+			Name            string `json:"name"`
+			Acknowledgement string `json:"acknowledgement"`
+		} `json:"license"`
+	} `json:"licenses"`
+	ExternalReferences []struct {
+		Type string `json:"type"`
+		URL  string `json:"url"`
+	} `json:"externalReferences"`
+	Components []cdxComponent `json:"components"`
+}
+
+// cdxScopeUsageRoles は CycloneDX の component.scope から推定する利用形態。
+// optional は実行時に任意で読み込まれるため RUNTIME_REQUIRED としてスコープポリシーの判定に委ね、
+// excluded は納品物に含まれない (テスト・開発用) ため DEV_ONLY とする。
+var cdxScopeUsageRoles = map[string]string{
+	"required": "RUNTIME_REQUIRED",
+	"optional": "RUNTIME_REQUIRED",
+	"excluded": "DEV_ONLY",
+}
+
+// ParseCycloneDXJSON は CycloneDX 1.x JSON 文書を読み込む。
+// metadata.component をルートとして除外し、入れ子の components は平坦化する。
+// dependencies でルートが直接依存する要素のみを直接依存とし、
+// ルートの依存関係が記載されていない場合は全パッケージを直接依存とみなす。
+func ParseCycloneDXJSON(r io.Reader) (*BOM, error) {
+	var doc cdxDoc
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decode CycloneDX JSON: %w", err)
+	}
+	if doc.BOMFormat != "CycloneDX" || !strings.HasPrefix(doc.SpecVersion, "1.") {
+		return nil, fmt.Errorf("%w: bomFormat %q specVersion %q", ErrUnsupportedCycloneDX, doc.BOMFormat, doc.SpecVersion)
+	}
+
+	deps := map[string][]string{}
+	for _, d := range doc.Dependencies {
+		deps[d.Ref] = append(deps[d.Ref], d.DependsOn...)
+	}
+	root := ""
+	bom := &BOM{Format: "cyclonedx-json", Dependencies: map[string][]string{}}
+	if c := doc.Metadata.Component; c != nil {
+		root = c.BOMRef
+		bom.Name = c.Name
+	}
+	direct := map[string]bool{}
+	for _, ref := range deps[root] {
+		direct[ref] = true
+	}
+
+	var walk func(cs []cdxComponent)
+	walk = func(cs []cdxComponent) {
+		for _, c := range cs {
+			if root == "" || c.BOMRef != root {
+				pkg := c.toPackage()
+				pkg.Direct = len(direct) == 0 || direct[c.BOMRef]
+				if d := deps[c.BOMRef]; len(d) > 0 {
+					bom.Dependencies[c.BOMRef] = d
+				}
+				bom.Packages = append(bom.Packages, pkg)
+			}
+			walk(c.Components)
+		}
+	}
+	walk(doc.Components)
+	return bom, nil
+}
+
+func (c cdxComponent) toPackage() Package {
+	name := strings.TrimSpace(c.Name)
+	// npm のスコープ (@scope/name) と Maven の groupId (group:artifact) を名前に含める
+	switch {
+	case c.Group == "":
+	case strings.HasPrefix(c.Group, "@"):
+		name = c.Group + "/" + name
+	default:
+		name = c.Group + ":" + name
+	}
+	pkg := Package{
+		Ref:       c.BOMRef,
+		Name:      name,
+		Version:   strings.TrimSpace(c.Version),
+		Purl:      c.Purl,
+		Copyright: strings.TrimSpace(c.Copyright),
+		Supplier:  c.Publisher,
+		UsageRole: cdxScopeUsageRoles[strings.ToLower(c.Scope)],
+	}
+	if pkg.Ref == "" {
+		pkg.Ref = c.Purl
+	}
+	if c.Supplier != nil && c.Supplier.Name != "" {
+		pkg.Supplier = c.Supplier.Name
+	}
+	if c.CPE != "" {
+		pkg.CPEs = []string{c.CPE}
+	}
+	for _, h := range c.Hashes {
+		switch strings.ToUpper(h.Alg) {
+		case "SHA-256":
+			pkg.SHA256 = strings.ToLower(h.Content)
+		case "SHA-512":
+			pkg.SHA512 = strings.ToLower(h.Content)
+		}
+	}
+	var declared, concluded []string
+	for _, l := range c.Licenses {
+		switch {
+		case l.Expression != "":
+			declared = append(declared, l.Expression)
+		case l.License == nil:
+		case strings.EqualFold(l.License.Acknowledgement, "concluded"):
+			concluded = append(concluded, cdxLicenseName(l.License.ID, l.License.Name))
+		default:
+			declared = append(declared, cdxLicenseName(l.License.ID, l.License.Name))
+		}
+	}
+	pkg.LicenseDeclared = joinLicenses(declared)
+	pkg.LicenseConcluded = joinLicenses(concluded)
+	for _, ref := range c.ExternalReferences {
+		if ref.Type == "website" && pkg.Homepage == "" {
+			pkg.Homepage = ref.URL
+		}
+	}
+	return pkg
+}
+
+// cdxLicenseName は SPDX ID を優先し、無ければライセンス名を返す。
+func cdxLicenseName(id, name string) string {
+	if id != "" {
+		return id
+	}
+	return name
+}
+
+// joinLicenses は複数のライセンス表記を AND で連結する。複合式は括弧で囲む。
+func joinLicenses(ls []string) string {
+	var parts []string
+	for _, l := range ls {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		if len(ls) > 1 && strings.Contains(l, " ") {
+			l = "(" + l + ")"
+		}
+		parts = append(parts, l)
+	}
+	return strings.Join(parts, " AND ")
+}
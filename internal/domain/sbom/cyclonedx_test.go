@@ -0,0 +1,88 @@
+package sbom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const cdxTestDoc = `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "sample-app", "version": "1.0.0"}},
+  "components": [
+    {
+      "bom-ref": "pkg:npm/%40angular/core@17.0.0", "type": "library", "group": "@angular", "name": "core", "version": "17.0.0",
+      "purl": "pkg:npm/%40angular/core@17.0.0", "scope": "required",
+      "supplier": {"name": "Google"},
+      "hashes": [{"alg": "SHA-1", "content": "abc"}, {"alg": "SHA-256", "content": "ABCDEF"}],
+      "licenses": [{"license": {"id": "MIT"}}],
+      "externalReferences": [{"type": "vcs", "url": "https://github.com/angular/angular"}, {"type": "website", "url": "https://angular.dev/"}]
+    },
+    {
+      "bom-ref": "commons", "type": "library", "group": "org.apache.commons", "name": "commons-lang3", "version": "3.14.0",
+      "cpe": "cpe:2.3:a:apache:commons_lang:3.14.0:*:*:*:*:*:*:*",
+      "licenses": [{"expression": "Apache-2.0 OR MIT"}, {"license": {"name": "Custom", "acknowledgement": "concluded"}}],
+      "components": [{"bom-ref": "shaded", "name": "shaded-lib", "version": "0.1", "scope": "optional"}]
+    },
+    {"bom-ref": "junit", "type": "library", "name": "junit", "version": "4.13.2", "scope": "excluded", "copyright": " Copyright JUnit "}
+  ],
+  "dependencies": [
+    {"ref": "app", "dependsOn": ["pkg:npm/%40angular/core@17.0.0", "commons", "junit"]},
+    {"ref": "commons", "dependsOn": ["shaded"]}
+  ]
+}`
+
+func TestParseCycloneDXJSON(t *testing.T) {
+	bom, err := ParseCycloneDXJSON(strings.NewReader(cdxTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "cyclonedx-json", bom.Format)
+	require.Equal(t, "sample-app", bom.Name)
+	require.Len(t, bom.Packages, 4)
+
+	angular := bom.Packages[0]
+	require.Equal(t, "@angular/core", angular.Name)
+	require.Equal(t, "pkg:npm/%40angular/core@17.0.0", angular.Purl)
+	require.Equal(t, "abcdef", angular.SHA256)
+	require.Equal(t, "MIT", angular.LicenseDeclared)
+	require.Equal(t, "Google", angular.Supplier)
+	require.Equal(t, "https://angular.dev/", angular.Homepage)
+	require.Equal(t, "RUNTIME_REQUIRED", angular.UsageRole)
+	require.True(t, angular.Direct)
+
+	commons := bom.Packages[1]
+	require.Equal(t, "org.apache.commons:commons-lang3", commons.Name)
+	require.Equal(t, "Apache-2.0 OR MIT", commons.LicenseDeclared)
+	require.Equal(t, "Custom", commons.LicenseConcluded)
+	require.Equal(t, []string{"cpe:2.3:a:apache:commons_lang:3.14.0:*:*:*:*:*:*:*"}, commons.CPEs)
+	require.Empty(t, commons.UsageRole)
+	require.Equal(t, []string{"shaded"}, bom.Dependencies["commons"])
+
+	shaded := bom.Packages[2]
+	require.Equal(t, "shaded-lib", shaded.Name)
+	require.False(t, shaded.Direct)
+	require.Equal(t, "RUNTIME_REQUIRED", shaded.UsageRole)
+
+	junit := bom.Packages[3]
+	require.Equal(t, "DEV_ONLY", junit.UsageRole)
+	require.Equal(t, "Copyright JUnit", junit.Copyright)
+	require.True(t, junit.Direct)
+}
+
+func TestParseCycloneDXJSON_NoDependencies(t *testing.T) {
+	doc := `{"bomFormat": "CycloneDX", "specVersion": "1.4", "components": [{"name": "a", "version": "1", "purl": "pkg:npm/a@1"}]}`
+	bom, err := ParseCycloneDXJSON(strings.NewReader(doc))
+	require.NoError(t, err)
+	require.Len(t, bom.Packages, 1)
+	require.Equal(t, "pkg:npm/a@1", bom.Packages[0].Ref)
+	require.True(t, bom.Packages[0].Direct)
+}
+
+func TestParseCycloneDXJSON_Unsupported(t *testing.T) {
+	_, err := ParseCycloneDXJSON(strings.NewReader(`{"spdxVersion": "SPDX-2.3"}`))
+	require.ErrorIs(t, err, ErrUnsupportedCycloneDX)
+
+	_, err = ParseCycloneDXJSON(strings.NewReader(`{`))
+	require.Error(t, err)
+}
@@ -0,0 +1,102 @@
+package sbom
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+// ParseGoMod は go.mod と go.sum (nil 可) を読み込む。モジュールプロキシへの問い合わせは行わない。
+// require の各モジュールを pkg:golang の purl で表し、// indirect のものは間接依存とする。
+// replace でモジュールが置き換えられている場合は置き換え先を、ローカルディレクトリへの置き換えは除外する。
+// go.sum にモジュール本体の h1 ハッシュ (SHA-256) があれば SHA256 に設定する。
+func ParseGoMod(gomod, gosum io.Reader) (*BOM, error) {
+	data, err := io.ReadAll(gomod)
+	if err != nil {
+		return nil, fmt.Errorf("read go.mod: %w", err)
+	}
+	f, err := modfile.Parse("go.mod", data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("parse go.mod: %w", err)
+	}
+	sums := map[string]string{}
+	if gosum != nil {
+		if sums, err = parseGoSum(gosum); err != nil {
+			return nil, err
+		}
+	}
+
+	bom := &BOM{Format: "gomod"}
+	if f.Module != nil {
+		bom.Name = f.Module.Mod.Path
+	}
+	for _, r := range f.Require {
+		mod := r.Mod
+		if rep := goReplacement(f.Replace, mod.Path, mod.Version); rep != nil {
+			if rep.New.Version == "" {
+				// ローカルディレクトリへの置き換え
+				continue
+			}
+			mod = rep.New
+		}
+		ns, name := path.Split(mod.Path)
+		bom.Packages = append(bom.Packages, Package{
+			Ref:     mod.Path + "@" + mod.Version,
+			Name:    mod.Path,
+			Version: mod.Version,
+			Purl:    purl("golang", strings.TrimSuffix(ns, "/"), name, mod.Version),
+			SHA256:  sums[mod.Path+" "+mod.Version],
+			Direct:  !r.Indirect,
+		})
+	}
+	return bom, nil
+}
+
+// goReplacement は modPath@version に適用される replace を返す。バージョン指定のある replace を優先する。
+func goReplacement(reps []*modfile.Replace, modPath, version string) *modfile.Replace {
+	var fallback *modfile.Replace
+	for _, r := range reps {
+		if r.Old.Path != modPath {
+			continue
+		}
+		if r.Old.Version == version {
+			return r
+		}
+		if r.Old.Version == "" {
+			fallback = r
+		}
+	}
+	return fallback
+}
+
+// parseGoSum は go.sum からモジュール本体の h1 ハッシュを 16 進表記の SHA-256 で返す。
+// キーは "path version"。go.mod ファイルのハッシュ (version/go.mod) は対象外。
+func parseGoSum(r io.Reader) (map[string]string, error) {
+	sums := map[string]string{}
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) != 3 || strings.HasSuffix(fields[1], "/go.mod") {
+			continue
+		}
+		h, ok := strings.CutPrefix(fields[2], "h1:")
+		if !ok {
+			continue
+		}
+		sum, err := base64.StdEncoding.DecodeString(h)
+		if err != nil || len(sum) != 32 {
+			continue
+		}
+		sums[fields[0]+" "+fields[1]] = hex.EncodeToString(sum)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read go.sum: %w", err)
+	}
+	return sums, nil
+}
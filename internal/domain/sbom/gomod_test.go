@@ -0,0 +1,71 @@
+package sbom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const goModTestDoc = `module example.com/app
+
+go 1.24
+
+require (
+	github.com/labstack/echo/v4 v4.13.4
+	github.com/google/uuid v1.6.0
+	github.com/old/lib v1.0.0
+	example.com/local v0.1.0
+	golang.org/x/mod v0.21.0 // indirect
+)
+
+replace github.com/old/lib => github.com/new/lib v1.2.0
+
+replace example.com/local => ../local
+`
+
+const goSumTestDoc = `github.com/google/uuid v1.6.0 h1:NIvaJDMOsjHA8n1jAhLSgzrAzy1Hgr+hNrb57e+94F0=
+github.com/google/uuid v1.6.0/go.mod h1:TIyPZe4MgqvfeYDBFedMoGGpEw/LqOeaOT+nhxU+yHo=
+golang.org/x/mod v0.21.0 h1:vvrHzRwRfVKSiLrG+d4FMl/Qi4ukBCE6kZlTUkDYRT0=
+github.com/new/lib v1.2.0 h1:invalid
+`
+
+func TestParseGoMod(t *testing.T) {
+	bom, err := ParseGoMod(strings.NewReader(goModTestDoc), strings.NewReader(goSumTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "gomod", bom.Format)
+	require.Equal(t, "example.com/app", bom.Name)
+	require.Len(t, bom.Packages, 4)
+
+	echo := bom.Packages[0]
+	require.Equal(t, "github.com/labstack/echo/v4", echo.Name)
+	require.Equal(t, "v4.13.4", echo.Version)
+	require.Equal(t, "pkg:golang/github.com/labstack/echo/v4@v4.13.4", echo.Purl)
+	require.True(t, echo.Direct)
+	require.Empty(t, echo.SHA256)
+
+	uuid := bom.Packages[1]
+	require.Equal(t, "348bda24330eb231c0f27d630212d2833ac0cf2d4782bfa136b6f9edefbde05d", uuid.SHA256)
+
+	replaced := bom.Packages[2]
+	require.Equal(t, "github.com/new/lib", replaced.Name)
+	require.Equal(t, "v1.2.0", replaced.Version)
+	require.Empty(t, replaced.SHA256)
+
+	mod := bom.Packages[3]
+	require.Equal(t, "golang.org/x/mod", mod.Name)
+	require.False(t, mod.Direct)
+	require.Len(t, mod.SHA256, 64)
+}
+
+func TestParseGoMod_WithoutSum(t *testing.T) {
+	bom, err := ParseGoMod(strings.NewReader("module m\n\nrequire rsc.io/quote v1.5.2\n"), nil)
+	require.NoError(t, err)
+	require.Len(t, bom.Packages, 1)
+	require.Equal(t, "pkg:golang/rsc.io/quote@v1.5.2", bom.Packages[0].Purl)
+}
+
+func TestParseGoMod_Invalid(t *testing.T) {
+	_, err := ParseGoMod(strings.NewReader("require ("), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,300 @@
+package sbom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// mavenScopeUsageRoles は Maven のスコープから推定する利用形態。
+// provided は実行環境 (アプリケーションサーバ等) が提供するため RUNTIME_REQUIRED、
+// compile / runtime は成果物に同梱されるため BUNDLED_BINARY とする。
+var mavenScopeUsageRoles = map[string]string{
+	"compile":  "BUNDLED_BINARY",
+	"runtime":  "BUNDLED_BINARY",
+	"provided": "RUNTIME_REQUIRED",
+	"system":   "RUNTIME_REQUIRED",
+	"test":     "TEST_ONLY",
+}
+
+// mavenScopeRank は同じアーティファクトが複数のスコープに現れた場合に採用する優先順位 (大きいほど優先)。
+var mavenScopeRank = map[string]int{
+	"compile":  4,
+	"runtime":  3,
+	"provided": 2,
+	"system":   2,
+	"test":     1,
+}
+
+// ParseMaven は Java の依存一覧を読み込む。内容から次の形式を判別する。
+//   - pom.xml: dependencies に明示されたバージョン (${...} のプロパティは同一ファイル内のみ解決)
+//   - Gradle dependencies.lock (Nebula 形式の JSON) / gradle.lockfile
+//   - mvn dependency:list の出力
+//
+// 各アーティファクトを pkg:maven の purl で表し、スコープ (Gradle は構成名) から利用形態を推定する。
+func ParseMaven(r io.Reader) (*BOM, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read dependency list: %w", err)
+	}
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return parsePom(data)
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return parseNebulaLock(data)
+	case gradleLockLine.Match(firstContentLine(trimmed)):
+		return parseGradleLockfile(data)
+	}
+	return parseMavenDependencyList(data)
+}
+
+// mavenDeps は読み込んだアーティファクトを出現順に保持し、重複時はより広いスコープを採用する。
+type mavenDeps struct {
+	order []string
+	pkgs  map[string]*mavenDep
+}
+
+type mavenDep struct {
+	group, artifact, version, scope string
+	direct                          bool
+}
+
+func (d *mavenDeps) add(group, artifact, version, scope string, direct bool) {
+	if d.pkgs == nil {
+		d.pkgs = map[string]*mavenDep{}
+	}
+	scope = strings.ToLower(scope)
+	if scope == "" {
+		scope = "compile"
+	}
+	key := group + ":" + artifact + ":" + version
+	if cur, ok := d.pkgs[key]; ok {
+		if mavenScopeRank[scope] > mavenScopeRank[cur.scope] {
+			cur.scope = scope
+		}
+		cur.direct = cur.direct || direct
+		return
+	}
+	d.order = append(d.order, key)
+	d.pkgs[key] = &mavenDep{group: group, artifact: artifact, version: version, scope: scope, direct: direct}
+}
+
+func (d *mavenDeps) bom(format, name string) *BOM {
+	bom := &BOM{Format: format, Name: name}
+	for _, key := range d.order {
+		dep := d.pkgs[key]
+		role, ok := mavenScopeUsageRoles[dep.scope]
+		if !ok {
+			role = mavenScopeUsageRoles["compile"]
+		}
+		bom.Packages = append(bom.Packages, Package{
+			Ref:       key,
+			Name:      dep.group + ":" + dep.artifact,
+			Version:   dep.version,
+			Purl:      purl("maven", dep.group, dep.artifact, dep.version),
+			Direct:    dep.direct,
+			UsageRole: role,
+		})
+	}
+	return bom
+}
+
+// parseMavenDependencyList は mvn dependency:list の出力 (group:artifact:type[:classifier]:version:scope) を読み込む。
+// 推移的な依存も区別なく列挙されるため、全て直接依存とみなす。
+func parseMavenDependencyList(data []byte) (*BOM, error) {
+	var deps mavenDeps
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		line := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(sc.Text()), "[INFO]"))
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		parts := strings.Split(fields[0], ":")
+		switch len(parts) {
+		case 5:
+			deps.add(parts[0], parts[1], parts[3], parts[4], true)
+		case 6:
+			deps.add(parts[0], parts[1], parts[4], parts[5], true)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read dependency list: %w", err)
+	}
+	if len(deps.order) == 0 {
+		return nil, fmt.Errorf("no dependency found in mvn dependency:list output")
+	}
+	return deps.bom("maven-dependency-list", ""), nil
+}
+
+// gradleLockLine は gradle.lockfile の 1 行 (group:artifact:version=構成名,...) に一致する。
+var gradleLockLine = regexp.MustCompile(`^[^\s:=]+:[^\s:=]+:[^\s:=]+=`)
+
+// firstContentLine はコメント (#) 以外の最初の行を返す。
+func firstContentLine(data []byte) []byte {
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 && line[0] != '#' {
+			return line
+		}
+	}
+	return nil
+}
+
+// gradleScope は Gradle の構成名を Maven のスコープに読み替える。
+// compileClasspath のみに現れる依存は実行時に含まれないため provided とする。
+func gradleScope(conf string) string {
+	c := strings.ToLower(conf)
+	switch {
+	case strings.Contains(c, "test"):
+		return "test"
+	case strings.Contains(c, "runtime"):
+		return "runtime"
+	case strings.Contains(c, "compileonly"), strings.Contains(c, "annotationprocessor"), strings.Contains(c, "compileclasspath"):
+		return "provided"
+	}
+	return "compile"
+}
+
+// parseGradleLockfile は Gradle の依存ロック (gradle.lockfile) を読み込む。
+// 直接依存か否かは記録されないため、全て直接依存とみなす。
+func parseGradleLockfile(data []byte) (*BOM, error) {
+	var deps mavenDeps
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		coord, confs, ok := strings.Cut(line, "=")
+		if !ok || strings.HasPrefix(line, "#") || coord == "empty" {
+			continue
+		}
+		parts := strings.Split(coord, ":")
+		if len(parts) != 3 {
+			continue
+		}
+		for _, conf := range strings.Split(confs, ",") {
+			deps.add(parts[0], parts[1], parts[2], gradleScope(conf), true)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read gradle.lockfile: %w", err)
+	}
+	return deps.bom("gradle-lock", ""), nil
+}
+
+// parseNebulaLock は Nebula gradle-dependency-lock-plugin の dependencies.lock を読み込む。
+// requested を持つものを直接依存とし、サブプロジェクト (project: true) は除外する。
+func parseNebulaLock(data []byte) (*BOM, error) {
+	var doc map[string]map[string]struct {
+		Locked    string `json:"locked"`
+		Requested string `json:"requested"`
+		Project   bool   `json:"project"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode dependencies.lock: %w", err)
+	}
+	confs := make([]string, 0, len(doc))
+	for conf := range doc {
+		confs = append(confs, conf)
+	}
+	sort.Strings(confs)
+
+	var deps mavenDeps
+	for _, conf := range confs {
+		coords := make([]string, 0, len(doc[conf]))
+		for coord := range doc[conf] {
+			coords = append(coords, coord)
+		}
+		sort.Strings(coords)
+		for _, coord := range coords {
+			e := doc[conf][coord]
+			group, artifact, ok := strings.Cut(coord, ":")
+			if !ok || e.Project || e.Locked == "" {
+				continue
+			}
+			deps.add(group, artifact, e.Locked, gradleScope(conf), e.Requested != "")
+		}
+	}
+	return deps.bom("gradle-lock", ""), nil
+}
+
+type pomProject struct {
+	GroupID    string `xml:"groupId"`
+	ArtifactID string `xml:"artifactId"`
+	Version    string `xml:"version"`
+	Parent     struct {
+		GroupID string `xml:"groupId"`
+		Version string `xml:"version"`
+	} `xml:"parent"`
+	Properties struct {
+		Entries []struct {
+			XMLName xml.Name
+			Value   string `xml:",chardata"`
+		} `xml:",any"`
+	} `xml:"properties"`
+	Dependencies []struct {
+		GroupID    string `xml:"groupId"`
+		ArtifactID string `xml:"artifactId"`
+		Version    string `xml:"version"`
+		Scope      string `xml:"scope"`
+	} `xml:"dependencies>dependency"`
+}
+
+// pomProperty は ${name} 形式の参照に一致する。
+var pomProperty = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// parsePom は pom.xml の dependencies を読み込む (dependencyManagement は対象外)。
+// 同一ファイル内で解決できないプロパティ参照やバージョン範囲はバージョン未指定として扱う。
+func parsePom(data []byte) (*BOM, error) {
+	var p pomProject
+	if err := xml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("decode pom.xml: %w", err)
+	}
+	props := map[string]string{
+		"project.groupId":        firstNonEmpty(p.GroupID, p.Parent.GroupID),
+		"project.version":        firstNonEmpty(p.Version, p.Parent.Version),
+		"project.parent.version": p.Parent.Version,
+	}
+	for _, e := range p.Properties.Entries {
+		props[e.XMLName.Local] = strings.TrimSpace(e.Value)
+	}
+	resolve := func(s string) string {
+		s = strings.TrimSpace(s)
+		for i := 0; i < 10 && strings.Contains(s, "${"); i++ {
+			s = pomProperty.ReplaceAllStringFunc(s, func(ref string) string {
+				if v, ok := props[ref[2:len(ref)-1]]; ok {
+					return v
+				}
+				return ref
+			})
+		}
+		if strings.Contains(s, "${") || strings.HasPrefix(s, "[") || strings.HasPrefix(s, "(") {
+			return ""
+		}
+		return s
+	}
+
+	var deps mavenDeps
+	for _, d := range p.Dependencies {
+		if strings.EqualFold(d.Scope, "import") {
+			continue
+		}
+		deps.add(resolve(d.GroupID), resolve(d.ArtifactID), resolve(d.Version), d.Scope, true)
+	}
+	return deps.bom("maven-pom", p.ArtifactID), nil
+}
+
+func firstNonEmpty(ss ...string) string {
+	for _, s := range ss {
+		if s != "" {
+			return s
+		}
+	}
+	return ""
+}
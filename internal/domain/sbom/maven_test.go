@@ -0,0 +1,171 @@
+package sbom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const mvnDependencyListTestDoc = `[INFO] Scanning for projects...
+[INFO] --- maven-dependency-plugin:3.6.0:list (default-cli) @ app ---
+[INFO]
+[INFO] The following files have been resolved:
+[INFO]    org.springframework:spring-core:jar:5.3.20:compile -- module spring.core [auto]
+[INFO]    javax.servlet:javax.servlet-api:jar:4.0.1:provided
+[INFO]    junit:junit:jar:4.13.2:test
+[INFO]    io.netty:netty-transport-native-epoll:jar:linux-x86_64:4.1.94.Final:runtime
+[INFO]    junit:junit:jar:4.13.2:compile
+[INFO] BUILD SUCCESS
+`
+
+func TestParseMaven_DependencyList(t *testing.T) {
+	bom, err := ParseMaven(strings.NewReader(mvnDependencyListTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "maven-dependency-list", bom.Format)
+	require.Len(t, bom.Packages, 4)
+
+	spring := bom.Packages[0]
+	require.Equal(t, "org.springframework:spring-core", spring.Name)
+	require.Equal(t, "5.3.20", spring.Version)
+	require.Equal(t, "pkg:maven/org.springframework/spring-core@5.3.20", spring.Purl)
+	require.Equal(t, "BUNDLED_BINARY", spring.UsageRole)
+	require.True(t, spring.Direct)
+
+	require.Equal(t, "RUNTIME_REQUIRED", bom.Packages[1].UsageRole)
+	// test と compile の両方に現れる場合は compile を採用する
+	require.Equal(t, "BUNDLED_BINARY", bom.Packages[2].UsageRole)
+	netty := bom.Packages[3]
+	require.Equal(t, "4.1.94.Final", netty.Version)
+	require.Equal(t, "BUNDLED_BINARY", netty.UsageRole)
+}
+
+func TestParseMaven_DependencyListEmpty(t *testing.T) {
+	_, err := ParseMaven(strings.NewReader("[INFO] BUILD SUCCESS\n"))
+	require.Error(t, err)
+}
+
+const gradleLockfileTestDoc = `# This is a Gradle generated file for dependency locking.
+com.google.guava:guava:31.1-jre=compileClasspath,runtimeClasspath
+org.projectlombok:lombok:1.18.28=annotationProcessor,compileClasspath
+org.junit.jupiter:junit-jupiter-api:5.9.3=testCompileClasspath,testRuntimeClasspath
+empty=
+`
+
+func TestParseMaven_GradleLockfile(t *testing.T) {
+	bom, err := ParseMaven(strings.NewReader(gradleLockfileTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "gradle-lock", bom.Format)
+	require.Len(t, bom.Packages, 3)
+	require.Equal(t, "pkg:maven/com.google.guava/guava@31.1-jre", bom.Packages[0].Purl)
+	require.Equal(t, "BUNDLED_BINARY", bom.Packages[0].UsageRole)
+	require.Equal(t, "RUNTIME_REQUIRED", bom.Packages[1].UsageRole)
+	require.Equal(t, "TEST_ONLY", bom.Packages[2].UsageRole)
+}
+
+const nebulaLockTestDoc = `{
+  "compileClasspath": {
+    "com.google.guava:guava": {"locked": "31.1-jre", "requested": "31.+"},
+    "com.google.guava:failureaccess": {"locked": "1.0.1", "transitive": ["com.google.guava:guava"]},
+    "com.example:shared": {"project": true}
+  },
+  "runtimeClasspath": {
+    "com.google.guava:guava": {"locked": "31.1-jre", "requested": "31.+"},
+    "com.google.guava:failureaccess": {"locked": "1.0.1", "transitive": ["com.google.guava:guava"]}
+  },
+  "testRuntimeClasspath": {
+    "junit:junit": {"locked": "4.13.2", "requested": "4.13.2"}
+  }
+}`
+
+func TestParseMaven_NebulaLock(t *testing.T) {
+	bom, err := ParseMaven(strings.NewReader(nebulaLockTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "gradle-lock", bom.Format)
+	require.Len(t, bom.Packages, 3)
+
+	byName := map[string]Package{}
+	for _, p := range bom.Packages {
+		byName[p.Name] = p
+	}
+	require.True(t, byName["com.google.guava:guava"].Direct)
+	require.Equal(t, "BUNDLED_BINARY", byName["com.google.guava:guava"].UsageRole)
+	require.False(t, byName["com.google.guava:failureaccess"].Direct)
+	require.Equal(t, "BUNDLED_BINARY", byName["com.google.guava:failureaccess"].UsageRole)
+	require.Equal(t, "TEST_ONLY", byName["junit:junit"].UsageRole)
+}
+
+const pomTestDoc = `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <modelVersion>4.0.0</modelVersion>
+  <groupId>com.example</groupId>
+  <artifactId>app</artifactId>
+  <version>1.2.0</version>
+  <properties>
+    <spring.version>5.3.20</spring.version>
+  </properties>
+  <dependencyManagement>
+    <dependencies>
+      <dependency>
+        <groupId>org.springframework</groupId>
+        <artifactId>spring-framework-bom</artifactId>
+        <version>5.3.20</version>
+        <type>pom</type>
+        <scope>import</scope>
+      </dependency>
+    </dependencies>
+  </dependencyManagement>
+  <dependencies>
+    <dependency>
+      <groupId>org.springframework</groupId>
+      <artifactId>spring-core</artifactId>
+      <version>${spring.version}</version>
+    </dependency>
+    <dependency>
+      <groupId>${project.groupId}</groupId>
+      <artifactId>shared</artifactId>
+      <version>${project.version}</version>
+      <scope>provided</scope>
+    </dependency>
+    <dependency>
+      <groupId>junit</groupId>
+      <artifactId>junit</artifactId>
+      <version>4.13.2</version>
+      <scope>test</scope>
+    </dependency>
+    <dependency>
+      <groupId>org.slf4j</groupId>
+      <artifactId>slf4j-api</artifactId>
+      <version>${slf4j.version}</version>
+    </dependency>
+    <dependency>
+      <groupId>com.fasterxml.jackson.core</groupId>
+      <artifactId>jackson-databind</artifactId>
+    </dependency>
+  </dependencies>
+</project>`
+
+func TestParseMaven_Pom(t *testing.T) {
+	bom, err := ParseMaven(strings.NewReader(pomTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "maven-pom", bom.Format)
+	require.Equal(t, "app", bom.Name)
+	require.Len(t, bom.Packages, 5)
+
+	require.Equal(t, "pkg:maven/org.springframework/spring-core@5.3.20", bom.Packages[0].Purl)
+	require.Equal(t, "BUNDLED_BINARY", bom.Packages[0].UsageRole)
+	require.Equal(t, "com.example:shared", bom.Packages[1].Name)
+	require.Equal(t, "1.2.0", bom.Packages[1].Version)
+	require.Equal(t, "RUNTIME_REQUIRED", bom.Packages[1].UsageRole)
+	require.Equal(t, "TEST_ONLY", bom.Packages[2].UsageRole)
+	// 解決できないプロパティ・バージョン未指定は取り込み時にスキップされる
+	require.Empty(t, bom.Packages[3].Version)
+	require.Empty(t, bom.Packages[4].Version)
+}
+
+func TestParseMaven_Invalid(t *testing.T) {
+	_, err := ParseMaven(strings.NewReader("<project><dependencies>"))
+	require.Error(t, err)
+	_, err = ParseMaven(strings.NewReader("{"))
+	require.Error(t, err)
+}
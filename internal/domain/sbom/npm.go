@@ -0,0 +1,393 @@
+package sbom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnsupportedNpmLock は package-lock.json v2/v3 以外の JSON 文書を読み込んだ場合に返す。
+var ErrUnsupportedNpmLock = errors.New("unsupported npm lockfile")
+
+// npm の利用形態。devDependencies は納品物に含まれないため DEV_ONLY、
+// それ以外はバンドラ等でソースごと成果物に取り込まれるため BUNDLED_SOURCE とする。
+const (
+	npmUsageRole    = "BUNDLED_SOURCE"
+	npmDevUsageRole = "DEV_ONLY"
+)
+
+// npmManifest は package.json およびロックファイルのパッケージ情報のうち取り込みに必要な項目を表す。
+type npmManifest struct {
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+}
+
+// runtimeDependencies は devDependencies 以外の依存を返す。
+func (m *npmManifest) runtimeDependencies() map[string]string {
+	deps := map[string]string{}
+	for _, d := range []map[string]string{m.PeerDependencies, m.OptionalDependencies, m.Dependencies} {
+		for name, rng := range d {
+			deps[name] = rng
+		}
+	}
+	return deps
+}
+
+type npmLockPackage struct {
+	npmManifest
+	Resolved  string          `json:"resolved"`
+	Integrity string          `json:"integrity"`
+	License   json.RawMessage `json:"license"`
+	Dev       bool            `json:"dev"`
+	// DevOptional は devDependencies の optionalDependencies としてのみ到達するパッケージに付く。
+	DevOptional bool `json:"devOptional"`
+	Link        bool `json:"link"`
+}
+
+// ParseNpmLock は package-lock.json (lockfileVersion 2/3) または yarn.lock (v1 / Berry) を読み込む。
+// JSON 文書は package-lock.json、それ以外は yarn.lock とみなす。
+// manifest (package.json、nil 可) は yarn.lock の直接依存と devDependencies の判別に用いる。
+// devDependencies からのみ到達するパッケージの利用形態を DEV_ONLY、それ以外を BUNDLED_SOURCE とし、
+// integrity に含まれる sha256 / sha512 を SHA256 / SHA512 に設定する。
+func ParseNpmLock(lock, manifest io.Reader) (*BOM, error) {
+	data, err := io.ReadAll(lock)
+	if err != nil {
+		return nil, fmt.Errorf("read lockfile: %w", err)
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return parsePackageLock(data)
+	}
+	var m *npmManifest
+	if manifest != nil {
+		m = &npmManifest{}
+		if err := json.NewDecoder(manifest).Decode(m); err != nil {
+			return nil, fmt.Errorf("decode package.json: %w", err)
+		}
+	}
+	return parseYarnLock(data, m)
+}
+
+// parsePackageLock は package-lock.json の packages を読み込む。
+// ルート ("") の依存に含まれる最上位の node_modules を直接依存とし、dev / devOptional フラグで DEV_ONLY を判別する。
+// 同じ名前・バージョンが複数の node_modules に配置されている場合は 1 件にまとめ、
+// いずれかが直接依存であれば直接依存、全てが dev の場合のみ DEV_ONLY とする。
+// ワークスペースへのリンクは除外する。
+func parsePackageLock(data []byte) (*BOM, error) {
+	var doc struct {
+		Name            string                    `json:"name"`
+		LockfileVersion int                       `json:"lockfileVersion"`
+		Packages        map[string]npmLockPackage `json:"packages"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode package-lock.json: %w", err)
+	}
+	if doc.LockfileVersion < 2 || doc.Packages == nil {
+		return nil, fmt.Errorf("%w: lockfileVersion %d", ErrUnsupportedNpmLock, doc.LockfileVersion)
+	}
+
+	root := doc.Packages[""]
+	direct := map[string]bool{}
+	for name := range root.runtimeDependencies() {
+		direct[name] = true
+	}
+	for name := range root.DevDependencies {
+		direct[name] = true
+	}
+	keys := make([]string, 0, len(doc.Packages))
+	for k := range doc.Packages {
+		keys = append(keys, k)
+	}
+	// 浅い配置を先に処理し、最上位のパスを Ref とする
+	sort.Slice(keys, func(i, j int) bool {
+		di, dj := strings.Count(keys[i], "node_modules/"), strings.Count(keys[j], "node_modules/")
+		if di != dj {
+			return di < dj
+		}
+		return keys[i] < keys[j]
+	})
+
+	bom := &BOM{Format: "npm-package-lock", Name: doc.Name}
+	index := map[string]int{}
+	for _, key := range keys {
+		e := doc.Packages[key]
+		idx := strings.LastIndex(key, "node_modules/")
+		if idx < 0 || e.Link || e.Version == "" {
+			continue
+		}
+		alias := key[idx+len("node_modules/"):]
+		name := alias
+		if e.Name != "" {
+			name = e.Name
+		}
+		isDirect := idx == 0 && direct[alias]
+		dev := e.Dev || e.DevOptional
+		sha256, sha512 := sriHashes(e.Integrity)
+		if i, ok := index[name+"@"+e.Version]; ok {
+			p := &bom.Packages[i]
+			p.Direct = p.Direct || isDirect
+			if !dev {
+				p.UsageRole = npmUsageRole
+			}
+			if p.SHA256 == "" {
+				p.SHA256 = sha256
+			}
+			if p.SHA512 == "" {
+				p.SHA512 = sha512
+			}
+			continue
+		}
+		pkg := npmPackage(name, e.Version)
+		pkg.Ref = key
+		pkg.SHA256, pkg.SHA512 = sha256, sha512
+		pkg.LicenseDeclared = npmLicense(e.License)
+		pkg.Direct = isDirect
+		pkg.UsageRole = npmUsageRole
+		if dev {
+			pkg.UsageRole = npmDevUsageRole
+		}
+		index[name+"@"+e.Version] = len(bom.Packages)
+		bom.Packages = append(bom.Packages, pkg)
+	}
+	return bom, nil
+}
+
+// yarnEntry は yarn.lock の 1 エントリを表す。
+type yarnEntry struct {
+	specs     []string
+	name      string
+	version   string
+	integrity string
+	deps      []string
+}
+
+// parseYarnLock は yarn.lock を読み込む。m が nil の場合は全パッケージを直接依存・BUNDLED_SOURCE とする。
+func parseYarnLock(data []byte, m *npmManifest) (*BOM, error) {
+	var entries []yarnEntry
+	var err error
+	berry := bytes.Contains(data, []byte("\n__metadata:")) || bytes.HasPrefix(data, []byte("__metadata:"))
+	if berry {
+		entries, err = parseYarnBerry(data)
+	} else {
+		entries, err = parseYarnClassic(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bySpec := map[string]int{}
+	for i, e := range entries {
+		for _, s := range e.specs {
+			bySpec[s] = i
+		}
+	}
+	// package.json の依存範囲を lockfile のキー形式 (Berry は npm: プロトコル付き) に揃える
+	rootSpecs := func(deps map[string]string) []string {
+		var specs []string
+		for name, rng := range deps {
+			if berry && !strings.Contains(rng, ":") {
+				rng = "npm:" + rng
+			}
+			specs = append(specs, name+"@"+rng)
+		}
+		return specs
+	}
+	reach := func(specs []string) map[int]bool {
+		seen := map[int]bool{}
+		stack := append([]string(nil), specs...)
+		for len(stack) > 0 {
+			s := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			i, ok := bySpec[s]
+			if !ok || seen[i] {
+				continue
+			}
+			seen[i] = true
+			stack = append(stack, entries[i].deps...)
+		}
+		return seen
+	}
+
+	bom := &BOM{Format: "yarn-lock"}
+	var prod, dev, direct map[int]bool
+	if m != nil {
+		bom.Name = m.Name
+		runtime := rootSpecs(m.runtimeDependencies())
+		devSpecs := rootSpecs(m.DevDependencies)
+		prod, dev = reach(runtime), reach(devSpecs)
+		direct = map[int]bool{}
+		for _, s := range append(runtime, devSpecs...) {
+			if i, ok := bySpec[s]; ok {
+				direct[i] = true
+			}
+		}
+	}
+	for i, e := range entries {
+		pkg := npmPackage(e.name, e.version)
+		pkg.Ref = e.name + "@" + e.version
+		pkg.SHA256, pkg.SHA512 = sriHashes(e.integrity)
+		pkg.Direct = m == nil || direct[i]
+		pkg.UsageRole = npmUsageRole
+		if m != nil && dev[i] && !prod[i] {
+			pkg.UsageRole = npmDevUsageRole
+		}
+		bom.Packages = append(bom.Packages, pkg)
+	}
+	return bom, nil
+}
+
+// parseYarnClassic は yarn.lock v1 の独自形式を読み込む。
+func parseYarnClassic(data []byte) ([]yarnEntry, error) {
+	var entries []yarnEntry
+	var cur *yarnEntry
+	inDeps := false
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), " \r")
+		text := strings.TrimSpace(line)
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		switch indent := len(line) - len(strings.TrimLeft(line, " ")); {
+		case indent == 0:
+			if !strings.HasSuffix(text, ":") {
+				return nil, fmt.Errorf("parse yarn.lock: unexpected line %q", text)
+			}
+			entries = append(entries, yarnEntry{})
+			cur = &entries[len(entries)-1]
+			for _, s := range strings.Split(strings.TrimSuffix(text, ":"), ",") {
+				cur.specs = append(cur.specs, strings.Trim(strings.TrimSpace(s), `"`))
+			}
+			cur.name = yarnSpecName(cur.specs[0])
+			inDeps = false
+		case cur == nil:
+			return nil, fmt.Errorf("parse yarn.lock: unexpected line %q", text)
+		case indent == 2:
+			inDeps = text == "dependencies:" || text == "optionalDependencies:"
+			key, val, _ := strings.Cut(text, " ")
+			val = strings.Trim(val, `"`)
+			switch key {
+			case "version":
+				cur.version = val
+			case "integrity":
+				cur.integrity = val
+			}
+		case inDeps:
+			name, rng, _ := strings.Cut(text, " ")
+			cur.deps = append(cur.deps, strings.Trim(name, `"`)+"@"+strings.Trim(rng, `"`))
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read yarn.lock: %w", err)
+	}
+	return entries, nil
+}
+
+// parseYarnBerry は yarn.lock v2 以降 (YAML 形式) を読み込む。ワークスペース等のローカル参照は除外する。
+func parseYarnBerry(data []byte) ([]yarnEntry, error) {
+	var doc map[string]struct {
+		Version              string            `yaml:"version"`
+		Resolution           string            `yaml:"resolution"`
+		Dependencies         map[string]string `yaml:"dependencies"`
+		OptionalDependencies map[string]string `yaml:"optionalDependencies"`
+		LinkType             string            `yaml:"linkType"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse yarn.lock: %w", err)
+	}
+	keys := make([]string, 0, len(doc))
+	for k := range doc {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var entries []yarnEntry
+	for _, key := range keys {
+		v := doc[key]
+		if key == "__metadata" || v.LinkType == "soft" || v.Version == "" {
+			continue
+		}
+		e := yarnEntry{name: yarnSpecName(v.Resolution), version: v.Version}
+		for _, s := range strings.Split(key, ",") {
+			e.specs = append(e.specs, strings.TrimSpace(s))
+		}
+		for _, deps := range []map[string]string{v.Dependencies, v.OptionalDependencies} {
+			for name, rng := range deps {
+				e.deps = append(e.deps, name+"@"+rng)
+			}
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// yarnSpecName は "name@range" 形式の指定からパッケージ名を取り出す。
+func yarnSpecName(spec string) string {
+	if i := strings.Index(spec[min(1, len(spec)):], "@"); i >= 0 {
+		return spec[:i+1]
+	}
+	return spec
+}
+
+// npmPackage は npm パッケージ名 (@scope/name 可) とバージョンからパッケージを作る。
+func npmPackage(name, version string) Package {
+	ns, n := "", name
+	if strings.HasPrefix(name, "@") {
+		if scope, rest, ok := strings.Cut(name, "/"); ok {
+			ns, n = scope, rest
+		}
+	}
+	return Package{Name: name, Version: version, Purl: purl("npm", ns, n, version)}
+}
+
+// npmLicense は license 項目 (文字列または {"type": ...}) を読み取る。
+func npmLicense(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return strings.TrimSpace(s)
+	}
+	var obj struct {
+		Type string `json:"type"`
+	}
+	if json.Unmarshal(raw, &obj) == nil {
+		return strings.TrimSpace(obj.Type)
+	}
+	return ""
+}
+
+// sriHashes は Subresource Integrity 形式 (sha512-... sha256-...) から SHA-256 と SHA-512 を 16 進表記で返す。
+// 含まれないアルゴリズムは空文字。
+func sriHashes(integrity string) (sha256, sha512 string) {
+	for _, f := range strings.Fields(integrity) {
+		alg, h, ok := strings.Cut(f, "-")
+		if !ok {
+			continue
+		}
+		sum, err := base64.StdEncoding.DecodeString(h)
+		if err != nil {
+			continue
+		}
+		switch {
+		case alg == "sha256" && len(sum) == 32 && sha256 == "":
+			sha256 = hex.EncodeToString(sum)
+		case alg == "sha512" && len(sum) == 64 && sha512 == "":
+			sha512 = hex.EncodeToString(sum)
+		}
+	}
+	return sha256, sha512
+}
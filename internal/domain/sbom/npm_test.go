@@ -0,0 +1,230 @@
+package sbom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const packageLockTestDoc = `{
+  "name": "web-app",
+  "version": "1.0.0",
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "web-app", "version": "1.0.0", "dependencies": {"react": "^18.2.0", "ui": "file:packages/ui"}, "devDependencies": {"@types/node": "^20.0.0"}},
+    "node_modules/react": {"version": "18.2.0", "resolved": "https://registry.npmjs.org/react/-/react-18.2.0.tgz", "integrity": "sha512-aaaa sha256-NIvaJDMOsjHA8n1jAhLSgzrAzy1Hgr+hNrb57e+94F0=", "license": "MIT"},
+    "node_modules/loose-envify": {"version": "1.4.0", "license": {"type": "MIT"}},
+    "node_modules/@types/node": {"version": "20.1.0", "dev": true, "license": "MIT"},
+    "node_modules/ui": {"resolved": "packages/ui", "link": true},
+    "node_modules/react/node_modules/scheduler": {"version": "0.23.0", "integrity": "sha512-bbbb"}
+  }
+}`
+
+func TestParseNpmLock_PackageLock(t *testing.T) {
+	bom, err := ParseNpmLock(strings.NewReader(packageLockTestDoc), nil)
+	require.NoError(t, err)
+	require.Equal(t, "npm-package-lock", bom.Format)
+	require.Equal(t, "web-app", bom.Name)
+	require.Len(t, bom.Packages, 4)
+
+	byName := map[string]Package{}
+	for _, p := range bom.Packages {
+		byName[p.Name] = p
+	}
+	react := byName["react"]
+	require.Equal(t, "pkg:npm/react@18.2.0", react.Purl)
+	require.Equal(t, "348bda24330eb231c0f27d630212d2833ac0cf2d4782bfa136b6f9edefbde05d", react.SHA256)
+	require.Equal(t, "MIT", react.LicenseDeclared)
+	require.Equal(t, "BUNDLED_SOURCE", react.UsageRole)
+	require.True(t, react.Direct)
+
+	types := byName["@types/node"]
+	require.Equal(t, "pkg:npm/%40types/node@20.1.0", types.Purl)
+	require.Equal(t, "DEV_ONLY", types.UsageRole)
+	require.True(t, types.Direct)
+
+	require.Equal(t, "MIT", byName["loose-envify"].LicenseDeclared)
+	require.False(t, byName["loose-envify"].Direct)
+	scheduler := byName["scheduler"]
+	require.False(t, scheduler.Direct)
+	require.Empty(t, scheduler.SHA256)
+	require.Equal(t, "node_modules/react/node_modules/scheduler", scheduler.Ref)
+}
+
+func TestParseNpmLock_PackageLockDuplicates(t *testing.T) {
+	// 入れ子の配置はキー順で最上位より先に並ぶ
+	doc := `{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "web-app", "dependencies": {"left-pad": "^1.3.0"}, "devDependencies": {"jest": "^29.0.0"}},
+    "node_modules/jest": {"version": "29.7.0", "dev": true},
+    "node_modules/jest/node_modules/left-pad": {"version": "1.3.0", "dev": true},
+    "node_modules/left-pad": {"version": "1.3.0", "integrity": "sha512-XRcglhh3p2lHAu4gFg75i5owZ3/uttIZh11iKj+W2fqc4Iu8OvwIHkDSftaw4gURo0WA2fT2oguwTa4HChLwJw=="},
+    "node_modules/fsevents": {"version": "2.3.3", "devOptional": true},
+    "node_modules/jest/node_modules/chalk": {"version": "4.1.2", "dev": true},
+    "node_modules/chalk": {"version": "4.1.2"}
+  }
+}`
+	bom, err := ParseNpmLock(strings.NewReader(doc), nil)
+	require.NoError(t, err)
+	require.Len(t, bom.Packages, 4)
+
+	byName := map[string]Package{}
+	for _, p := range bom.Packages {
+		byName[p.Name] = p
+	}
+	leftPad := byName["left-pad"]
+	require.Equal(t, "node_modules/left-pad", leftPad.Ref)
+	require.True(t, leftPad.Direct)
+	require.Equal(t, "BUNDLED_SOURCE", leftPad.UsageRole)
+	require.Equal(t, "5d1720961877a7694702ee20160ef98b9a30677feeb6d219875d622a3f96d9fa9ce08bbc3afc081e40d27ed6b0e20511a34580d9f4f6a20bb04dae070a12f027", leftPad.SHA512)
+	require.Empty(t, leftPad.SHA256)
+
+	require.Equal(t, "DEV_ONLY", byName["jest"].UsageRole)
+	require.Equal(t, "DEV_ONLY", byName["fsevents"].UsageRole)
+	// dev 以外の配置が 1 つでもあれば DEV_ONLY にしない
+	require.Equal(t, "BUNDLED_SOURCE", byName["chalk"].UsageRole)
+	require.False(t, byName["chalk"].Direct)
+}
+
+func TestParseNpmLock_UnsupportedVersion(t *testing.T) {
+	_, err := ParseNpmLock(strings.NewReader(`{"lockfileVersion": 1, "dependencies": {}}`), nil)
+	require.ErrorIs(t, err, ErrUnsupportedNpmLock)
+}
+
+const yarnClassicTestDoc = `# THIS IS AN AUTOGENERATED FILE. DO NOT EDIT THIS FILE DIRECTLY.
+# yarn lockfile v1
+
+
+"@babel/core@^7.0.0":
+  version "7.22.0"
+  resolved "https://registry.yarnpkg.com/@babel/core/-/core-7.22.0.tgz"
+  integrity sha512-cccc
+  dependencies:
+    semver "^6.3.0"
+
+js-tokens@^4.0.0:
+  version "4.0.0"
+  integrity sha256-NIvaJDMOsjHA8n1jAhLSgzrAzy1Hgr+hNrb57e+94F0=
+
+loose-envify@^1.1.0:
+  version "1.4.0"
+  dependencies:
+    js-tokens "^3.0.0 || ^4.0.0"
+
+react@^18.2.0:
+  version "18.2.0"
+  dependencies:
+    loose-envify "^1.1.0"
+
+semver@^6.3.0, semver@^6.0.0:
+  version "6.3.1"
+`
+
+const yarnManifest = `{"name": "web-app", "dependencies": {"react": "^18.2.0"}, "devDependencies": {"@babel/core": "^7.0.0"}}`
+
+func TestParseNpmLock_YarnClassic(t *testing.T) {
+	bom, err := ParseNpmLock(strings.NewReader(yarnClassicTestDoc), strings.NewReader(yarnManifest))
+	require.NoError(t, err)
+	require.Equal(t, "yarn-lock", bom.Format)
+	require.Equal(t, "web-app", bom.Name)
+	require.Len(t, bom.Packages, 5)
+
+	babel := bom.Packages[0]
+	require.Equal(t, "@babel/core", babel.Name)
+	require.Equal(t, "pkg:npm/%40babel/core@7.22.0", babel.Purl)
+	require.Equal(t, "DEV_ONLY", babel.UsageRole)
+	require.True(t, babel.Direct)
+
+	// js-tokens の依存範囲 "^3.0.0 || ^4.0.0" は lockfile のキーと一致しないため到達しない
+	require.Equal(t, "348bda24330eb231c0f27d630212d2833ac0cf2d4782bfa136b6f9edefbde05d", bom.Packages[1].SHA256)
+	require.Equal(t, "BUNDLED_SOURCE", bom.Packages[1].UsageRole)
+
+	require.Equal(t, "BUNDLED_SOURCE", bom.Packages[2].UsageRole)
+	require.False(t, bom.Packages[2].Direct)
+	require.True(t, bom.Packages[3].Direct)
+
+	semver := bom.Packages[4]
+	require.Equal(t, "semver@6.3.1", semver.Ref)
+	require.Equal(t, "DEV_ONLY", semver.UsageRole)
+	require.False(t, semver.Direct)
+}
+
+func TestParseNpmLock_YarnWithoutManifest(t *testing.T) {
+	bom, err := ParseNpmLock(strings.NewReader(yarnClassicTestDoc), nil)
+	require.NoError(t, err)
+	for _, p := range bom.Packages {
+		require.True(t, p.Direct)
+		require.Equal(t, "BUNDLED_SOURCE", p.UsageRole)
+	}
+}
+
+const yarnBerryTestDoc = `__metadata:
+  version: 6
+  cacheKey: 8
+
+"js-tokens@npm:^3.0.0 || ^4.0.0":
+  version: 4.0.0
+  resolution: "js-tokens@npm:4.0.0"
+  checksum: 8a95213a5a77deb6cbe94d86340e8d9ace2b93bc367790b260101d2f36a2eaf4e4e22d9fa9cf459b38af3a32fb4190e638024cf82ec95ef708680e405ea7
+  languageName: node
+  linkType: hard
+
+"loose-envify@npm:^1.1.0":
+  version: 1.4.0
+  resolution: "loose-envify@npm:1.4.0"
+  dependencies:
+    js-tokens: "npm:^3.0.0 || ^4.0.0"
+  languageName: node
+  linkType: hard
+
+"react@npm:^18.2.0":
+  version: 18.2.0
+  resolution: "react@npm:18.2.0"
+  dependencies:
+    loose-envify: "npm:^1.1.0"
+  languageName: node
+  linkType: hard
+
+"typescript@npm:^5.0.0":
+  version: 5.1.6
+  resolution: "typescript@npm:5.1.6"
+  languageName: node
+  linkType: hard
+
+"web-app@workspace:.":
+  version: 0.0.0-use.local
+  resolution: "web-app@workspace:."
+  languageName: unknown
+  linkType: soft
+`
+
+func TestParseNpmLock_YarnBerry(t *testing.T) {
+	manifest := `{"name": "web-app", "dependencies": {"react": "^18.2.0"}, "devDependencies": {"typescript": "^5.0.0"}}`
+	bom, err := ParseNpmLock(strings.NewReader(yarnBerryTestDoc), strings.NewReader(manifest))
+	require.NoError(t, err)
+	require.Len(t, bom.Packages, 4)
+
+	roles := map[string]string{}
+	for _, p := range bom.Packages {
+		roles[p.Name] = p.UsageRole
+		require.Empty(t, p.SHA256)
+	}
+	require.Equal(t, map[string]string{
+		"js-tokens":    "BUNDLED_SOURCE",
+		"loose-envify": "BUNDLED_SOURCE",
+		"react":        "BUNDLED_SOURCE",
+		"typescript":   "DEV_ONLY",
+	}, roles)
+	require.Equal(t, "pkg:npm/js-tokens@4.0.0", bom.Packages[0].Purl)
+	require.False(t, bom.Packages[0].Direct)
+	require.True(t, bom.Packages[2].Direct)
+}
+
+func TestParseNpmLock_Invalid(t *testing.T) {
+	_, err := ParseNpmLock(strings.NewReader("  version \"1.0.0\"\n"), nil)
+	require.Error(t, err)
+	_, err = ParseNpmLock(strings.NewReader(yarnClassicTestDoc), strings.NewReader("{"))
+	require.Error(t, err)
+}
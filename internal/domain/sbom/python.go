@@ -0,0 +1,268 @@
+package sbom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ParsePython は Python の依存ロックを読み込む。内容から次の形式を判別する。
+//   - Pipfile.lock (JSON): develop セクションは DEV_ONLY とする
+//   - poetry.lock: category = "dev" のものは DEV_ONLY とする
+//   - requirements.txt: == で固定されたもののみバージョンを持つ (pip-compile の "# via" 注記から直接依存を判別する)
+//
+// 各パッケージを pkg:pypi の purl で表し、--hash / hashes / files に含まれる sha256 を SHA256 に設定する。
+// 配布ファイルごとに複数のハッシュがある場合は、sdist があればそのハッシュ、なければ先頭のものを採用する。
+func ParsePython(r io.Reader) (*BOM, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read python lockfile: %w", err)
+	}
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return parsePipfileLock(data)
+	case bytes.Contains(data, []byte("[[package]]")):
+		return parsePoetryLock(data)
+	}
+	return parseRequirements(data)
+}
+
+// pypiName は PEP 503 に従ってパッケージ名を正規化する (小文字化し、-_. の連続を - に置き換える)。
+func pypiName(name string) string {
+	return pypiSeparators.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "-")
+}
+
+var pypiSeparators = regexp.MustCompile(`[-_.]+`)
+
+func pypiPackage(name, version string) Package {
+	name = pypiName(name)
+	return Package{
+		Ref:     name + "@" + version,
+		Name:    name,
+		Version: version,
+		Purl:    purl("pypi", "", name, version),
+		Direct:  true,
+	}
+}
+
+// sha256Digest は "sha256:<hex>" 形式から 16 進表記を取り出す。sha256 以外は空文字。
+func sha256Digest(s string) string {
+	h, ok := strings.CutPrefix(strings.TrimSpace(s), "sha256:")
+	if !ok {
+		return ""
+	}
+	return strings.ToLower(h)
+}
+
+// firstSHA256 は列挙されたハッシュのうち最初の sha256 を返す。
+func firstSHA256(hashes []string) string {
+	for _, h := range hashes {
+		if d := sha256Digest(h); d != "" {
+			return d
+		}
+	}
+	return ""
+}
+
+// requirementLine は requirements.txt の要件 (name[extras] 指定子 ; マーカー) に一致する。
+var requirementLine = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)\s*(\[[^\]]*\])?\s*([^;]*)`)
+
+// parseRequirements は requirements.txt を読み込む。
+// == / === で固定されていない要件はバージョン未指定として扱い、取り込み時にスキップされる。
+// pip-compile の "# via" 注記がある場合、-r / -c (入力ファイル) 経由のものを直接依存とする。
+func parseRequirements(data []byte) (*BOM, error) {
+	bom := &BOM{Format: "requirements"}
+	// 継続行 (末尾の \) を連結してから読み込む
+	text := strings.ReplaceAll(strings.ReplaceAll(string(data), "\r\n", "\n"), "\\\n", " ")
+	var (
+		cur   *Package
+		via   []string
+		inVia bool
+	)
+	flush := func() {
+		if cur == nil {
+			return
+		}
+		if len(via) > 0 {
+			cur.Direct = false
+			for _, v := range via {
+				if strings.HasPrefix(v, "-r ") || strings.HasPrefix(v, "-c ") {
+					cur.Direct = true
+				}
+			}
+		}
+		bom.Packages = append(bom.Packages, *cur)
+		cur, via, inVia = nil, nil, false
+	}
+	sc := bufio.NewScanner(strings.NewReader(text))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if comment, ok := strings.CutPrefix(line, "#"); ok {
+			comment = strings.TrimSpace(comment)
+			if rest, ok := strings.CutPrefix(comment, "via"); ok && cur != nil && (rest == "" || rest[0] == ' ') {
+				inVia = true
+				if rest = strings.TrimSpace(rest); rest != "" {
+					via = append(via, rest)
+				}
+			} else if inVia && comment != "" {
+				via = append(via, comment)
+			}
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		flush()
+		if strings.HasPrefix(line, "-") {
+			continue // -r / -e / --index-url などのオプション行
+		}
+		var hashes []string
+		fields := strings.Fields(line)
+		req := make([]string, 0, len(fields))
+		for _, f := range fields {
+			if h, ok := strings.CutPrefix(f, "--hash="); ok {
+				hashes = append(hashes, h)
+			} else if strings.HasPrefix(f, "--") {
+				continue
+			} else {
+				req = append(req, f)
+			}
+		}
+		spec := strings.Join(req, " ")
+		if i := strings.Index(spec, " #"); i >= 0 {
+			spec = spec[:i]
+		}
+		m := requirementLine.FindStringSubmatch(spec)
+		if m == nil {
+			continue // URL やローカルパスの指定
+		}
+		version := ""
+		constraint := strings.TrimSpace(m[3])
+		if v, ok := strings.CutPrefix(constraint, "==="); ok {
+			version = strings.TrimSpace(v)
+		} else if v, ok := strings.CutPrefix(constraint, "=="); ok && !strings.ContainsAny(v, ",*") {
+			version = strings.TrimSpace(v)
+		}
+		pkg := pypiPackage(m[1], version)
+		pkg.SHA256 = firstSHA256(hashes)
+		cur = &pkg
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read requirements.txt: %w", err)
+	}
+	flush()
+	return bom, nil
+}
+
+// parsePipfileLock は Pipfile.lock を読み込む。
+// 直接依存か否かは記録されないため、全て直接依存とみなす。
+func parsePipfileLock(data []byte) (*BOM, error) {
+	var doc struct {
+		Default map[string]pipfileEntry `json:"default"`
+		Develop map[string]pipfileEntry `json:"develop"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode Pipfile.lock: %w", err)
+	}
+	bom := &BOM{Format: "pipfile-lock"}
+	seen := map[string]bool{}
+	add := func(section map[string]pipfileEntry, role string) {
+		names := make([]string, 0, len(section))
+		for name := range section {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			e := section[name]
+			version, ok := strings.CutPrefix(e.Version, "==")
+			if !ok {
+				continue // git / パス指定はバージョンを持たない
+			}
+			pkg := pypiPackage(name, version)
+			if seen[pkg.Ref] {
+				continue
+			}
+			seen[pkg.Ref] = true
+			pkg.SHA256 = firstSHA256(e.Hashes)
+			pkg.UsageRole = role
+			bom.Packages = append(bom.Packages, pkg)
+		}
+	}
+	add(doc.Default, "")
+	add(doc.Develop, "DEV_ONLY")
+	return bom, nil
+}
+
+type pipfileEntry struct {
+	Version string   `json:"version"`
+	Hashes  []string `json:"hashes"`
+}
+
+// parsePoetryLock は poetry.lock を読み込む。
+// 直接依存か否かは pyproject.toml を参照しないと判別できないため、全て直接依存とみなす。
+// ハッシュは各パッケージの files (poetry 1.2 以降) または [metadata.files] (それ以前) から取得する。
+func parsePoetryLock(data []byte) (*BOM, error) {
+	lock, err := parseTOMLLock(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode poetry.lock: %w", err)
+	}
+	legacyFiles := lock.Tables["metadata.files"]
+	bom := &BOM{Format: "poetry-lock"}
+	for _, p := range lock.Packages {
+		name, version := p.tomlString("name"), p.tomlString("version")
+		if name == "" {
+			continue
+		}
+		if src, ok := p["source"].(tomlTable); ok {
+			switch src.tomlString("type") {
+			case "directory", "file", "git", "url":
+				continue // レジストリ以外から取得したパッケージは purl で表せない
+			}
+		}
+		pkg := pypiPackage(name, version)
+		files := p["files"]
+		if files == nil && legacyFiles != nil {
+			files = legacyFiles[name]
+			if files == nil {
+				files = legacyFiles[pkg.Name]
+			}
+		}
+		pkg.SHA256 = poetryFileHash(files)
+		if p.tomlString("category") == "dev" {
+			pkg.UsageRole = "DEV_ONLY"
+		}
+		bom.Packages = append(bom.Packages, pkg)
+	}
+	return bom, nil
+}
+
+// poetryFileHash は files 配列 ({file = "...", hash = "sha256:..."}) から sdist (.tar.gz / .zip) のハッシュを優先して返す。
+func poetryFileHash(v any) string {
+	files, _ := v.([]any)
+	first := ""
+	for _, f := range files {
+		t, ok := f.(tomlTable)
+		if !ok {
+			continue
+		}
+		h := sha256Digest(t.tomlString("hash"))
+		if h == "" {
+			continue
+		}
+		name := t.tomlString("file")
+		if strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".zip") {
+			return h
+		}
+		if first == "" {
+			first = h
+		}
+	}
+	return first
+}
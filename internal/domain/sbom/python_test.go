@@ -0,0 +1,178 @@
+package sbom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const requirementsTestDoc = `#
+# This file is autogenerated by pip-compile with Python 3.11
+#
+--index-url https://pypi.org/simple
+
+certifi==2023.7.22 \
+    --hash=sha256:92d6037539857d8206b8f6ae472e8b77db8058fec5937a1ef3f54304089edbb9 \
+    --hash=sha256:539cc1d13202e33ca466e88b2807e29f4c13049d6d87031a3c110744495cb082
+    # via requests
+Django==4.2.5 ; python_version >= "3.8" \
+    --hash=sha256:b6b2b5cae821077f137dc4dade696a1c2aa292f892eca28fa8d7bfdf2608ddd4
+    # via -r requirements.in
+requests[socks]==2.31.0
+    # via
+    #   -r requirements.in
+    #   other-lib
+flask>=2.0
+-e ./local-package
+`
+
+func TestParsePython_Requirements(t *testing.T) {
+	bom, err := ParsePython(strings.NewReader(requirementsTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "requirements", bom.Format)
+	require.Len(t, bom.Packages, 4)
+
+	certifi := bom.Packages[0]
+	require.Equal(t, "pkg:pypi/certifi@2023.7.22", certifi.Purl)
+	require.Equal(t, "92d6037539857d8206b8f6ae472e8b77db8058fec5937a1ef3f54304089edbb9", certifi.SHA256)
+	require.False(t, certifi.Direct)
+
+	django := bom.Packages[1]
+	require.Equal(t, "django", django.Name)
+	require.Equal(t, "pkg:pypi/django@4.2.5", django.Purl)
+	require.True(t, django.Direct)
+
+	requests := bom.Packages[2]
+	require.Equal(t, "2.31.0", requests.Version)
+	require.Empty(t, requests.SHA256)
+	require.True(t, requests.Direct)
+
+	// 固定されていない要件はバージョン未指定となる
+	require.Equal(t, "flask", bom.Packages[3].Name)
+	require.Empty(t, bom.Packages[3].Version)
+	require.True(t, bom.Packages[3].Direct)
+}
+
+const pipfileLockTestDoc = `{
+  "_meta": {"hash": {"sha256": "abc"}},
+  "default": {
+    "requests": {"hashes": ["sha256:58cd2187c01e70e6e26505bca751777aa9f2ee0b7f4300988b709f44e013003f", "sha256:942c5a758f98d790eaed1a29cb6eefc7ffb0d1cf7af05c3d2791656dbd6ad1e1"], "version": "==2.31.0"},
+    "Typing_Extensions": {"hashes": [], "version": "==4.8.0"},
+    "local": {"path": "."}
+  },
+  "develop": {
+    "pytest": {"hashes": ["sha256:1d881c6124e08ff0a1bb75ba3ec0bfd8b5354a01c194ddd5a0a870a48d99b002"], "version": "==7.4.2"}
+  }
+}`
+
+func TestParsePython_PipfileLock(t *testing.T) {
+	bom, err := ParsePython(strings.NewReader(pipfileLockTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "pipfile-lock", bom.Format)
+	require.Len(t, bom.Packages, 3)
+
+	require.Equal(t, "pkg:pypi/typing-extensions@4.8.0", bom.Packages[0].Purl)
+	require.Empty(t, bom.Packages[0].SHA256)
+	require.Equal(t, "pkg:pypi/requests@2.31.0", bom.Packages[1].Purl)
+	require.Equal(t, "58cd2187c01e70e6e26505bca751777aa9f2ee0b7f4300988b709f44e013003f", bom.Packages[1].SHA256)
+	require.Empty(t, bom.Packages[1].UsageRole)
+	require.Equal(t, "DEV_ONLY", bom.Packages[2].UsageRole)
+}
+
+const poetryLockTestDoc = `# This file is automatically @generated by Poetry 1.6.1 and should not be changed by hand.
+
+[[package]]
+name = "certifi"
+version = "2023.7.22"
+description = "Python package for providing Mozilla's CA Bundle."
+optional = false
+python-versions = ">=3.6"
+files = [
+    {file = "certifi-2023.7.22-py3-none-any.whl", hash = "sha256:92d6037539857d8206b8f6ae472e8b77db8058fec5937a1ef3f54304089edbb9"},
+    {file = "certifi-2023.7.22.tar.gz", hash = "sha256:539cc1d13202e33ca466e88b2807e29f4c13049d6d87031a3c110744495cb082"},
+]
+
+[[package]]
+name = "pytest"
+version = "7.4.2"
+description = """
+pytest: simple powerful testing with Python
+"""
+category = "dev"
+optional = false
+python-versions = ">=3.7"
+files = [
+    {file = "pytest-7.4.2-py3-none-any.whl", hash = "sha256:1d881c6124e08ff0a1bb75ba3ec0bfd8b5354a01c194ddd5a0a870a48d99b002"},
+]
+
+[package.dependencies]
+colorama = {version = "*", markers = "sys_platform == \"win32\""}
+
+[package.extras]
+testing = ["argcomplete", "hypothesis (>=3.56)"] # comment
+
+[[package]]
+name = "mylib"
+version = "0.1.0"
+description = ""
+optional = false
+python-versions = "*"
+files = []
+
+[package.source]
+type = "directory"
+url = "../mylib"
+
+[metadata]
+lock-version = "2.0"
+python-versions = "^3.11"
+content-hash = "0123"
+`
+
+func TestParsePython_PoetryLock(t *testing.T) {
+	bom, err := ParsePython(strings.NewReader(poetryLockTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "poetry-lock", bom.Format)
+	require.Len(t, bom.Packages, 2)
+
+	certifi := bom.Packages[0]
+	require.Equal(t, "pkg:pypi/certifi@2023.7.22", certifi.Purl)
+	// sdist のハッシュを優先する
+	require.Equal(t, "539cc1d13202e33ca466e88b2807e29f4c13049d6d87031a3c110744495cb082", certifi.SHA256)
+	require.Empty(t, certifi.UsageRole)
+
+	pytest := bom.Packages[1]
+	require.Equal(t, "1d881c6124e08ff0a1bb75ba3ec0bfd8b5354a01c194ddd5a0a870a48d99b002", pytest.SHA256)
+	require.Equal(t, "DEV_ONLY", pytest.UsageRole)
+}
+
+const poetryLegacyLockTestDoc = `[[package]]
+name = "PyYAML"
+version = "6.0.1"
+category = "main"
+optional = false
+
+[metadata]
+lock-version = "1.1"
+
+[metadata.files]
+pyyaml = [
+    {file = "PyYAML-6.0.1.tar.gz", hash = "sha256:bfdf460b1736c775f2ba9f6a92bca30bc2095067b8a9d77876d1fad6cc3b4a43"},
+]
+`
+
+func TestParsePython_PoetryLegacyFiles(t *testing.T) {
+	bom, err := ParsePython(strings.NewReader(poetryLegacyLockTestDoc))
+	require.NoError(t, err)
+	require.Len(t, bom.Packages, 1)
+	require.Equal(t, "pkg:pypi/pyyaml@6.0.1", bom.Packages[0].Purl)
+	require.Equal(t, "bfdf460b1736c775f2ba9f6a92bca30bc2095067b8a9d77876d1fad6cc3b4a43", bom.Packages[0].SHA256)
+}
+
+func TestParsePython_Invalid(t *testing.T) {
+	_, err := ParsePython(strings.NewReader("{"))
+	require.Error(t, err)
+	_, err = ParsePython(strings.NewReader("[[package]]\nname = \"x\nfiles = ["))
+	require.Error(t, err)
+}
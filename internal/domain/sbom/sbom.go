@@ -0,0 +1,76 @@
+// Package sbom は SBOM などの部品表を読み込み、取り込み用の共通形式に変換する。
+package sbom
+
+import (
+	"net/url"
+	"strings"
+)
+
+// BOM は読み込んだ部品表を表す。
+// ルート (対象プロダクト自身) を表す要素は Packages に含めない。
+type BOM struct {
+	// Format は読み込み元の形式 (spdx-json など)。
+	Format   string
+	Name     string
+	Packages []Package
+	// Dependencies は Package.Ref ごとの依存先 Ref。依存関係を持たない形式では nil。
+	Dependencies map[string][]string
+}
+
+// Package は部品表中の 1 パッケージを表す。未設定の項目は空文字。
+type Package struct {
+	// Ref は元文書内での識別子 (SPDXID など)。
+	Ref              string
+	Name             string
+	Version          string
+	Purl             string
+	LicenseConcluded string
+	LicenseDeclared  string
+	Homepage         string
+	Supplier         string
+	SHA256           string
+	// SHA512 は SHA-512 ハッシュ (16 進表記)。npm の integrity など SHA-256 を持たない形式で用いる。
+	SHA512    string
+	Copyright string
+	CPEs      []string
+	// Direct はルートからの直接依存であるかどうか。依存関係が不明な場合は true。
+	Direct bool
+	// UsageRole は形式から判別できた利用形態 (DEV_ONLY など)。判別できない場合は空文字。
+	UsageRole string
+	// Layers はコンポーネントを新規登録する際に設定するレイヤー (OS / LIB など)。
+	Layers []string
+	// InclusionNote は利用情報の組み込み経緯に記録する注記 (取得元イメージなど)。
+	InclusionNote string
+}
+
+// noAssertion は NOASSERTION / NONE など値が無いことを示す表記を空文字に置き換える。
+func noAssertion(s string) string {
+	s = strings.TrimSpace(s)
+	switch strings.ToUpper(s) {
+	case "NOASSERTION", "NONE":
+		return ""
+	}
+	return s
+}
+
+// purl は Package URL (pkg:type/namespace/name@version) を組み立てる。
+// namespace は "/" 区切りのまま各セグメントをエスケープする (npm の @scope は %40scope となる)。
+func purl(typ, namespace, name, version string) string {
+	escape := func(s string) string {
+		return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+	}
+	var b strings.Builder
+	b.WriteString("pkg:" + typ + "/")
+	if namespace != "" {
+		segs := strings.Split(namespace, "/")
+		for i, s := range segs {
+			segs[i] = escape(s)
+		}
+		b.WriteString(strings.Join(segs, "/") + "/")
+	}
+	b.WriteString(escape(name))
+	if version != "" {
+		b.WriteString("@" + escape(version))
+	}
+	return b.String()
+}
@@ -0,0 +1,140 @@
+package sbom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrUnsupportedSPDX は SPDX 2.x JSON 以外の文書を読み込んだ場合に返す。
+var ErrUnsupportedSPDX = errors.New("unsupported SPDX document")
+
+const spdxDocumentID = "SPDXRef-DOCUMENT"
+
+// spdxDoc は取り込みに必要な SPDX 2.x JSON の項目のみを表す。
+type spdxDoc struct {
+	SPDXVersion       string   `json:"spdxVersion"`
+	Name              string   `json:"name"`
+	DocumentDescribes []string `json:"documentDescribes"`
+	Packages          []struct {
+		SPDXID           string `json:"SPDXID"`
+		Name             string `json:"name"`
+		VersionInfo      string `json:"versionInfo"`
+		Homepage         string `json:"homepage"`
+		Supplier         string `json:"supplier"`
+		LicenseConcluded string `json:"licenseConcluded"`
+		LicenseDeclared  string `json:"licenseDeclared"`
+		CopyrightText    string `json:"copyrightText"`
+		Checksums        []struct {
+			Algorithm     string `json:"algorithm"`
+			ChecksumValue string `json:"checksumValue"`
+		} `json:"checksums"`
+		ExternalRefs []struct {
+			ReferenceCategory string `json:"referenceCategory"`
+			ReferenceType     string `json:"referenceType"`
+			ReferenceLocator  string `json:"referenceLocator"`
+		} `json:"externalRefs"`
+	} `json:"packages"`
+	Relationships []struct {
+		SPDXElementID      string `json:"spdxElementId"`
+		RelationshipType   string `json:"relationshipType"`
+		RelatedSPDXElement string `json:"relatedSpdxElement"`
+	} `json:"relationships"`
+}
+
+// ParseSPDXJSON は SPDX 2.x JSON 文書を読み込む。
+// DESCRIBES (または documentDescribes) で示されるルートパッケージは除外し、
+// ルートからの DEPENDS_ON / CONTAINS / DEPENDENCY_OF で直接依存を判定する。
+// ルートからの関係が 1 つも無い場合は全パッケージを直接依存とみなす。
+func ParseSPDXJSON(r io.Reader) (*BOM, error) {
+	var doc spdxDoc
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decode SPDX JSON: %w", err)
+	}
+	if !strings.HasPrefix(doc.SPDXVersion, "SPDX-2.") {
+		return nil, fmt.Errorf("%w: spdxVersion %q", ErrUnsupportedSPDX, doc.SPDXVersion)
+	}
+
+	roots := map[string]bool{}
+	for _, id := range doc.DocumentDescribes {
+		roots[id] = true
+	}
+	deps := map[string][]string{}
+	for _, rel := range doc.Relationships {
+		from, to := rel.SPDXElementID, rel.RelatedSPDXElement
+		switch strings.ToUpper(rel.RelationshipType) {
+		case "DESCRIBES":
+			if from == spdxDocumentID {
+				roots[to] = true
+			}
+		case "DESCRIBED_BY":
+			if to == spdxDocumentID {
+				roots[from] = true
+			}
+		case "DEPENDS_ON", "CONTAINS":
+			deps[from] = append(deps[from], to)
+		case "DEPENDENCY_OF", "CONTAINED_BY":
+			deps[to] = append(deps[to], from)
+		}
+	}
+	direct := map[string]bool{}
+	for root := range roots {
+		for _, to := range deps[root] {
+			direct[to] = true
+		}
+	}
+
+	bom := &BOM{Format: "spdx-json", Name: doc.Name, Dependencies: map[string][]string{}}
+	for _, p := range doc.Packages {
+		if roots[p.SPDXID] {
+			continue
+		}
+		pkg := Package{
+			Ref:              p.SPDXID,
+			Name:             strings.TrimSpace(p.Name),
+			Version:          noAssertion(p.VersionInfo),
+			LicenseConcluded: noAssertion(p.LicenseConcluded),
+			LicenseDeclared:  noAssertion(p.LicenseDeclared),
+			Homepage:         noAssertion(p.Homepage),
+			Supplier:         spdxSupplier(p.Supplier),
+			Copyright:        noAssertion(p.CopyrightText),
+			Direct:           len(direct) == 0 || direct[p.SPDXID],
+		}
+		for _, c := range p.Checksums {
+			switch strings.ToUpper(c.Algorithm) {
+			case "SHA256":
+				pkg.SHA256 = strings.ToLower(c.ChecksumValue)
+			case "SHA512":
+				pkg.SHA512 = strings.ToLower(c.ChecksumValue)
+			}
+		}
+		for _, ref := range p.ExternalRefs {
+			switch strings.ToLower(ref.ReferenceType) {
+			case "purl":
+				if pkg.Purl == "" {
+					pkg.Purl = ref.ReferenceLocator
+				}
+			case "cpe22type", "cpe23type":
+				pkg.CPEs = append(pkg.CPEs, ref.ReferenceLocator)
+			}
+		}
+		if d := deps[p.SPDXID]; len(d) > 0 {
+			bom.Dependencies[p.SPDXID] = d
+		}
+		bom.Packages = append(bom.Packages, pkg)
+	}
+	return bom, nil
+}
+
+// spdxSupplier は "Organization: Foo" 形式の供給者表記から名称を取り出す。
+func spdxSupplier(s string) string {
+	s = noAssertion(s)
+	for _, prefix := range []string{"Organization:", "Person:", "Tool:"} {
+		if strings.HasPrefix(s, prefix) {
+			return strings.TrimSpace(strings.TrimPrefix(s, prefix))
+		}
+	}
+	return s
+}
@@ -0,0 +1,72 @@
+package sbom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const spdxTestDoc = `{
+  "spdxVersion": "SPDX-2.3",
+  "SPDXID": "SPDXRef-DOCUMENT",
+  "name": "sample-app",
+  "packages": [
+    {"SPDXID": "SPDXRef-Root", "name": "sample-app", "versionInfo": "1.0.0"},
+    {
+      "SPDXID": "SPDXRef-lodash", "name": "lodash", "versionInfo": "4.17.21",
+      "supplier": "Organization: OpenJS Foundation",
+      "homepage": "https://lodash.com/",
+      "licenseConcluded": "MIT", "licenseDeclared": "MIT",
+      "copyrightText": "Copyright OpenJS Foundation",
+      "checksums": [{"algorithm": "SHA1", "checksumValue": "abc"}, {"algorithm": "SHA256", "checksumValue": "ABCDEF"}],
+      "externalRefs": [
+        {"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/lodash@4.17.21"},
+        {"referenceCategory": "SECURITY", "referenceType": "cpe23Type", "referenceLocator": "cpe:2.3:a:lodash:lodash:4.17.21:*:*:*:*:*:*:*"}
+      ]
+    },
+    {"SPDXID": "SPDXRef-dep", "name": "dep", "versionInfo": "NOASSERTION", "licenseConcluded": "NOASSERTION", "homepage": "NONE"}
+  ],
+  "relationships": [
+    {"spdxElementId": "SPDXRef-DOCUMENT", "relationshipType": "DESCRIBES", "relatedSpdxElement": "SPDXRef-Root"},
+    {"spdxElementId": "SPDXRef-Root", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-lodash"},
+    {"spdxElementId": "SPDXRef-dep", "relationshipType": "DEPENDENCY_OF", "relatedSpdxElement": "SPDXRef-lodash"}
+  ]
+}`
+
+func TestParseSPDXJSON(t *testing.T) {
+	bom, err := ParseSPDXJSON(strings.NewReader(spdxTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "sample-app", bom.Name)
+	require.Len(t, bom.Packages, 2)
+
+	lodash := bom.Packages[0]
+	require.Equal(t, "SPDXRef-lodash", lodash.Ref)
+	require.Equal(t, "4.17.21", lodash.Version)
+	require.Equal(t, "pkg:npm/lodash@4.17.21", lodash.Purl)
+	require.Equal(t, "OpenJS Foundation", lodash.Supplier)
+	require.Equal(t, "abcdef", lodash.SHA256)
+	require.Len(t, lodash.CPEs, 1)
+	require.True(t, lodash.Direct)
+
+	dep := bom.Packages[1]
+	require.Equal(t, "", dep.Version)
+	require.Equal(t, "", dep.LicenseConcluded)
+	require.Equal(t, "", dep.Homepage)
+	require.False(t, dep.Direct)
+	require.Equal(t, []string{"SPDXRef-dep"}, bom.Dependencies["SPDXRef-lodash"])
+}
+
+func TestParseSPDXJSON_NoRelationships(t *testing.T) {
+	bom, err := ParseSPDXJSON(strings.NewReader(`{"spdxVersion":"SPDX-2.2","packages":[{"SPDXID":"SPDXRef-a","name":"a","versionInfo":"1"}]}`))
+	require.NoError(t, err)
+	require.Len(t, bom.Packages, 1)
+	require.True(t, bom.Packages[0].Direct)
+}
+
+func TestParseSPDXJSON_Invalid(t *testing.T) {
+	_, err := ParseSPDXJSON(strings.NewReader(`{"spdxVersion":"SPDX-3.0"}`))
+	require.ErrorIs(t, err, ErrUnsupportedSPDX)
+	_, err = ParseSPDXJSON(strings.NewReader(`not json`))
+	require.Error(t, err)
+}
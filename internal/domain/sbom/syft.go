@@ -0,0 +1,232 @@
+package sbom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrUnsupportedSyft は syft JSON 以外の文書を読み込んだ場合に返す。
+var ErrUnsupportedSyft = errors.New("unsupported syft document")
+
+// syftDoc は取り込みに必要な syft JSON (`syft -o json`) の項目のみを表す。
+type syftDoc struct {
+	Artifacts     []syftArtifact `json:"artifacts"`
+	Relationships []struct {
+		Parent string `json:"parent"`
+		Child  string `json:"child"`
+		Type   string `json:"type"`
+	} `json:"artifactRelationships"`
+	Source struct {
+		Name     string      `json:"name"`
+		Type     string      `json:"type"`
+		Metadata *syftSource `json:"metadata"`
+		// Target は schema 10 以前の source の詳細。
+		Target json.RawMessage `json:"target"`
+	} `json:"source"`
+	Schema struct {
+		Version string `json:"version"`
+	} `json:"schema"`
+}
+
+type syftSource struct {
+	UserInput      string   `json:"userInput"`
+	ImageID        string   `json:"imageID"`
+	ManifestDigest string   `json:"manifestDigest"`
+	RepoDigests    []string `json:"repoDigests"`
+}
+
+type syftArtifact struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Version   string          `json:"version"`
+	Type      string          `json:"type"`
+	Purl      string          `json:"purl"`
+	Licenses  json.RawMessage `json:"licenses"`
+	CPEs      json.RawMessage `json:"cpes"`
+	Locations []struct {
+		Path    string `json:"path"`
+		LayerID string `json:"layerID"`
+	} `json:"locations"`
+	Metadata struct {
+		URL      string `json:"url"`
+		Homepage string `json:"homepage"`
+	} `json:"metadata"`
+}
+
+// syftOSTypes は OS のパッケージマネージャが管理するパッケージ種別。
+// イメージにバイナリとして同梱されるため Layer OS・利用形態 BUNDLED_BINARY とする。
+var syftOSTypes = map[string]bool{
+	"apk":     true,
+	"deb":     true,
+	"rpm":     true,
+	"alpm":    true,
+	"portage": true,
+}
+
+// syftLanguageTypes は言語のパッケージマネージャが管理するパッケージ種別 (Layer LIB)。
+var syftLanguageTypes = map[string]bool{
+	"npm":            true,
+	"python":         true,
+	"go-module":      true,
+	"java-archive":   true,
+	"jenkins-plugin": true,
+	"gem":            true,
+	"rust-crate":     true,
+	"php-composer":   true,
+	"php-pecl":       true,
+	"dotnet":         true,
+	"pod":            true,
+	"conan":          true,
+	"dart-pub":       true,
+	"hackage":        true,
+	"hex":            true,
+	"swift":          true,
+	"R-package":      true,
+	"lua-rocks":      true,
+	"erlang-otp":     true,
+}
+
+// ParseSyftJSON は syft JSON 文書を読み込む。
+// パッケージ種別から apk / deb / rpm などの OS パッケージは Layer OS・利用形態 BUNDLED_BINARY、
+// 言語パッケージは Layer LIB に分類する。イメージを走査した文書では、
+// 取得元イメージのダイジェストと検出したレイヤーを InclusionNote に記録する。
+// イメージにはルートとなる要素が無いため、全パッケージを直接依存とみなす。
+func ParseSyftJSON(r io.Reader) (*BOM, error) {
+	var doc syftDoc
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decode syft JSON: %w", err)
+	}
+	if doc.Schema.Version == "" {
+		return nil, fmt.Errorf("%w: schema version is missing", ErrUnsupportedSyft)
+	}
+	src := doc.Source.Metadata
+	if src == nil && len(doc.Source.Target) > 0 {
+		// ディレクトリ走査では target が文字列となるため、デコードできない場合は無視する
+		var t syftSource
+		if json.Unmarshal(doc.Source.Target, &t) == nil {
+			src = &t
+		}
+	}
+
+	bom := &BOM{Format: "syft-json", Name: doc.Source.Name, Dependencies: map[string][]string{}}
+	image := ""
+	if src != nil {
+		if src.UserInput != "" {
+			bom.Name = src.UserInput
+		}
+		if doc.Source.Type == "image" {
+			image = syftImageRef(src)
+		}
+	}
+	for _, rel := range doc.Relationships {
+		// "A dependency-of B" は B が A に依存することを表す
+		if rel.Type == "dependency-of" {
+			bom.Dependencies[rel.Child] = append(bom.Dependencies[rel.Child], rel.Parent)
+		}
+	}
+	for _, a := range doc.Artifacts {
+		pkg, err := a.toPackage(image)
+		if err != nil {
+			return nil, err
+		}
+		bom.Packages = append(bom.Packages, pkg)
+	}
+	return bom, nil
+}
+
+func (a syftArtifact) toPackage(image string) (Package, error) {
+	pkg := Package{
+		Ref:      a.ID,
+		Name:     strings.TrimSpace(a.Name),
+		Version:  strings.TrimSpace(a.Version),
+		Purl:     a.Purl,
+		Homepage: a.Metadata.URL,
+		Direct:   true,
+	}
+	if pkg.Homepage == "" {
+		pkg.Homepage = a.Metadata.Homepage
+	}
+	switch {
+	case syftOSTypes[a.Type]:
+		pkg.Layers = []string{"OS"}
+		pkg.UsageRole = "BUNDLED_BINARY"
+	case syftLanguageTypes[a.Type]:
+		pkg.Layers = []string{"LIB"}
+	}
+
+	licenses, err := syftValues(a.Licenses, "spdxExpression", "value")
+	if err != nil {
+		return pkg, fmt.Errorf("artifact %s licenses: %w", a.ID, err)
+	}
+	pkg.LicenseDeclared = joinLicenses(licenses)
+	if pkg.CPEs, err = syftValues(a.CPEs, "cpe"); err != nil {
+		return pkg, fmt.Errorf("artifact %s cpes: %w", a.ID, err)
+	}
+
+	if image != "" {
+		pkg.InclusionNote = "image " + image
+		if len(a.Locations) > 0 && a.Locations[0].LayerID != "" {
+			pkg.InclusionNote += " (layer " + a.Locations[0].LayerID + ")"
+		}
+	}
+	return pkg, nil
+}
+
+// syftImageRef はイメージの参照名とダイジェストを name@digest の形式で返す。
+// ダイジェストはマニフェスト、リポジトリダイジェスト、イメージ ID の順で採用し、
+// 参照名がダイジェストを含む場合はそのまま返す。
+func syftImageRef(src *syftSource) string {
+	digest := src.ManifestDigest
+	if digest == "" && len(src.RepoDigests) > 0 {
+		if _, d, ok := strings.Cut(src.RepoDigests[0], "@"); ok {
+			digest = d
+		}
+	}
+	if digest == "" {
+		digest = src.ImageID
+	}
+	switch {
+	case digest == "" || strings.Contains(src.UserInput, "@"):
+		return src.UserInput
+	case src.UserInput == "":
+		return digest
+	}
+	return src.UserInput + "@" + digest
+}
+
+// syftValues は文字列の配列、またはオブジェクトの配列を読み込み、
+// オブジェクトの場合は keys のうち最初に値を持つ項目を取り出す。
+// syft のスキーマバージョンにより licenses・cpes の表現が異なるため両方を受け付ける。
+func syftValues(raw json.RawMessage, keys ...string) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+	var res []string
+	for _, item := range items {
+		var s string
+		if json.Unmarshal(item, &s) == nil {
+			if s = strings.TrimSpace(s); s != "" {
+				res = append(res, s)
+			}
+			continue
+		}
+		var obj map[string]any
+		if err := json.Unmarshal(item, &obj); err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			if v, _ := obj[k].(string); strings.TrimSpace(v) != "" {
+				res = append(res, strings.TrimSpace(v))
+				break
+			}
+		}
+	}
+	return res, nil
+}
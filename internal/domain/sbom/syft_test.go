@@ -0,0 +1,118 @@
+package sbom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const syftTestDoc = `{
+  "artifacts": [
+    {
+      "id": "a1",
+      "name": "musl",
+      "version": "1.2.4-r2",
+      "type": "apk",
+      "purl": "pkg:apk/alpine/musl@1.2.4-r2?arch=x86_64&distro=alpine-3.18.4",
+      "licenses": [{"value": "MIT", "spdxExpression": "MIT", "type": "declared"}],
+      "cpes": [{"cpe": "cpe:2.3:a:musl-libc:musl:1.2.4-r2:*:*:*:*:*:*:*", "source": "syft-generated"}],
+      "locations": [{"path": "/lib/apk/db/installed", "layerID": "sha256:aaaa"}],
+      "metadata": {"url": "https://musl.libc.org/"}
+    },
+    {
+      "id": "a2",
+      "name": "lodash",
+      "version": "4.17.21",
+      "type": "npm",
+      "purl": "pkg:npm/lodash@4.17.21",
+      "licenses": [{"value": "MIT"}, {"value": "Apache 2.0"}],
+      "cpes": [],
+      "locations": [{"path": "/app/node_modules/lodash/package.json", "layerID": "sha256:bbbb"}],
+      "metadata": {"homepage": "https://lodash.com/"}
+    },
+    {
+      "id": "a3",
+      "name": "custom",
+      "version": "",
+      "type": "binary",
+      "locations": [{"path": "/usr/local/bin/custom"}]
+    }
+  ],
+  "artifactRelationships": [
+    {"parent": "a1", "child": "a2", "type": "dependency-of"},
+    {"parent": "a1", "child": "f1", "type": "contains"}
+  ],
+  "source": {
+    "id": "s1",
+    "name": "alpine",
+    "type": "image",
+    "metadata": {
+      "userInput": "registry.example.com/app:1.0",
+      "imageID": "sha256:cccc",
+      "manifestDigest": "sha256:dddd",
+      "repoDigests": ["registry.example.com/app@sha256:eeee"]
+    }
+  },
+  "schema": {"version": "16.0.0"}
+}`
+
+func TestParseSyftJSON(t *testing.T) {
+	bom, err := ParseSyftJSON(strings.NewReader(syftTestDoc))
+	require.NoError(t, err)
+	require.Equal(t, "syft-json", bom.Format)
+	require.Equal(t, "registry.example.com/app:1.0", bom.Name)
+	require.Len(t, bom.Packages, 3)
+
+	musl := bom.Packages[0]
+	require.Equal(t, "a1", musl.Ref)
+	require.Equal(t, "MIT", musl.LicenseDeclared)
+	require.Equal(t, []string{"cpe:2.3:a:musl-libc:musl:1.2.4-r2:*:*:*:*:*:*:*"}, musl.CPEs)
+	require.Equal(t, "https://musl.libc.org/", musl.Homepage)
+	require.Equal(t, []string{"OS"}, musl.Layers)
+	require.Equal(t, "BUNDLED_BINARY", musl.UsageRole)
+	require.True(t, musl.Direct)
+	require.Equal(t, "image registry.example.com/app:1.0@sha256:dddd (layer sha256:aaaa)", musl.InclusionNote)
+
+	lodash := bom.Packages[1]
+	require.Equal(t, "MIT AND (Apache 2.0)", lodash.LicenseDeclared)
+	require.Equal(t, "https://lodash.com/", lodash.Homepage)
+	require.Equal(t, []string{"LIB"}, lodash.Layers)
+	require.Empty(t, lodash.UsageRole)
+	require.Equal(t, "image registry.example.com/app:1.0@sha256:dddd (layer sha256:bbbb)", lodash.InclusionNote)
+
+	custom := bom.Packages[2]
+	require.Empty(t, custom.Layers)
+	require.Equal(t, "image registry.example.com/app:1.0@sha256:dddd", custom.InclusionNote)
+
+	require.Equal(t, map[string][]string{"a2": {"a1"}}, bom.Dependencies)
+}
+
+func TestParseSyftJSON_LegacySource(t *testing.T) {
+	doc := `{
+  "artifacts": [{"id": "a1", "name": "openssl", "version": "3.0.11-1", "type": "deb", "licenses": ["Apache-2.0"], "cpes": ["cpe:2.3:a:openssl:openssl:3.0.11-1:*:*:*:*:*:*:*"]}],
+  "source": {"type": "image", "target": {"userInput": "debian:12", "imageID": "sha256:ffff", "repoDigests": ["debian@sha256:9999"]}},
+  "schema": {"version": "6.0.0"}
+}`
+	bom, err := ParseSyftJSON(strings.NewReader(doc))
+	require.NoError(t, err)
+	require.Equal(t, "debian:12", bom.Name)
+	p := bom.Packages[0]
+	require.Equal(t, "Apache-2.0", p.LicenseDeclared)
+	require.Equal(t, []string{"cpe:2.3:a:openssl:openssl:3.0.11-1:*:*:*:*:*:*:*"}, p.CPEs)
+	require.Equal(t, []string{"OS"}, p.Layers)
+	require.Equal(t, "image debian:12@sha256:9999", p.InclusionNote)
+
+	// ディレクトリ走査では取得元イメージを記録しない
+	doc = `{"artifacts": [{"id": "a1", "name": "lodash", "version": "4.17.21", "type": "npm"}], "source": {"type": "directory", "target": "/src"}, "schema": {"version": "6.0.0"}}`
+	bom, err = ParseSyftJSON(strings.NewReader(doc))
+	require.NoError(t, err)
+	require.Empty(t, bom.Packages[0].InclusionNote)
+}
+
+func TestParseSyftJSON_Unsupported(t *testing.T) {
+	_, err := ParseSyftJSON(strings.NewReader(`{"bomFormat": "CycloneDX", "specVersion": "1.5"}`))
+	require.ErrorIs(t, err, ErrUnsupportedSyft)
+	_, err = ParseSyftJSON(strings.NewReader(`not json`))
+	require.Error(t, err)
+}
@@ -0,0 +1,270 @@
+package sbom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tomlTable は TOML のテーブルを表す。値は string / []any / tomlTable のいずれか
+// (数値・真偽値は表記のまま string とする)。
+type tomlTable map[string]any
+
+// tomlLock は poetry.lock / Cargo.lock を読み込んだ結果を表す。
+type tomlLock struct {
+	// Packages は [[package]] の配列。[package.xxx] は各要素の xxx に格納する。
+	Packages []tomlTable
+	// Tables はそれ以外のテーブル ([metadata.files] など) を見出し名で保持する。ルートは "" とする。
+	Tables map[string]tomlTable
+}
+
+// parseTOMLLock はロックファイルで使われる範囲の TOML (テーブル・テーブル配列・文字列・配列・インラインテーブル) を読み込む。
+func parseTOMLLock(data []byte) (*tomlLock, error) {
+	lock := &tomlLock{Tables: map[string]tomlTable{"": {}}}
+	cur := lock.Tables[""]
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	for n := 0; n < len(lines); n++ {
+		line := strings.TrimSpace(stripTOMLComment(lines[n]))
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "[["):
+			name := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(line, "[["), "]]"))
+			if name != "package" {
+				return nil, fmt.Errorf("line %d: unsupported array of tables %q", n+1, name)
+			}
+			cur = tomlTable{}
+			lock.Packages = append(lock.Packages, cur)
+			continue
+		case strings.HasPrefix(line, "["):
+			name := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(line, "["), "]"))
+			cur = tomlTable{}
+			if sub, ok := strings.CutPrefix(name, "package."); ok && len(lock.Packages) > 0 {
+				lock.Packages[len(lock.Packages)-1][sub] = cur
+			} else {
+				lock.Tables[name] = cur
+			}
+			continue
+		}
+
+		key, rest, ok := cutTOMLKey(line)
+		if !ok {
+			return nil, fmt.Errorf("line %d: invalid key/value %q", n+1, line)
+		}
+		// 複数行にまたがる配列・文字列は閉じるまで連結する
+		for !tomlComplete(rest) && n+1 < len(lines) {
+			n++
+			sep := "\n"
+			if !strings.HasPrefix(rest, `"""`) && !strings.HasPrefix(rest, "'''") {
+				sep = " "
+				lines[n] = stripTOMLComment(lines[n])
+			}
+			rest += sep + strings.TrimSpace(lines[n])
+		}
+		p := &tomlParser{s: rest}
+		v, err := p.value()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+		cur[key] = v
+	}
+	return lock, nil
+}
+
+// cutTOMLKey は "key = value" を分割する。キーは引用符付きでもよい。
+func cutTOMLKey(line string) (string, string, bool) {
+	if strings.HasPrefix(line, `"`) || strings.HasPrefix(line, "'") {
+		p := &tomlParser{s: line}
+		key, err := p.str()
+		if err != nil {
+			return "", "", false
+		}
+		rest, ok := strings.CutPrefix(strings.TrimSpace(line[p.i:]), "=")
+		return key, strings.TrimSpace(rest), ok
+	}
+	key, rest, ok := strings.Cut(line, "=")
+	return strings.TrimSpace(key), strings.TrimSpace(rest), ok
+}
+
+// stripTOMLComment は文字列外の # 以降を取り除く。
+func stripTOMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// tomlComplete は値の括弧・複数行文字列が閉じているかどうかを返す。
+func tomlComplete(v string) bool {
+	for _, q := range []string{`"""`, "'''"} {
+		if strings.HasPrefix(v, q) {
+			return strings.Count(v, q) >= 2
+		}
+	}
+	depth := 0
+	var quote byte
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		}
+	}
+	return depth <= 0
+}
+
+type tomlParser struct {
+	s string
+	i int
+}
+
+func (p *tomlParser) skipSpace() {
+	for p.i < len(p.s) && strings.ContainsRune(" \t\n", rune(p.s[p.i])) {
+		p.i++
+	}
+}
+
+func (p *tomlParser) value() (any, error) {
+	p.skipSpace()
+	if p.i >= len(p.s) {
+		return nil, fmt.Errorf("missing value")
+	}
+	switch p.s[p.i] {
+	case '"', '\'':
+		return p.str()
+	case '[':
+		return p.array()
+	case '{':
+		return p.inlineTable()
+	}
+	start := p.i
+	for p.i < len(p.s) && !strings.ContainsRune(",]} \t\n", rune(p.s[p.i])) {
+		p.i++
+	}
+	return p.s[start:p.i], nil
+}
+
+func (p *tomlParser) str() (string, error) {
+	for _, q := range []string{`"""`, "'''"} {
+		if strings.HasPrefix(p.s[p.i:], q) {
+			end := strings.Index(p.s[p.i+3:], q)
+			if end < 0 {
+				return "", fmt.Errorf("unterminated string")
+			}
+			v := strings.TrimPrefix(p.s[p.i+3:p.i+3+end], "\n")
+			p.i += 3 + end + 3
+			return v, nil
+		}
+	}
+	quote := p.s[p.i]
+	for j := p.i + 1; j < len(p.s); j++ {
+		switch {
+		case p.s[j] == '\\' && quote == '"':
+			j++
+		case p.s[j] == quote:
+			raw := p.s[p.i : j+1]
+			p.i = j + 1
+			if quote == '\'' {
+				return raw[1 : len(raw)-1], nil
+			}
+			return strconv.Unquote(raw)
+		}
+	}
+	return "", fmt.Errorf("unterminated string")
+}
+
+func (p *tomlParser) array() ([]any, error) {
+	p.i++ // [
+	var arr []any
+	for {
+		p.skipSpace()
+		if p.i >= len(p.s) {
+			return nil, fmt.Errorf("unterminated array")
+		}
+		if p.s[p.i] == ']' {
+			p.i++
+			return arr, nil
+		}
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, v)
+		p.skipSpace()
+		if p.i < len(p.s) && p.s[p.i] == ',' {
+			p.i++
+		}
+	}
+}
+
+func (p *tomlParser) inlineTable() (tomlTable, error) {
+	p.i++ // {
+	t := tomlTable{}
+	for {
+		p.skipSpace()
+		if p.i >= len(p.s) {
+			return nil, fmt.Errorf("unterminated inline table")
+		}
+		if p.s[p.i] == '}' {
+			p.i++
+			return t, nil
+		}
+		var key string
+		if p.s[p.i] == '"' || p.s[p.i] == '\'' {
+			k, err := p.str()
+			if err != nil {
+				return nil, err
+			}
+			key = k
+		} else {
+			start := p.i
+			for p.i < len(p.s) && !strings.ContainsRune("= \t", rune(p.s[p.i])) {
+				p.i++
+			}
+			key = p.s[start:p.i]
+		}
+		p.skipSpace()
+		if p.i >= len(p.s) || p.s[p.i] != '=' {
+			return nil, fmt.Errorf("invalid inline table")
+		}
+		p.i++
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		t[key] = v
+		p.skipSpace()
+		if p.i < len(p.s) && p.s[p.i] == ',' {
+			p.i++
+		}
+	}
+}
+
+// tomlString は t[key] が文字列の場合にその値を返す。
+func (t tomlTable) tomlString(key string) string {
+	s, _ := t[key].(string)
+	return s
+}
@@ -0,0 +1,502 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+// CatalogRowRejected は一括取り込みで行を登録しなかったことを表す。
+// 検証エラーのある行と、厳格モードで他の行にエラーがあった場合の全行に用いる。
+const CatalogRowRejected = "REJECTED"
+
+// ErrInvalidCatalogCSV は CSV のヘッダが不正であることを表す。
+var ErrInvalidCatalogCSV = errors.New("invalid catalog csv")
+
+// catalogColumns は一括取り込み CSV の列名。ヘッダは大文字小文字を区別せず、
+// catalogColumnAliases の別名も受け付ける。name 以外の列は省略できる。
+var catalogColumns = []string{
+	"name",
+	"homepageUrl",
+	"repositoryUrl",
+	"description",
+	"primaryLanguage",
+	"layers",
+	"tags",
+	"defaultUsageRole",
+	"version",
+	"licenseExpressionRaw",
+	"licenseConcluded",
+	"purl",
+	"cpeList",
+	"hashSha256",
+	"supplierType",
+}
+
+var catalogColumnAliases = map[string]string{
+	"homepage":   "homepageUrl",
+	"repository": "repositoryUrl",
+	"license":    "licenseExpressionRaw",
+	"cpe":        "cpeList",
+	"hash":       "hashSha256",
+}
+
+// catalogVersionColumns はバージョンの列。version が空の行でこれらを指定した場合はエラーとする。
+var catalogVersionColumns = []string{"licenseExpressionRaw", "licenseConcluded", "purl", "cpeList", "hashSha256", "supplierType"}
+
+var sha256Hex = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+// CatalogImportOptions は一括取り込み時の指定を表す。
+type CatalogImportOptions struct {
+	// Strict が true の場合、1 行でも検証エラーがあれば全ての行を登録しない。
+	Strict bool
+	// User は監査ログに記録する操作ユーザ。
+	User string
+}
+
+// CatalogImportRow は CSV 1 行分の取り込み結果を表す。
+type CatalogImportRow struct {
+	// Line は CSV 上の行番号 (ヘッダが 1 行目)。
+	Line         int
+	Name         string
+	Version      string
+	Result       string
+	OssID        string
+	OssVersionID string
+	Errors       []string
+}
+
+// CatalogImportReport は一括取り込みの結果を表す。
+type CatalogImportReport struct {
+	// Committed は有効な行を登録したかどうか。厳格モードでエラーがあった場合は false。
+	Committed bool
+	Total     int
+	Created   int
+	Matched   int
+	Rejected  int
+	Rows      []CatalogImportRow
+}
+
+func (r *CatalogImportReport) add(row CatalogImportRow) {
+	switch row.Result {
+	case ImportCreated:
+		r.Created++
+	case ImportMatched:
+		r.Matched++
+	default:
+		r.Rejected++
+	}
+	r.Total++
+	r.Rows = append(r.Rows, row)
+}
+
+// CatalogImportService は既存の台帳 (CSV) から OSS コンポーネント・バージョンを一括登録する。
+type CatalogImportService struct {
+	OssComponentRepo      domrepo.OssComponentRepository
+	OssComponentLayerRepo domrepo.OssComponentLayerRepository
+	OssComponentTagRepo   domrepo.OssComponentTagRepository
+	TagRepo               domrepo.TagRepository
+	OssVersionRepo        domrepo.OssVersionRepository
+	AuditRepo             domrepo.AuditLogRepository
+	// Enums は列挙型 (Layer / UsageRole / SupplierType) ごとの許容値。OpenAPI 定義の enum を設定する。
+	Enums map[string][]string
+	// WithinTx は fn を 1 トランザクションで実行する。fn にはトランザクションに束縛したサービスを渡す。
+	// nil の場合はトランザクションを用いずに自身を渡す。
+	WithinTx func(ctx context.Context, fn func(ctx context.Context, s *CatalogImportService) error) error
+}
+
+func (s *CatalogImportService) tx(ctx context.Context, fn func(ctx context.Context, s *CatalogImportService) error) error {
+	if s.WithinTx == nil {
+		return fn(ctx, s)
+	}
+	return s.WithinTx(ctx, fn)
+}
+
+// catalogRow は検証済みの CSV 1 行を表す。
+type catalogRow struct {
+	line    int
+	comp    model.OssComponent
+	tags    []string
+	version *model.OssVersion
+	errs    []string
+}
+
+// Import は r の CSV を読み込み、検証エラーの無い行を 1 トランザクションで登録する。
+// 同名 (正規化名) のコンポーネントが既に存在する場合はその属性を変更せずにバージョンのみ追加し、
+// 同じバージョンが既に存在する場合は MATCHED とする。同名コンポーネントの属性は最初の行の値を用いる。
+// 登録したバージョンは draft とし、存在しないタグは新規に作成する。
+// ヘッダが不正な場合は ErrInvalidCatalogCSV を返す。
+func (s *CatalogImportService) Import(ctx context.Context, r io.Reader, opts CatalogImportOptions) (*CatalogImportReport, error) {
+	rows, err := s.parse(r)
+	if err != nil {
+		return nil, err
+	}
+	invalid := slices.ContainsFunc(rows, func(row catalogRow) bool { return len(row.errs) > 0 })
+	if opts.Strict && invalid {
+		report := &CatalogImportReport{}
+		for _, row := range rows {
+			report.add(rejectedRow(row))
+		}
+		return report, nil
+	}
+
+	var report *CatalogImportReport
+	err = s.tx(ctx, func(ctx context.Context, tx *CatalogImportService) error {
+		report = &CatalogImportReport{}
+		tags, err := tx.tagIndex(ctx)
+		if err != nil {
+			return err
+		}
+		comps := map[string]*model.OssComponent{}
+		for _, row := range rows {
+			if len(row.errs) > 0 {
+				report.add(rejectedRow(row))
+				continue
+			}
+			res, err := tx.applyRow(ctx, row, comps, tags, opts.User)
+			if err != nil {
+				return err
+			}
+			report.add(res)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Committed = true
+	return report, nil
+}
+
+func rejectedRow(row catalogRow) CatalogImportRow {
+	res := CatalogImportRow{Line: row.line, Name: row.comp.Name, Result: CatalogRowRejected, Errors: row.errs}
+	if row.version != nil {
+		res.Version = row.version.Version
+	}
+	return res
+}
+
+// parse は CSV を読み込み、行ごとに検証する。ファイル内で重複する名前とバージョンの組はエラーとする。
+func (s *CatalogImportService) parse(r io.Reader) ([]catalogRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: header is required", ErrInvalidCatalogCSV)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCatalogCSV, err)
+	}
+	cols := map[string]int{}
+	for i, h := range header {
+		name, ok := catalogColumn(h)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrInvalidCatalogCSV, h)
+		}
+		if _, dup := cols[name]; dup {
+			return nil, fmt.Errorf("%w: duplicate column %q", ErrInvalidCatalogCSV, h)
+		}
+		cols[name] = i
+	}
+	if _, ok := cols["name"]; !ok {
+		return nil, fmt.Errorf("%w: column \"name\" is required", ErrInvalidCatalogCSV)
+	}
+
+	var rows []catalogRow
+	seen := map[string]int{}
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var perr *csv.ParseError
+			if errors.As(err, &perr) {
+				rows = append(rows, catalogRow{line: perr.StartLine, errs: []string{perr.Err.Error()}})
+				continue
+			}
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(rec) {
+				return strings.TrimSpace(rec[i])
+			}
+			return ""
+		}
+		if strings.TrimSpace(strings.Join(rec, "")) == "" {
+			continue
+		}
+		row := s.parseRow(line, field)
+		if len(row.errs) == 0 {
+			key := row.comp.NormalizedName
+			if row.version != nil {
+				key += "@" + row.version.Version
+			}
+			if prev, ok := seen[key]; ok {
+				row.errs = append(row.errs, fmt.Sprintf("duplicate of line %d", prev))
+			} else {
+				seen[key] = line
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// catalogColumn はヘッダの列名を正規の列名に読み替える。
+func catalogColumn(h string) (string, bool) {
+	h = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")) // Excel が付与する BOM
+	for _, c := range catalogColumns {
+		if strings.EqualFold(h, c) {
+			return c, true
+		}
+	}
+	for alias, c := range catalogColumnAliases {
+		if strings.EqualFold(h, alias) {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+// parseRow は 1 行分の値を検証し、登録するコンポーネント・バージョンを組み立てる。
+func (s *CatalogImportService) parseRow(line int, field func(string) string) catalogRow {
+	row := catalogRow{line: line}
+	fail := func(format string, args ...any) {
+		row.errs = append(row.errs, fmt.Sprintf(format, args...))
+	}
+
+	name := field("name")
+	if name == "" {
+		fail("name is required")
+	}
+	row.comp = model.OssComponent{
+		Name:            name,
+		NormalizedName:  NormalizeComponentName(name),
+		HomepageURL:     optional(field("homepageUrl")),
+		RepositoryURL:   optional(field("repositoryUrl")),
+		Description:     optional(field("description")),
+		PrimaryLanguage: optional(field("primaryLanguage")),
+	}
+	for _, l := range splitCatalogList(field("layers"), ";,") {
+		if !s.allowed("Layer", l) {
+			fail("layers: invalid value %q", l)
+		}
+		row.comp.Layers = append(row.comp.Layers, l)
+	}
+	row.tags = splitCatalogList(field("tags"), ";,")
+	if role := field("defaultUsageRole"); role != "" {
+		if !s.allowed("UsageRole", role) {
+			fail("defaultUsageRole: invalid value %q", role)
+		}
+		row.comp.DefaultUsageRole = &role
+	}
+
+	version := field("version")
+	if version == "" {
+		for _, c := range catalogVersionColumns {
+			if field(c) != "" {
+				fail("%s requires version", c)
+			}
+		}
+		return row
+	}
+	v := &model.OssVersion{
+		Version:              version,
+		LicenseExpressionRaw: optional(field("licenseExpressionRaw")),
+		LicenseConcluded:     optional(field("licenseConcluded")),
+		Purl:                 optional(field("purl")),
+		CpeList:              splitCatalogList(field("cpeList"), ";"),
+		ReviewStatus:         "draft",
+		ScopeStatus:          "IN_SCOPE",
+	}
+	for _, lf := range []struct {
+		name  string
+		value *string
+	}{{"licenseExpressionRaw", v.LicenseExpressionRaw}, {"licenseConcluded", v.LicenseConcluded}} {
+		if lf.value == nil {
+			continue
+		}
+		norm, err := license.Normalize(*lf.value)
+		if err != nil {
+			fail("%s: %v", lf.name, err)
+			continue
+		}
+		*lf.value = norm
+	}
+	if p := field("purl"); p != "" && (!strings.HasPrefix(p, "pkg:") || !strings.Contains(p, "/")) {
+		fail("purl: invalid value %q", p)
+	}
+	for _, cpe := range v.CpeList {
+		if !strings.HasPrefix(cpe, "cpe:") {
+			fail("cpeList: invalid value %q", cpe)
+		}
+	}
+	if h := field("hashSha256"); h != "" {
+		if !sha256Hex.MatchString(h) {
+			fail("hashSha256: invalid value %q", h)
+		}
+		h = strings.ToLower(h)
+		v.HashSha256 = &h
+	}
+	if st := field("supplierType"); st != "" {
+		if !s.allowed("SupplierType", st) {
+			fail("supplierType: invalid value %q", st)
+		}
+		v.SupplierType = &st
+	}
+	row.version = v
+	return row
+}
+
+// allowed は value が列挙型 schema の許容値に含まれるかどうかを返す。
+func (s *CatalogImportService) allowed(schema, value string) bool {
+	return slices.Contains(s.Enums[schema], value)
+}
+
+// splitCatalogList は seps のいずれかで区切られた複数値を分割する。空の要素は除く。
+func splitCatalogList(v string, seps string) []string {
+	var out []string
+	for _, e := range strings.FieldsFunc(v, func(r rune) bool { return strings.ContainsRune(seps, r) }) {
+		if e = strings.TrimSpace(e); e != "" {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// tagIndex は登録済みタグを正規化名で引ける索引を返す。
+func (s *CatalogImportService) tagIndex(ctx context.Context) (map[string]string, error) {
+	tags, err := s.TagRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx := make(map[string]string, len(tags))
+	for _, t := range tags {
+		idx[NormalizeComponentName(t.Name)] = t.ID
+	}
+	return idx, nil
+}
+
+// applyRow は 1 行分のコンポーネント・バージョンを登録する。
+func (s *CatalogImportService) applyRow(ctx context.Context, row catalogRow, comps map[string]*model.OssComponent, tags map[string]string, user string) (CatalogImportRow, error) {
+	res := CatalogImportRow{Line: row.line, Name: row.comp.Name, Result: ImportMatched}
+	comp, ok := comps[row.comp.NormalizedName]
+	if !ok {
+		existing, err := s.OssComponentRepo.FindByNormalizedName(ctx, row.comp.NormalizedName)
+		switch {
+		case err == nil:
+			comp = existing
+		case errors.Is(err, sql.ErrNoRows):
+			if comp, err = s.createComponent(ctx, row, tags, user); err != nil {
+				return res, err
+			}
+			res.Result = ImportCreated
+		default:
+			return res, err
+		}
+		comps[row.comp.NormalizedName] = comp
+	}
+	res.OssID = comp.ID
+	if row.version == nil {
+		return res, nil
+	}
+
+	res.Version = row.version.Version
+	existing, err := s.OssVersionRepo.FindByVersion(ctx, comp.ID, row.version.Version)
+	if err == nil {
+		res.OssVersionID = existing.ID
+		return res, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return res, err
+	}
+	now := dbtime.DBTime{Time: time.Now()}
+	v := *row.version
+	v.ID = uuid.NewString()
+	v.OssID = comp.ID
+	v.CreatedAt = now
+	v.UpdatedAt = now
+	if err := s.OssVersionRepo.Create(ctx, &v); err != nil {
+		return res, err
+	}
+	if err := s.audit(ctx, model.AuditEntityOssVersion, v.ID, user, "imported catalog version "+comp.Name+" "+v.Version); err != nil {
+		return res, err
+	}
+	res.OssVersionID = v.ID
+	res.Result = ImportCreated
+	return res, nil
+}
+
+// createComponent は行の属性でコンポーネントを登録し、レイヤ・タグを設定する。
+func (s *CatalogImportService) createComponent(ctx context.Context, row catalogRow, tags map[string]string, user string) (*model.OssComponent, error) {
+	now := dbtime.DBTime{Time: time.Now()}
+	comp := row.comp
+	comp.ID = uuid.NewString()
+	comp.CreatedAt = now
+	comp.UpdatedAt = now
+	if err := s.OssComponentRepo.Create(ctx, &comp); err != nil {
+		return nil, err
+	}
+	if len(comp.Layers) > 0 {
+		if err := s.OssComponentLayerRepo.Replace(ctx, comp.ID, comp.Layers); err != nil {
+			return nil, err
+		}
+	}
+	if len(row.tags) > 0 {
+		ids := make([]string, 0, len(row.tags))
+		for _, name := range row.tags {
+			id, ok := tags[NormalizeComponentName(name)]
+			if !ok {
+				tag := &model.Tag{ID: uuid.NewString(), Name: name, CreatedAt: &now}
+				if err := s.TagRepo.Create(ctx, tag); err != nil {
+					return nil, err
+				}
+				id = tag.ID
+				tags[NormalizeComponentName(name)] = id
+			}
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		if err := s.OssComponentTagRepo.Replace(ctx, comp.ID, ids); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.audit(ctx, model.AuditEntityOssComponent, comp.ID, user, "imported catalog component "+comp.Name); err != nil {
+		return nil, err
+	}
+	return &comp, nil
+}
+
+// audit は登録操作の監査ログを 1 件記録する。AuditRepo が未設定の場合は何もしない。
+func (s *CatalogImportService) audit(ctx context.Context, entityType, entityID, user, summary string) error {
+	if s.AuditRepo == nil {
+		return nil
+	}
+	return s.AuditRepo.Create(ctx, &model.AuditLog{
+		ID:         uuid.NewString(),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     model.AuditActionCreate,
+		UserName:   user,
+		Summary:    &summary,
+		CreatedAt:  dbtime.DBTime{Time: time.Now()},
+	})
+}
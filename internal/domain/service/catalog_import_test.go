@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+type memLayerRepo struct {
+	domrepo.OssComponentLayerRepository
+	layers map[string][]string
+}
+
+func (m *memLayerRepo) Replace(ctx context.Context, ossID string, layers []string) error {
+	m.layers[ossID] = layers
+	return nil
+}
+
+type memTagRepo struct {
+	domrepo.TagRepository
+	tags []model.Tag
+}
+
+func (m *memTagRepo) List(ctx context.Context) ([]model.Tag, error) {
+	return m.tags, nil
+}
+
+func (m *memTagRepo) Create(ctx context.Context, t *model.Tag) error {
+	m.tags = append(m.tags, *t)
+	return nil
+}
+
+type memComponentTagRepo struct {
+	domrepo.OssComponentTagRepository
+	tags map[string][]string
+}
+
+func (m *memComponentTagRepo) Replace(ctx context.Context, ossID string, tagIDs []string) error {
+	m.tags[ossID] = tagIDs
+	return nil
+}
+
+var testCatalogEnums = map[string][]string{
+	"Layer":        {"LIB", "FRAMEWORK", "RUNTIME"},
+	"UsageRole":    {"BUNDLED_BINARY", "RUNTIME_REQUIRED"},
+	"SupplierType": {"UPSTREAM", "INTERNAL_FORK", "REPACKAGE"},
+}
+
+func newCatalogImportService(c *memCatalog) (*CatalogImportService, *memLayerRepo, *memTagRepo, *memComponentTagRepo) {
+	layers := &memLayerRepo{layers: map[string][]string{}}
+	tags := &memTagRepo{tags: []model.Tag{{ID: "t-web", Name: "Web"}}}
+	compTags := &memComponentTagRepo{tags: map[string][]string{}}
+	return &CatalogImportService{
+		OssComponentRepo:      &memComponentRepo{c: c},
+		OssComponentLayerRepo: layers,
+		OssComponentTagRepo:   compTags,
+		TagRepo:               tags,
+		OssVersionRepo:        &memVersionRepo{c: c},
+		AuditRepo:             &memAuditRepo{},
+		Enums:                 testCatalogEnums,
+	}, layers, tags, compTags
+}
+
+const catalogTestCSV = "\ufeffName,Homepage,Layers,Tags,defaultUsageRole,version,license,purl,cpe,hash,supplierType\n" +
+	"lodash,https://lodash.com,LIB,web;util,BUNDLED_BINARY,4.17.21,MIT,pkg:npm/lodash@4.17.21,cpe:2.3:a:lodash:lodash:4.17.21:*:*:*:*:*:*:*,49D7B5C0DB2D3E8BA1B17F64A0F3C3C0D4A7C2E7F3A6B9A1B2C3D4E5F6A7B8C9,UPSTREAM\n" +
+	"lodash,,,,,4.17.20,mit,,,,\n" +
+	"spring-core,,\"LIB, FRAMEWORK\",,,,,,,,\n" +
+	"existing,,,,,1.0.0,,,,,\n" +
+	"bad,,KERNEL,,EVERYWHERE,1.0,Apache 2,npm/bad,,xyz,VENDOR\n" +
+	",,,,,,,,,,\n" +
+	"noversion,,,,,,MIT,,,,\n" +
+	"lodash,,,,,4.17.21,,,,,\n"
+
+func TestCatalogImportService_Import(t *testing.T) {
+	c := &memCatalog{
+		components: []model.OssComponent{{ID: "c-existing", Name: "existing", NormalizedName: "existing"}},
+		versions:   []model.OssVersion{{ID: "v-existing", OssID: "c-existing", Version: "1.0.0"}},
+	}
+	svc, layers, tags, compTags := newCatalogImportService(c)
+
+	report, err := svc.Import(context.Background(), strings.NewReader(catalogTestCSV), CatalogImportOptions{User: "admin"})
+	require.NoError(t, err)
+	require.True(t, report.Committed)
+	require.Equal(t, 7, report.Total)
+	require.Equal(t, 3, report.Created)
+	require.Equal(t, 1, report.Matched)
+	require.Equal(t, 3, report.Rejected)
+
+	lodash := report.Rows[0]
+	require.Equal(t, 2, lodash.Line)
+	require.Equal(t, ImportCreated, lodash.Result)
+	require.Len(t, c.components, 3)
+	comp := c.components[1]
+	require.Equal(t, "lodash", comp.Name)
+	require.Equal(t, "https://lodash.com", *comp.HomepageURL)
+	require.Equal(t, "BUNDLED_BINARY", *comp.DefaultUsageRole)
+	require.Equal(t, []string{"LIB"}, layers.layers[comp.ID])
+	// 既存タグは名前 (大文字小文字を区別しない) で再利用し、無いタグは作成する
+	require.Len(t, tags.tags, 2)
+	require.Equal(t, []string{"t-web", tags.tags[1].ID}, compTags.tags[comp.ID])
+
+	v := c.versions[1]
+	require.Equal(t, lodash.OssVersionID, v.ID)
+	require.Equal(t, "MIT", *v.LicenseExpressionRaw)
+	require.Equal(t, "pkg:npm/lodash@4.17.21", *v.Purl)
+	require.Equal(t, "49d7b5c0db2d3e8ba1b17f64a0f3c3c0d4a7c2e7f3a6b9a1b2c3d4e5f6a7b8c9", *v.HashSha256)
+	require.Equal(t, "UPSTREAM", *v.SupplierType)
+	require.Equal(t, "draft", v.ReviewStatus)
+
+	// 同じコンポーネントの 2 行目はバージョンのみ追加する
+	require.Equal(t, ImportCreated, report.Rows[1].Result)
+	require.Equal(t, lodash.OssID, report.Rows[1].OssID)
+	// ライセンス式は正規化した表記で登録する
+	require.Equal(t, "MIT", *c.versions[2].LicenseExpressionRaw)
+	require.Equal(t, []string{"LIB", "FRAMEWORK"}, layers.layers[report.Rows[2].OssID])
+	require.Equal(t, ImportMatched, report.Rows[3].Result)
+	require.Equal(t, "v-existing", report.Rows[3].OssVersionID)
+
+	bad := report.Rows[4]
+	require.Equal(t, 6, bad.Line)
+	require.Equal(t, CatalogRowRejected, bad.Result)
+	require.Equal(t, []string{
+		`layers: invalid value "KERNEL"`,
+		`defaultUsageRole: invalid value "EVERYWHERE"`,
+		`licenseExpressionRaw: invalid license expression "Apache 2": unknown license ID "Apache"; unexpected "2"`,
+		`purl: invalid value "npm/bad"`,
+		`hashSha256: invalid value "xyz"`,
+		`supplierType: invalid value "VENDOR"`,
+	}, bad.Errors)
+	require.Equal(t, []string{"licenseExpressionRaw requires version"}, report.Rows[5].Errors)
+	require.Equal(t, []string{"duplicate of line 2"}, report.Rows[6].Errors)
+}
+
+func TestCatalogImportService_Import_Strict(t *testing.T) {
+	c := &memCatalog{}
+	svc, _, _, _ := newCatalogImportService(c)
+
+	report, err := svc.Import(context.Background(), strings.NewReader(catalogTestCSV), CatalogImportOptions{Strict: true})
+	require.NoError(t, err)
+	require.False(t, report.Committed)
+	require.Equal(t, 7, report.Rejected)
+	require.Empty(t, report.Rows[0].Errors)
+	require.Empty(t, c.components)
+	require.Empty(t, c.versions)
+
+	report, err = svc.Import(context.Background(), strings.NewReader("name,version\nzlib,1.3\n"), CatalogImportOptions{Strict: true})
+	require.NoError(t, err)
+	require.True(t, report.Committed)
+	require.Equal(t, 1, report.Created)
+}
+
+func TestCatalogImportService_Import_InvalidHeader(t *testing.T) {
+	svc, _, _, _ := newCatalogImportService(&memCatalog{})
+	for _, doc := range []string{"", "version\n1.0\n", "name,owner\nx,y\n", "name,NAME\n"} {
+		_, err := svc.Import(context.Background(), strings.NewReader(doc), CatalogImportOptions{})
+		require.ErrorIs(t, err, ErrInvalidCatalogCSV, doc)
+	}
+}
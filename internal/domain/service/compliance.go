@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/policy"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// ComplianceService はプロジェクトの OSS 利用をライセンスポリシーで評価する。
+type ComplianceService struct {
+	ProjectRepo       domrepo.ProjectRepository
+	ProjectUsageRepo  domrepo.ProjectUsageRepository
+	LicensePolicyRepo domrepo.LicensePolicyRuleRepository
+	// LicenseRepo はルールの分類条件に用いるライセンスカタログ。未設定の場合は分類で判定しない。
+	LicenseRepo domrepo.LicenseRepository
+}
+
+// Evaluate は指定スコープの利用を有効なルールで評価する。
+// プロジェクトが存在しない場合は sql.ErrNoRows を返す。
+func (s *ComplianceService) Evaluate(ctx context.Context, projectID string, scopes []string) (*policy.Report, error) {
+	p, err := s.ProjectRepo.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.ProjectUsageRepo.ListDetails(ctx, p.ID, scopes)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.LicensePolicyRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.categories(ctx, rules, items)
+	if err != nil {
+		return nil, err
+	}
+	return policy.Evaluate(rules, *p, items, func(id string) string { return categories[id] }), nil
+}
+
+// categories は分類条件を持つルールがある場合に、利用一覧のライセンスの分類をライセンスカタログから取得する。
+func (s *ComplianceService) categories(ctx context.Context, rules []model.LicensePolicyRule, items []model.ProjectUsageDetail) (map[string]string, error) {
+	res := map[string]string{}
+	if s.LicenseRepo == nil || !hasCategoryRule(rules) {
+		return res, nil
+	}
+	seen := map[string]bool{}
+	for _, it := range items {
+		for _, expr := range []*string{it.Version.LicenseConcluded, it.Version.LicenseExpressionRaw} {
+			for _, id := range licenseIDs(expr) {
+				if seen[id] {
+					continue
+				}
+				seen[id] = true
+				l, err := s.LicenseRepo.Get(ctx, id)
+				if errors.Is(err, sql.ErrNoRows) {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				if l.Category != nil {
+					res[id] = *l.Category
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+func hasCategoryRule(rules []model.LicensePolicyRule) bool {
+	for _, r := range rules {
+		if r.Enabled && len(r.Categories) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// licenseIDs はライセンス式を構文解析し、含まれるライセンス ID (正式表記) を返す。
+// 未設定・不正な式の場合は nil を返す。
+func licenseIDs(expr *string) []string {
+	if expr == nil {
+		return nil
+	}
+	e, err := license.Parse(*expr)
+	if err != nil {
+		return nil
+	}
+	var ids []string
+	for _, l := range e.Licenses() {
+		ids = append(ids, l.License)
+	}
+	return ids
+}
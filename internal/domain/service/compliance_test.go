@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+type stubLicensePolicyRepo struct {
+	domrepo.LicensePolicyRuleRepository
+	rules []model.LicensePolicyRule
+}
+
+func (s *stubLicensePolicyRepo) List(ctx context.Context) ([]model.LicensePolicyRule, error) {
+	return s.rules, nil
+}
+
+func TestComplianceService_Evaluate(t *testing.T) {
+	sspl, raw := "SSPL-1.0", "GPL-2.0+"
+	network, strong := "NETWORK_COPYLEFT", "STRONG_COPYLEFT"
+	licenses := &memLicenseRepo{licenses: map[string]model.License{
+		"SSPL-1.0":         {ID: "SSPL-1.0", Category: &network},
+		"GPL-2.0-or-later": {ID: "GPL-2.0-or-later", Category: &strong},
+	}}
+	svc := &ComplianceService{
+		ProjectRepo: &stubProjectRepo{project: &model.Project{ID: "p1"}},
+		ProjectUsageRepo: &stubProjectUsageRepo{items: []model.ProjectUsageDetail{
+			{Usage: model.ProjectUsage{ID: "u1", UsageRole: "SERVER_ENV", ScopeStatus: "IN_SCOPE"}, Version: model.OssVersion{LicenseConcluded: &sspl}},
+			{Usage: model.ProjectUsage{ID: "u2", UsageRole: "STATIC_LINK", ScopeStatus: "IN_SCOPE"}, Version: model.OssVersion{LicenseExpressionRaw: &raw}},
+		}},
+		LicensePolicyRepo: &stubLicensePolicyRepo{rules: []model.LicensePolicyRule{
+			{ID: "r1", Name: "deny network copyleft", Severity: model.PolicySeverityDeny, Categories: []string{network}, Enabled: true},
+			{ID: "r2", Name: "warn strong copyleft static link", Severity: model.PolicySeverityWarn, Categories: []string{strong}, UsageRoles: []string{"STATIC_LINK"}, Enabled: true},
+		}},
+		LicenseRepo: licenses,
+	}
+
+	r, err := svc.Evaluate(context.Background(), "p1", []string{"IN_SCOPE"})
+	require.NoError(t, err)
+	require.Equal(t, 1, r.Deny)
+	require.Equal(t, 1, r.Warn)
+	require.Equal(t, "SSPL-1.0", r.Violations[0].License)
+	// 非推奨 ID は正規化した ID で分類を引く
+	require.Equal(t, "GPL-2.0-or-later", r.Violations[1].License)
+
+	_, err = svc.Evaluate(context.Background(), "missing", nil)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
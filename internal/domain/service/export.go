@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/export"
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// ExportService はプロジェクトの納品用エクスポート対象を収集する。
+type ExportService struct {
+	ProjectRepo      domrepo.ProjectRepository
+	ProjectUsageRepo domrepo.ProjectUsageRepository
+	// LicenseRepo は NOTICE に掲載するライセンス本文の取得元。未設定の場合は同梱の本文のみを用いる。
+	LicenseRepo domrepo.LicenseRepository
+	// OssComponentLayerRepo はテンプレートに渡すコンポーネントのレイヤーの取得元。未設定の場合はレイヤーを設定しない。
+	OssComponentLayerRepo domrepo.OssComponentLayerRepository
+	// Vulnerabilities は VEX 形式に載せる脆弱性の該当と分析の取得元。
+	Vulnerabilities *VulnerabilityService
+}
+
+// BuildDocument はプロジェクトと指定スコープの利用一覧からエクスポート用 Document を組み立てる。
+// プロジェクトが存在しない場合は sql.ErrNoRows を返す。
+func (s *ExportService) BuildDocument(ctx context.Context, projectID string, scopes []string, user string) (*export.Document, error) {
+	p, err := s.ProjectRepo.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.ProjectUsageRepo.ListDetails(ctx, p.ID, scopes)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.componentLayers(ctx, items); err != nil {
+		return nil, err
+	}
+	texts, err := s.licenseTexts(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	deps, err := s.ProjectUsageRepo.ListDependencies(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &export.Document{Project: *p, Items: items, Scopes: scopes, GeneratedAt: time.Now(), GeneratedBy: user, LicenseTexts: texts, Dependencies: deps}, nil
+}
+
+// AddFindings は Document のプロジェクト・スコープで該当した脆弱性と VEX 分析を Document.Findings に設定する。
+// VEX では影響を受けない・修正済みの分析も伝えるため、抑止された該当も含める。
+func (s *ExportService) AddFindings(ctx context.Context, d *export.Document) error {
+	if s.Vulnerabilities == nil {
+		return errors.New("vulnerability service is not configured")
+	}
+	_, findings, err := s.Vulnerabilities.ProjectFindings(ctx, d.Project.ID, ProjectVulnerabilityFilter{Scopes: d.Scopes, IncludeSuppressed: true})
+	if err != nil {
+		return err
+	}
+	d.Findings = findings
+	return nil
+}
+
+// componentLayers は利用一覧の各コンポーネントにレイヤーを設定する。
+func (s *ExportService) componentLayers(ctx context.Context, items []model.ProjectUsageDetail) error {
+	if s.OssComponentLayerRepo == nil {
+		return nil
+	}
+	layers := map[string][]string{}
+	for i := range items {
+		id := items[i].Component.ID
+		ls, ok := layers[id]
+		if !ok {
+			var err error
+			if ls, err = s.OssComponentLayerRepo.ListByOssID(ctx, id); err != nil {
+				return err
+			}
+			layers[id] = ls
+		}
+		items[i].Component.Layers = ls
+	}
+	return nil
+}
+
+// licenseTexts は利用一覧のライセンス式に含まれるライセンスの本文をライセンスカタログから取得する。
+// 未登録・本文未設定のライセンスは含めない。
+func (s *ExportService) licenseTexts(ctx context.Context, items []model.ProjectUsageDetail) (map[string]string, error) {
+	if s.LicenseRepo == nil {
+		return nil, nil
+	}
+	texts := map[string]string{}
+	seen := map[string]bool{}
+	for _, it := range items {
+		for _, expr := range []*string{it.Version.LicenseConcluded, it.Version.LicenseExpressionRaw} {
+			if expr == nil {
+				continue
+			}
+			for _, id := range license.IDs(*expr) {
+				if seen[id] {
+					continue
+				}
+				seen[id] = true
+				l, err := s.LicenseRepo.Get(ctx, id)
+				if errors.Is(err, sql.ErrNoRows) {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				if l.Text != nil && *l.Text != "" {
+					texts[id] = *l.Text
+				}
+			}
+		}
+	}
+	return texts, nil
+}
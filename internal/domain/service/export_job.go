@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/export"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+var (
+	ErrExportQueueFull     = errors.New("export queue is full")
+	ErrUnknownExportFormat = errors.New("unknown export format")
+)
+
+// ExportJobService は非同期エクスポートジョブを固定数のワーカーで処理する。
+// 生成結果は Dir 配下に保存し、Retention 経過後に削除する。
+// ジョブは DB に保存されるため、再起動時には未完了ジョブを再投入する。
+type ExportJobService struct {
+	JobRepo   domrepo.ExportJobRepository
+	Exporter  *ExportService
+	Dir       string
+	Retention time.Duration
+	// Workers は同時実行数。0 以下の場合は 1 とする。
+	Workers int
+	// QueueSize は待ち行列の上限。0 以下の場合は 100 とする。
+	QueueSize int
+	// CleanupInterval は期限切れファイル削除の間隔。0 以下の場合は 10 分とする。
+	CleanupInterval time.Duration
+
+	queue chan string
+	wg    sync.WaitGroup
+}
+
+// Start はワーカーと期限切れファイルの削除処理を起動し、未完了ジョブを再投入する。
+// ctx がキャンセルされると各処理は終了する。Enqueue より前に呼び出すこと。
+func (s *ExportJobService) Start(ctx context.Context) error {
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+	workers := s.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	size := s.QueueSize
+	if size <= 0 {
+		size = 100
+	}
+	interval := s.CleanupInterval
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+	s.queue = make(chan string, size)
+
+	for i := 0; i < workers; i++ {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case id := <-s.queue:
+					s.run(ctx, id)
+				}
+			}
+		}()
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-t.C:
+				if err := s.Cleanup(ctx, now); err != nil {
+					log.Printf("export cleanup: %v", err)
+				}
+			}
+		}
+	}()
+
+	return s.recover(ctx)
+}
+
+// Wait は Start で起動した処理の終了を待つ。
+func (s *ExportJobService) Wait() {
+	s.wg.Wait()
+}
+
+// recover は前回起動時に完了しなかったジョブを待ち行列に戻す。
+func (s *ExportJobService) recover(ctx context.Context) error {
+	jobs, err := s.JobRepo.ListByStatus(ctx, []string{model.ExportJobQueued, model.ExportJobRunning})
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for _, j := range jobs {
+		if j.Status == model.ExportJobRunning {
+			j.Status = model.ExportJobQueued
+			j.Progress = 0
+			j.StartedAt = nil
+			if err := s.JobRepo.Update(ctx, &j); err != nil {
+				return err
+			}
+		}
+		ids = append(ids, j.ID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for _, id := range ids {
+			select {
+			case <-ctx.Done():
+				return
+			case s.queue <- id:
+			}
+		}
+	}()
+	return nil
+}
+
+// Enqueue はエクスポートジョブを登録して待ち行列に投入する。
+// プロジェクトが存在しない場合は sql.ErrNoRows、待ち行列が満杯の場合は ErrExportQueueFull を返す。
+func (s *ExportJobService) Enqueue(ctx context.Context, projectID, format string, scopes []string, user string) (*model.ExportJob, error) {
+	if _, ok := export.LookupFormat(format); !ok {
+		return nil, ErrUnknownExportFormat
+	}
+	if _, err := s.Exporter.ProjectRepo.Get(ctx, projectID); err != nil {
+		return nil, err
+	}
+	j := &model.ExportJob{
+		ID:        uuid.NewString(),
+		ProjectID: projectID,
+		Format:    format,
+		Scopes:    scopes,
+		Status:    model.ExportJobQueued,
+		CreatedBy: user,
+		CreatedAt: dbtime.DBTime{Time: time.Now()},
+	}
+	if err := s.JobRepo.Create(ctx, j); err != nil {
+		return nil, err
+	}
+	select {
+	case s.queue <- j.ID:
+		return j, nil
+	default:
+		s.fail(ctx, j, ErrExportQueueFull)
+		return nil, ErrExportQueueFull
+	}
+}
+
+// FilePath はジョブ ID に対応する結果ファイルのパスを返す。
+func (s *ExportJobService) FilePath(jobID string) string {
+	return filepath.Join(s.Dir, jobID)
+}
+
+// run は 1 件のジョブを実行し、結果をファイルに保存する。
+func (s *ExportJobService) run(ctx context.Context, id string) {
+	j, err := s.JobRepo.Get(ctx, id)
+	if err != nil {
+		log.Printf("export job %s: %v", id, err)
+		return
+	}
+	if j.Status != model.ExportJobQueued {
+		return
+	}
+	started := dbtime.DBTime{Time: time.Now()}
+	j.Status = model.ExportJobRunning
+	j.StartedAt = &started
+	j.Progress = 10
+	if err := s.JobRepo.Update(ctx, j); err != nil {
+		log.Printf("export job %s: %v", id, err)
+		return
+	}
+
+	f, _ := export.LookupFormat(j.Format)
+	doc, err := s.Exporter.BuildDocument(ctx, j.ProjectID, j.Scopes, j.CreatedBy)
+	if err == nil && f.NeedsFindings() {
+		err = s.Exporter.AddFindings(ctx, doc)
+	}
+	if err != nil {
+		s.fail(ctx, j, err)
+		return
+	}
+	j.Progress = 50
+	if err := s.JobRepo.Update(ctx, j); err != nil {
+		log.Printf("export job %s: %v", id, err)
+	}
+
+	path := s.FilePath(j.ID)
+	size, err := writeFile(path, func(w *os.File) error { return f.Write(w, doc) })
+	if err != nil {
+		s.fail(ctx, j, err)
+		return
+	}
+
+	now := time.Now()
+	finished := dbtime.DBTime{Time: now}
+	expires := dbtime.DBTime{Time: now.Add(s.Retention)}
+	name := f.FileName(doc.Project.ProjectCode)
+	j.Status = model.ExportJobSucceeded
+	j.Progress = 100
+	j.FileName = &name
+	j.FilePath = &path
+	j.ContentType = &f.ContentType
+	j.FileSize = &size
+	j.FinishedAt = &finished
+	j.ExpiresAt = &expires
+	if err := s.JobRepo.Update(ctx, j); err != nil {
+		log.Printf("export job %s: %v", id, err)
+	}
+}
+
+// fail はジョブを失敗として記録する。
+func (s *ExportJobService) fail(ctx context.Context, j *model.ExportJob, cause error) {
+	msg := cause.Error()
+	finished := dbtime.DBTime{Time: time.Now()}
+	j.Status = model.ExportJobFailed
+	j.Error = &msg
+	j.FinishedAt = &finished
+	if err := s.JobRepo.Update(ctx, j); err != nil {
+		log.Printf("export job %s: %v", j.ID, err)
+	}
+}
+
+// Cleanup は保持期限を過ぎたジョブの結果ファイルを削除して EXPIRED とする。
+// DB に対応するジョブが無いファイル (プロジェクト削除時など) や失敗・期限切れのジョブのファイルも保持期間経過後に削除する。
+// Dir に置かれた他のファイルを消さないよう、対象はジョブ ID (UUID) 名のファイルと一時ファイルに限る。
+func (s *ExportJobService) Cleanup(ctx context.Context, now time.Time) error {
+	jobs, err := s.JobRepo.ListExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, j := range jobs {
+		if j.FilePath != nil {
+			if err := os.Remove(*j.FilePath); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		j.Status = model.ExportJobExpired
+		j.FilePath = nil
+		if err := s.JobRepo.Update(ctx, &j); err != nil {
+			return err
+		}
+	}
+
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil || e.IsDir() || !info.ModTime().Before(now.Add(-s.Retention)) {
+			continue
+		}
+		orphan, err := s.isOrphan(ctx, e.Name())
+		if err != nil {
+			return err
+		}
+		if !orphan {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.Dir, e.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// isOrphan は Dir 配下のファイル名が、ジョブが無いか失敗・期限切れとなったジョブの結果ファイル (<jobID> / <jobID>.tmp) かを返す。
+func (s *ExportJobService) isOrphan(ctx context.Context, name string) (bool, error) {
+	id := strings.TrimSuffix(name, ".tmp")
+	if u, err := uuid.Parse(id); err != nil || u.String() != id {
+		return false, nil
+	}
+	j, err := s.JobRepo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return j.Status == model.ExportJobExpired || j.Status == model.ExportJobFailed, nil
+}
+
+// writeFile は一時ファイルに書き込んでから path にリネームし、書き込んだサイズを返す。
+func writeFile(path string, write func(*os.File) error) (int64, error) {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return 0, err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	return info.Size(), nil
+}
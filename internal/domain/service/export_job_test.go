@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+type stubProjectRepo struct {
+	domrepo.ProjectRepository
+	project *model.Project
+}
+
+func (s *stubProjectRepo) Get(ctx context.Context, id string) (*model.Project, error) {
+	if s.project == nil || s.project.ID != id {
+		return nil, sql.ErrNoRows
+	}
+	p := *s.project
+	return &p, nil
+}
+
+type stubProjectUsageRepo struct {
+	domrepo.ProjectUsageRepository
+	items []model.ProjectUsageDetail
+	deps  map[string][]string
+	err   error
+}
+
+func (s *stubProjectUsageRepo) ListDetails(ctx context.Context, projectID string, scopes []string) ([]model.ProjectUsageDetail, error) {
+	return s.items, s.err
+}
+
+func (s *stubProjectUsageRepo) ListDependencies(ctx context.Context, projectID string) (map[string][]string, error) {
+	return s.deps, nil
+}
+
+// memExportJobRepo はテスト用のインメモリ ExportJobRepository。
+type memExportJobRepo struct {
+	mu   sync.Mutex
+	jobs map[string]model.ExportJob
+}
+
+func newMemExportJobRepo() *memExportJobRepo {
+	return &memExportJobRepo{jobs: map[string]model.ExportJob{}}
+}
+
+func (m *memExportJobRepo) Create(ctx context.Context, j *model.ExportJob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs[j.ID] = *j
+	return nil
+}
+
+func (m *memExportJobRepo) Get(ctx context.Context, id string) (*model.ExportJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j, ok := m.jobs[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &j, nil
+}
+
+func (m *memExportJobRepo) Update(ctx context.Context, j *model.ExportJob) error {
+	return m.Create(ctx, j)
+}
+
+func (m *memExportJobRepo) ListByStatus(ctx context.Context, statuses []string) ([]model.ExportJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var res []model.ExportJob
+	for _, j := range m.jobs {
+		for _, s := range statuses {
+			if j.Status == s {
+				res = append(res, j)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (m *memExportJobRepo) ListExpired(ctx context.Context, before time.Time) ([]model.ExportJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var res []model.ExportJob
+	for _, j := range m.jobs {
+		if j.Status == model.ExportJobSucceeded && j.ExpiresAt != nil && j.ExpiresAt.Before(before) {
+			res = append(res, j)
+		}
+	}
+	return res, nil
+}
+
+func newTestExportJobService(t *testing.T, repo *memExportJobRepo, usages *stubProjectUsageRepo) *ExportJobService {
+	t.Helper()
+	return &ExportJobService{
+		JobRepo: repo,
+		Exporter: &ExportService{
+			ProjectRepo:      &stubProjectRepo{project: &model.Project{ID: "p1", ProjectCode: "P1", Name: "Proj"}},
+			ProjectUsageRepo: usages,
+		},
+		Dir:       t.TempDir(),
+		Retention: time.Hour,
+		Workers:   2,
+	}
+}
+
+func waitForStatus(t *testing.T, repo *memExportJobRepo, id string, statuses ...string) *model.ExportJob {
+	t.Helper()
+	var j *model.ExportJob
+	require.Eventually(t, func() bool {
+		var err error
+		j, err = repo.Get(context.Background(), id)
+		if err != nil {
+			return false
+		}
+		for _, s := range statuses {
+			if j.Status == s {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+	return j
+}
+
+func TestExportJobService_EnqueueAndRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := newMemExportJobRepo()
+	svc := newTestExportJobService(t, repo, &stubProjectUsageRepo{})
+	require.NoError(t, svc.Start(ctx))
+	defer func() { cancel(); svc.Wait() }()
+
+	j, err := svc.Enqueue(ctx, "p1", "csv", []string{"IN_SCOPE"}, "alice")
+	require.NoError(t, err)
+	require.Equal(t, model.ExportJobQueued, j.Status)
+
+	done := waitForStatus(t, repo, j.ID, model.ExportJobSucceeded, model.ExportJobFailed)
+	require.Equal(t, model.ExportJobSucceeded, done.Status)
+	require.Equal(t, 100, done.Progress)
+	require.Equal(t, "P1-oss-list.csv", *done.FileName)
+	require.NotNil(t, done.ExpiresAt)
+	b, err := os.ReadFile(*done.FilePath)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(b)), *done.FileSize)
+	require.Contains(t, string(b), "component,version")
+}
+
+func TestExportJobService_EnqueueErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := newMemExportJobRepo()
+	svc := newTestExportJobService(t, repo, &stubProjectUsageRepo{})
+	require.NoError(t, svc.Start(ctx))
+	defer func() { cancel(); svc.Wait() }()
+
+	_, err := svc.Enqueue(ctx, "p1", "pdf", nil, "alice")
+	require.ErrorIs(t, err, ErrUnknownExportFormat)
+	_, err = svc.Enqueue(ctx, "missing", "csv", nil, "alice")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestExportJobService_Failure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := newMemExportJobRepo()
+	svc := newTestExportJobService(t, repo, &stubProjectUsageRepo{err: errors.New("db down")})
+	require.NoError(t, svc.Start(ctx))
+	defer func() { cancel(); svc.Wait() }()
+
+	j, err := svc.Enqueue(ctx, "p1", "csv", nil, "alice")
+	require.NoError(t, err)
+	done := waitForStatus(t, repo, j.ID, model.ExportJobFailed)
+	require.Equal(t, "db down", *done.Error)
+}
+
+func TestExportJobService_RecoverOnStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := newMemExportJobRepo()
+	now := dbtime.DBTime{Time: time.Now()}
+	require.NoError(t, repo.Create(ctx, &model.ExportJob{ID: "queued", ProjectID: "p1", Format: "csv", Status: model.ExportJobQueued, CreatedAt: now}))
+	require.NoError(t, repo.Create(ctx, &model.ExportJob{ID: "running", ProjectID: "p1", Format: "notice", Status: model.ExportJobRunning, Progress: 50, StartedAt: &now, CreatedAt: now}))
+
+	svc := newTestExportJobService(t, repo, &stubProjectUsageRepo{})
+	require.NoError(t, svc.Start(ctx))
+	defer func() { cancel(); svc.Wait() }()
+
+	require.Equal(t, model.ExportJobSucceeded, waitForStatus(t, repo, "queued", model.ExportJobSucceeded).Status)
+	require.Equal(t, "P1-NOTICE.txt", *waitForStatus(t, repo, "running", model.ExportJobSucceeded).FileName)
+}
+
+func TestExportJobService_Cleanup(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemExportJobRepo()
+	svc := newTestExportJobService(t, repo, &stubProjectUsageRepo{})
+
+	path := svc.FilePath("old")
+	require.NoError(t, os.WriteFile(path, []byte("x"), 0o644))
+	// ジョブの無い結果ファイル・失敗したジョブの一時ファイルは削除し、ジョブ ID 名でないファイルや実行中のジョブの一時ファイルは残す
+	orphan := svc.FilePath(uuid.NewString())
+	failedID, runningID := uuid.NewString(), uuid.NewString()
+	failedTmp, runningTmp := svc.FilePath(failedID)+".tmp", svc.FilePath(runningID)+".tmp"
+	foreign := filepath.Join(svc.Dir, "data.db")
+	past := time.Now().Add(-2 * time.Hour)
+	for _, p := range []string{orphan, failedTmp, runningTmp, foreign} {
+		require.NoError(t, os.WriteFile(p, []byte("x"), 0o644))
+		require.NoError(t, os.Chtimes(p, past, past))
+	}
+	require.NoError(t, repo.Create(ctx, &model.ExportJob{ID: failedID, Status: model.ExportJobFailed}))
+	require.NoError(t, repo.Create(ctx, &model.ExportJob{ID: runningID, Status: model.ExportJobRunning}))
+	expires := dbtime.DBTime{Time: time.Now().Add(-time.Minute)}
+	require.NoError(t, repo.Create(ctx, &model.ExportJob{ID: "old", Status: model.ExportJobSucceeded, FilePath: &path, ExpiresAt: &expires}))
+
+	require.NoError(t, svc.Cleanup(ctx, time.Now()))
+
+	j, err := repo.Get(ctx, "old")
+	require.NoError(t, err)
+	require.Equal(t, model.ExportJobExpired, j.Status)
+	require.Nil(t, j.FilePath)
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+	for _, p := range []string{orphan, failedTmp} {
+		_, err = os.Stat(p)
+		require.True(t, os.IsNotExist(err), p)
+	}
+	for _, p := range []string{runningTmp, foreign} {
+		_, err = os.Stat(p)
+		require.NoError(t, err, p)
+	}
+}
@@ -0,0 +1,575 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/internal/domain/sbom"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+// 取り込み結果の区分。
+const (
+	// ImportCreated はカタログにコンポーネントまたはバージョンを新規登録したことを表す。
+	ImportCreated = "CREATED"
+	// ImportMatched は既存のカタログ情報に一致したことを表す。
+	ImportMatched = "MATCHED"
+	// ImportSkipped は取り込まなかったことを表す。理由は Reason に記録する。
+	ImportSkipped = "SKIPPED"
+)
+
+// defaultImportUsageRole はパッケージ・リクエスト・コンポーネントのいずれにも
+// 利用形態の指定が無い場合に用いる利用形態。
+const defaultImportUsageRole = "RUNTIME_REQUIRED"
+
+// ImportItem は 1 パッケージ分の取り込み結果を表す。
+type ImportItem struct {
+	Ref          string
+	Name         string
+	Version      string
+	Purl         string
+	Result       string
+	Reason       string
+	OssID        string
+	OssVersionID string
+	UsageID      string
+	UsageRole    string
+	ScopeStatus  string
+}
+
+// ImportReport は取り込み結果の一覧と件数を表す。
+type ImportReport struct {
+	ProjectID string
+	Format    string
+	Created   int
+	Matched   int
+	Skipped   int
+	Items     []ImportItem
+}
+
+func (r *ImportReport) add(it ImportItem) {
+	switch it.Result {
+	case ImportCreated:
+		r.Created++
+	case ImportMatched:
+		r.Matched++
+	default:
+		r.Skipped++
+	}
+	r.Items = append(r.Items, it)
+}
+
+// ImportOptions は取り込み時の指定を表す。
+type ImportOptions struct {
+	// UsageRole は形式から利用形態が判別できないパッケージに用いる利用形態。
+	// 空の場合はコンポーネントの既定利用形態、それも無ければ RUNTIME_REQUIRED とする。
+	UsageRole string
+	// User は監査ログに記録する操作ユーザ。
+	User string
+}
+
+// ImportService は SBOM などから読み込んだパッケージをカタログとプロジェクトに取り込む。
+type ImportService struct {
+	ProjectRepo           domrepo.ProjectRepository
+	OssComponentRepo      domrepo.OssComponentRepository
+	OssComponentLayerRepo domrepo.OssComponentLayerRepository
+	OssVersionRepo        domrepo.OssVersionRepository
+	ProjectUsageRepo      domrepo.ProjectUsageRepository
+	ScopePolicyRepo       domrepo.ScopePolicyRepository
+	AuditRepo             domrepo.AuditLogRepository
+	SessionRepo           domrepo.ImportSessionRepository
+	// WithinTx は fn を 1 トランザクションで実行する。fn にはトランザクションに束縛したサービスを渡す。
+	// nil の場合はトランザクションを用いずに自身を渡す。
+	WithinTx func(ctx context.Context, fn func(ctx context.Context, s *ImportService) error) error
+}
+
+func (s *ImportService) tx(ctx context.Context, fn func(ctx context.Context, s *ImportService) error) error {
+	if s.WithinTx == nil {
+		return fn(ctx, s)
+	}
+	return s.WithinTx(ctx, fn)
+}
+
+// Import は bom の各パッケージを既存のカタログ情報と照合し、プロジェクトの利用情報として登録する。
+// 照合は purl の完全一致、次に正規化名とバージョンの一致の順で行い、
+// 一致しない場合はコンポーネント・バージョンを draft として新規登録する。
+// 利用情報の初期スコープは InitialScopeStatus で決定する。
+// 登録したコンポーネント・バージョン・利用情報と取り込み結果の件数は監査ログに記録する。
+// 処理は 1 トランザクションで行い、途中で失敗した場合は何も登録しない。
+// プロジェクトが存在しない場合は sql.ErrNoRows を返す。
+func (s *ImportService) Import(ctx context.Context, projectID string, bom *sbom.BOM, opts ImportOptions) (*ImportReport, error) {
+	var report *ImportReport
+	err := s.tx(ctx, func(ctx context.Context, tx *ImportService) error {
+		if _, err := tx.ProjectRepo.Get(ctx, projectID); err != nil {
+			return err
+		}
+		// 照合は apply で行うため、ここでは必須項目のみ確認する
+		items := make([]model.ImportSessionItem, len(bom.Packages))
+		for i, p := range bom.Packages {
+			items[i] = newSessionItem(i+1, p)
+			items[i].DependsOn = bom.Dependencies[p.Ref]
+			skipIncomplete(&items[i], p)
+		}
+		var err error
+		report, err = tx.apply(ctx, projectID, bom.Format, bom.Name, items, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// plan は bom の各パッケージを照合し、カタログを変更せずに取り込み内容の提案を作る。
+func (s *ImportService) plan(ctx context.Context, projectID string, bom *sbom.BOM) ([]model.ImportSessionItem, error) {
+	if _, err := s.ProjectRepo.Get(ctx, projectID); err != nil {
+		return nil, err
+	}
+	items := make([]model.ImportSessionItem, 0, len(bom.Packages))
+	seen := map[string]bool{}
+	for i, p := range bom.Packages {
+		it := newSessionItem(i+1, p)
+		it.DependsOn = bom.Dependencies[p.Ref]
+		if err := s.propose(ctx, projectID, &it, p, seen); err != nil {
+			return nil, err
+		}
+		items = append(items, it)
+	}
+	return items, nil
+}
+
+// propose は 1 パッケージ分の提案 (Proposal / Reason / 照合先) を設定する。
+func (s *ImportService) propose(ctx context.Context, projectID string, it *model.ImportSessionItem, p sbom.Package, seen map[string]bool) error {
+	skip := func(reason string) error {
+		it.Proposal = model.ImportProposalSkip
+		it.Reason = &reason
+		return nil
+	}
+	if skipIncomplete(it, p) {
+		return nil
+	}
+
+	comp, ver, reason, err := s.match(ctx, p)
+	if err != nil {
+		return err
+	}
+	key := NormalizeComponentName(p.Name) + "@" + p.Version
+	switch {
+	case ver != nil:
+		it.Proposal = model.ImportProposalMatch
+		it.OssID, it.OssVersionID = &ver.OssID, &ver.ID
+		key = ver.ID
+	case comp != nil:
+		it.Proposal = model.ImportProposalNewVersion
+		it.OssID = &comp.ID
+		reason = "new draft version for existing component"
+	default:
+		it.Proposal = model.ImportProposalNewComponent
+		reason = "new draft component and version"
+	}
+	it.Reason = &reason
+	if seen[key] {
+		return skip("duplicate package in document")
+	}
+	seen[key] = true
+	if ver != nil {
+		existing, err := s.ProjectUsageRepo.FindByVersion(ctx, projectID, ver.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if existing != nil {
+			it.UsageID = &existing.ID
+			return skip("already used in project")
+		}
+	}
+	return nil
+}
+
+// skipIncomplete は名前またはバージョンが無いパッケージを SKIP とし、その場合 true を返す。
+func skipIncomplete(it *model.ImportSessionItem, p sbom.Package) bool {
+	var reason string
+	switch {
+	case p.Name == "":
+		reason = "package name is missing"
+	case p.Version == "":
+		reason = "package version is missing"
+	default:
+		return false
+	}
+	it.Proposal = model.ImportProposalSkip
+	it.Reason = &reason
+	return true
+}
+
+// apply は提案とレビュー結果に従って items をカタログとプロジェクトに登録し、
+// 各項目の Result / UsageID / 照合先を更新する。
+func (s *ImportService) apply(ctx context.Context, projectID, format, name string, items []model.ImportSessionItem, opts ImportOptions) (*ImportReport, error) {
+	policy, err := s.ScopePolicyRepo.Get(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	report := &ImportReport{ProjectID: projectID, Format: format}
+	seen := map[string]bool{}
+	for i := range items {
+		res, err := s.applyItem(ctx, projectID, &items[i], policy, opts, seen)
+		if err != nil {
+			return nil, err
+		}
+		items[i].Result = &res.Result
+		items[i].OssID = optional(res.OssID)
+		items[i].OssVersionID = optional(res.OssVersionID)
+		items[i].UsageID = optional(res.UsageID)
+		report.add(res)
+	}
+	if err := s.linkDependencies(ctx, items); err != nil {
+		return nil, err
+	}
+	summary := fmt.Sprintf("imported %s %q: created=%d matched=%d skipped=%d", report.Format, name, report.Created, report.Matched, report.Skipped)
+	if err := s.audit(ctx, model.AuditEntityProject, projectID, model.AuditActionImport, opts.User, summary); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *ImportService) applyItem(ctx context.Context, projectID string, it *model.ImportSessionItem, policy *model.ScopePolicy, opts ImportOptions, seen map[string]bool) (ImportItem, error) {
+	res := ImportItem{
+		Ref:          it.Ref,
+		Name:         it.Name,
+		Version:      it.Version,
+		Purl:         deref(it.Purl),
+		Result:       ImportSkipped,
+		Reason:       deref(it.Reason),
+		OssID:        deref(it.OssID),
+		OssVersionID: deref(it.OssVersionID),
+		UsageID:      deref(it.UsageID),
+	}
+	switch {
+	case it.Decision == model.ImportDecisionRejected:
+		res.Reason = "rejected in review"
+		return res, nil
+	case it.Proposal == model.ImportProposalSkip && it.Decision != model.ImportDecisionRemapped:
+		return res, nil
+	}
+
+	p := itemPackage(it)
+	var comp *model.OssComponent
+	var ver *model.OssVersion
+	var reason string
+	var err error
+	if it.Decision == model.ImportDecisionRemapped {
+		comp, ver, err = s.remapTarget(ctx, it)
+		reason = "remapped in review"
+	} else {
+		comp, ver, reason, err = s.match(ctx, p)
+	}
+	if err != nil {
+		return res, err
+	}
+	res.Result = ImportMatched
+	if ver == nil {
+		res.Result = ImportCreated
+		var created string
+		if comp, ver, created, err = s.createDraft(ctx, comp, p, opts.User); err != nil {
+			return res, err
+		}
+		if it.Decision != model.ImportDecisionRemapped {
+			reason = created
+		} else if _, note := draftLicense(p); note != "" {
+			reason += "; " + note
+		}
+	}
+	res.Reason = reason
+	res.OssID = ver.OssID
+	res.OssVersionID = ver.ID
+	res.UsageID = ""
+
+	if seen[ver.ID] {
+		res.Result = ImportSkipped
+		res.Reason = "duplicate package in document"
+		return res, nil
+	}
+	seen[ver.ID] = true
+	existing, err := s.ProjectUsageRepo.FindByVersion(ctx, projectID, ver.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return res, err
+	}
+	if existing != nil {
+		res.Result = ImportSkipped
+		res.Reason = "already used in project"
+		res.UsageID = existing.ID
+		res.UsageRole = existing.UsageRole
+		res.ScopeStatus = existing.ScopeStatus
+		return res, nil
+	}
+
+	role := p.UsageRole
+	if role == "" {
+		role = opts.UsageRole
+	}
+	if role == "" && comp != nil && comp.DefaultUsageRole != nil {
+		role = *comp.DefaultUsageRole
+	}
+	if role == "" {
+		role = defaultImportUsageRole
+	}
+	u := &model.ProjectUsage{
+		ID:               uuid.NewString(),
+		ProjectID:        projectID,
+		OssID:            ver.OssID,
+		OssVersionID:     ver.ID,
+		UsageRole:        role,
+		ScopeStatus:      InitialScopeStatus(policy, role),
+		InclusionNote:    optional(p.InclusionNote),
+		DirectDependency: p.Direct,
+		AddedAt:          dbtime.DBTime{Time: time.Now()},
+	}
+	if err := s.ProjectUsageRepo.Create(ctx, u); err != nil {
+		return res, err
+	}
+	summary := fmt.Sprintf("imported %s %s (%s, %s)", p.Name, p.Version, u.UsageRole, u.ScopeStatus)
+	if err := s.audit(ctx, model.AuditEntityProjectUsage, u.ID, model.AuditActionCreate, opts.User, summary); err != nil {
+		return res, err
+	}
+	res.UsageID = u.ID
+	res.UsageRole = u.UsageRole
+	res.ScopeStatus = u.ScopeStatus
+	return res, nil
+}
+
+// linkDependencies は文書内の依存関係 (DependsOn) を、登録または照合した利用情報間の依存関係として登録する。
+// 文書内で重複したパッケージは同じバージョンの利用情報に読み替え、却下した項目・利用情報の無い項目の依存関係は登録しない。
+func (s *ImportService) linkDependencies(ctx context.Context, items []model.ImportSessionItem) error {
+	usageByVersion := map[string]string{}
+	for _, it := range items {
+		if it.UsageID != nil && it.OssVersionID != nil {
+			usageByVersion[*it.OssVersionID] = *it.UsageID
+		}
+	}
+	usageByRef := map[string]string{}
+	for _, it := range items {
+		if it.Ref == "" || it.OssVersionID == nil || it.Decision == model.ImportDecisionRejected {
+			continue
+		}
+		if u, ok := usageByVersion[*it.OssVersionID]; ok {
+			usageByRef[it.Ref] = u
+		}
+	}
+	for _, it := range items {
+		from, ok := usageByRef[it.Ref]
+		if !ok {
+			continue
+		}
+		for _, ref := range it.DependsOn {
+			to, ok := usageByRef[ref]
+			if !ok || to == from {
+				continue
+			}
+			if err := s.ProjectUsageRepo.AddDependency(ctx, from, to); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// match は purl、正規化名とバージョンの順で既存のバージョンを探す。
+// バージョンが見つからない場合もコンポーネントが一致すれば comp を返す。
+func (s *ImportService) match(ctx context.Context, p sbom.Package) (*model.OssComponent, *model.OssVersion, string, error) {
+	if p.Purl != "" {
+		v, err := s.OssVersionRepo.FindByPurl(ctx, p.Purl)
+		if err == nil {
+			comp, err := s.OssComponentRepo.Get(ctx, v.OssID)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return comp, v, "matched by purl", nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, "", err
+		}
+	}
+	comp, err := s.OssComponentRepo.FindByNormalizedName(ctx, NormalizeComponentName(p.Name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, "", nil
+	}
+	if err != nil {
+		return nil, nil, "", err
+	}
+	v, err := s.OssVersionRepo.FindByVersion(ctx, comp.ID, p.Version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return comp, nil, "", nil
+	}
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return comp, v, "matched by name and version", nil
+}
+
+// createDraft は未登録のコンポーネント・バージョンを draft として登録する。
+func (s *ImportService) createDraft(ctx context.Context, comp *model.OssComponent, p sbom.Package, user string) (*model.OssComponent, *model.OssVersion, string, error) {
+	now := dbtime.DBTime{Time: time.Now()}
+	reason := "created draft version for existing component"
+	if comp == nil {
+		comp = &model.OssComponent{
+			ID:             uuid.NewString(),
+			Name:           p.Name,
+			NormalizedName: NormalizeComponentName(p.Name),
+			HomepageURL:    optional(p.Homepage),
+			Layers:         p.Layers,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if err := s.OssComponentRepo.Create(ctx, comp); err != nil {
+			return nil, nil, "", err
+		}
+		if len(comp.Layers) > 0 {
+			if err := s.OssComponentLayerRepo.Replace(ctx, comp.ID, comp.Layers); err != nil {
+				return nil, nil, "", err
+			}
+		}
+		if err := s.audit(ctx, model.AuditEntityOssComponent, comp.ID, model.AuditActionCreate, user, "imported draft component "+comp.Name); err != nil {
+			return nil, nil, "", err
+		}
+		reason = "created draft component and version"
+	}
+	expr, note := draftLicense(p)
+	if note != "" {
+		reason += "; " + note
+	}
+	v := &model.OssVersion{
+		ID:                   uuid.NewString(),
+		OssID:                comp.ID,
+		Version:              p.Version,
+		LicenseExpressionRaw: optional(expr),
+		Purl:                 optional(p.Purl),
+		CpeList:              p.CPEs,
+		HashSha256:           optional(p.SHA256),
+		HashSha512:           optional(p.SHA512),
+		CopyrightText:        optional(p.Copyright),
+		ReviewStatus:         "draft",
+		ScopeStatus:          "IN_SCOPE",
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+	if err := s.OssVersionRepo.Create(ctx, v); err != nil {
+		return nil, nil, "", err
+	}
+	if err := s.audit(ctx, model.AuditEntityOssVersion, v.ID, model.AuditActionCreate, user, "imported draft version "+comp.Name+" "+v.Version); err != nil {
+		return nil, nil, "", err
+	}
+	return comp, v, reason, nil
+}
+
+// draftLicense は draft バージョンに記録するライセンス式を返す (宣言ライセンスを優先する)。
+// SPDX ライセンス式として解釈できない場合は NOASSERTION とし、元の記載を note に残す。
+func draftLicense(p sbom.Package) (expr, note string) {
+	raw := p.LicenseDeclared
+	if raw == "" {
+		raw = p.LicenseConcluded
+	}
+	if strings.TrimSpace(raw) == "" {
+		return "", ""
+	}
+	norm, err := license.Normalize(raw)
+	if err != nil {
+		return license.NoAssertion, fmt.Sprintf("license %q is not a valid SPDX expression, recorded as NOASSERTION", raw)
+	}
+	return norm, ""
+}
+
+// audit は監査ログを 1 件記録する。AuditRepo が未設定の場合は何もしない。
+func (s *ImportService) audit(ctx context.Context, entityType, entityID, action, user, summary string) error {
+	if s.AuditRepo == nil {
+		return nil
+	}
+	return s.AuditRepo.Create(ctx, &model.AuditLog{
+		ID:         uuid.NewString(),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		UserName:   user,
+		Summary:    &summary,
+		CreatedAt:  dbtime.DBTime{Time: time.Now()},
+	})
+}
+
+// newSessionItem はパッケージから未判定の取り込み項目を作る。
+func newSessionItem(seq int, p sbom.Package) model.ImportSessionItem {
+	return model.ImportSessionItem{
+		ID:               uuid.NewString(),
+		Seq:              seq,
+		Ref:              p.Ref,
+		Name:             p.Name,
+		Version:          p.Version,
+		Purl:             optional(p.Purl),
+		LicenseConcluded: optional(p.LicenseConcluded),
+		LicenseDeclared:  optional(p.LicenseDeclared),
+		HomepageURL:      optional(p.Homepage),
+		Supplier:         optional(p.Supplier),
+		HashSha256:       optional(p.SHA256),
+		HashSha512:       optional(p.SHA512),
+		CopyrightText:    optional(p.Copyright),
+		CpeList:          p.CPEs,
+		DirectDependency: p.Direct,
+		UsageRole:        optional(p.UsageRole),
+		Layers:           p.Layers,
+		InclusionNote:    optional(p.InclusionNote),
+		Decision:         model.ImportDecisionPending,
+	}
+}
+
+// itemPackage は取り込み項目をパッケージに戻す。利用形態はレビューでの指定を優先する。
+func itemPackage(it *model.ImportSessionItem) sbom.Package {
+	role := it.UsageRole
+	if it.UsageRoleOverride != nil {
+		role = it.UsageRoleOverride
+	}
+	return sbom.Package{
+		Ref:              it.Ref,
+		Name:             it.Name,
+		Version:          it.Version,
+		Purl:             deref(it.Purl),
+		LicenseConcluded: deref(it.LicenseConcluded),
+		LicenseDeclared:  deref(it.LicenseDeclared),
+		Homepage:         deref(it.HomepageURL),
+		Supplier:         deref(it.Supplier),
+		SHA256:           deref(it.HashSha256),
+		SHA512:           deref(it.HashSha512),
+		Copyright:        deref(it.CopyrightText),
+		CPEs:             it.CpeList,
+		Direct:           it.DirectDependency,
+		UsageRole:        deref(role),
+		Layers:           it.Layers,
+		InclusionNote:    deref(it.InclusionNote),
+	}
+}
+
+// NormalizeComponentName はコンポーネント名を照合用に正規化する (前後の空白除去と小文字化)。
+func NormalizeComponentName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// optional は空文字の場合に nil を返す。
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
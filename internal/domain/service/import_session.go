@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/sbom"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+var (
+	// ErrImportSessionClosed は確定済みのセッションを変更しようとした場合に返す。
+	ErrImportSessionClosed = errors.New("import session already committed")
+	// ErrInvalidImportDecision はレビュー結果の指定が不正な場合に返す。
+	ErrInvalidImportDecision = errors.New("invalid import decision")
+)
+
+// ImportDecision は取り込み項目 1 件分のレビュー結果の指定を表す。
+type ImportDecision struct {
+	// Decision は ACCEPTED / REJECTED / REMAPPED / PENDING のいずれか。
+	Decision string
+	// OssID / OssVersionID は REMAPPED の付け替え先。OssID のみの場合は
+	// そのコンポーネントの同一バージョン (無ければ draft で新規登録) に付け替える。
+	OssID        *string
+	OssVersionID *string
+	// UsageRole は利用形態の上書き。nil の場合は変更せず、空文字の場合は上書きを解除して推定した利用形態に戻す。
+	UsageRole *string
+}
+
+// Preview は bom を照合した結果を取り込みセッションとして保存する (dry-run)。
+// カタログとプロジェクトの利用情報は変更しない。
+// プロジェクトが存在しない場合は sql.ErrNoRows を返す。
+func (s *ImportService) Preview(ctx context.Context, projectID string, bom *sbom.BOM, opts ImportOptions) (*model.ImportSession, []model.ImportSessionItem, error) {
+	sess := &model.ImportSession{
+		ID:           uuid.NewString(),
+		ProjectID:    projectID,
+		Format:       bom.Format,
+		DocumentName: optional(bom.Name),
+		UsageRole:    optional(opts.UsageRole),
+		Status:       model.ImportSessionOpen,
+		CreatedBy:    opts.User,
+		CreatedAt:    dbtime.DBTime{Time: time.Now()},
+	}
+	var items []model.ImportSessionItem
+	err := s.tx(ctx, func(ctx context.Context, tx *ImportService) error {
+		var err error
+		if items, err = tx.plan(ctx, projectID, bom); err != nil {
+			return err
+		}
+		for i := range items {
+			items[i].SessionID = sess.ID
+		}
+		return tx.SessionRepo.Create(ctx, sess, items)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return sess, items, nil
+}
+
+// Decide は取り込み項目にレビュー結果を設定する。
+// REMAPPED の場合は付け替え先の存在を確認し、ACCEPTED / REJECTED / PENDING に戻す場合は照合をやり直す。
+// セッション・項目が存在しない場合は sql.ErrNoRows、確定済みの場合は ErrImportSessionClosed、
+// 指定が不正な場合は ErrInvalidImportDecision を返す。
+func (s *ImportService) Decide(ctx context.Context, sessionID, itemID string, d ImportDecision) (*model.ImportSessionItem, error) {
+	sess, err := s.SessionRepo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if sess.Status != model.ImportSessionOpen {
+		return nil, ErrImportSessionClosed
+	}
+	it, err := s.SessionRepo.GetItem(ctx, sessionID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch d.Decision {
+	case model.ImportDecisionRemapped:
+		if err := s.remap(ctx, it, d); err != nil {
+			return nil, err
+		}
+	case model.ImportDecisionAccepted, model.ImportDecisionRejected, model.ImportDecisionPending:
+		if d.OssID != nil || d.OssVersionID != nil {
+			return nil, fmt.Errorf("%w: ossId / ossVersionId are only allowed for REMAPPED", ErrInvalidImportDecision)
+		}
+		if it.Decision == model.ImportDecisionRemapped {
+			// 付け替え前の提案に戻す
+			it.OssID, it.OssVersionID, it.UsageID = nil, nil, nil
+			if err := s.propose(ctx, sess.ProjectID, it, itemPackage(it), map[string]bool{}); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown decision %q", ErrInvalidImportDecision, d.Decision)
+	}
+	it.Decision = d.Decision
+	if d.UsageRole != nil {
+		it.UsageRoleOverride = optional(*d.UsageRole)
+	}
+	if err := s.SessionRepo.UpdateItem(ctx, it); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// remap は付け替え先を検証して項目に設定する。
+func (s *ImportService) remap(ctx context.Context, it *model.ImportSessionItem, d ImportDecision) error {
+	if d.OssVersionID != nil {
+		v, err := s.OssVersionRepo.Get(ctx, *d.OssVersionID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: version %s not found", ErrInvalidImportDecision, *d.OssVersionID)
+		}
+		if err != nil {
+			return err
+		}
+		if d.OssID != nil && *d.OssID != v.OssID {
+			return fmt.Errorf("%w: version %s does not belong to component %s", ErrInvalidImportDecision, v.ID, *d.OssID)
+		}
+		it.OssID, it.OssVersionID = &v.OssID, &v.ID
+		return nil
+	}
+	if d.OssID == nil {
+		return fmt.Errorf("%w: ossId or ossVersionId is required for REMAPPED", ErrInvalidImportDecision)
+	}
+	if it.Version == "" {
+		return fmt.Errorf("%w: ossVersionId is required for a package without version", ErrInvalidImportDecision)
+	}
+	comp, err := s.OssComponentRepo.Get(ctx, *d.OssID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: component %s not found", ErrInvalidImportDecision, *d.OssID)
+	}
+	if err != nil {
+		return err
+	}
+	it.OssID, it.OssVersionID = &comp.ID, nil
+	v, err := s.OssVersionRepo.FindByVersion(ctx, comp.ID, it.Version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if v != nil {
+		it.OssVersionID = &v.ID
+	}
+	return nil
+}
+
+// remapTarget は REMAPPED の項目の付け替え先を取得する。バージョン未登録の場合は ver を nil で返す。
+func (s *ImportService) remapTarget(ctx context.Context, it *model.ImportSessionItem) (*model.OssComponent, *model.OssVersion, error) {
+	var ver *model.OssVersion
+	ossID := deref(it.OssID)
+	if it.OssVersionID != nil {
+		v, err := s.OssVersionRepo.Get(ctx, *it.OssVersionID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: remapped version of %s: %v", ErrInvalidImportDecision, it.Ref, err)
+		}
+		ver, ossID = v, v.OssID
+	}
+	comp, err := s.OssComponentRepo.Get(ctx, ossID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: remapped component of %s: %v", ErrInvalidImportDecision, it.Ref, err)
+	}
+	if ver == nil {
+		v, err := s.OssVersionRepo.FindByVersion(ctx, comp.ID, it.Version)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, err
+		}
+		ver = v
+	}
+	return comp, ver, nil
+}
+
+// Commit はセッションのレビュー結果に従って 1 トランザクションで取り込みを確定する。
+// PENDING の項目は提案どおり、REJECTED の項目は取り込まない。
+// セッションが存在しない場合は sql.ErrNoRows、確定済みの場合は ErrImportSessionClosed を返す。
+func (s *ImportService) Commit(ctx context.Context, sessionID, user string) (*ImportReport, error) {
+	var report *ImportReport
+	err := s.tx(ctx, func(ctx context.Context, tx *ImportService) error {
+		sess, err := tx.SessionRepo.Get(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if sess.Status != model.ImportSessionOpen {
+			return ErrImportSessionClosed
+		}
+		items, err := tx.SessionRepo.ListItems(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		opts := ImportOptions{UsageRole: deref(sess.UsageRole), User: user}
+		if report, err = tx.apply(ctx, sess.ProjectID, sess.Format, deref(sess.DocumentName), items, opts); err != nil {
+			return err
+		}
+		for i := range items {
+			if err := tx.SessionRepo.UpdateItem(ctx, &items[i]); err != nil {
+				return err
+			}
+		}
+		now := dbtime.DBTime{Time: time.Now()}
+		sess.Status = model.ImportSessionCommitted
+		sess.CommittedBy = &user
+		sess.CommittedAt = &now
+		return tx.SessionRepo.Update(ctx, sess)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Discard は未確定のセッションを削除する。
+// セッションが存在しない場合は sql.ErrNoRows、確定済みの場合は ErrImportSessionClosed を返す。
+func (s *ImportService) Discard(ctx context.Context, sessionID string) error {
+	sess, err := s.SessionRepo.Get(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if sess.Status != model.ImportSessionOpen {
+		return ErrImportSessionClosed
+	}
+	return s.SessionRepo.Delete(ctx, sessionID)
+}
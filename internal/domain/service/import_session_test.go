@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/internal/domain/sbom"
+)
+
+type memSessionRepo struct {
+	domrepo.ImportSessionRepository
+	sessions map[string]*model.ImportSession
+	items    map[string][]model.ImportSessionItem
+}
+
+func (m *memSessionRepo) Create(ctx context.Context, s *model.ImportSession, items []model.ImportSessionItem) error {
+	c := *s
+	m.sessions[s.ID] = &c
+	m.items[s.ID] = append([]model.ImportSessionItem(nil), items...)
+	return nil
+}
+
+func (m *memSessionRepo) Get(ctx context.Context, id string) (*model.ImportSession, error) {
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	c := *s
+	return &c, nil
+}
+
+func (m *memSessionRepo) Update(ctx context.Context, s *model.ImportSession) error {
+	c := *s
+	m.sessions[s.ID] = &c
+	return nil
+}
+
+func (m *memSessionRepo) Delete(ctx context.Context, id string) error {
+	delete(m.sessions, id)
+	delete(m.items, id)
+	return nil
+}
+
+func (m *memSessionRepo) ListItems(ctx context.Context, sessionID string) ([]model.ImportSessionItem, error) {
+	return append([]model.ImportSessionItem(nil), m.items[sessionID]...), nil
+}
+
+func (m *memSessionRepo) GetItem(ctx context.Context, sessionID, itemID string) (*model.ImportSessionItem, error) {
+	for _, it := range m.items[sessionID] {
+		if it.ID == itemID {
+			return &it, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *memSessionRepo) UpdateItem(ctx context.Context, it *model.ImportSessionItem) error {
+	items := m.items[it.SessionID]
+	for i := range items {
+		if items[i].ID == it.ID {
+			items[i] = *it
+		}
+	}
+	return nil
+}
+
+func TestImportService_Session(t *testing.T) {
+	str := func(s string) *string { return &s }
+	c := &memCatalog{
+		components: []model.OssComponent{
+			{ID: "c-lodash", Name: "lodash", NormalizedName: "lodash"},
+			{ID: "c-react", Name: "react", NormalizedName: "react"},
+		},
+		versions: []model.OssVersion{
+			{ID: "v-lodash", OssID: "c-lodash", Version: "4.17.21", Purl: str("pkg:npm/lodash@4.17.21")},
+			{ID: "v-react", OssID: "c-react", Version: "18.2.0"},
+		},
+	}
+	svc := newImportService(c, nil)
+	sessions := &memSessionRepo{sessions: map[string]*model.ImportSession{}, items: map[string][]model.ImportSessionItem{}}
+	svc.SessionRepo = sessions
+	ctx := context.Background()
+
+	bom := &sbom.BOM{Format: "cyclonedx-json", Name: "app", Packages: []sbom.Package{
+		{Ref: "a", Name: "lodash", Version: "4.17.21", Purl: "pkg:npm/lodash@4.17.21"},
+		{Ref: "b", Name: "lodash", Version: "4.17.20"},
+		{Ref: "c", Name: "reactjs", Version: "18.2.0"},
+		{Ref: "d", Name: "left-pad", Version: "1.3.0"},
+	}}
+	sess, items, err := svc.Preview(ctx, "p1", bom, ImportOptions{User: "alice", UsageRole: "DEV_ONLY"})
+	require.NoError(t, err)
+	require.Equal(t, model.ImportSessionOpen, sess.Status)
+	require.Equal(t, "app", *sess.DocumentName)
+	require.Len(t, items, 4)
+	require.Equal(t, model.ImportProposalMatch, items[0].Proposal)
+	require.Equal(t, model.ImportProposalNewVersion, items[1].Proposal)
+	require.Equal(t, model.ImportProposalNewComponent, items[2].Proposal)
+	require.Equal(t, model.ImportProposalNewComponent, items[3].Proposal)
+	for _, it := range items {
+		require.Equal(t, model.ImportDecisionPending, it.Decision)
+	}
+	// dry-run ではカタログを変更しない
+	require.Len(t, c.components, 2)
+	require.Len(t, c.versions, 2)
+	require.Empty(t, c.usages)
+	require.Empty(t, svc.AuditRepo.(*memAuditRepo).logs)
+
+	// 未知のパッケージを既存コンポーネントに付け替える
+	it, err := svc.Decide(ctx, sess.ID, items[2].ID, ImportDecision{Decision: model.ImportDecisionRemapped, OssID: str("c-react")})
+	require.NoError(t, err)
+	require.Equal(t, "v-react", *it.OssVersionID)
+	_, err = svc.Decide(ctx, sess.ID, items[3].ID, ImportDecision{Decision: model.ImportDecisionRejected})
+	require.NoError(t, err)
+	_, err = svc.Decide(ctx, sess.ID, items[1].ID, ImportDecision{Decision: model.ImportDecisionAccepted, UsageRole: str("BUILD_ONLY")})
+	require.NoError(t, err)
+
+	_, err = svc.Decide(ctx, sess.ID, items[0].ID, ImportDecision{Decision: model.ImportDecisionRemapped, OssID: str("missing")})
+	require.ErrorIs(t, err, ErrInvalidImportDecision)
+	_, err = svc.Decide(ctx, sess.ID, items[0].ID, ImportDecision{Decision: "MAYBE"})
+	require.ErrorIs(t, err, ErrInvalidImportDecision)
+	_, err = svc.Decide(ctx, sess.ID, "missing", ImportDecision{Decision: model.ImportDecisionAccepted})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	report, err := svc.Commit(ctx, sess.ID, "bob")
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Created)
+	require.Equal(t, 2, report.Matched)
+	require.Equal(t, 1, report.Skipped)
+	require.Equal(t, "rejected in review", report.Items[3].Reason)
+	require.Equal(t, "BUILD_ONLY", report.Items[1].UsageRole)
+	require.Equal(t, "DEV_ONLY", report.Items[0].UsageRole)
+	require.Equal(t, "v-react", report.Items[2].OssVersionID)
+
+	require.Len(t, c.components, 2)
+	require.Len(t, c.versions, 3)
+	require.Len(t, c.usages, 3)
+
+	stored := sessions.sessions[sess.ID]
+	require.Equal(t, model.ImportSessionCommitted, stored.Status)
+	require.Equal(t, "bob", *stored.CommittedBy)
+	require.NotNil(t, stored.CommittedAt)
+	require.Equal(t, ImportSkipped, *sessions.items[sess.ID][3].Result)
+	require.NotNil(t, sessions.items[sess.ID][2].UsageID)
+
+	// 確定済みのセッションは変更できない
+	_, err = svc.Commit(ctx, sess.ID, "bob")
+	require.ErrorIs(t, err, ErrImportSessionClosed)
+	_, err = svc.Decide(ctx, sess.ID, items[0].ID, ImportDecision{Decision: model.ImportDecisionAccepted})
+	require.ErrorIs(t, err, ErrImportSessionClosed)
+	require.ErrorIs(t, svc.Discard(ctx, sess.ID), ErrImportSessionClosed)
+}
+
+func TestImportService_Decide_RevertRemap(t *testing.T) {
+	str := func(s string) *string { return &s }
+	c := &memCatalog{
+		components: []model.OssComponent{{ID: "c-react", Name: "react", NormalizedName: "react"}},
+		versions:   []model.OssVersion{{ID: "v-react", OssID: "c-react", Version: "18.2.0"}},
+	}
+	svc := newImportService(c, nil)
+	svc.SessionRepo = &memSessionRepo{sessions: map[string]*model.ImportSession{}, items: map[string][]model.ImportSessionItem{}}
+	ctx := context.Background()
+
+	sess, items, err := svc.Preview(ctx, "p1", &sbom.BOM{Packages: []sbom.Package{{Name: "reactjs", Version: "18.2.0"}}}, ImportOptions{})
+	require.NoError(t, err)
+	it, err := svc.Decide(ctx, sess.ID, items[0].ID, ImportDecision{Decision: model.ImportDecisionRemapped, OssVersionID: str("v-react")})
+	require.NoError(t, err)
+	require.Equal(t, "c-react", *it.OssID)
+
+	it, err = svc.Decide(ctx, sess.ID, items[0].ID, ImportDecision{Decision: model.ImportDecisionPending})
+	require.NoError(t, err)
+	require.Nil(t, it.OssID)
+	require.Nil(t, it.OssVersionID)
+	require.Equal(t, model.ImportProposalNewComponent, it.Proposal)
+
+	require.NoError(t, svc.Discard(ctx, sess.ID))
+	_, err = svc.Commit(ctx, sess.ID, "bob")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestImportService_Decide_UsageRoleOverride(t *testing.T) {
+	str := func(s string) *string { return &s }
+	c := &memCatalog{}
+	svc := newImportService(c, nil)
+	sessions := &memSessionRepo{sessions: map[string]*model.ImportSession{}, items: map[string][]model.ImportSessionItem{}}
+	svc.SessionRepo = sessions
+	ctx := context.Background()
+
+	bom := &sbom.BOM{Format: "npm-lock", Packages: []sbom.Package{
+		{Ref: "a", Name: "jest", Version: "29.7.0", UsageRole: "DEV_ONLY"},
+		{Ref: "b", Name: "esbuild", Version: "0.19.0", UsageRole: "DEV_ONLY"},
+		{Ref: "c", Name: "busybox", Version: "1.36.1", UsageRole: "BUNDLED_BINARY"},
+	}}
+	sess, items, err := svc.Preview(ctx, "p1", bom, ImportOptions{User: "alice"})
+	require.NoError(t, err)
+
+	// decision のみの指定では推定した利用形態を維持する
+	it, err := svc.Decide(ctx, sess.ID, items[0].ID, ImportDecision{Decision: model.ImportDecisionAccepted})
+	require.NoError(t, err)
+	require.Equal(t, "DEV_ONLY", *it.UsageRole)
+	require.Nil(t, it.UsageRoleOverride)
+
+	// 上書きを解除すると推定した利用形態に戻る
+	_, err = svc.Decide(ctx, sess.ID, items[1].ID, ImportDecision{Decision: model.ImportDecisionAccepted, UsageRole: str("BUILD_ONLY")})
+	require.NoError(t, err)
+	it, err = svc.Decide(ctx, sess.ID, items[1].ID, ImportDecision{Decision: model.ImportDecisionAccepted, UsageRole: str("")})
+	require.NoError(t, err)
+	require.Nil(t, it.UsageRoleOverride)
+
+	_, err = svc.Decide(ctx, sess.ID, items[2].ID, ImportDecision{Decision: model.ImportDecisionAccepted, UsageRole: str("STATIC_LINK")})
+	require.NoError(t, err)
+	it, err = svc.Decide(ctx, sess.ID, items[2].ID, ImportDecision{Decision: model.ImportDecisionAccepted})
+	require.NoError(t, err)
+	require.Equal(t, "STATIC_LINK", *it.UsageRoleOverride)
+	require.Equal(t, "BUNDLED_BINARY", *it.UsageRole)
+
+	report, err := svc.Commit(ctx, sess.ID, "bob")
+	require.NoError(t, err)
+	require.Equal(t, "DEV_ONLY", report.Items[0].UsageRole)
+	require.Equal(t, "DEV_ONLY", report.Items[1].UsageRole)
+	require.Equal(t, "STATIC_LINK", report.Items[2].UsageRole)
+	require.Equal(t, "DEV_ONLY", c.usages[0].UsageRole)
+}
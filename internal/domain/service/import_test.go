@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/internal/domain/sbom"
+)
+
+// memCatalog はテスト用のインメモリなコンポーネント・バージョン・利用情報リポジトリ。
+type memCatalog struct {
+	components   []model.OssComponent
+	versions     []model.OssVersion
+	usages       []model.ProjectUsage
+	dependencies [][2]string
+}
+
+type memComponentRepo struct {
+	domrepo.OssComponentRepository
+	c *memCatalog
+}
+
+func (m *memComponentRepo) FindByNormalizedName(ctx context.Context, name string) (*model.OssComponent, error) {
+	for _, c := range m.c.components {
+		if c.NormalizedName == name {
+			return &c, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *memComponentRepo) Get(ctx context.Context, id string) (*model.OssComponent, error) {
+	for _, c := range m.c.components {
+		if c.ID == id {
+			return &c, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *memComponentRepo) Create(ctx context.Context, c *model.OssComponent) error {
+	m.c.components = append(m.c.components, *c)
+	return nil
+}
+
+type memVersionRepo struct {
+	domrepo.OssVersionRepository
+	c *memCatalog
+}
+
+func (m *memVersionRepo) Get(ctx context.Context, id string) (*model.OssVersion, error) {
+	for _, v := range m.c.versions {
+		if v.ID == id {
+			return &v, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *memVersionRepo) FindByPurl(ctx context.Context, purl string) (*model.OssVersion, error) {
+	for _, v := range m.c.versions {
+		if v.Purl != nil && *v.Purl == purl {
+			return &v, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *memVersionRepo) FindByVersion(ctx context.Context, ossID, version string) (*model.OssVersion, error) {
+	for _, v := range m.c.versions {
+		if v.OssID == ossID && v.Version == version {
+			return &v, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *memVersionRepo) Create(ctx context.Context, v *model.OssVersion) error {
+	m.c.versions = append(m.c.versions, *v)
+	return nil
+}
+
+type memUsageRepo struct {
+	domrepo.ProjectUsageRepository
+	c *memCatalog
+}
+
+func (m *memUsageRepo) FindByVersion(ctx context.Context, projectID, ossVersionID string) (*model.ProjectUsage, error) {
+	for _, u := range m.c.usages {
+		if u.ProjectID == projectID && u.OssVersionID == ossVersionID {
+			return &u, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *memUsageRepo) Create(ctx context.Context, u *model.ProjectUsage) error {
+	m.c.usages = append(m.c.usages, *u)
+	return nil
+}
+
+func (m *memUsageRepo) AddDependency(ctx context.Context, usageID, dependsOnUsageID string) error {
+	m.c.dependencies = append(m.c.dependencies, [2]string{usageID, dependsOnUsageID})
+	return nil
+}
+
+type memAuditRepo struct {
+	domrepo.AuditLogRepository
+	logs []model.AuditLog
+}
+
+func (m *memAuditRepo) Create(ctx context.Context, l *model.AuditLog) error {
+	m.logs = append(m.logs, *l)
+	return nil
+}
+
+type stubScopePolicyRepo struct {
+	domrepo.ScopePolicyRepository
+	policy *model.ScopePolicy
+}
+
+func (s *stubScopePolicyRepo) Get(ctx context.Context) (*model.ScopePolicy, error) {
+	if s.policy == nil {
+		return nil, sql.ErrNoRows
+	}
+	return s.policy, nil
+}
+
+func newImportService(c *memCatalog, policy *model.ScopePolicy) *ImportService {
+	return &ImportService{
+		ProjectRepo:           &stubProjectRepo{project: &model.Project{ID: "p1", ProjectCode: "PRJ"}},
+		OssComponentRepo:      &memComponentRepo{c: c},
+		OssComponentLayerRepo: &memLayerRepo{layers: map[string][]string{}},
+		OssVersionRepo:        &memVersionRepo{c: c},
+		ProjectUsageRepo:      &memUsageRepo{c: c},
+		ScopePolicyRepo:       &stubScopePolicyRepo{policy: policy},
+		AuditRepo:             &memAuditRepo{},
+	}
+}
+
+func TestImportService_Import(t *testing.T) {
+	str := func(s string) *string { return &s }
+	c := &memCatalog{
+		components: []model.OssComponent{
+			{ID: "c-lodash", Name: "lodash", NormalizedName: "lodash"},
+			{ID: "c-redis", Name: "Redis", NormalizedName: "redis", DefaultUsageRole: str("SERVER_ENV")},
+		},
+		versions: []model.OssVersion{
+			{ID: "v-lodash", OssID: "c-lodash", Version: "4.17.21", Purl: str("pkg:npm/lodash@4.17.21")},
+			{ID: "v-redis", OssID: "c-redis", Version: "7.2.0"},
+		},
+		usages: []model.ProjectUsage{
+			{ID: "u-existing", ProjectID: "p1", OssID: "c-redis", OssVersionID: "v-redis", UsageRole: "SERVER_ENV", ScopeStatus: "OUT_SCOPE"},
+		},
+	}
+	svc := newImportService(c, &model.ScopePolicy{RuntimeRequiredDefaultInScope: true})
+
+	bom := &sbom.BOM{Format: "spdx-json", Packages: []sbom.Package{
+		{Ref: "a", Name: "lodash", Version: "4.17.21", Purl: "pkg:npm/lodash@4.17.21", Direct: true},
+		{Ref: "b", Name: " REDIS ", Version: "7.2.0"},
+		{Ref: "c", Name: "lodash", Version: "4.17.20", LicenseConcluded: "MIT", UsageRole: "BUILD_ONLY"},
+		{Ref: "d", Name: "left-pad", Version: "1.3.0", LicenseDeclared: "WTFPL", Purl: "pkg:npm/left-pad@1.3.0", SHA256: "abc", SHA512: "def", CPEs: []string{"cpe:2.3:a:left-pad:left-pad:1.3.0:*:*:*:*:*:*:*"}},
+		{Ref: "e", Name: "lodash", Version: "4.17.21"},
+		{Ref: "f", Name: "noversion"},
+	}}
+	report, err := svc.Import(context.Background(), "p1", bom, ImportOptions{User: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, "spdx-json", report.Format)
+	require.Equal(t, 2, report.Created)
+	require.Equal(t, 1, report.Matched)
+	require.Equal(t, 3, report.Skipped)
+	require.Len(t, report.Items, 6)
+
+	lodash := report.Items[0]
+	require.Equal(t, ImportMatched, lodash.Result)
+	require.Equal(t, "v-lodash", lodash.OssVersionID)
+	require.Equal(t, "RUNTIME_REQUIRED", lodash.UsageRole)
+	require.Equal(t, "IN_SCOPE", lodash.ScopeStatus)
+
+	redis := report.Items[1]
+	require.Equal(t, ImportSkipped, redis.Result)
+	require.Equal(t, "u-existing", redis.UsageID)
+
+	older := report.Items[2]
+	require.Equal(t, ImportCreated, older.Result)
+	require.Equal(t, "c-lodash", older.OssID)
+	require.Equal(t, "OUT_SCOPE", older.ScopeStatus)
+
+	leftPad := report.Items[3]
+	require.Equal(t, ImportCreated, leftPad.Result)
+	require.Equal(t, "created draft component and version", leftPad.Reason)
+
+	require.Equal(t, ImportSkipped, report.Items[4].Result)
+	require.Equal(t, "duplicate package in document", report.Items[4].Reason)
+	require.Equal(t, "package version is missing", report.Items[5].Reason)
+
+	require.Len(t, c.components, 3)
+	require.Equal(t, "left-pad", c.components[2].NormalizedName)
+	require.Len(t, c.versions, 4)
+	created := c.versions[3]
+	require.Equal(t, "draft", created.ReviewStatus)
+	require.Equal(t, "WTFPL", *created.LicenseExpressionRaw)
+	require.Nil(t, created.LicenseConcluded)
+	require.Equal(t, "abc", *created.HashSha256)
+	require.Equal(t, "def", *created.HashSha512)
+	require.Len(t, created.CpeList, 1)
+	require.Equal(t, "MIT", *c.versions[2].LicenseExpressionRaw)
+	require.Len(t, c.usages, 4)
+	require.True(t, c.usages[1].DirectDependency)
+
+	// コンポーネント 1 件・バージョン 2 件・利用情報 3 件の登録と取り込み結果
+	logs := svc.AuditRepo.(*memAuditRepo).logs
+	require.Len(t, logs, 7)
+	counts := map[string]int{}
+	for _, l := range logs {
+		require.Equal(t, "alice", l.UserName)
+		counts[l.EntityType+" "+l.Action]++
+	}
+	require.Equal(t, map[string]int{
+		"OSS_COMPONENT CREATE": 1,
+		"OSS_VERSION CREATE":   2,
+		"PROJECT_USAGE CREATE": 3,
+		"PROJECT IMPORT":       1,
+	}, counts)
+	last := logs[len(logs)-1]
+	require.Equal(t, "p1", last.EntityID)
+	require.Contains(t, *last.Summary, "created=2 matched=1 skipped=3")
+}
+
+func TestImportService_Import_UsageRoleOption(t *testing.T) {
+	c := &memCatalog{}
+	svc := newImportService(c, nil)
+	bom := &sbom.BOM{Packages: []sbom.Package{{Name: "jq", Version: "1.7"}}}
+
+	report, err := svc.Import(context.Background(), "p1", bom, ImportOptions{UsageRole: "DEV_ONLY"})
+	require.NoError(t, err)
+	require.Equal(t, "DEV_ONLY", report.Items[0].UsageRole)
+	require.Equal(t, "OUT_SCOPE", report.Items[0].ScopeStatus)
+}
+
+func TestImportService_Import_ProjectNotFound(t *testing.T) {
+	svc := newImportService(&memCatalog{}, nil)
+	_, err := svc.Import(context.Background(), "missing", &sbom.BOM{}, ImportOptions{})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestImportService_Import_DraftLicense(t *testing.T) {
+	c := &memCatalog{}
+	svc := newImportService(c, nil)
+	bom := &sbom.BOM{Packages: []sbom.Package{
+		{Name: "zlib", Version: "1.3", LicenseDeclared: "zlib"},
+		{Name: "legacy", Version: "0.1", LicenseDeclared: "BSD-like"},
+	}}
+
+	report, err := svc.Import(context.Background(), "p1", bom, ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "Zlib", *c.versions[0].LicenseExpressionRaw)
+	// SPDX ライセンス式として解釈できない記載は NOASSERTION とし、元の記載を理由に残す
+	require.Equal(t, "NOASSERTION", *c.versions[1].LicenseExpressionRaw)
+	require.Equal(t, `created draft component and version; license "BSD-like" is not a valid SPDX expression, recorded as NOASSERTION`, report.Items[1].Reason)
+}
+
+func TestImportService_Import_LayersAndInclusionNote(t *testing.T) {
+	c := &memCatalog{components: []model.OssComponent{{ID: "c-musl", Name: "musl", NormalizedName: "musl"}}}
+	svc := newImportService(c, nil)
+	layers := svc.OssComponentLayerRepo.(*memLayerRepo)
+	bom := &sbom.BOM{Format: "syft-json", Packages: []sbom.Package{
+		{Ref: "a", Name: "busybox", Version: "1.36.1-r5", Layers: []string{"OS"}, UsageRole: "BUNDLED_BINARY", InclusionNote: "image alpine:3.18@sha256:aaaa"},
+		{Ref: "b", Name: "musl", Version: "1.2.4-r2", Layers: []string{"OS"}, InclusionNote: "image alpine:3.18@sha256:aaaa"},
+	}}
+
+	report, err := svc.Import(context.Background(), "p1", bom, ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Created)
+	// レイヤーは新規登録したコンポーネントにのみ設定する
+	require.Equal(t, map[string][]string{report.Items[0].OssID: {"OS"}}, layers.layers)
+	require.Equal(t, []string{"OS"}, c.components[1].Layers)
+	require.Equal(t, "BUNDLED_BINARY", c.usages[0].UsageRole)
+	require.Equal(t, "image alpine:3.18@sha256:aaaa", *c.usages[0].InclusionNote)
+	require.Equal(t, "image alpine:3.18@sha256:aaaa", *c.usages[1].InclusionNote)
+}
+
+func TestImportService_Import_Dependencies(t *testing.T) {
+	c := &memCatalog{}
+	svc := newImportService(c, nil)
+	bom := &sbom.BOM{Format: "cyclonedx-json", Packages: []sbom.Package{
+		{Ref: "express", Name: "express", Version: "4.18.2", Direct: true},
+		{Ref: "body-parser", Name: "body-parser", Version: "1.20.1"},
+		{Ref: "bytes", Name: "bytes", Version: "3.1.2"},
+		// 重複したパッケージは先の利用情報に読み替える
+		{Ref: "bytes-dup", Name: "bytes", Version: "3.1.2"},
+		{Ref: "noversion", Name: "noversion"},
+	}, Dependencies: map[string][]string{
+		"express":     {"body-parser", "noversion", "express"},
+		"body-parser": {"bytes-dup"},
+	}}
+
+	report, err := svc.Import(context.Background(), "p1", bom, ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 3, report.Created)
+	express, bodyParser, bytes := report.Items[0].UsageID, report.Items[1].UsageID, report.Items[2].UsageID
+	require.Equal(t, [][2]string{{express, bodyParser}, {bodyParser, bytes}}, c.dependencies)
+}
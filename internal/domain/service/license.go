@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/license"
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+// SeedLicenses は埋め込みの SPDX ライセンスリストをライセンスカタログに登録する。
+// 起動時に毎回呼び出し、登録済みのライセンスは変更しない。
+func SeedLicenses(ctx context.Context, repo domrepo.LicenseRepository) error {
+	now := dbtime.DBTime{Time: time.Now()}
+	infos := license.Builtin()
+	ls := make([]model.License, len(infos))
+	for i, info := range infos {
+		ls[i] = model.License{
+			ID:          info.ID,
+			Name:        info.Name,
+			OsiApproved: info.OsiApproved,
+			FsfLibre:    info.FsfLibre,
+			Category:    optional(info.Category),
+			Text:        optional(info.Text),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+	}
+	return repo.Seed(ctx, ls)
+}
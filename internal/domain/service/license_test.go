@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// memLicenseRepo はテスト用のインメモリ LicenseRepository。
+type memLicenseRepo struct {
+	domrepo.LicenseRepository
+	licenses map[string]model.License
+	gets     int
+}
+
+func (m *memLicenseRepo) Get(ctx context.Context, id string) (*model.License, error) {
+	m.gets++
+	l, ok := m.licenses[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &l, nil
+}
+
+func (m *memLicenseRepo) Seed(ctx context.Context, ls []model.License) error {
+	for _, l := range ls {
+		if cur, ok := m.licenses[l.ID]; !ok {
+			m.licenses[l.ID] = l
+		} else if cur.Text == nil {
+			cur.Text = l.Text
+			m.licenses[l.ID] = cur
+		}
+	}
+	return nil
+}
+
+func TestSeedLicenses(t *testing.T) {
+	edited := "edited"
+	repo := &memLicenseRepo{licenses: map[string]model.License{"MIT": {ID: "MIT", Name: "MIT License", Text: &edited}}}
+	require.NoError(t, SeedLicenses(context.Background(), repo))
+
+	require.Greater(t, len(repo.licenses), 600)
+	require.Equal(t, edited, *repo.licenses["MIT"].Text)
+	apache := repo.licenses["Apache-2.0"]
+	require.True(t, apache.OsiApproved)
+	require.Equal(t, "PERMISSIVE", *apache.Category)
+	require.Contains(t, *apache.Text, "TERMS AND CONDITIONS FOR USE")
+	require.False(t, apache.Custom)
+	gpl := repo.licenses["GPL-3.0-only"]
+	require.Equal(t, "STRONG_COPYLEFT", *gpl.Category)
+	require.Contains(t, *gpl.Text, "GNU GENERAL PUBLIC LICENSE")
+	require.Nil(t, repo.licenses["AGPL-3.0-only"].Text)
+}
+
+func TestExportService_BuildDocument_LicenseTexts(t *testing.T) {
+	mit, custom, dual := "MIT", "LicenseRef-acme", "MIT OR Apache-2.0"
+	acme := "ACME License"
+	licenses := &memLicenseRepo{licenses: map[string]model.License{
+		"MIT":             {ID: "MIT"},
+		"LicenseRef-acme": {ID: "LicenseRef-acme", Text: &acme, Custom: true},
+	}}
+	svc := &ExportService{
+		ProjectRepo: &stubProjectRepo{project: &model.Project{ID: "p1"}},
+		ProjectUsageRepo: &stubProjectUsageRepo{items: []model.ProjectUsageDetail{
+			{Version: model.OssVersion{ID: "v1", LicenseConcluded: &custom, LicenseExpressionRaw: &mit}},
+			{Version: model.OssVersion{ID: "v2", LicenseExpressionRaw: &dual}},
+		}},
+		LicenseRepo: licenses,
+	}
+
+	d, err := svc.BuildDocument(context.Background(), "p1", []string{"IN_SCOPE"}, "alice")
+	require.NoError(t, err)
+	// 本文未設定 (MIT)・未登録 (Apache-2.0) のライセンスは含めない
+	require.Equal(t, map[string]string{"LicenseRef-acme": acme}, d.LicenseTexts)
+	require.Equal(t, 3, licenses.gets)
+}
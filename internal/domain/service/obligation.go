@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/internal/domain/obligation"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+// obligationScopes は義務を導出する利用のスコープ。納品対象外 (OUT_SCOPE) の利用は対象外とする。
+var obligationScopes = []string{"IN_SCOPE", "REVIEW_NEEDED"}
+
+// ObligationService はプロジェクトの利用からライセンスの義務を導出して記録する。
+type ObligationService struct {
+	ProjectRepo      domrepo.ProjectRepository
+	ProjectUsageRepo domrepo.ProjectUsageRepository
+	ObligationRepo   domrepo.ProjectObligationRepository
+	// Rules は導出ルール表。未設定の場合は obligation.Rules を用いる。
+	Rules []obligation.Rule
+}
+
+// Sync はプロジェクトの利用から義務を導出し、未登録の義務を OPEN で登録する。
+// 導出されなくなった OPEN の義務は削除し、履行済み・免除済みの義務は残す。
+// プロジェクトが存在しない場合は sql.ErrNoRows を返す。
+func (s *ObligationService) Sync(ctx context.Context, projectID string) (added, removed int, err error) {
+	p, err := s.ProjectRepo.Get(ctx, projectID)
+	if err != nil {
+		return 0, 0, err
+	}
+	derived, err := s.derive(ctx, p.ID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return s.ObligationRepo.Sync(ctx, p.ID, derived)
+}
+
+// Unsynced は現在の利用から導出される義務のうち、recorded (プロジェクトに登録済みの義務) に無いものの件数を返す。
+// 0 より大きい場合は利用の追加・変更後に Sync されておらず、登録済みの義務だけでは納品可否を判断できない。
+func (s *ObligationService) Unsynced(ctx context.Context, projectID string, recorded []model.ProjectObligationDetail) (int, error) {
+	derived, err := s.derive(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	type key struct{ usage, license, obligation string }
+	have := map[key]bool{}
+	for _, d := range recorded {
+		have[key{d.Obligation.UsageID, d.Obligation.License, d.Obligation.Obligation}] = true
+	}
+	missing := 0
+	for _, o := range derived {
+		k := key{o.UsageID, o.License, o.Obligation}
+		if !have[k] {
+			have[k] = true
+			missing++
+		}
+	}
+	return missing, nil
+}
+
+// derive はプロジェクトの利用から義務を導出する。
+func (s *ObligationService) derive(ctx context.Context, projectID string) ([]model.ProjectObligation, error) {
+	items, err := s.ProjectUsageRepo.ListDetails(ctx, projectID, obligationScopes)
+	if err != nil {
+		return nil, err
+	}
+	rules := s.Rules
+	if rules == nil {
+		rules = obligation.Rules
+	}
+	now := dbtime.DBTime{Time: time.Now()}
+	var derived []model.ProjectObligation
+	for _, it := range items {
+		for _, d := range obligation.Derive(rules, it.Usage.UsageRole, it.Version) {
+			derived = append(derived, model.ProjectObligation{
+				ID:          uuid.NewString(),
+				ProjectID:   projectID,
+				UsageID:     it.Usage.ID,
+				License:     d.License,
+				Obligation:  d.Obligation,
+				Description: d.Description,
+				Status:      model.ObligationOpen,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			})
+		}
+	}
+	return derived, nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+type stubObligationRepo struct {
+	domrepo.ProjectObligationRepository
+	derived []model.ProjectObligation
+}
+
+func (s *stubObligationRepo) Sync(ctx context.Context, projectID string, derived []model.ProjectObligation) (int, int, error) {
+	s.derived = derived
+	return len(derived), 0, nil
+}
+
+func TestObligationService_Sync(t *testing.T) {
+	lgpl, apache := "LGPL-2.1-only", "Apache-2.0"
+	repo := &stubObligationRepo{}
+	svc := &ObligationService{
+		ProjectRepo: &stubProjectRepo{project: &model.Project{ID: "p1"}},
+		ProjectUsageRepo: &stubProjectUsageRepo{items: []model.ProjectUsageDetail{
+			{Usage: model.ProjectUsage{ID: "u1", UsageRole: "STATIC_LINK"}, Version: model.OssVersion{LicenseConcluded: &lgpl}},
+			{Usage: model.ProjectUsage{ID: "u2", UsageRole: "TEST_ONLY"}, Version: model.OssVersion{LicenseConcluded: &apache}},
+		}},
+		ObligationRepo: repo,
+	}
+
+	added, _, err := svc.Sync(context.Background(), "p1")
+	require.NoError(t, err)
+	require.Equal(t, 3, added)
+	for _, o := range repo.derived {
+		require.Equal(t, "p1", o.ProjectID)
+		require.Equal(t, "u1", o.UsageID)
+		require.Equal(t, model.ObligationOpen, o.Status)
+	}
+	require.Equal(t, "RELINKABLE_OBJECTS", repo.derived[2].Obligation)
+
+	_, _, err = svc.Sync(context.Background(), "missing")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestObligationService_Unsynced(t *testing.T) {
+	lgpl := "LGPL-2.1-only"
+	repo := &stubObligationRepo{}
+	svc := &ObligationService{
+		ProjectRepo: &stubProjectRepo{project: &model.Project{ID: "p1"}},
+		ProjectUsageRepo: &stubProjectUsageRepo{items: []model.ProjectUsageDetail{
+			{Usage: model.ProjectUsage{ID: "u1", UsageRole: "STATIC_LINK"}, Version: model.OssVersion{LicenseConcluded: &lgpl}},
+		}},
+		ObligationRepo: repo,
+	}
+
+	// 一度も導出していない場合は導出される義務がすべて未登録
+	n, err := svc.Unsynced(context.Background(), "p1", nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	_, _, err = svc.Sync(context.Background(), "p1")
+	require.NoError(t, err)
+	recorded := make([]model.ProjectObligationDetail, len(repo.derived))
+	for i, o := range repo.derived {
+		o.Status = model.ObligationFulfilled
+		recorded[i] = model.ProjectObligationDetail{Obligation: o}
+	}
+	n, err = svc.Unsynced(context.Background(), "p1", recorded)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	// 登録後に追加された利用の義務は未登録として数える
+	n, err = svc.Unsynced(context.Background(), "p1", recorded[:1])
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+}
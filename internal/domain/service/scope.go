@@ -0,0 +1,24 @@
+package service
+
+import "github.com/ramsesyok/oss-catalog/internal/domain/model"
+
+// InitialScopeStatus は利用形態とスコープポリシーから ProjectUsage の初期スコープを決定する。
+// policy が nil の場合は既定のポリシー (全項目 false) として扱う。
+func InitialScopeStatus(policy *model.ScopePolicy, role string) string {
+	switch role {
+	case "BUILD_ONLY", "DEV_ONLY", "TEST_ONLY":
+		return "OUT_SCOPE"
+	case "SERVER_ENV":
+		if policy != nil && policy.ServerEnvIncluded {
+			return "IN_SCOPE"
+		}
+		return "OUT_SCOPE"
+	case "RUNTIME_REQUIRED":
+		if policy != nil && policy.RuntimeRequiredDefaultInScope {
+			return "IN_SCOPE"
+		}
+		return "REVIEW_NEEDED"
+	default:
+		return "IN_SCOPE"
+	}
+}
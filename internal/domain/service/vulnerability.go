@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/internal/domain/vuln"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+// 取り下げられた脆弱性の状態 (NVD の vulnStatus と OSV の withdrawn)。該当判定の対象外とする。
+const (
+	vulnStatusRejected  = "Rejected"
+	vulnStatusWithdrawn = "Withdrawn"
+)
+
+// VulnerabilityImportReport は脆弱性フィード 1 ファイル分の取り込み結果を表す。
+type VulnerabilityImportReport struct {
+	Total   int
+	Created int
+	Updated int
+	// Unchanged は最終更新日時が登録済みのものと同じため置き換えなかった件数。
+	Unchanged int
+	// Skipped は他の取り込み元から登録済みの ID と重複したため取り込まなかった件数 (OSV のみ)。
+	Skipped int
+}
+
+// VulnerabilityService は脆弱性フィードの取り込みと、OSS バージョン・プロジェクトへの該当判定を行う。
+type VulnerabilityService struct {
+	VulnerabilityRepo domrepo.VulnerabilityRepository
+	ProjectRepo       domrepo.ProjectRepository
+	ProjectUsageRepo  domrepo.ProjectUsageRepository
+	// AnalysisRepo は利用毎の VEX 分析の保存先。未設定の場合は分析を参照しない。
+	AnalysisRepo domrepo.VulnerabilityAnalysisRepository
+	AuditRepo    domrepo.AuditLogRepository
+	// WithinTx は fn を 1 トランザクションで実行する。fn にはトランザクションに束縛したサービスを渡す。
+	// nil の場合はトランザクションを用いずに自身を渡す。
+	WithinTx func(ctx context.Context, fn func(ctx context.Context, s *VulnerabilityService) error) error
+}
+
+func (s *VulnerabilityService) tx(ctx context.Context, fn func(ctx context.Context, s *VulnerabilityService) error) error {
+	if s.WithinTx == nil {
+		return fn(ctx, s)
+	}
+	return s.WithinTx(ctx, fn)
+}
+
+// ImportNVD は NVD CVE JSON 2.0 フィード (gzip 圧縮も可) を 1 トランザクションで取り込む。
+// 登録済みの脆弱性は最終更新日時が変わった場合のみ CVSS 評価・CPE 条件とともに置き換える。
+// name はフィードのファイル名で、監査ログに記録する。フィードの形式が不正な場合は vuln.ErrInvalidFeed を返す。
+func (s *VulnerabilityService) ImportNVD(ctx context.Context, r io.Reader, name, user string) (*VulnerabilityImportReport, error) {
+	report := &VulnerabilityImportReport{}
+	err := s.tx(ctx, func(ctx context.Context, tx *VulnerabilityService) error {
+		*report = VulnerabilityImportReport{}
+		if err := vuln.ParseNVD(r, func(rec vuln.Record) error {
+			return tx.importRecord(ctx, rec, report)
+		}); err != nil {
+			return err
+		}
+		summary := fmt.Sprintf("imported NVD feed %s: total=%d created=%d updated=%d unchanged=%d", name, report.Total, report.Created, report.Updated, report.Unchanged)
+		return tx.audit(ctx, model.AuditEntityVulnerabilityFeed, name, model.AuditActionImport, user, summary)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// ImportOSV は fsys 配下の OSV JSON (エコシステム別ダンプの zip を含む) を 1 トランザクションで取り込む。
+// 登録済みの脆弱性は最終更新日時が変わった場合のみ置き換える。
+// NVD から登録済みの ID (Linux カーネルの CVE など) は NVD の情報を優先して取り込まない。
+// name は取り込み元 (ディレクトリまたは zip) の名前で、監査ログに記録する。形式が不正な場合は vuln.ErrInvalidFeed を返す。
+func (s *VulnerabilityService) ImportOSV(ctx context.Context, fsys fs.FS, name, user string) (*VulnerabilityImportReport, error) {
+	report := &VulnerabilityImportReport{}
+	err := s.tx(ctx, func(ctx context.Context, tx *VulnerabilityService) error {
+		*report = VulnerabilityImportReport{}
+		if err := vuln.WalkOSV(fsys, func(_ string, rec vuln.Record) error {
+			return tx.importRecord(ctx, rec, report)
+		}); err != nil {
+			return err
+		}
+		summary := fmt.Sprintf("imported OSV dataset %s: total=%d created=%d updated=%d unchanged=%d skipped=%d", name, report.Total, report.Created, report.Updated, report.Unchanged, report.Skipped)
+		return tx.audit(ctx, model.AuditEntityVulnerabilityFeed, name, model.AuditActionImport, user, summary)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *VulnerabilityService) importRecord(ctx context.Context, rec vuln.Record, report *VulnerabilityImportReport) error {
+	report.Total++
+	v := rec.Vulnerability
+	now := dbtime.DBTime{Time: time.Now()}
+	v.CreatedAt, v.UpdatedAt = now, now
+	existing, err := s.VulnerabilityRepo.Get(ctx, v.ID)
+	switch {
+	case err == nil:
+		if v.Source == model.VulnerabilitySourceOSV && existing.Source != v.Source {
+			report.Skipped++
+			return nil
+		}
+		if existing.LastModifiedAt != nil && v.LastModifiedAt != nil && existing.LastModifiedAt.Equal(v.LastModifiedAt.Time) {
+			report.Unchanged++
+			return nil
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+	created, err := s.VulnerabilityRepo.Upsert(ctx, &v, rec.Metrics, rec.Matches, rec.Packages)
+	if err != nil {
+		return err
+	}
+	if created {
+		report.Created++
+	} else {
+		report.Updated++
+	}
+	return nil
+}
+
+// audit は監査ログを 1 件記録する。AuditRepo が未設定の場合は何もしない。
+func (s *VulnerabilityService) audit(ctx context.Context, entityType, entityID, action, user, summary string) error {
+	if s.AuditRepo == nil {
+		return nil
+	}
+	return s.AuditRepo.Create(ctx, &model.AuditLog{
+		ID:         uuid.NewString(),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		UserName:   user,
+		Summary:    &summary,
+		CreatedAt:  dbtime.DBTime{Time: time.Now()},
+	})
+}
+
+// Match は OSS バージョンの CPE 一覧と purl を脆弱性の CPE 条件・パッケージのバージョン範囲と照合し、
+// 該当する脆弱性を CVSS スコアの高い順で返す。1 件の脆弱性に複数の条件が該当した場合は最初に該当した条件を根拠とする。
+// 別名で結び付く脆弱性 (CVE と GHSA など) は 1 件にまとめる。取り下げられた脆弱性は除く。
+func (s *VulnerabilityService) Match(ctx context.Context, v model.OssVersion) ([]model.VersionVulnerability, error) {
+	found := map[string]model.VulnerabilityMatch{}
+	var ids []string
+	add := func(m model.VulnerabilityMatch) {
+		if _, ok := found[m.VulnerabilityID]; !ok {
+			found[m.VulnerabilityID] = m
+			ids = append(ids, m.VulnerabilityID)
+		}
+	}
+	for _, raw := range v.CpeList {
+		cpe, err := vuln.ParseCPE(raw)
+		if err != nil {
+			continue
+		}
+		matches, err := s.VulnerabilityRepo.FindCpeMatches(ctx, cpe.Vendor(), cpe.Product())
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range matches {
+			if vuln.MatchCPE(m, cpe, v.Version) {
+				add(model.VulnerabilityMatch{VulnerabilityID: m.VulnerabilityID, MatchedBy: model.VulnerabilityMatchedByCPE, Identifier: raw, Cpe: &m})
+			}
+		}
+	}
+	if v.Purl != nil {
+		if p, ok := vuln.ParsePurl(*v.Purl); ok {
+			// purl にバージョンが無い場合は OSS バージョンのバージョンで判定する
+			version := p.Version
+			if version == "" {
+				version = v.Version
+			}
+			ranges, err := s.VulnerabilityRepo.FindPackageRanges(ctx, p.Key())
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range ranges {
+				if vuln.MatchPackage(r, version) {
+					add(model.VulnerabilityMatch{VulnerabilityID: r.VulnerabilityID, MatchedBy: model.VulnerabilityMatchedByPurl, Identifier: *v.Purl, Package: &r})
+				}
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.mergeFindings(ctx, ids, found)
+}
+
+// mergeFindings は該当した脆弱性を別名でまとめる。まとめた脆弱性の代表には NVD のものを優先し、
+// 代表が CVSS 評価を持たない場合は他の脆弱性の評価で補う。
+func (s *VulnerabilityService) mergeFindings(ctx context.Context, ids []string, found map[string]model.VulnerabilityMatch) ([]model.VersionVulnerability, error) {
+	aliases, err := s.VulnerabilityRepo.ListAliases(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	parent := map[string]string{}
+	var root func(id string) string
+	root = func(id string) string {
+		p, ok := parent[id]
+		if !ok || p == id {
+			return id
+		}
+		r := root(p)
+		parent[id] = r
+		return r
+	}
+	members := map[string]bool{}
+	for _, id := range ids {
+		members[id] = true
+	}
+	for _, a := range aliases {
+		members[a.VulnerabilityID], members[a.Alias] = true, true
+		if ra, rb := root(a.VulnerabilityID), root(a.Alias); ra != rb {
+			parent[rb] = ra
+		}
+	}
+	all := make([]string, 0, len(members))
+	for id := range members {
+		all = append(all, id)
+	}
+	sort.Strings(all)
+	vulns, err := s.VulnerabilityRepo.ListByIDs(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+	records := map[string]model.Vulnerability{}
+	for _, vu := range vulns {
+		if !withdrawn(vu) {
+			records[vu.ID] = vu
+		}
+	}
+	groups := map[string][]string{}
+	var roots []string
+	for _, id := range all {
+		r := root(id)
+		if _, ok := groups[r]; !ok {
+			roots = append(roots, r)
+		}
+		groups[r] = append(groups[r], id)
+	}
+
+	res := make([]model.VersionVulnerability, 0, len(roots))
+	for _, r := range roots {
+		var item model.VersionVulnerability
+		var rep *model.Vulnerability
+		for _, id := range groups[r] {
+			vu, ok := records[id]
+			if !ok {
+				continue
+			}
+			if m, ok := found[id]; ok {
+				item.Matches = append(item.Matches, m)
+				if rep == nil {
+					rep = &vu
+				}
+			}
+			if vu.Source == model.VulnerabilitySourceNVD && (rep == nil || rep.Source != model.VulnerabilitySourceNVD) {
+				rep = &vu
+			}
+		}
+		// 該当した脆弱性がすべて取り下げられている
+		if len(item.Matches) == 0 {
+			continue
+		}
+		item.Vulnerability = *rep
+		item.FixedVersions = fixedVersions(item.Matches)
+		for _, id := range groups[r] {
+			if id == rep.ID {
+				continue
+			}
+			item.Aliases = append(item.Aliases, id)
+			if vu, ok := records[id]; ok && item.Vulnerability.CvssScore == nil && vu.CvssScore != nil {
+				item.Vulnerability.Severity, item.Vulnerability.CvssScore = vu.Severity, vu.CvssScore
+				item.Vulnerability.CvssVersion, item.Vulnerability.CvssVector = vu.CvssVersion, vu.CvssVector
+			}
+			if vu, ok := records[id]; ok && item.Vulnerability.Severity == nil {
+				item.Vulnerability.Severity = vu.Severity
+			}
+		}
+		res = append(res, item)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		si, sj := cvssScore(res[i].Vulnerability), cvssScore(res[j].Vulnerability)
+		if si != sj {
+			return si > sj
+		}
+		return res[i].Vulnerability.ID < res[j].Vulnerability.ID
+	})
+	return res, nil
+}
+
+// ProjectVulnerabilityFilter はプロジェクトの脆弱性一覧の絞り込み条件を表す。空の項目では絞り込まない。
+type ProjectVulnerabilityFilter struct {
+	// Scopes は利用のスコープ (ScopeStatus)。
+	Scopes []string
+	// Severities は代表の深刻度。深刻度が不明な脆弱性は指定した場合に除く。
+	Severities []string
+	// FixAvailable は修正バージョンの有無。
+	FixAvailable *bool
+	// IncludeSuppressed が true の場合は、分析で影響を受けない・修正済みとした該当も含める。
+	IncludeSuppressed bool
+}
+
+// match は脆弱性の該当が条件を満たすかを返す。
+func (f ProjectVulnerabilityFilter) match(vv model.VersionVulnerability) bool {
+	if len(f.Severities) > 0 {
+		sev := vv.Vulnerability.Severity
+		if sev == nil || !slices.Contains(f.Severities, *sev) {
+			return false
+		}
+	}
+	if f.FixAvailable != nil && *f.FixAvailable != (len(vv.FixedVersions) > 0) {
+		return false
+	}
+	return true
+}
+
+// ProjectFindings はプロジェクトの利用それぞれについて、利用しているバージョンに該当する脆弱性を記録済みの分析と共に返す。
+// 利用はコンポーネント名・バージョン順、利用毎の脆弱性は CVSS スコアの高い順とする。
+// 同じバージョンを複数の利用で参照する場合の照合は 1 回にまとめる。プロジェクトが存在しない場合は sql.ErrNoRows を返す。
+func (s *VulnerabilityService) ProjectFindings(ctx context.Context, projectID string, f ProjectVulnerabilityFilter) (*model.Project, []model.ProjectVulnerability, error) {
+	p, err := s.ProjectRepo.Get(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	details, err := s.ProjectUsageRepo.ListDetails(ctx, projectID, f.Scopes)
+	if err != nil {
+		return nil, nil, err
+	}
+	analyses, err := s.projectAnalyses(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	matched := map[string][]model.VersionVulnerability{}
+	var res []model.ProjectVulnerability
+	for _, d := range details {
+		list, ok := matched[d.Version.ID]
+		if !ok {
+			if list, err = s.Match(ctx, d.Version); err != nil {
+				return nil, nil, err
+			}
+			matched[d.Version.ID] = list
+		}
+		for _, vv := range list {
+			a := findingAnalysis(analyses[d.Usage.ID], vv)
+			if f.match(vv) && (f.IncludeSuppressed || !suppressed(a)) {
+				res = append(res, model.ProjectVulnerability{Usage: d, Finding: vv, Analysis: a})
+			}
+		}
+	}
+	return p, res, nil
+}
+
+// fixedVersions は根拠の範囲から修正バージョンを重複を除いて集める。
+func fixedVersions(matches []model.VulnerabilityMatch) []string {
+	var res []string
+	for _, m := range matches {
+		var v *string
+		switch {
+		case m.Package != nil:
+			v = m.Package.Fixed
+		case m.Cpe != nil:
+			v = m.Cpe.VersionEndExcluding
+		}
+		if v != nil && !slices.Contains(res, *v) {
+			res = append(res, *v)
+		}
+	}
+	return res
+}
+
+// withdrawn は脆弱性が取り下げられているかを返す。
+func withdrawn(v model.Vulnerability) bool {
+	return v.Status != nil && (*v.Status == vulnStatusRejected || *v.Status == vulnStatusWithdrawn)
+}
+
+// cvssScore は代表の CVSS スコアを返す。未評価の場合は -1 とし、評価済みのものより後に並べる。
+func cvssScore(v model.Vulnerability) float64 {
+	if v.CvssScore == nil {
+		return -1
+	}
+	return *v.CvssScore
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+// ErrInvalidAnalysis は VEX 分析の内容が不正であることを表す。
+var ErrInvalidAnalysis = errors.New("invalid vulnerability analysis")
+
+var (
+	analysisStates = []string{model.AnalysisNotAffected, model.AnalysisAffected, model.AnalysisFixed, model.AnalysisUnderInvestigation}
+	justifications = []string{
+		model.JustificationComponentNotPresent,
+		model.JustificationVulnerableCodeNotPresent,
+		model.JustificationVulnerableCodeNotInExecutePath,
+		model.JustificationVulnerableCodeCannotBeControlledByAdversary,
+		model.JustificationInlineMitigationsAlreadyExist,
+	}
+	analysisResponses = []string{model.ResponseCanNotFix, model.ResponseWillNotFix, model.ResponseUpdate, model.ResponseRollback, model.ResponseWorkaroundAvailable}
+)
+
+// AnalysisInput は記録する VEX 分析の内容を表す。空文字の項目は未指定として扱う。
+type AnalysisInput struct {
+	State         string
+	Justification *string
+	Response      *string
+	Note          *string
+}
+
+// validate は状態・根拠・対応方針を検証する。not_affected には根拠が必須で、他の状態には根拠を指定できない。
+func (in *AnalysisInput) validate() error {
+	in.Justification, in.Response, in.Note = trimmed(in.Justification), trimmed(in.Response), trimmed(in.Note)
+	if !slices.Contains(analysisStates, in.State) {
+		return fmt.Errorf("%w: invalid state %q", ErrInvalidAnalysis, in.State)
+	}
+	switch {
+	case in.State == model.AnalysisNotAffected && in.Justification == nil:
+		return fmt.Errorf("%w: justification is required for %s", ErrInvalidAnalysis, in.State)
+	case in.State != model.AnalysisNotAffected && in.Justification != nil:
+		return fmt.Errorf("%w: justification is only allowed for %s", ErrInvalidAnalysis, model.AnalysisNotAffected)
+	case in.Justification != nil && !slices.Contains(justifications, *in.Justification):
+		return fmt.Errorf("%w: invalid justification %q", ErrInvalidAnalysis, *in.Justification)
+	case in.Response != nil && !slices.Contains(analysisResponses, *in.Response):
+		return fmt.Errorf("%w: invalid response %q", ErrInvalidAnalysis, *in.Response)
+	}
+	return nil
+}
+
+func trimmed(s *string) *string {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	return &v
+}
+
+// suppressed は分析により報告から除く (影響を受けない・修正済み) かを返す。
+func suppressed(a *model.VulnerabilityAnalysis) bool {
+	return a != nil && (a.State == model.AnalysisNotAffected || a.State == model.AnalysisFixed)
+}
+
+// GetAnalysis はプロジェクトの利用と脆弱性の組の分析を返す。存在しない場合は sql.ErrNoRows を返す。
+func (s *VulnerabilityService) GetAnalysis(ctx context.Context, projectID, usageID, vulnerabilityID string) (*model.VulnerabilityAnalysis, error) {
+	a, err := s.AnalysisRepo.Find(ctx, usageID, vulnerabilityID)
+	if err != nil {
+		return nil, err
+	}
+	if a.ProjectID != projectID {
+		return nil, sql.ErrNoRows
+	}
+	return a, nil
+}
+
+// RecordAnalysis はプロジェクトの利用と脆弱性の組に VEX 分析を記録し、監査ログを残す。記録済みの場合は置き換える。
+// 新規に記録した場合は created に true を返す。利用がプロジェクトに無い場合・脆弱性が未登録の場合は sql.ErrNoRows を、
+// 内容が不正な場合は ErrInvalidAnalysis を返す。
+func (s *VulnerabilityService) RecordAnalysis(ctx context.Context, projectID, usageID, vulnerabilityID string, in AnalysisInput, user string) (a *model.VulnerabilityAnalysis, created bool, err error) {
+	if err := in.validate(); err != nil {
+		return nil, false, err
+	}
+	err = s.tx(ctx, func(ctx context.Context, tx *VulnerabilityService) error {
+		d, err := tx.projectUsage(ctx, projectID, usageID)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.VulnerabilityRepo.Get(ctx, vulnerabilityID); err != nil {
+			return err
+		}
+		now := dbtime.DBTime{Time: time.Now()}
+		a, err = tx.AnalysisRepo.Find(ctx, usageID, vulnerabilityID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			created = true
+			a = &model.VulnerabilityAnalysis{ID: uuid.NewString(), ProjectID: projectID, UsageID: usageID, VulnerabilityID: vulnerabilityID, CreatedAt: now}
+		case err != nil:
+			return err
+		}
+		a.State, a.Justification, a.Response, a.Note = in.State, in.Justification, in.Response, in.Note
+		a.UpdatedBy, a.UpdatedAt = user, now
+		action := model.AuditActionUpdate
+		if created {
+			action = model.AuditActionCreate
+			err = tx.AnalysisRepo.Create(ctx, a)
+		} else {
+			err = tx.AnalysisRepo.Update(ctx, a)
+		}
+		if err != nil {
+			return err
+		}
+		summary := fmt.Sprintf("%s %s %s: state=%s", vulnerabilityID, d.Component.Name, d.Version.Version, a.State)
+		if a.Justification != nil {
+			summary += " justification=" + *a.Justification
+		}
+		if a.Response != nil {
+			summary += " response=" + *a.Response
+		}
+		return tx.audit(ctx, model.AuditEntityVulnerabilityAnalysis, a.ID, action, user, summary)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return a, created, nil
+}
+
+// DeleteAnalysis はプロジェクトの利用と脆弱性の組の分析を削除し、監査ログを残す。存在しない場合は sql.ErrNoRows を返す。
+func (s *VulnerabilityService) DeleteAnalysis(ctx context.Context, projectID, usageID, vulnerabilityID, user string) error {
+	return s.tx(ctx, func(ctx context.Context, tx *VulnerabilityService) error {
+		a, err := tx.GetAnalysis(ctx, projectID, usageID, vulnerabilityID)
+		if err != nil {
+			return err
+		}
+		if err := tx.AnalysisRepo.Delete(ctx, a.ID); err != nil {
+			return err
+		}
+		return tx.audit(ctx, model.AuditEntityVulnerabilityAnalysis, a.ID, model.AuditActionDelete, user, fmt.Sprintf("%s: deleted analysis (state=%s)", vulnerabilityID, a.State))
+	})
+}
+
+// projectUsage はプロジェクトの利用を返す。プロジェクトに無い場合は sql.ErrNoRows を返す。
+func (s *VulnerabilityService) projectUsage(ctx context.Context, projectID, usageID string) (*model.ProjectUsageDetail, error) {
+	details, err := s.ProjectUsageRepo.ListDetails(ctx, projectID, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range details {
+		if d.Usage.ID == usageID {
+			return &d, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+// projectAnalyses はプロジェクトの分析を利用 ID・脆弱性 ID で引けるようにまとめる。
+func (s *VulnerabilityService) projectAnalyses(ctx context.Context, projectID string) (map[string]map[string]*model.VulnerabilityAnalysis, error) {
+	res := map[string]map[string]*model.VulnerabilityAnalysis{}
+	if s.AnalysisRepo == nil {
+		return res, nil
+	}
+	list, err := s.AnalysisRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list {
+		a := &list[i]
+		if res[a.UsageID] == nil {
+			res[a.UsageID] = map[string]*model.VulnerabilityAnalysis{}
+		}
+		res[a.UsageID][a.VulnerabilityID] = a
+	}
+	return res, nil
+}
+
+// findingAnalysis は該当に対する分析を返す。分析は代表の ID または別名のいずれかに記録されたものを用いる。
+func findingAnalysis(byVuln map[string]*model.VulnerabilityAnalysis, vv model.VersionVulnerability) *model.VulnerabilityAnalysis {
+	if a, ok := byVuln[vv.Vulnerability.ID]; ok {
+		return a
+	}
+	for _, id := range vv.Aliases {
+		if a, ok := byVuln[id]; ok {
+			return a
+		}
+	}
+	return nil
+}
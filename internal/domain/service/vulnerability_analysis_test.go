@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// memAnalysisRepo はテスト用のインメモリ VulnerabilityAnalysisRepository。
+type memAnalysisRepo struct {
+	items []model.VulnerabilityAnalysis
+}
+
+func (m *memAnalysisRepo) ListByProject(ctx context.Context, projectID string) ([]model.VulnerabilityAnalysis, error) {
+	var res []model.VulnerabilityAnalysis
+	for _, a := range m.items {
+		if a.ProjectID == projectID {
+			res = append(res, a)
+		}
+	}
+	return res, nil
+}
+
+func (m *memAnalysisRepo) Find(ctx context.Context, usageID, vulnerabilityID string) (*model.VulnerabilityAnalysis, error) {
+	for _, a := range m.items {
+		if a.UsageID == usageID && a.VulnerabilityID == vulnerabilityID {
+			return &a, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *memAnalysisRepo) Create(ctx context.Context, a *model.VulnerabilityAnalysis) error {
+	m.items = append(m.items, *a)
+	return nil
+}
+
+func (m *memAnalysisRepo) Update(ctx context.Context, a *model.VulnerabilityAnalysis) error {
+	for i := range m.items {
+		if m.items[i].ID == a.ID {
+			m.items[i] = *a
+		}
+	}
+	return nil
+}
+
+func (m *memAnalysisRepo) Delete(ctx context.Context, id string) error {
+	for i := range m.items {
+		if m.items[i].ID == id {
+			m.items = append(m.items[:i], m.items[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func strp(s string) *string { return &s }
+
+func newAnalysisTestService(t *testing.T) (*VulnerabilityService, *memAnalysisRepo, *memAuditRepo) {
+	t.Helper()
+	purl := "pkg:npm/lodash@4.17.20"
+	usages := &stubProjectUsageRepo{items: []model.ProjectUsageDetail{
+		{Usage: model.ProjectUsage{ID: "u1"}, Component: model.OssComponent{Name: "lodash"}, Version: model.OssVersion{ID: "v1", Version: "4.17.20", Purl: &purl}},
+		{Usage: model.ProjectUsage{ID: "u2"}, Component: model.OssComponent{Name: "lodash"}, Version: model.OssVersion{ID: "v1", Version: "4.17.20", Purl: &purl}},
+	}}
+	analyses, audit := &memAnalysisRepo{}, &memAuditRepo{}
+	svc := &VulnerabilityService{
+		VulnerabilityRepo: newMemVulnerabilityRepo(),
+		ProjectRepo:       &stubProjectRepo{project: &model.Project{ID: "p1"}},
+		ProjectUsageRepo:  usages,
+		AnalysisRepo:      analyses,
+		AuditRepo:         audit,
+	}
+	_, err := svc.ImportOSV(context.Background(), osvTestFS, "osv", "admin")
+	require.NoError(t, err)
+	audit.logs = nil
+	return svc, analyses, audit
+}
+
+func TestVulnerabilityService_RecordAnalysis(t *testing.T) {
+	svc, analyses, audit := newAnalysisTestService(t)
+	ctx := context.Background()
+
+	in := AnalysisInput{State: model.AnalysisNotAffected, Justification: strp(model.JustificationVulnerableCodeNotInExecutePath), Note: strp("  template は使用していない ")}
+	a, created, err := svc.RecordAnalysis(ctx, "p1", "u1", "GHSA-35jh-r3h4-6jhm", in, "alice")
+	require.NoError(t, err)
+	require.True(t, created)
+	require.Equal(t, "template は使用していない", *a.Note)
+	require.Equal(t, "alice", a.UpdatedBy)
+	require.Len(t, analyses.items, 1)
+
+	// 記録済みの場合は置き換える
+	a2, created, err := svc.RecordAnalysis(ctx, "p1", "u1", "GHSA-35jh-r3h4-6jhm", AnalysisInput{State: model.AnalysisAffected, Response: strp(model.ResponseUpdate)}, "bob")
+	require.NoError(t, err)
+	require.False(t, created)
+	require.Equal(t, a.ID, a2.ID)
+	require.Nil(t, a2.Justification)
+	require.Len(t, analyses.items, 1)
+
+	require.Len(t, audit.logs, 2)
+	require.Equal(t, model.AuditEntityVulnerabilityAnalysis, audit.logs[0].EntityType)
+	require.Equal(t, model.AuditActionCreate, audit.logs[0].Action)
+	require.Equal(t, model.AuditActionUpdate, audit.logs[1].Action)
+	require.Equal(t, "bob", audit.logs[1].UserName)
+
+	got, err := svc.GetAnalysis(ctx, "p1", "u1", "GHSA-35jh-r3h4-6jhm")
+	require.NoError(t, err)
+	require.Equal(t, model.AnalysisAffected, got.State)
+	_, err = svc.GetAnalysis(ctx, "other", "u1", "GHSA-35jh-r3h4-6jhm")
+	require.True(t, errors.Is(err, sql.ErrNoRows))
+
+	require.NoError(t, svc.DeleteAnalysis(ctx, "p1", "u1", "GHSA-35jh-r3h4-6jhm", "bob"))
+	require.Empty(t, analyses.items)
+	require.Equal(t, model.AuditActionDelete, audit.logs[2].Action)
+	require.True(t, errors.Is(svc.DeleteAnalysis(ctx, "p1", "u1", "GHSA-35jh-r3h4-6jhm", "bob"), sql.ErrNoRows))
+}
+
+func TestVulnerabilityService_RecordAnalysisInvalid(t *testing.T) {
+	svc, _, _ := newAnalysisTestService(t)
+	ctx := context.Background()
+
+	for _, in := range []AnalysisInput{
+		{State: "exploitable"},
+		{State: model.AnalysisNotAffected},
+		{State: model.AnalysisAffected, Justification: strp(model.JustificationComponentNotPresent)},
+		{State: model.AnalysisNotAffected, Justification: strp("not_used")},
+		{State: model.AnalysisAffected, Response: strp("ignore")},
+	} {
+		_, _, err := svc.RecordAnalysis(ctx, "p1", "u1", "GHSA-35jh-r3h4-6jhm", in, "alice")
+		require.True(t, errors.Is(err, ErrInvalidAnalysis), "%+v", in)
+	}
+
+	// プロジェクトに無い利用・未登録の脆弱性
+	_, _, err := svc.RecordAnalysis(ctx, "p1", "u9", "GHSA-35jh-r3h4-6jhm", AnalysisInput{State: model.AnalysisAffected}, "alice")
+	require.True(t, errors.Is(err, sql.ErrNoRows))
+	_, _, err = svc.RecordAnalysis(ctx, "p1", "u1", "CVE-2000-0000", AnalysisInput{State: model.AnalysisAffected}, "alice")
+	require.True(t, errors.Is(err, sql.ErrNoRows))
+}
+
+func TestVulnerabilityService_ProjectFindingsSuppressed(t *testing.T) {
+	svc, _, _ := newAnalysisTestService(t)
+	ctx := context.Background()
+
+	// 別名で記録した分析も該当に紐付ける
+	in := AnalysisInput{State: model.AnalysisNotAffected, Justification: strp(model.JustificationVulnerableCodeNotPresent)}
+	_, _, err := svc.RecordAnalysis(ctx, "p1", "u1", "CVE-2021-23337", in, "alice")
+	require.NoError(t, err)
+	_, _, err = svc.RecordAnalysis(ctx, "p1", "u2", "GHSA-35jh-r3h4-6jhm", AnalysisInput{State: model.AnalysisUnderInvestigation}, "alice")
+	require.NoError(t, err)
+
+	_, res, err := svc.ProjectFindings(ctx, "p1", ProjectVulnerabilityFilter{})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "u2", res[0].Usage.Usage.ID)
+	require.Equal(t, model.AnalysisUnderInvestigation, res[0].Analysis.State)
+
+	_, res, err = svc.ProjectFindings(ctx, "p1", ProjectVulnerabilityFilter{IncludeSuppressed: true})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "u1", res[0].Usage.Usage.ID)
+	require.Equal(t, model.AnalysisNotAffected, res[0].Analysis.State)
+}
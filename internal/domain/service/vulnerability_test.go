@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+	"github.com/ramsesyok/oss-catalog/internal/domain/vuln"
+)
+
+type memVulnerabilityRepo struct {
+	domrepo.VulnerabilityRepository
+	vulns    map[string]model.Vulnerability
+	matches  map[string][]model.CpeMatch
+	packages map[string][]model.PackageRange
+}
+
+func newMemVulnerabilityRepo() *memVulnerabilityRepo {
+	return &memVulnerabilityRepo{vulns: map[string]model.Vulnerability{}, matches: map[string][]model.CpeMatch{}, packages: map[string][]model.PackageRange{}}
+}
+
+func (m *memVulnerabilityRepo) Get(ctx context.Context, id string) (*model.Vulnerability, error) {
+	v, ok := m.vulns[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &v, nil
+}
+
+func (m *memVulnerabilityRepo) ListByIDs(ctx context.Context, ids []string) ([]model.Vulnerability, error) {
+	var res []model.Vulnerability
+	for _, id := range ids {
+		if v, ok := m.vulns[id]; ok {
+			res = append(res, v)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+func (m *memVulnerabilityRepo) FindCpeMatches(ctx context.Context, vendor, product string) ([]model.CpeMatch, error) {
+	var res []model.CpeMatch
+	for _, list := range m.matches {
+		for _, c := range list {
+			if c.Vendor == vendor && c.Product == product {
+				res = append(res, c)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (m *memVulnerabilityRepo) FindPackageRanges(ctx context.Context, key string) ([]model.PackageRange, error) {
+	var res []model.PackageRange
+	for _, list := range m.packages {
+		for _, p := range list {
+			if p.PackageKey == key {
+				res = append(res, p)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (m *memVulnerabilityRepo) ListAliases(ctx context.Context, ids []string) ([]model.VulnerabilityAlias, error) {
+	want := map[string]bool{}
+	for _, id := range ids {
+		want[id] = true
+	}
+	var res []model.VulnerabilityAlias
+	for _, v := range m.vulns {
+		for _, a := range v.Aliases {
+			if want[v.ID] || want[a] {
+				res = append(res, model.VulnerabilityAlias{VulnerabilityID: v.ID, Alias: a})
+			}
+		}
+	}
+	return res, nil
+}
+
+func (m *memVulnerabilityRepo) Upsert(ctx context.Context, v *model.Vulnerability, metrics []model.CvssMetric, matches []model.CpeMatch, packages []model.PackageRange) (bool, error) {
+	_, exists := m.vulns[v.ID]
+	m.vulns[v.ID] = *v
+	m.matches[v.ID] = matches
+	for i := range packages {
+		packages[i].VulnerabilityID = v.ID
+	}
+	m.packages[v.ID] = packages
+	return !exists, nil
+}
+
+const vulnerabilityTestFeed = `{"vulnerabilities": [
+  {"cve": {"id": "CVE-2022-37434", "lastModified": "2023-07-19T00:00:00.000", "vulnStatus": "Modified",
+    "metrics": {"cvssMetricV31": [{"source": "nvd@nist.gov", "type": "Primary", "cvssData": {"version": "3.1", "baseScore": 9.8, "baseSeverity": "CRITICAL"}}]},
+    "configurations": [{"nodes": [{"cpeMatch": [{"vulnerable": true, "criteria": "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", "versionEndIncluding": "1.2.12"}]}]}]}},
+  {"cve": {"id": "CVE-2018-25032", "lastModified": "2023-01-01T00:00:00.000", "vulnStatus": "Analyzed",
+    "metrics": {"cvssMetricV31": [{"source": "nvd@nist.gov", "type": "Primary", "cvssData": {"version": "3.1", "baseScore": 7.5, "baseSeverity": "HIGH"}}]},
+    "configurations": [{"nodes": [{"cpeMatch": [{"vulnerable": true, "criteria": "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", "versionEndExcluding": "1.2.12"}]}]}]}},
+  {"cve": {"id": "CVE-2099-0001", "lastModified": "2023-01-01T00:00:00.000", "vulnStatus": "Rejected",
+    "configurations": [{"nodes": [{"cpeMatch": [{"vulnerable": true, "criteria": "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*"}]}]}]}}
+]}`
+
+func TestVulnerabilityService_ImportNVD(t *testing.T) {
+	repo := newMemVulnerabilityRepo()
+	audit := &memAuditRepo{}
+	svc := &VulnerabilityService{VulnerabilityRepo: repo, AuditRepo: audit}
+	ctx := context.Background()
+
+	report, err := svc.ImportNVD(ctx, strings.NewReader(vulnerabilityTestFeed), "nvdcve-2.0-2022.json", "admin")
+	require.NoError(t, err)
+	require.Equal(t, VulnerabilityImportReport{Total: 3, Created: 3}, *report)
+	require.Len(t, audit.logs, 1)
+	require.Equal(t, model.AuditEntityVulnerabilityFeed, audit.logs[0].EntityType)
+	require.Equal(t, "nvdcve-2.0-2022.json", audit.logs[0].EntityID)
+	require.Equal(t, model.AuditActionImport, audit.logs[0].Action)
+
+	// 最終更新日時が変わったもののみ置き換える
+	feed := strings.Replace(vulnerabilityTestFeed, "2023-07-19T00:00:00.000", "2024-01-30T00:00:00.000", 1)
+	report, err = svc.ImportNVD(ctx, strings.NewReader(feed), "nvdcve-2.0-modified.json", "admin")
+	require.NoError(t, err)
+	require.Equal(t, VulnerabilityImportReport{Total: 3, Updated: 1, Unchanged: 2}, *report)
+
+	_, err = svc.ImportNVD(ctx, strings.NewReader(`{"format": "NVD_CVE"}`), "broken.json", "admin")
+	require.True(t, errors.Is(err, vuln.ErrInvalidFeed))
+}
+
+func TestVulnerabilityService_Match(t *testing.T) {
+	repo := newMemVulnerabilityRepo()
+	svc := &VulnerabilityService{VulnerabilityRepo: repo}
+	ctx := context.Background()
+	_, err := svc.ImportNVD(ctx, strings.NewReader(vulnerabilityTestFeed), "feed.json", "admin")
+	require.NoError(t, err)
+
+	// CPE にバージョンが無いため OSS バージョンのバージョンで判定する。取り下げられた脆弱性は除く
+	res, err := svc.Match(ctx, model.OssVersion{Version: "1.2.11", CpeList: []string{"not-a-cpe", "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*"}})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "CVE-2022-37434", res[0].Vulnerability.ID)
+	require.Equal(t, "CVE-2018-25032", res[1].Vulnerability.ID)
+	require.Len(t, res[0].Matches, 1)
+	require.Equal(t, model.VulnerabilityMatchedByCPE, res[0].Matches[0].MatchedBy)
+	require.Equal(t, "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", res[0].Matches[0].Identifier)
+	require.Equal(t, "1.2.12", *res[0].Matches[0].Cpe.VersionEndIncluding)
+
+	res, err = svc.Match(ctx, model.OssVersion{Version: "1.2.12", CpeList: []string{"cpe:2.3:a:zlib:zlib:1.2.12:*:*:*:*:*:*:*"}})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "CVE-2022-37434", res[0].Vulnerability.ID)
+
+	res, err = svc.Match(ctx, model.OssVersion{Version: "1.3", CpeList: []string{"cpe:2.3:a:zlib:zlib:1.3:*:*:*:*:*:*:*"}})
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+var osvTestFS = fstest.MapFS{
+	"npm/GHSA-35jh-r3h4-6jhm.json": {Data: []byte(`{"id": "GHSA-35jh-r3h4-6jhm", "modified": "2024-01-01T00:00:00Z", "aliases": ["CVE-2021-23337"],
+  "database_specific": {"severity": "HIGH"},
+  "affected": [{"package": {"ecosystem": "npm", "name": "lodash", "purl": "pkg:npm/lodash"},
+    "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]}]}`)},
+	"npm/GHSA-withdrawn.json": {Data: []byte(`{"id": "GHSA-withdrawn", "modified": "2024-01-01T00:00:00Z", "withdrawn": "2024-01-02T00:00:00Z",
+  "affected": [{"package": {"ecosystem": "npm", "name": "lodash"}, "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}]}]}]}`)},
+	"CVE-2021-23337.json": {Data: []byte(`{"id": "CVE-2021-23337", "modified": "2024-01-01T00:00:00Z"}`)},
+	"README.md":           {Data: []byte("not a record")},
+}
+
+func TestVulnerabilityService_ImportOSV(t *testing.T) {
+	repo := newMemVulnerabilityRepo()
+	audit := &memAuditRepo{}
+	svc := &VulnerabilityService{VulnerabilityRepo: repo, AuditRepo: audit}
+	ctx := context.Background()
+
+	nvd := `{"vulnerabilities": [{"cve": {"id": "CVE-2021-23337", "lastModified": "2022-09-13T00:00:00.000",
+	  "metrics": {"cvssMetricV31": [{"source": "nvd@nist.gov", "type": "Primary", "cvssData": {"version": "3.1", "baseScore": 7.2, "baseSeverity": "HIGH"}}]}}}]}`
+	_, err := svc.ImportNVD(ctx, strings.NewReader(nvd), "nvd.json", "admin")
+	require.NoError(t, err)
+
+	// NVD から登録済みの ID は取り込まない
+	report, err := svc.ImportOSV(ctx, osvTestFS, "osv", "admin")
+	require.NoError(t, err)
+	require.Equal(t, VulnerabilityImportReport{Total: 3, Created: 2, Skipped: 1}, *report)
+	require.Equal(t, model.VulnerabilitySourceNVD, repo.vulns["CVE-2021-23337"].Source)
+	require.Equal(t, []string{"CVE-2021-23337"}, repo.vulns["GHSA-35jh-r3h4-6jhm"].Aliases)
+	require.Len(t, audit.logs, 2)
+	require.Equal(t, "osv", audit.logs[1].EntityID)
+
+	report, err = svc.ImportOSV(ctx, osvTestFS, "osv", "admin")
+	require.NoError(t, err)
+	require.Equal(t, VulnerabilityImportReport{Total: 3, Unchanged: 2, Skipped: 1}, *report)
+
+	_, err = svc.ImportOSV(ctx, fstest.MapFS{"broken.json": {Data: []byte("{")}}, "broken", "admin")
+	require.True(t, errors.Is(err, vuln.ErrInvalidFeed))
+}
+
+func TestVulnerabilityService_MatchPurl(t *testing.T) {
+	repo := newMemVulnerabilityRepo()
+	svc := &VulnerabilityService{VulnerabilityRepo: repo}
+	ctx := context.Background()
+	nvd := `{"vulnerabilities": [{"cve": {"id": "CVE-2021-23337", "lastModified": "2022-09-13T00:00:00.000",
+	  "metrics": {"cvssMetricV31": [{"source": "nvd@nist.gov", "type": "Primary", "cvssData": {"version": "3.1", "baseScore": 7.2, "baseSeverity": "HIGH"}}]},
+	  "configurations": [{"nodes": [{"cpeMatch": [{"vulnerable": true, "criteria": "cpe:2.3:a:lodash:lodash:*:*:*:*:*:node.js:*:*", "versionEndExcluding": "4.17.21"}]}]}]}}]}`
+	_, err := svc.ImportNVD(ctx, strings.NewReader(nvd), "nvd.json", "admin")
+	require.NoError(t, err)
+	_, err = svc.ImportOSV(ctx, osvTestFS, "osv", "admin")
+	require.NoError(t, err)
+
+	// purl のみで該当した OSV の脆弱性は、別名の NVD の脆弱性にまとめる。取り下げられた脆弱性は除く
+	purl := "pkg:npm/lodash@4.17.20"
+	res, err := svc.Match(ctx, model.OssVersion{Version: "4.17.20", Purl: &purl})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "CVE-2021-23337", res[0].Vulnerability.ID)
+	require.Equal(t, 7.2, *res[0].Vulnerability.CvssScore)
+	require.Equal(t, []string{"GHSA-35jh-r3h4-6jhm"}, res[0].Aliases)
+	require.Len(t, res[0].Matches, 1)
+	require.Equal(t, model.VulnerabilityMatchedByPurl, res[0].Matches[0].MatchedBy)
+	require.Equal(t, "4.17.21", *res[0].Matches[0].Package.Fixed)
+
+	// CPE と purl の両方で該当した場合も 1 件にまとめる
+	res, err = svc.Match(ctx, model.OssVersion{Version: "4.17.20", Purl: &purl, CpeList: []string{"cpe:2.3:a:lodash:lodash:4.17.20:*:*:*:*:node.js:*:*"}})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Len(t, res[0].Matches, 2)
+
+	fixed := "pkg:npm/lodash@4.17.21"
+	res, err = svc.Match(ctx, model.OssVersion{Version: "4.17.21", Purl: &fixed})
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestVulnerabilityService_ProjectFindings(t *testing.T) {
+	repo := newMemVulnerabilityRepo()
+	purl := "pkg:npm/lodash@4.17.20"
+	usages := &stubProjectUsageRepo{items: []model.ProjectUsageDetail{
+		{Usage: model.ProjectUsage{ID: "u1"}, Version: model.OssVersion{ID: "v1", Version: "4.17.20", Purl: &purl}},
+		{Usage: model.ProjectUsage{ID: "u2"}, Version: model.OssVersion{ID: "v1", Version: "4.17.20", Purl: &purl}},
+		{Usage: model.ProjectUsage{ID: "u3"}, Version: model.OssVersion{ID: "v2", Version: "1.0.0"}},
+	}}
+	svc := &VulnerabilityService{VulnerabilityRepo: repo, ProjectRepo: &stubProjectRepo{project: &model.Project{ID: "p1"}}, ProjectUsageRepo: usages}
+	ctx := context.Background()
+	_, err := svc.ImportOSV(ctx, osvTestFS, "osv", "admin")
+	require.NoError(t, err)
+
+	p, res, err := svc.ProjectFindings(ctx, "p1", ProjectVulnerabilityFilter{})
+	require.NoError(t, err)
+	require.Equal(t, "p1", p.ID)
+	require.Len(t, res, 2)
+	require.Equal(t, "u1", res[0].Usage.Usage.ID)
+	require.Equal(t, "u2", res[1].Usage.Usage.ID)
+	// NVD の脆弱性が無い場合は該当した OSV の脆弱性を代表とし、別名は残す
+	require.Equal(t, "GHSA-35jh-r3h4-6jhm", res[0].Finding.Vulnerability.ID)
+	require.Equal(t, []string{"CVE-2021-23337"}, res[0].Finding.Aliases)
+	require.Equal(t, "HIGH", *res[0].Finding.Vulnerability.Severity)
+	require.Equal(t, []string{"4.17.21"}, res[0].Finding.FixedVersions)
+
+	// 深刻度・修正バージョンの有無で絞り込む
+	_, res, err = svc.ProjectFindings(ctx, "p1", ProjectVulnerabilityFilter{Severities: []string{"HIGH", "CRITICAL"}})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	_, res, err = svc.ProjectFindings(ctx, "p1", ProjectVulnerabilityFilter{Severities: []string{"LOW"}})
+	require.NoError(t, err)
+	require.Empty(t, res)
+	noFix := false
+	_, res, err = svc.ProjectFindings(ctx, "p1", ProjectVulnerabilityFilter{FixAvailable: &noFix})
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	_, _, err = svc.ProjectFindings(ctx, "missing", ProjectVulnerabilityFilter{})
+	require.True(t, errors.Is(err, sql.ErrNoRows))
+}
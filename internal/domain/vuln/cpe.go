@@ -0,0 +1,132 @@
+// Package vuln は脆弱性データベース (NVD など) の取り込みと、OSS バージョンとの該当判定を行う。
+package vuln
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// CPE の属性の位置。
+const (
+	cpePart = iota
+	cpeVendor
+	cpeProduct
+	cpeVersion
+	cpeUpdate
+	cpeEdition
+	cpeLanguage
+	cpeSwEdition
+	cpeTargetSw
+	cpeTargetHw
+	cpeOther
+	cpeAttrs
+)
+
+// CPE の論理値。
+const (
+	// Any は任意の値に一致する (*)。
+	Any = "*"
+	// NA は値が存在しないことを表す (-)。
+	NA = "-"
+)
+
+var errInvalidCPE = errors.New("invalid cpe")
+
+// CPE は CPE 名を属性ごとに分解したもの。エスケープを外した小文字の値を保持し、省略した属性は Any とする。
+type CPE struct {
+	attrs [cpeAttrs]string
+}
+
+// Vendor はベンダ名を返す。
+func (c CPE) Vendor() string { return c.attrs[cpeVendor] }
+
+// Product は製品名を返す。
+func (c CPE) Product() string { return c.attrs[cpeProduct] }
+
+// Version はバージョンを返す。
+func (c CPE) Version() string { return c.attrs[cpeVersion] }
+
+// ParseCPE は CPE 2.3 形式 (cpe:2.3:a:vendor:product:...) または URI 形式 (cpe:/a:vendor:product:...) の文字列を解析する。
+func ParseCPE(s string) (CPE, error) {
+	var c CPE
+	for i := range c.attrs {
+		c.attrs[i] = Any
+	}
+	var parts []string
+	switch {
+	case strings.HasPrefix(s, "cpe:2.3:"):
+		parts = splitFormatted(s[len("cpe:2.3:"):])
+		if len(parts) != cpeAttrs {
+			return c, errInvalidCPE
+		}
+		for i, p := range parts {
+			c.attrs[i] = strings.ToLower(unescape(p))
+		}
+	case strings.HasPrefix(s, "cpe:/"):
+		parts = strings.Split(s[len("cpe:/"):], ":")
+		if len(parts) > cpeEdition+1 {
+			return c, errInvalidCPE
+		}
+		for i, p := range parts {
+			v, err := url.PathUnescape(p)
+			if err != nil {
+				return c, errInvalidCPE
+			}
+			if v == "" {
+				v = Any
+			}
+			c.attrs[i] = strings.ToLower(v)
+		}
+	default:
+		return c, errInvalidCPE
+	}
+	if c.attrs[cpePart] == Any || c.Vendor() == Any || c.Product() == Any {
+		return c, errInvalidCPE
+	}
+	return c, nil
+}
+
+// splitFormatted は CPE 2.3 形式の属性をエスケープされていない ":" で分割する。
+func splitFormatted(s string) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case ':':
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unescape は CPE 2.3 形式のエスケープ (\. \: など) を外す。論理値 * と - はそのまま返す。
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// matchAttr は条件側の属性値 want が対象側の値 got に一致するかを返す。
+// 条件側の Any は任意の値に一致し、NA は値が無い対象 (省略した Any を含む) に一致する。
+// 対象側の Any は特定の値 (update の sp1 や target_sw など) を限定した条件には一致しない。
+func matchAttr(want, got string) bool {
+	switch want {
+	case Any:
+		return true
+	case NA:
+		return got == NA || got == Any
+	}
+	return want == got
+}
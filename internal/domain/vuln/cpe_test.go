@@ -0,0 +1,32 @@
+package vuln
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCPE(t *testing.T) {
+	c, err := ParseCPE(`cpe:2.3:a:Apache:log4j:2.14.1:*:*:*:*:*:*:*`)
+	require.NoError(t, err)
+	require.Equal(t, "apache", c.Vendor())
+	require.Equal(t, "log4j", c.Product())
+	require.Equal(t, "2.14.1", c.Version())
+
+	// エスケープした ":" で分割しない
+	c, err = ParseCPE(`cpe:2.3:a:microsoft:.net\:framework:4.8:*:*:*:*:*:*:*`)
+	require.NoError(t, err)
+	require.Equal(t, ".net:framework", c.Product())
+
+	// URI 形式は省略した属性を Any とする
+	c, err = ParseCPE("cpe:/a:openssl:openssl:1.1.1t")
+	require.NoError(t, err)
+	require.Equal(t, "openssl", c.Product())
+	require.Equal(t, "1.1.1t", c.Version())
+	require.Equal(t, Any, c.attrs[cpeUpdate])
+
+	for _, s := range []string{"", "cpe:2.3:a:vendor", "cpe:2.3:a:*:*:1.0:*:*:*:*:*:*:*", "pkg:npm/lodash@4.17.21"} {
+		_, err := ParseCPE(s)
+		require.Error(t, err, s)
+	}
+}
@@ -0,0 +1,36 @@
+package vuln
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCVSS3BaseScore(t *testing.T) {
+	cases := map[string]float64{
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H": 9.8,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H": 10.0,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:U/C:N/I:N/A:H": 6.5,
+		"CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:H/I:N/A:N": 5.9,
+		"CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H": 7.8,
+		"CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:C/C:L/I:L/A:N": 6.4,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N": 0,
+	}
+	for vector, want := range cases {
+		got, ok := CVSS3BaseScore(vector)
+		require.True(t, ok, vector)
+		require.Equal(t, want, got, vector)
+	}
+	for _, vector := range []string{"", "AV:N/AC:L/Au:N/C:P/I:P/A:P", "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H", "CVSS:3.1/AV:X/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"} {
+		_, ok := CVSS3BaseScore(vector)
+		require.False(t, ok, vector)
+	}
+}
+
+func TestCVSSSeverity(t *testing.T) {
+	require.Equal(t, "CRITICAL", CVSSSeverity(9.0))
+	require.Equal(t, "HIGH", CVSSSeverity(8.9))
+	require.Equal(t, "MEDIUM", CVSSSeverity(4.0))
+	require.Equal(t, "LOW", CVSSSeverity(0.1))
+	require.Equal(t, "NONE", CVSSSeverity(0))
+}
@@ -0,0 +1,455 @@
+package vuln
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// semverPattern は Semantic Versioning 2.0 のバージョン (先頭の v と MINOR・PATCH の省略を許容する)。
+var semverPattern = regexp.MustCompile(`^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// CompareSemver は Semantic Versioning 2.0 の優先順位で比較する。ビルドメタデータは無視する。
+// どちらかが semver として解析できない場合は CompareVersions で比較する。
+func CompareSemver(a, b string) int {
+	ma, mb := semverPattern.FindStringSubmatch(strings.TrimSpace(a)), semverPattern.FindStringSubmatch(strings.TrimSpace(b))
+	if ma == nil || mb == nil {
+		return CompareVersions(a, b)
+	}
+	for i := 1; i <= 3; i++ {
+		if c := compareNumeric(ma[i], mb[i]); c != 0 {
+			return c
+		}
+	}
+	// プレリリースを持つ方が前
+	switch {
+	case ma[4] == "" && mb[4] == "":
+		return 0
+	case ma[4] == "":
+		return 1
+	case mb[4] == "":
+		return -1
+	}
+	pa, pb := strings.Split(ma[4], "."), strings.Split(mb[4], ".")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, nb := isDigits(pa[i]), isDigits(pb[i])
+		var c int
+		switch {
+		case na && nb:
+			c = compareNumeric(pa[i], pb[i])
+		case na:
+			c = -1
+		case nb:
+			c = 1
+		default:
+			c = strings.Compare(pa[i], pb[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return len(pa) - len(pb)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// CompareDebian は Debian (dpkg) のバージョン規則 ([epoch:]upstream[-revision]) で比較する。
+// "~" は空文字より前に並ぶ (1.0~rc1 < 1.0)。
+func CompareDebian(a, b string) int {
+	ea, ua, ra := splitDebian(a)
+	eb, ub, rb := splitDebian(b)
+	if ea != eb {
+		if ea < eb {
+			return -1
+		}
+		return 1
+	}
+	if c := compareDebianPart(ua, ub); c != 0 {
+		return c
+	}
+	return compareDebianPart(ra, rb)
+}
+
+func splitDebian(v string) (epoch int, upstream, revision string) {
+	v = strings.TrimSpace(v)
+	if e, rest, ok := strings.Cut(v, ":"); ok {
+		if n, err := strconv.Atoi(e); err == nil {
+			epoch, v = n, rest
+		}
+	}
+	if i := strings.LastIndexByte(v, '-'); i >= 0 {
+		return epoch, v[:i], v[i+1:]
+	}
+	return epoch, v, ""
+}
+
+// compareDebianPart は dpkg の verrevcmp に従い、非数字部分と数字部分を交互に比較する。
+func compareDebianPart(a, b string) int {
+	order := func(c byte) int {
+		switch {
+		case c == '~':
+			return -1
+		case unicode.IsLetter(rune(c)):
+			return int(c)
+		default:
+			return int(c) + 256
+		}
+	}
+	for a != "" || b != "" {
+		for (a != "" && !unicode.IsDigit(rune(a[0]))) || (b != "" && !unicode.IsDigit(rune(b[0]))) {
+			var ca, cb int
+			if a != "" && !unicode.IsDigit(rune(a[0])) {
+				ca = order(a[0])
+			}
+			if b != "" && !unicode.IsDigit(rune(b[0])) {
+				cb = order(b[0])
+			}
+			if ca != cb {
+				return ca - cb
+			}
+			a, b = a[1:], b[1:]
+		}
+		da, db := leadingDigits(a), leadingDigits(b)
+		if c := compareNumeric(da, db); c != 0 {
+			return c
+		}
+		a, b = a[len(da):], b[len(db):]
+	}
+	return 0
+}
+
+func leadingDigits(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i]
+}
+
+// pep440Pattern は PEP 440 のバージョン ([N!]N(.N)*[{a|b|rc}N][.postN][.devN][+local])。
+// 正規化前の表記 (alpha / beta / c / pre / preview / rev / r、区切り文字の省略、1.0-1 形式の post) も許容する。
+var pep440Pattern = regexp.MustCompile(`^v?(?:(\d+)!)?(\d+(?:\.\d+)*)` +
+	`(?:[-_.]?(alpha|a|beta|b|preview|pre|c|rc)[-_.]?(\d*))?` +
+	`(?:-(\d+)|[-_.]?(post|rev|r)[-_.]?(\d*))?` +
+	`(?:[-_.]?(dev)[-_.]?(\d*))?` +
+	`(?:\+([a-z0-9]+(?:[-_.][a-z0-9]+)*))?$`)
+
+// pep440Pre はプレリリースの区分の順序 (a < b < rc)。
+var pep440Pre = map[string]int{"alpha": 0, "a": 0, "beta": 1, "b": 1, "preview": 2, "pre": 2, "c": 2, "rc": 2}
+
+// ComparePEP440 は PEP 440 (PyPI) の規則で比較する。
+// 1.0.dev1 < 1.0a1 < 1.0a1.post1 < 1.0rc1 < 1.0 < 1.0.post1 < 1.0+local の順に並ぶ。
+// どちらかが PEP 440 として解析できない場合は CompareVersions で比較する。
+func ComparePEP440(a, b string) int {
+	ma := pep440Pattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(a)))
+	mb := pep440Pattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(b)))
+	if ma == nil || mb == nil {
+		return CompareVersions(a, b)
+	}
+	if c := compareNumeric(ma[1], mb[1]); c != 0 {
+		return c
+	}
+	ra, rb := strings.Split(ma[2], "."), strings.Split(mb[2], ".")
+	for i := 0; i < len(ra) || i < len(rb); i++ {
+		var x, y string
+		if i < len(ra) {
+			x = ra[i]
+		}
+		if i < len(rb) {
+			y = rb[i]
+		}
+		if c := compareNumeric(x, y); c != 0 {
+			return c
+		}
+	}
+	for _, f := range []func(m []string) (int, string){pep440PreKey, pep440PostKey, pep440DevKey} {
+		ka, na := f(ma)
+		kb, nb := f(mb)
+		if ka != kb {
+			return ka - kb
+		}
+		if c := compareNumeric(na, nb); c != 0 {
+			return c
+		}
+	}
+	return comparePEP440Local(ma[10], mb[10])
+}
+
+// pep440PreKey はプレリリースの並び順を返す。
+// プレリリース・ポストリリースの無い開発版 (1.0.dev1) はすべてのプレリリースより前、正式版はすべてのプレリリースより後とする。
+func pep440PreKey(m []string) (int, string) {
+	switch {
+	case m[3] != "":
+		return pep440Pre[m[3]], m[4]
+	case m[5] == "" && m[6] == "" && m[8] != "":
+		return -1, ""
+	}
+	return 3, ""
+}
+
+// pep440PostKey はポストリリースの並び順を返す。ポストリリースの無い方が前。
+func pep440PostKey(m []string) (int, string) {
+	switch {
+	case m[5] != "":
+		return 1, m[5]
+	case m[6] != "":
+		return 1, m[7]
+	}
+	return 0, ""
+}
+
+// pep440DevKey は開発版の並び順を返す。開発版の方が前。
+func pep440DevKey(m []string) (int, string) {
+	if m[8] != "" {
+		return 0, m[9]
+	}
+	return 1, ""
+}
+
+// comparePEP440Local はローカルバージョン (+ 以降) を比較する。
+// ローカルバージョンの無い方が前で、要素は数字が英字より後、英字同士は辞書順で比較する。
+func comparePEP440Local(a, b string) int {
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return -1
+	case b == "":
+		return 1
+	}
+	split := func(s string) []string {
+		return strings.FieldsFunc(s, func(r rune) bool { return r == '.' || r == '-' || r == '_' })
+	}
+	la, lb := split(a), split(b)
+	for i := 0; i < len(la) && i < len(lb); i++ {
+		na, nb := isDigits(la[i]), isDigits(lb[i])
+		var c int
+		switch {
+		case na && nb:
+			c = compareNumeric(la[i], lb[i])
+		case na:
+			c = 1
+		case nb:
+			c = -1
+		default:
+			c = strings.Compare(la[i], lb[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return len(la) - len(lb)
+}
+
+// mavenQualifiers は Maven の ComparableVersion における修飾子の順序。空文字は正式版を表す。
+// 一覧に無い修飾子は sp より後に辞書順で並ぶ。
+var mavenQualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+// mavenAliases は正式版・rc と同じ扱いの修飾子。
+var mavenAliases = map[string]string{"ga": "", "final": "", "release": "", "cr": "rc"}
+
+// mavenItem は ComparableVersion の要素 (数値・修飾子・"-" で始まる下位リスト)。
+type mavenItem struct {
+	kind  int // mavenInt / mavenString / mavenList
+	value string
+	list  []*mavenItem
+}
+
+const (
+	mavenInt = iota
+	mavenString
+	mavenList
+)
+
+// CompareMaven は Maven の ComparableVersion の規則で比較する。
+// 修飾子は alpha < beta < milestone < rc < snapshot < (正式版) < sp の順で、
+// ga / final / release は正式版 (1.0.Final == 1.0)、cr は rc、数字が続く a / b / m は alpha / beta / milestone とみなす。
+func CompareMaven(a, b string) int {
+	return compareMavenItem(parseMaven(a), parseMaven(b))
+}
+
+// parseMaven はバージョンを "." と数字・英字の境界で区切り、"-" と数字・英字の境界で下位リストを始める。
+func parseMaven(v string) *mavenItem {
+	v = strings.ToLower(strings.TrimSpace(v))
+	root := &mavenItem{kind: mavenList}
+	list := root
+	stack := []*mavenItem{root}
+	sub := func() {
+		l := &mavenItem{kind: mavenList}
+		list.list = append(list.list, l)
+		list = l
+		stack = append(stack, l)
+	}
+	digit, start := false, 0
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case c == '.' || c == '-':
+			if i == start {
+				list.list = append(list.list, &mavenItem{kind: mavenInt, value: "0"})
+			} else {
+				list.list = append(list.list, newMavenItem(digit, v[start:i], false))
+			}
+			start = i + 1
+			if c == '-' {
+				sub()
+			}
+		case c >= '0' && c <= '9':
+			if !digit && i > start {
+				// 1.0.0.X1 は数字の前の英字を修飾子として扱う
+				list.list = append(list.list, newMavenItem(false, v[start:i], true))
+				start = i
+				sub()
+			}
+			digit = true
+		default:
+			if digit && i > start {
+				list.list = append(list.list, newMavenItem(true, v[start:i], false))
+				start = i
+				sub()
+			}
+			digit = false
+		}
+	}
+	if len(v) > start {
+		list.list = append(list.list, newMavenItem(digit, v[start:], false))
+	}
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].normalize()
+	}
+	return root
+}
+
+func newMavenItem(digit bool, s string, followedByDigit bool) *mavenItem {
+	if digit {
+		return &mavenItem{kind: mavenInt, value: s}
+	}
+	if followedByDigit && len(s) == 1 {
+		switch s {
+		case "a":
+			s = "alpha"
+		case "b":
+			s = "beta"
+		case "m":
+			s = "milestone"
+		}
+	}
+	if alias, ok := mavenAliases[s]; ok {
+		s = alias
+	}
+	return &mavenItem{kind: mavenString, value: s}
+}
+
+// isNull は要素が 0・正式版・空リストのいずれかの場合に true を返す。
+func (m *mavenItem) isNull() bool {
+	switch m.kind {
+	case mavenInt:
+		return strings.TrimLeft(m.value, "0") == ""
+	case mavenString:
+		return m.value == ""
+	}
+	return len(m.list) == 0
+}
+
+// normalize は末尾の空要素を取り除く (1.0.0 == 1、1-ga == 1)。
+func (m *mavenItem) normalize() {
+	for i := len(m.list) - 1; i >= 0; i-- {
+		if m.list[i].isNull() {
+			m.list = append(m.list[:i], m.list[i+1:]...)
+		} else if m.list[i].kind != mavenList {
+			break
+		}
+	}
+}
+
+// mavenQualifierKey は修飾子を順序どおりに並ぶ比較用の文字列にする。
+func mavenQualifierKey(s string) string {
+	for i, q := range mavenQualifiers {
+		if q == s {
+			return strconv.Itoa(i)
+		}
+	}
+	return strconv.Itoa(len(mavenQualifiers)) + "-" + s
+}
+
+// compareMavenItem は要素を比較する。y が nil の場合は要素が無い (0・正式版) ものとして比較する。
+// 種類が異なる場合は 数値 > 下位リスト > 修飾子 の順とする。
+func compareMavenItem(x, y *mavenItem) int {
+	switch x.kind {
+	case mavenInt:
+		switch {
+		case y == nil:
+			if x.isNull() {
+				return 0
+			}
+			return 1
+		case y.kind == mavenInt:
+			return compareNumeric(x.value, y.value)
+		}
+		return 1
+	case mavenString:
+		switch {
+		case y == nil:
+			return strings.Compare(mavenQualifierKey(x.value), mavenQualifierKey(""))
+		case y.kind == mavenString:
+			return strings.Compare(mavenQualifierKey(x.value), mavenQualifierKey(y.value))
+		}
+		return -1
+	}
+	switch {
+	case y == nil:
+		for _, it := range x.list {
+			if c := compareMavenItem(it, nil); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case y.kind == mavenInt:
+		return -1
+	case y.kind == mavenString:
+		return 1
+	}
+	for i := 0; i < len(x.list) || i < len(y.list); i++ {
+		var c int
+		switch {
+		case i >= len(x.list):
+			c = -compareMavenItem(y.list[i], nil)
+		case i >= len(y.list):
+			c = compareMavenItem(x.list[i], nil)
+		default:
+			c = compareMavenItem(x.list[i], y.list[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// semverEcosystems はバージョンが semver に従う OSV のエコシステム。
+var semverEcosystems = map[string]bool{"npm": true, "Go": true, "crates.io": true, "Hex": true, "Pub": true}
+
+// EcosystemComparator は OSV の範囲の種類 (SEMVER / ECOSYSTEM) とエコシステムに応じたバージョン比較関数を返す。
+// 固有の規則を実装していないエコシステムは CompareVersions で比較する。
+func EcosystemComparator(rangeType, ecosystem string) func(a, b string) int {
+	base, _, _ := strings.Cut(ecosystem, ":")
+	switch {
+	case rangeType == RangeSemver || semverEcosystems[base]:
+		return CompareSemver
+	case base == "Debian" || base == "Ubuntu":
+		return CompareDebian
+	case base == "PyPI":
+		return ComparePEP440
+	case base == "Maven":
+		return CompareMaven
+	}
+	return CompareVersions
+}
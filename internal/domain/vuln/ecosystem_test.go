@@ -0,0 +1,124 @@
+package vuln
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareSemver(t *testing.T) {
+	less := [][2]string{
+		{"1.0.0-alpha", "1.0.0-alpha.1"},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta"},
+		{"1.0.0-beta.2", "1.0.0-beta.11"},
+		{"1.0.0-rc.1", "1.0.0"},
+		{"v1.9.0", "v1.10.0"},
+		{"0.0.0-20210101000000-abcdef", "0.0.1"},
+	}
+	for _, p := range less {
+		require.Negative(t, CompareSemver(p[0], p[1]), p)
+		require.Positive(t, CompareSemver(p[1], p[0]), p)
+	}
+	require.Zero(t, CompareSemver("1.0.0+build.1", "1.0.0"))
+	require.Zero(t, CompareSemver("v2", "2.0.0"))
+}
+
+func TestCompareDebian(t *testing.T) {
+	less := [][2]string{
+		{"1.0~rc1", "1.0"},
+		{"1.2.11.dfsg-2", "1.2.13.dfsg-1"},
+		{"1.2.13.dfsg-1", "1.2.13.dfsg-1+deb12u1"},
+		{"9.9", "1:1.0"},
+		{"1.0a", "1.0+"},
+		{"2.36-9", "2.36-9+deb12u4"},
+	}
+	for _, p := range less {
+		require.Negative(t, CompareDebian(p[0], p[1]), p)
+		require.Positive(t, CompareDebian(p[1], p[0]), p)
+	}
+	require.Zero(t, CompareDebian("0:1.0-1", "1.0-1"))
+	require.Zero(t, CompareDebian("1.01", "1.1"))
+}
+
+func TestComparePEP440(t *testing.T) {
+	less := [][2]string{
+		{"2.0.0a1", "2.0.0"},
+		{"1.0.dev1", "1.0a1"},
+		{"1.0a1.dev1", "1.0a1"},
+		{"1.0a1", "1.0a2"},
+		{"1.0a2", "1.0b1"},
+		{"1.0b1", "1.0rc1"},
+		{"1.0a1", "1.0a1.post1"},
+		{"1.0rc1", "1.0"},
+		{"1.0", "1.0.post1.dev1"},
+		{"1.0.post1.dev1", "1.0.post1"},
+		{"1.0.post1", "1.0.1"},
+		{"1.0", "1.0+local"},
+		{"1.0+abc", "1.0+5"},
+		{"1.9", "1.10"},
+		{"1.0", "1!0.1"},
+	}
+	for _, p := range less {
+		require.Negative(t, ComparePEP440(p[0], p[1]), p)
+		require.Positive(t, ComparePEP440(p[1], p[0]), p)
+	}
+	equal := [][2]string{
+		{"1.0", "1.0.0"},
+		{"1.0alpha1", "1.0a1"},
+		{"1.0-beta.2", "1.0b2"},
+		{"1.0c1", "1.0rc1"},
+		{"1.0-1", "1.0.post1"},
+		{"1.0rev1", "1.0.post1"},
+		{"v1.0RC1", "1.0rc1"},
+		{"0!1.0", "1.0"},
+	}
+	for _, p := range equal {
+		require.Zero(t, ComparePEP440(p[0], p[1]), p)
+	}
+}
+
+func TestCompareMaven(t *testing.T) {
+	less := [][2]string{
+		{"1.0-alpha-1", "1.0-beta-1"},
+		{"1.0-a1", "1.0-b1"},
+		{"1.0-beta-1", "1.0-M1"},
+		{"1.0-M1", "1.0-RC1"},
+		{"1.0-RC1", "1.0-SNAPSHOT"},
+		{"1.0-SNAPSHOT", "1.0"},
+		{"1.0", "1.0-sp1"},
+		{"1.0-sp1", "1.0-foo"},
+		{"1.0-foo", "1.0.1"},
+		{"1.0-1", "1.0.1"},
+		{"2.0.0.Beta1", "2.0.0.CR1"},
+		{"2.0.0.CR1", "2.0.0.Final"},
+		{"1.9", "1.10"},
+	}
+	for _, p := range less {
+		require.Negative(t, CompareMaven(p[0], p[1]), p)
+		require.Positive(t, CompareMaven(p[1], p[0]), p)
+	}
+	equal := [][2]string{
+		{"1", "1.0.0"},
+		{"1.0.Final", "1.0"},
+		{"1.0-RELEASE", "1.0"},
+		{"1.0-ga", "1.0"},
+		{"1.0-cr1", "1.0-rc1"},
+		{"1.0-a1", "1.0-alpha-1"},
+		{"1.0-ALPHA1", "1.0-alpha1"},
+	}
+	for _, p := range equal {
+		require.Zero(t, CompareMaven(p[0], p[1]), p)
+	}
+}
+
+func TestEcosystemComparator(t *testing.T) {
+	// semver のプレリリースは CompareVersions と順序が異なる
+	require.Negative(t, EcosystemComparator(RangeEcosystem, "npm")("1.0.0-alpha.beta", "1.0.0-beta"))
+	require.Negative(t, EcosystemComparator(RangeSemver, "Maven")("1.0.0-rc.1", "1.0.0"))
+	require.Negative(t, EcosystemComparator(RangeEcosystem, "Debian:12")("1.0~rc1", "1.0"))
+	require.Positive(t, EcosystemComparator(RangeEcosystem, "PyPI")("1.10", "1.9"))
+	// PyPI・Maven のプレリリースは正式版より前
+	require.Negative(t, EcosystemComparator(RangeEcosystem, "PyPI")("2.0.0a1", "2.0.0"))
+	require.Negative(t, EcosystemComparator(RangeEcosystem, "Maven")("2.0.0-SNAPSHOT", "2.0.0"))
+	require.Zero(t, EcosystemComparator(RangeEcosystem, "Maven")("5.6.15.Final", "5.6.15"))
+}
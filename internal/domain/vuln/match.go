@@ -0,0 +1,81 @@
+package vuln
+
+import "github.com/ramsesyok/oss-catalog/internal/domain/model"
+
+// MatchCPE は脆弱性の CPE 条件 m が、OSS バージョンの CPE c に該当するかを返す。
+// c のバージョンが Any または NA の場合は OSS バージョンのバージョン文字列 version を用いる。
+// 条件がバージョン範囲を持つ場合はその範囲で、持たない場合は条件のバージョンと比較する。
+// 比較するバージョンが不明な場合は、バージョンを限定しない条件にのみ該当とする。
+func MatchCPE(m model.CpeMatch, c CPE, version string) bool {
+	want, err := ParseCPE(m.Criteria)
+	if err != nil {
+		return false
+	}
+	for i := range want.attrs {
+		if i != cpeVersion && !matchAttr(want.attrs[i], c.attrs[i]) {
+			return false
+		}
+	}
+
+	got := c.Version()
+	if got == Any || got == NA {
+		got = version
+	}
+	ranged := m.VersionStartIncluding != nil || m.VersionStartExcluding != nil || m.VersionEndIncluding != nil || m.VersionEndExcluding != nil
+	exact := want.Version() != Any && want.Version() != NA
+	if !ranged && !exact {
+		return true
+	}
+	if got == "" {
+		return false
+	}
+	if exact && CompareVersions(got, want.Version()) != 0 {
+		return false
+	}
+	return inRange(m, got)
+}
+
+// inRange は version が条件のバージョン範囲に含まれるかを返す。
+func inRange(m model.CpeMatch, version string) bool {
+	if m.VersionStartIncluding != nil && CompareVersions(version, *m.VersionStartIncluding) < 0 {
+		return false
+	}
+	if m.VersionStartExcluding != nil && CompareVersions(version, *m.VersionStartExcluding) <= 0 {
+		return false
+	}
+	if m.VersionEndIncluding != nil && CompareVersions(version, *m.VersionEndIncluding) > 0 {
+		return false
+	}
+	if m.VersionEndExcluding != nil && CompareVersions(version, *m.VersionEndExcluding) >= 0 {
+		return false
+	}
+	return true
+}
+
+// MatchPackage は OSV のバージョン範囲 r に version が含まれるかを返す。比較はエコシステムの規則に従う。
+// introduced が無い範囲は下限無し、fixed・last_affected が無い範囲は上限無しとする。
+// version が不明な場合は、バージョンを限定しない範囲にのみ該当とする。
+func MatchPackage(r model.PackageRange, version string) bool {
+	cmp := EcosystemComparator(r.RangeType, r.Ecosystem)
+	if r.RangeType == RangeVersions {
+		for _, v := range r.Versions {
+			if version != "" && (v == version || cmp(v, version) == 0) {
+				return true
+			}
+		}
+		return false
+	}
+	if version == "" {
+		return r.Introduced == nil && r.Fixed == nil && r.LastAffected == nil
+	}
+	if r.Introduced != nil && cmp(version, *r.Introduced) < 0 {
+		return false
+	}
+	if r.Fixed != nil && cmp(version, *r.Fixed) >= 0 {
+		return false
+	}
+	if r.LastAffected != nil && cmp(version, *r.LastAffected) > 0 {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package vuln
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func strp(s string) *string { return &s }
+
+func TestMatchCPE(t *testing.T) {
+	ranged := model.CpeMatch{
+		Criteria:              "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*",
+		VersionStartIncluding: strp("2.0.1"),
+		VersionEndExcluding:   strp("2.15.0"),
+	}
+	cpe := func(s string) CPE {
+		c, err := ParseCPE(s)
+		require.NoError(t, err)
+		return c
+	}
+
+	require.True(t, MatchCPE(ranged, cpe("cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"), ""))
+	require.False(t, MatchCPE(ranged, cpe("cpe:2.3:a:apache:log4j:2.15.0:*:*:*:*:*:*:*"), ""))
+	require.False(t, MatchCPE(ranged, cpe("cpe:2.3:a:apache:log4j:2.0:*:*:*:*:*:*:*"), ""))
+	// 製品が異なる
+	require.False(t, MatchCPE(ranged, cpe("cpe:2.3:a:apache:tomcat:9.0.1:*:*:*:*:*:*:*"), ""))
+	// CPE にバージョンが無い場合は OSS バージョンのバージョンを用いる
+	require.True(t, MatchCPE(ranged, cpe("cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*"), "2.14.1"))
+	// 比較するバージョンが不明な場合は範囲付きの条件に該当しない
+	require.False(t, MatchCPE(ranged, cpe("cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*"), ""))
+
+	exact := model.CpeMatch{Criteria: "cpe:2.3:a:openssl:openssl:1.0.1f:-:*:*:*:*:*:*"}
+	require.True(t, MatchCPE(exact, cpe("cpe:/a:openssl:openssl:1.0.1f"), ""))
+	require.False(t, MatchCPE(exact, cpe("cpe:/a:openssl:openssl:1.0.1g"), ""))
+	// 属性が異なる
+	require.False(t, MatchCPE(exact, cpe("cpe:2.3:a:openssl:openssl:1.0.1f:beta1:*:*:*:*:*:*"), ""))
+
+	// カタログ側の * は update・target_sw を限定した条件には該当しない
+	sp1 := model.CpeMatch{Criteria: "cpe:2.3:a:openssl:openssl:1.0.1f:sp1:*:*:*:*:*:*"}
+	require.False(t, MatchCPE(sp1, cpe("cpe:2.3:a:openssl:openssl:1.0.1f:*:*:*:*:*:*:*"), ""))
+	require.True(t, MatchCPE(sp1, cpe("cpe:2.3:a:openssl:openssl:1.0.1f:sp1:*:*:*:*:*:*"), ""))
+	platform := model.CpeMatch{Criteria: "cpe:2.3:a:jenkins:git:*:*:*:*:*:jenkins:*:*", VersionEndExcluding: strp("4.11.2")}
+	require.False(t, MatchCPE(platform, cpe("cpe:2.3:a:jenkins:git:4.11.0:*:*:*:*:*:*:*"), ""))
+	require.True(t, MatchCPE(platform, cpe("cpe:2.3:a:jenkins:git:4.11.0:*:*:*:*:jenkins:*:*"), ""))
+
+	// バージョンを限定しない条件は全バージョンに該当する
+	unversioned := model.CpeMatch{Criteria: "cpe:2.3:a:zlib:zlib:-:*:*:*:*:*:*:*"}
+	require.True(t, MatchCPE(unversioned, cpe("cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*"), ""))
+}
+
+func TestMatchPackage(t *testing.T) {
+	npm := model.PackageRange{Ecosystem: "npm", RangeType: RangeSemver, Introduced: strp("4.0.0"), Fixed: strp("4.17.21")}
+	require.True(t, MatchPackage(npm, "4.17.20"))
+	require.True(t, MatchPackage(npm, "4.0.0"))
+	require.False(t, MatchPackage(npm, "4.17.21"))
+	require.False(t, MatchPackage(npm, "3.10.1"))
+	// semver ではプレリリースは正式版より前
+	require.True(t, MatchPackage(npm, "4.17.21-rc.1"))
+	require.False(t, MatchPackage(npm, ""))
+
+	deb := model.PackageRange{Ecosystem: "Debian:12", RangeType: RangeEcosystem, LastAffected: strp("1:1.2.13.dfsg-1")}
+	require.True(t, MatchPackage(deb, "1:1.2.13.dfsg-1"))
+	require.True(t, MatchPackage(deb, "1.3"))
+	require.False(t, MatchPackage(deb, "1:1.2.13.dfsg-1+deb12u1"))
+
+	// 上限・下限が無い範囲はバージョン不明でも該当とする
+	require.True(t, MatchPackage(model.PackageRange{Ecosystem: "PyPI", RangeType: RangeEcosystem}, ""))
+
+	versions := model.PackageRange{Ecosystem: "PyPI", RangeType: RangeVersions, Versions: []string{"1.0", "1.1"}}
+	require.True(t, MatchPackage(versions, "1.1"))
+	require.True(t, MatchPackage(versions, "1.1.0"))
+	require.False(t, MatchPackage(versions, "1.2"))
+	require.False(t, MatchPackage(versions, ""))
+}
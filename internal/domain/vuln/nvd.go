@@ -0,0 +1,259 @@
+package vuln
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+// ErrInvalidFeed はフィードの形式が不正であることを表す。
+var ErrInvalidFeed = errors.New("invalid vulnerability feed")
+
+// Record はフィードから読み込んだ脆弱性 1 件と、その CVSS 評価・CPE 条件・パッケージのバージョン範囲を表す。
+type Record struct {
+	Vulnerability model.Vulnerability
+	Metrics       []model.CvssMetric
+	Matches       []model.CpeMatch
+	// Packages は OSV のみ。
+	Packages []model.PackageRange
+}
+
+// nvdCVE は NVD CVE JSON 2.0 の cve 要素のうち取り込む項目。
+type nvdCVE struct {
+	ID           string `json:"id"`
+	Published    string `json:"published"`
+	LastModified string `json:"lastModified"`
+	VulnStatus   string `json:"vulnStatus"`
+	Descriptions []struct {
+		Lang  string `json:"lang"`
+		Value string `json:"value"`
+	} `json:"descriptions"`
+	Metrics    map[string][]nvdMetric `json:"metrics"`
+	Weaknesses []struct {
+		Description []struct {
+			Value string `json:"value"`
+		} `json:"description"`
+	} `json:"weaknesses"`
+	Configurations []struct {
+		Nodes []struct {
+			Negate   bool `json:"negate"`
+			CpeMatch []struct {
+				Vulnerable            bool   `json:"vulnerable"`
+				Criteria              string `json:"criteria"`
+				MatchCriteriaID       string `json:"matchCriteriaId"`
+				VersionStartIncluding string `json:"versionStartIncluding"`
+				VersionStartExcluding string `json:"versionStartExcluding"`
+				VersionEndIncluding   string `json:"versionEndIncluding"`
+				VersionEndExcluding   string `json:"versionEndExcluding"`
+			} `json:"cpeMatch"`
+		} `json:"nodes"`
+	} `json:"configurations"`
+	References []struct {
+		URL string `json:"url"`
+	} `json:"references"`
+}
+
+type nvdMetric struct {
+	Source   string `json:"source"`
+	Type     string `json:"type"`
+	CvssData struct {
+		Version      string  `json:"version"`
+		VectorString string  `json:"vectorString"`
+		BaseScore    float64 `json:"baseScore"`
+		BaseSeverity string  `json:"baseSeverity"`
+	} `json:"cvssData"`
+	// BaseSeverity は CVSS v2 の場合のみ cvssData の外に置かれる。
+	BaseSeverity string `json:"baseSeverity"`
+}
+
+// nvdMetricKeys は metrics の種類。代表の評価は先頭 (新しいバージョン) から選ぶ。
+var nvdMetricKeys = []string{"cvssMetricV40", "cvssMetricV31", "cvssMetricV30", "cvssMetricV2"}
+
+// ParseNVD は NVD CVE JSON 2.0 フィード (gzip 圧縮も可) を読み込み、脆弱性ごとに fn を呼び出す。
+// フィード全体をメモリに展開しないよう vulnerabilities 配列を 1 件ずつ読み込む。
+// 影響を受ける CPE 条件は vulnerable=true のものに限り、否定 (negate) のノードは除く。
+// AND で結合したプラットフォーム条件 (vulnerable=false) は考慮せず、対象製品のみで判定する。
+func ParseNVD(r io.Reader, fn func(Record) error) error {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidFeed, err)
+		}
+		defer gz.Close()
+		r = gz
+	} else {
+		r = br
+	}
+
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	found := false
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidFeed, err)
+		}
+		if key != "vulnerabilities" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidFeed, err)
+			}
+			continue
+		}
+		found = true
+		if err := expectDelim(dec, '['); err != nil {
+			return err
+		}
+		for dec.More() {
+			var item struct {
+				CVE nvdCVE `json:"cve"`
+			}
+			if err := dec.Decode(&item); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidFeed, err)
+			}
+			if item.CVE.ID == "" {
+				return fmt.Errorf("%w: cve id is required", ErrInvalidFeed)
+			}
+			if err := fn(item.CVE.record()); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: vulnerabilities is required", ErrInvalidFeed)
+	}
+	return nil
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFeed, err)
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("%w: expected %q", ErrInvalidFeed, want)
+	}
+	return nil
+}
+
+// record は cve 要素を取り込み用の形式に変換する。
+func (c nvdCVE) record() Record {
+	v := model.Vulnerability{
+		ID:             c.ID,
+		Source:         model.VulnerabilitySourceNVD,
+		Status:         optional(c.VulnStatus),
+		PublishedAt:    nvdTime(c.Published),
+		LastModifiedAt: nvdTime(c.LastModified),
+	}
+	for _, d := range c.Descriptions {
+		if d.Lang == "en" {
+			v.Description = optional(d.Value)
+			break
+		}
+	}
+	seen := map[string]bool{}
+	for _, w := range c.Weaknesses {
+		for _, d := range w.Description {
+			if strings.HasPrefix(d.Value, "CWE-") && !seen[d.Value] {
+				seen[d.Value] = true
+				v.Weaknesses = append(v.Weaknesses, d.Value)
+			}
+		}
+	}
+	for _, ref := range c.References {
+		v.ReferenceURLs = append(v.ReferenceURLs, ref.URL)
+	}
+
+	rec := Record{}
+	primary := -1
+	for _, key := range nvdMetricKeys {
+		for _, m := range c.Metrics[key] {
+			severity := m.CvssData.BaseSeverity
+			if severity == "" {
+				severity = m.BaseSeverity
+			}
+			rec.Metrics = append(rec.Metrics, model.CvssMetric{
+				VulnerabilityID: c.ID,
+				Version:         m.CvssData.Version,
+				Source:          m.Source,
+				Type:            m.Type,
+				BaseScore:       m.CvssData.BaseScore,
+				BaseSeverity:    severity,
+				Vector:          m.CvssData.VectorString,
+			})
+			// 最も新しいバージョンの評価のうち、Primary (NVD による評価) を優先する
+			last := len(rec.Metrics) - 1
+			if primary < 0 || (rec.Metrics[primary].Type != "Primary" && m.Type == "Primary" && rec.Metrics[primary].Version == m.CvssData.Version) {
+				primary = last
+			}
+		}
+	}
+	if primary >= 0 {
+		p := rec.Metrics[primary]
+		v.Severity = optional(p.BaseSeverity)
+		v.CvssScore = &p.BaseScore
+		v.CvssVersion = optional(p.Version)
+		v.CvssVector = optional(p.Vector)
+	}
+	rec.Vulnerability = v
+
+	for _, cfg := range c.Configurations {
+		for _, n := range cfg.Nodes {
+			if n.Negate {
+				continue
+			}
+			for _, m := range n.CpeMatch {
+				if !m.Vulnerable {
+					continue
+				}
+				cpe, err := ParseCPE(m.Criteria)
+				if err != nil {
+					continue
+				}
+				rec.Matches = append(rec.Matches, model.CpeMatch{
+					VulnerabilityID:       c.ID,
+					Criteria:              m.Criteria,
+					Vendor:                cpe.Vendor(),
+					Product:               cpe.Product(),
+					VersionStartIncluding: optional(m.VersionStartIncluding),
+					VersionStartExcluding: optional(m.VersionStartExcluding),
+					VersionEndIncluding:   optional(m.VersionEndIncluding),
+					VersionEndExcluding:   optional(m.VersionEndExcluding),
+					MatchCriteriaID:       optional(m.MatchCriteriaID),
+				})
+			}
+		}
+	}
+	return rec
+}
+
+// nvdTime は NVD の日時 (タイムゾーン無しの UTC) を解析する。解析できない場合は nil を返す。
+func nvdTime(s string) *dbtime.DBTime {
+	for _, layout := range []string{"2006-01-02T15:04:05.999", time.RFC3339Nano} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &dbtime.DBTime{Time: t.UTC()}
+		}
+	}
+	return nil
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
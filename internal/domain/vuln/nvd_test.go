@@ -0,0 +1,130 @@
+package vuln
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const nvdTestFeed = `{
+  "resultsPerPage": 2,
+  "format": "NVD_CVE",
+  "version": "2.0",
+  "vulnerabilities": [
+    {
+      "cve": {
+        "id": "CVE-2021-44228",
+        "sourceIdentifier": "security@apache.org",
+        "published": "2021-12-10T10:15:09.143",
+        "lastModified": "2023-11-07T03:39:36.747",
+        "vulnStatus": "Analyzed",
+        "descriptions": [{"lang": "es", "value": "..."}, {"lang": "en", "value": "Apache Log4j2 JNDI features do not protect against attacker controlled LDAP."}],
+        "metrics": {
+          "cvssMetricV31": [
+            {"source": "security@apache.org", "type": "Secondary", "cvssData": {"version": "3.1", "vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", "baseScore": 10.0, "baseSeverity": "CRITICAL"}},
+            {"source": "nvd@nist.gov", "type": "Primary", "cvssData": {"version": "3.1", "vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", "baseScore": 10.0, "baseSeverity": "CRITICAL"}}
+          ],
+          "cvssMetricV2": [
+            {"source": "nvd@nist.gov", "type": "Primary", "cvssData": {"version": "2.0", "vectorString": "AV:N/AC:M/Au:N/C:C/I:C/A:C", "baseScore": 9.3}, "baseSeverity": "HIGH"}
+          ]
+        },
+        "weaknesses": [{"source": "nvd@nist.gov", "type": "Primary", "description": [{"lang": "en", "value": "CWE-917"}, {"lang": "en", "value": "NVD-CWE-Other"}]}],
+        "configurations": [
+          {
+            "nodes": [
+              {"operator": "OR", "negate": false, "cpeMatch": [
+                {"vulnerable": true, "criteria": "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", "versionStartIncluding": "2.0.1", "versionEndExcluding": "2.3.1", "matchCriteriaId": "03FA5E81"},
+                {"vulnerable": true, "criteria": "cpe:2.3:a:apache:log4j:2.0:beta9:*:*:*:*:*:*", "matchCriteriaId": "17854E42"}
+              ]}
+            ]
+          },
+          {
+            "operator": "AND",
+            "nodes": [
+              {"operator": "OR", "negate": false, "cpeMatch": [{"vulnerable": true, "criteria": "cpe:2.3:a:siemens:sppa-t3000:*:*:*:*:*:*:*:*"}]},
+              {"operator": "OR", "negate": false, "cpeMatch": [{"vulnerable": false, "criteria": "cpe:2.3:o:microsoft:windows:-:*:*:*:*:*:*:*"}]}
+            ]
+          }
+        ],
+        "references": [{"url": "https://logging.apache.org/log4j/2.x/security.html", "source": "security@apache.org"}]
+      }
+    },
+    {
+      "cve": {
+        "id": "CVE-1999-0001",
+        "published": "1999-12-30T05:00:00.000",
+        "lastModified": "2010-12-16T05:00:00.000",
+        "vulnStatus": "Rejected",
+        "descriptions": [{"lang": "en", "value": "Rejected reason: duplicate."}],
+        "metrics": {}
+      }
+    }
+  ],
+  "timestamp": "2024-01-01T00:00:00.000"
+}`
+
+func TestParseNVD(t *testing.T) {
+	var recs []Record
+	require.NoError(t, ParseNVD(strings.NewReader(nvdTestFeed), func(r Record) error {
+		recs = append(recs, r)
+		return nil
+	}))
+	require.Len(t, recs, 2)
+
+	v := recs[0].Vulnerability
+	require.Equal(t, "CVE-2021-44228", v.ID)
+	require.Equal(t, "NVD", v.Source)
+	require.Equal(t, "Analyzed", *v.Status)
+	require.Contains(t, *v.Description, "JNDI")
+	require.Equal(t, 2021, v.PublishedAt.Year())
+	// 最も新しい CVSS バージョンの NVD 評価を代表とする
+	require.Equal(t, "CRITICAL", *v.Severity)
+	require.Equal(t, 10.0, *v.CvssScore)
+	require.Equal(t, "3.1", *v.CvssVersion)
+	require.Equal(t, []string{"CWE-917"}, v.Weaknesses)
+	require.Equal(t, []string{"https://logging.apache.org/log4j/2.x/security.html"}, v.ReferenceURLs)
+
+	require.Len(t, recs[0].Metrics, 3)
+	require.Equal(t, "HIGH", recs[0].Metrics[2].BaseSeverity)
+	require.Equal(t, "2.0", recs[0].Metrics[2].Version)
+
+	// プラットフォーム条件 (vulnerable=false) は取り込まない
+	require.Len(t, recs[0].Matches, 3)
+	m := recs[0].Matches[0]
+	require.Equal(t, "apache", m.Vendor)
+	require.Equal(t, "log4j", m.Product)
+	require.Equal(t, "2.0.1", *m.VersionStartIncluding)
+	require.Equal(t, "2.3.1", *m.VersionEndExcluding)
+	require.Nil(t, m.VersionEndIncluding)
+	require.Equal(t, "sppa-t3000", recs[0].Matches[2].Product)
+
+	require.Nil(t, recs[1].Vulnerability.Severity)
+	require.Empty(t, recs[1].Matches)
+}
+
+func TestParseNVD_Gzip(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(nvdTestFeed))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	n := 0
+	require.NoError(t, ParseNVD(&buf, func(Record) error { n++; return nil }))
+	require.Equal(t, 2, n)
+}
+
+func TestParseNVD_Invalid(t *testing.T) {
+	for _, doc := range []string{"", "[]", `{"format": "NVD_CVE"}`, `{"vulnerabilities": [{"cve": {}}]}`, `{"vulnerabilities": [`} {
+		err := ParseNVD(strings.NewReader(doc), func(Record) error { return nil })
+		require.True(t, errors.Is(err, ErrInvalidFeed), doc)
+	}
+
+	// fn のエラーはそのまま返す
+	stop := errors.New("stop")
+	require.ErrorIs(t, ParseNVD(strings.NewReader(nvdTestFeed), func(Record) error { return stop }), stop)
+}
@@ -0,0 +1,258 @@
+package vuln
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+// OSV の範囲の種類。VERSIONS は affected[].versions に列挙されたバージョンを保持する独自の種類。
+const (
+	RangeSemver    = "SEMVER"
+	RangeEcosystem = "ECOSYSTEM"
+	RangeGit       = "GIT"
+	RangeVersions  = "VERSIONS"
+)
+
+// osvStatusWithdrawn は取り下げられた OSV の脆弱性に設定する状態。
+const osvStatusWithdrawn = "Withdrawn"
+
+// osvEntry は OSV スキーマの脆弱性 1 件のうち取り込む項目。
+type osvEntry struct {
+	ID        string   `json:"id"`
+	Modified  string   `json:"modified"`
+	Published string   `json:"published"`
+	Withdrawn string   `json:"withdrawn"`
+	Aliases   []string `json:"aliases"`
+	Summary   string   `json:"summary"`
+	Details   string   `json:"details"`
+	Severity  []struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	} `json:"severity"`
+	Affected []struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+			Purl      string `json:"purl"`
+		} `json:"package"`
+		Ranges []struct {
+			Type   string `json:"type"`
+			Events []struct {
+				Introduced   string `json:"introduced"`
+				Fixed        string `json:"fixed"`
+				LastAffected string `json:"last_affected"`
+			} `json:"events"`
+		} `json:"ranges"`
+		Versions []string `json:"versions"`
+	} `json:"affected"`
+	References []struct {
+		URL string `json:"url"`
+	} `json:"references"`
+	DatabaseSpecific struct {
+		Severity string   `json:"severity"`
+		CweIDs   []string `json:"cwe_ids"`
+	} `json:"database_specific"`
+}
+
+// ParseOSV は OSV スキーマの JSON (脆弱性 1 件) を読み込む。形式が不正な場合は ErrInvalidFeed を返す。
+func ParseOSV(r io.Reader) (Record, error) {
+	var e osvEntry
+	if err := json.NewDecoder(r).Decode(&e); err != nil {
+		return Record{}, fmt.Errorf("%w: %v", ErrInvalidFeed, err)
+	}
+	if e.ID == "" {
+		return Record{}, fmt.Errorf("%w: id is required", ErrInvalidFeed)
+	}
+	return e.record(), nil
+}
+
+// WalkOSV は fsys 配下の OSV JSON (*.json) を読み込み、脆弱性ごとに fn を呼び出す。
+// エコシステム別のダンプ (all.zip) を展開せずに置けるよう、*.zip はその中の *.json を読み込む。
+// path は fsys 内のパスで、zip 内のファイルは "all.zip/GHSA-xxxx.json" のように表す。
+func WalkOSV(fsys fs.FS, fn func(path string, rec Record) error) error {
+	return fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch strings.ToLower(path.Ext(p)) {
+		case ".json":
+			f, err := fsys.Open(p)
+			if err != nil {
+				return err
+			}
+			rec, err := ParseOSV(f)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("%s: %w", p, err)
+			}
+			return fn(p, rec)
+		case ".zip":
+			return walkOSVZip(fsys, p, fn)
+		}
+		return nil
+	})
+}
+
+func walkOSVZip(fsys fs.FS, p string, fn func(path string, rec Record) error) error {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	// ダンプは大きいため、可能な場合はメモリに読み込まずに開く
+	ra, ok := f.(io.ReaderAt)
+	if !ok {
+		b, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		ra = bytes.NewReader(b)
+	}
+	zr, err := zip.NewReader(ra, st.Size())
+	if err != nil {
+		return fmt.Errorf("%s: %w: %v", p, ErrInvalidFeed, err)
+	}
+	return WalkOSV(zr, func(name string, rec Record) error {
+		return fn(p+"/"+name, rec)
+	})
+}
+
+// record は OSV の脆弱性を取り込み用の形式に変換する。
+// 深刻度は CVSS v3 のベクトルから算出し、無い場合は database_specific.severity (GitHub Advisory) を用いる。
+// バージョン範囲は照合できるよう purl のキーを付けて保持する。GIT (コミット) の範囲は取り込まない。
+func (e osvEntry) record() Record {
+	v := model.Vulnerability{
+		ID:             e.ID,
+		Source:         model.VulnerabilitySourceOSV,
+		Description:    optional(e.Details),
+		PublishedAt:    osvTime(e.Published),
+		LastModifiedAt: osvTime(e.Modified),
+		Weaknesses:     e.DatabaseSpecific.CweIDs,
+	}
+	if v.Description == nil {
+		v.Description = optional(e.Summary)
+	}
+	if e.Withdrawn != "" {
+		v.Status = optional(osvStatusWithdrawn)
+	}
+	seen := map[string]bool{e.ID: true}
+	for _, a := range e.Aliases {
+		if a != "" && !seen[a] {
+			seen[a] = true
+			v.Aliases = append(v.Aliases, a)
+		}
+	}
+	for _, ref := range e.References {
+		v.ReferenceURLs = append(v.ReferenceURLs, ref.URL)
+	}
+
+	rec := Record{}
+	for _, s := range e.Severity {
+		if s.Type != "CVSS_V3" {
+			continue
+		}
+		score, ok := CVSS3BaseScore(s.Score)
+		if !ok {
+			continue
+		}
+		version := strings.TrimPrefix(strings.SplitN(s.Score, "/", 2)[0], "CVSS:")
+		m := model.CvssMetric{
+			VulnerabilityID: e.ID,
+			Version:         version,
+			Source:          model.VulnerabilitySourceOSV,
+			Type:            "Primary",
+			BaseScore:       score,
+			BaseSeverity:    CVSSSeverity(score),
+			Vector:          s.Score,
+		}
+		rec.Metrics = append(rec.Metrics, m)
+		if v.CvssScore == nil {
+			v.Severity = optional(m.BaseSeverity)
+			v.CvssScore = &m.BaseScore
+			v.CvssVersion = optional(m.Version)
+			v.CvssVector = optional(m.Vector)
+		}
+	}
+	if v.Severity == nil {
+		switch s := strings.ToUpper(e.DatabaseSpecific.Severity); s {
+		case "MODERATE":
+			v.Severity = optional("MEDIUM")
+		case "CRITICAL", "HIGH", "MEDIUM", "LOW":
+			v.Severity = optional(s)
+		}
+	}
+	rec.Vulnerability = v
+
+	for _, a := range e.Affected {
+		pkg, ok := ParsePurl(a.Package.Purl)
+		if !ok {
+			if pkg, ok = EcosystemPurl(a.Package.Ecosystem, a.Package.Name); !ok {
+				continue
+			}
+		}
+		base := model.PackageRange{
+			VulnerabilityID: e.ID,
+			Ecosystem:       a.Package.Ecosystem,
+			PackageName:     a.Package.Name,
+			PackageKey:      pkg.Key(),
+		}
+		for _, r := range a.Ranges {
+			if r.Type == RangeGit {
+				continue
+			}
+			// introduced から fixed / last_affected までを 1 つの範囲とする
+			var cur *model.PackageRange
+			for _, ev := range r.Events {
+				switch {
+				case ev.Introduced != "":
+					if cur == nil {
+						pr := base
+						pr.RangeType = r.Type
+						if ev.Introduced != "0" {
+							pr.Introduced = optional(ev.Introduced)
+						}
+						cur = &pr
+					}
+				case cur != nil && (ev.Fixed != "" || ev.LastAffected != ""):
+					cur.Fixed = optional(ev.Fixed)
+					cur.LastAffected = optional(ev.LastAffected)
+					rec.Packages = append(rec.Packages, *cur)
+					cur = nil
+				}
+			}
+			if cur != nil {
+				rec.Packages = append(rec.Packages, *cur)
+			}
+		}
+		if len(a.Versions) > 0 {
+			pr := base
+			pr.RangeType = RangeVersions
+			pr.Versions = a.Versions
+			rec.Packages = append(rec.Packages, pr)
+		}
+	}
+	return rec
+}
+
+// osvTime は OSV の日時 (RFC 3339) を解析する。解析できない場合は nil を返す。
+func osvTime(s string) *dbtime.DBTime {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil
+	}
+	return &dbtime.DBTime{Time: t.UTC()}
+}
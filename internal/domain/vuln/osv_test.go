@@ -0,0 +1,118 @@
+package vuln
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+const osvTestEntry = `{
+  "id": "GHSA-jfh8-c2jp-5v3q",
+  "modified": "2024-02-01T12:00:00Z",
+  "published": "2021-12-10T00:40:56Z",
+  "aliases": ["CVE-2021-44228"],
+  "summary": "Remote code injection in Log4j",
+  "details": "Apache Log4j2 JNDI features do not protect against attacker controlled LDAP.",
+  "severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"}],
+  "affected": [
+    {"package": {"ecosystem": "Maven", "name": "org.apache.logging.log4j:log4j-core", "purl": "pkg:maven/org.apache.logging.log4j/log4j-core"},
+     "ranges": [
+       {"type": "ECOSYSTEM", "events": [{"introduced": "2.13.0"}, {"fixed": "2.15.0"}, {"introduced": "2.0-beta9"}, {"fixed": "2.12.2"}]},
+       {"type": "GIT", "repo": "https://github.com/apache/logging-log4j2", "events": [{"introduced": "0"}, {"fixed": "abc"}]}
+     ]},
+    {"package": {"ecosystem": "Debian:11", "name": "apache-log4j2"},
+     "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"last_affected": "2.15.0-1"}]}],
+     "versions": ["2.13.3-1"]},
+    {"package": {"ecosystem": "OSS-Fuzz", "name": "log4j"}, "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}]}]}
+  ],
+  "references": [{"type": "ADVISORY", "url": "https://nvd.nist.gov/vuln/detail/CVE-2021-44228"}],
+  "database_specific": {"severity": "CRITICAL", "cwe_ids": ["CWE-502", "CWE-917"]}
+}`
+
+func TestParseOSV(t *testing.T) {
+	rec, err := ParseOSV(strings.NewReader(osvTestEntry))
+	require.NoError(t, err)
+
+	v := rec.Vulnerability
+	require.Equal(t, "GHSA-jfh8-c2jp-5v3q", v.ID)
+	require.Equal(t, model.VulnerabilitySourceOSV, v.Source)
+	require.Equal(t, "Apache Log4j2 JNDI features do not protect against attacker controlled LDAP.", *v.Description)
+	require.Nil(t, v.Status)
+	require.Equal(t, []string{"CVE-2021-44228"}, v.Aliases)
+	require.Equal(t, []string{"CWE-502", "CWE-917"}, v.Weaknesses)
+	require.Equal(t, "2024-02-01T12:00:00Z", v.LastModifiedAt.Format("2006-01-02T15:04:05Z07:00"))
+	// 深刻度はベクトルから算出する
+	require.Equal(t, 10.0, *v.CvssScore)
+	require.Equal(t, "CRITICAL", *v.Severity)
+	require.Equal(t, "3.1", *v.CvssVersion)
+	require.Len(t, rec.Metrics, 1)
+
+	// GIT の範囲と purl に対応しないエコシステムは取り込まない
+	require.Len(t, rec.Packages, 4)
+	p := rec.Packages[0]
+	require.Equal(t, "maven/org.apache.logging.log4j/log4j-core", p.PackageKey)
+	require.Equal(t, RangeEcosystem, p.RangeType)
+	require.Equal(t, "2.13.0", *p.Introduced)
+	require.Equal(t, "2.15.0", *p.Fixed)
+	require.Equal(t, "2.0-beta9", *rec.Packages[1].Introduced)
+	deb := rec.Packages[2]
+	require.Equal(t, "deb/debian/apache-log4j2", deb.PackageKey)
+	require.Nil(t, deb.Introduced)
+	require.Equal(t, "2.15.0-1", *deb.LastAffected)
+	require.Equal(t, RangeVersions, rec.Packages[3].RangeType)
+	require.Equal(t, []string{"2.13.3-1"}, rec.Packages[3].Versions)
+}
+
+func TestParseOSV_Fallbacks(t *testing.T) {
+	rec, err := ParseOSV(strings.NewReader(`{"id": "PYSEC-2021-1", "summary": "short", "withdrawn": "2022-01-01T00:00:00Z",
+		"aliases": ["PYSEC-2021-1", "CVE-2021-1", "CVE-2021-1"], "database_specific": {"severity": "MODERATE"}}`))
+	require.NoError(t, err)
+	require.Equal(t, "short", *rec.Vulnerability.Description)
+	require.Equal(t, "Withdrawn", *rec.Vulnerability.Status)
+	require.Equal(t, "MEDIUM", *rec.Vulnerability.Severity)
+	require.Nil(t, rec.Vulnerability.CvssScore)
+	require.Equal(t, []string{"CVE-2021-1"}, rec.Vulnerability.Aliases)
+
+	for _, doc := range []string{"", "[]", `{"summary": "no id"}`} {
+		_, err := ParseOSV(strings.NewReader(doc))
+		require.True(t, errors.Is(err, ErrInvalidFeed), doc)
+	}
+}
+
+func TestWalkOSV(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, id := range []string{"GHSA-0001", "GHSA-0002"} {
+		w, err := zw.Create(id + ".json")
+		require.NoError(t, err)
+		_, err = w.Write([]byte(`{"id": "` + id + `"}`))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	fsys := fstest.MapFS{
+		"Maven/all.zip":        {Data: buf.Bytes()},
+		"PyPI/PYSEC-0001.json": {Data: []byte(`{"id": "PYSEC-0001"}`)},
+		"README.md":            {Data: []byte("#")},
+	}
+	var paths []string
+	require.NoError(t, WalkOSV(fsys, func(path string, rec Record) error {
+		paths = append(paths, path+"="+rec.Vulnerability.ID)
+		return nil
+	}))
+	require.Equal(t, []string{"Maven/all.zip/GHSA-0001.json=GHSA-0001", "Maven/all.zip/GHSA-0002.json=GHSA-0002", "PyPI/PYSEC-0001.json=PYSEC-0001"}, paths)
+
+	// 不正なファイルはパスを付けて返す
+	err := WalkOSV(fstest.MapFS{"npm/broken.json": {Data: []byte("{")}}, func(string, Record) error { return nil })
+	require.True(t, errors.Is(err, ErrInvalidFeed))
+	require.Contains(t, err.Error(), "npm/broken.json")
+	err = WalkOSV(fstest.MapFS{"all.zip": {Data: []byte("not a zip")}}, func(string, Record) error { return nil })
+	require.True(t, errors.Is(err, ErrInvalidFeed))
+}
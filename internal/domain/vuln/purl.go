@@ -0,0 +1,123 @@
+package vuln
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Purl は Package URL (pkg:type/namespace/name@version) を分解したもの。qualifiers と subpath は保持しない。
+type Purl struct {
+	Type      string
+	Namespace string
+	Name      string
+	Version   string
+}
+
+// ParsePurl は Package URL を解析する。形式が不正な場合は false を返す。
+func ParsePurl(s string) (Purl, bool) {
+	var p Purl
+	rest, ok := strings.CutPrefix(strings.TrimSpace(s), "pkg:")
+	if !ok {
+		return p, false
+	}
+	if i := strings.IndexByte(rest, '#'); i >= 0 {
+		rest = rest[:i]
+	}
+	if i := strings.IndexByte(rest, '?'); i >= 0 {
+		rest = rest[:i]
+	}
+	rest = strings.TrimLeft(rest, "/")
+	typ, path, ok := strings.Cut(rest, "/")
+	if !ok || typ == "" {
+		return p, false
+	}
+	// npm の @scope がエスケープされていない場合があるため、最後の "/" より後の "@" をバージョンの区切りとする
+	if i := strings.LastIndexByte(path, '@'); i > strings.LastIndexByte(path, '/') {
+		v, err := url.PathUnescape(path[i+1:])
+		if err != nil {
+			return p, false
+		}
+		p.Version = v
+		path = path[:i]
+	}
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	for i, seg := range segs {
+		v, err := url.PathUnescape(seg)
+		if err != nil {
+			return p, false
+		}
+		segs[i] = v
+	}
+	p.Type = strings.ToLower(typ)
+	p.Name = segs[len(segs)-1]
+	p.Namespace = strings.Join(segs[:len(segs)-1], "/")
+	if p.Name == "" {
+		return p, false
+	}
+	return p, true
+}
+
+// caseInsensitiveTypes はパッケージ名の大文字小文字を区別しない purl の type。
+var caseInsensitiveTypes = map[string]bool{
+	"npm": true, "pypi": true, "nuget": true, "composer": true, "cargo": true, "hex": true, "pub": true, "deb": true, "apk": true,
+}
+
+var pypiSeparators = regexp.MustCompile(`[-_.]+`)
+
+// Key はバージョンを除いたパッケージの照合キー (type/namespace/name) を返す。
+// 大文字小文字を区別しないエコシステムは小文字に揃え、PyPI は PEP 503 に従い区切り文字を "-" に揃える。
+func (p Purl) Key() string {
+	ns, name := p.Namespace, p.Name
+	if caseInsensitiveTypes[p.Type] {
+		ns, name = strings.ToLower(ns), strings.ToLower(name)
+	}
+	if p.Type == "pypi" {
+		name = pypiSeparators.ReplaceAllString(name, "-")
+	}
+	if ns == "" {
+		return p.Type + "/" + name
+	}
+	return p.Type + "/" + ns + "/" + name
+}
+
+// ecosystemPurlTypes は OSV のエコシステム名と purl の type・namespace の対応。
+var ecosystemPurlTypes = map[string][2]string{
+	"npm":       {"npm", ""},
+	"PyPI":      {"pypi", ""},
+	"Maven":     {"maven", ""},
+	"Go":        {"golang", ""},
+	"crates.io": {"cargo", ""},
+	"RubyGems":  {"gem", ""},
+	"NuGet":     {"nuget", ""},
+	"Packagist": {"composer", ""},
+	"Hex":       {"hex", ""},
+	"Pub":       {"pub", ""},
+	"Debian":    {"deb", "debian"},
+	"Ubuntu":    {"deb", "ubuntu"},
+	"Alpine":    {"apk", "alpine"},
+}
+
+// EcosystemPurl は OSV のエコシステム名とパッケージ名から purl を組み立てる。対応しないエコシステムの場合は false を返す。
+// エコシステム名の ":" 以降 (Debian:12 などのリリース) は無視する。
+func EcosystemPurl(ecosystem, name string) (Purl, bool) {
+	base, _, _ := strings.Cut(ecosystem, ":")
+	t, ok := ecosystemPurlTypes[base]
+	if !ok || name == "" {
+		return Purl{}, false
+	}
+	p := Purl{Type: t[0], Namespace: t[1], Name: name}
+	switch base {
+	case "Maven":
+		// group:artifact
+		if g, a, ok := strings.Cut(name, ":"); ok {
+			p.Namespace, p.Name = g, a
+		}
+	case "Go", "Packagist", "npm":
+		// github.com/org/repo, vendor/package, @scope/name
+		if i := strings.LastIndexByte(name, '/'); i >= 0 {
+			p.Namespace, p.Name = name[:i], name[i+1:]
+		}
+	}
+	return p, true
+}
@@ -0,0 +1,61 @@
+package vuln
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePurl(t *testing.T) {
+	p, ok := ParsePurl("pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1?type=jar#src")
+	require.True(t, ok)
+	require.Equal(t, Purl{Type: "maven", Namespace: "org.apache.logging.log4j", Name: "log4j-core", Version: "2.14.1"}, p)
+
+	// エスケープしていない npm の scope
+	p, ok = ParsePurl("pkg:npm/@babel/core@7.0.0")
+	require.True(t, ok)
+	require.Equal(t, Purl{Type: "npm", Namespace: "@babel", Name: "core", Version: "7.0.0"}, p)
+
+	p, ok = ParsePurl("pkg:NPM/%40babel/core")
+	require.True(t, ok)
+	require.Equal(t, Purl{Type: "npm", Namespace: "@babel", Name: "core"}, p)
+
+	p, ok = ParsePurl("pkg:golang/github.com/gin-gonic/gin@v1.9.0")
+	require.True(t, ok)
+	require.Equal(t, "github.com/gin-gonic", p.Namespace)
+
+	for _, s := range []string{"", "npm/lodash", "pkg:npm", "pkg:npm/", "pkg:/lodash"} {
+		_, ok := ParsePurl(s)
+		require.False(t, ok, s)
+	}
+}
+
+func TestPurlKey(t *testing.T) {
+	key := func(s string) string {
+		p, ok := ParsePurl(s)
+		require.True(t, ok, s)
+		return p.Key()
+	}
+	require.Equal(t, "npm/@babel/core", key("pkg:npm/%40Babel/Core@7.0.0"))
+	require.Equal(t, "pypi/zope-interface", key("pkg:pypi/Zope.Interface@5.0"))
+	require.Equal(t, "maven/org.Example/Lib", key("pkg:maven/org.Example/Lib@1.0"))
+	require.Equal(t, "golang/github.com/gin-gonic/gin", key("pkg:golang/github.com/gin-gonic/gin@v1.9.0"))
+}
+
+func TestEcosystemPurl(t *testing.T) {
+	cases := map[[2]string]string{
+		{"Maven", "org.apache.logging.log4j:log4j-core"}: "maven/org.apache.logging.log4j/log4j-core",
+		{"npm", "@babel/core"}:                           "npm/@babel/core",
+		{"Go", "github.com/gin-gonic/gin"}:               "golang/github.com/gin-gonic/gin",
+		{"PyPI", "Django"}:                               "pypi/django",
+		{"Debian:12", "zlib"}:                            "deb/debian/zlib",
+		{"crates.io", "openssl"}:                         "cargo/openssl",
+	}
+	for in, want := range cases {
+		p, ok := EcosystemPurl(in[0], in[1])
+		require.True(t, ok, in)
+		require.Equal(t, want, p.Key(), in)
+	}
+	_, ok := EcosystemPurl("OSS-Fuzz", "zlib")
+	require.False(t, ok)
+}
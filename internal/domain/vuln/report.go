@@ -0,0 +1,82 @@
+package vuln
+
+import (
+	"encoding/csv"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+// reportCSVHeader はプロジェクトの脆弱性一覧 CSV のヘッダ行。
+var reportCSVHeader = []string{
+	"component",
+	"version",
+	"purl",
+	"usageRole",
+	"scopeStatus",
+	"vulnerability",
+	"aliases",
+	"severity",
+	"cvssScore",
+	"fixedVersions",
+	"matchedBy",
+	"analysisState",
+	"justification",
+}
+
+// WriteReportCSV はプロジェクトの利用に該当した脆弱性を 1 行 1 件の CSV として w に書き出す。
+// 別名・修正バージョン・判定方法のように複数ある項目は空白区切りとする。未分析の該当は VEX 分析の列を空とする。
+func WriteReportCSV(w io.Writer, items []model.ProjectVulnerability) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(reportCSVHeader); err != nil {
+		return err
+	}
+	for _, it := range items {
+		v := it.Finding.Vulnerability
+		var score string
+		if v.CvssScore != nil {
+			score = strconv.FormatFloat(*v.CvssScore, 'f', 1, 64)
+		}
+		var matchedBy []string
+		for _, m := range it.Finding.Matches {
+			if !slices.Contains(matchedBy, m.MatchedBy) {
+				matchedBy = append(matchedBy, m.MatchedBy)
+			}
+		}
+		var state, justification string
+		if a := it.Analysis; a != nil {
+			state, justification = a.State, deref(a.Justification)
+		}
+		rec := []string{
+			it.Usage.Component.Name,
+			it.Usage.Version.Version,
+			deref(it.Usage.Version.Purl),
+			it.Usage.Usage.UsageRole,
+			it.Usage.Usage.ScopeStatus,
+			v.ID,
+			strings.Join(it.Finding.Aliases, " "),
+			deref(v.Severity),
+			score,
+			strings.Join(it.Finding.FixedVersions, " "),
+			strings.Join(matchedBy, " "),
+			state,
+			justification,
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// deref は nil の場合に空文字を返す。
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,50 @@
+package vuln
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+)
+
+func TestWriteReportCSV(t *testing.T) {
+	score := 7.2
+	purl := "pkg:npm/lodash@4.17.20"
+	justification := model.JustificationVulnerableCodeNotInExecutePath
+	items := []model.ProjectVulnerability{{
+		Usage: model.ProjectUsageDetail{
+			Usage:     model.ProjectUsage{UsageRole: "BUNDLED_SOFTWARE", ScopeStatus: "IN_SCOPE"},
+			Component: model.OssComponent{Name: "lodash"},
+			Version:   model.OssVersion{Version: "4.17.20", Purl: &purl},
+		},
+		Finding: model.VersionVulnerability{
+			Vulnerability: model.Vulnerability{ID: "CVE-2021-23337", Severity: strp("HIGH"), CvssScore: &score},
+			Aliases:       []string{"GHSA-35jh-r3h4-6jhm"},
+			Matches: []model.VulnerabilityMatch{
+				{MatchedBy: model.VulnerabilityMatchedByCPE},
+				{MatchedBy: model.VulnerabilityMatchedByPurl},
+				{MatchedBy: model.VulnerabilityMatchedByPurl},
+			},
+			FixedVersions: []string{"4.17.21"},
+		},
+		Analysis: &model.VulnerabilityAnalysis{State: model.AnalysisNotAffected, Justification: &justification},
+	}, {
+		Usage: model.ProjectUsageDetail{
+			Usage:     model.ProjectUsage{UsageRole: "RUNTIME_REQUIRED", ScopeStatus: "IN_SCOPE"},
+			Component: model.OssComponent{Name: "zlib"},
+			Version:   model.OssVersion{Version: "1.2.11"},
+		},
+		Finding: model.VersionVulnerability{
+			Vulnerability: model.Vulnerability{ID: "CVE-2022-37434"},
+			Matches:       []model.VulnerabilityMatch{{MatchedBy: model.VulnerabilityMatchedByCPE}},
+		},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteReportCSV(&buf, items))
+	require.Equal(t, "component,version,purl,usageRole,scopeStatus,vulnerability,aliases,severity,cvssScore,fixedVersions,matchedBy,analysisState,justification\n"+
+		"lodash,4.17.20,pkg:npm/lodash@4.17.20,BUNDLED_SOFTWARE,IN_SCOPE,CVE-2021-23337,GHSA-35jh-r3h4-6jhm,HIGH,7.2,4.17.21,CPE PURL,not_affected,vulnerable_code_not_in_execute_path\n"+
+		"zlib,1.2.11,,RUNTIME_REQUIRED,IN_SCOPE,CVE-2022-37434,,,,,CPE,,\n", buf.String())
+}
@@ -0,0 +1,91 @@
+package vuln
+
+import (
+	"strings"
+	"unicode"
+)
+
+// preReleases はリリース前を表す語。これらの語を含むバージョンは、語を除いたバージョンより前とみなす (1.0rc1 < 1.0)。
+// それ以外の英字は後続のリリースとみなす (OpenSSL の 1.1.1t > 1.1.1)。
+// 1 文字の a / b / c / m は OpenSSL の表記と区別できないため含めない。PyPI・Maven は ComparePEP440・CompareMaven で比較する。
+var preReleases = map[string]bool{
+	"alpha": true, "beta": true, "rc": true, "cr": true, "pre": true, "preview": true, "dev": true, "snapshot": true, "milestone": true,
+}
+
+// CompareVersions は 2 つのバージョン文字列を比較し、a < b なら負、a == b なら 0、a > b なら正を返す。
+// バージョンは区切り文字 (. - _ +) と数字・英字の境界で分割し、数字は数値として、英字は大文字小文字を区別せずに比較する。
+// 先頭の v と末尾の 0 は無視する (v1.0 == 1.0.0)。
+func CompareVersions(a, b string) int {
+	ta, tb := versionTokens(a), versionTokens(b)
+	for i := 0; i < len(ta) || i < len(tb); i++ {
+		var x, y string
+		if i < len(ta) {
+			x = ta[i]
+		}
+		if i < len(tb) {
+			y = tb[i]
+		}
+		if c := compareToken(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// versionTokens はバージョン文字列を数字・英字の要素に分割する。
+func versionTokens(v string) []string {
+	v = strings.ToLower(strings.TrimSpace(v))
+	if len(v) > 1 && v[0] == 'v' && unicode.IsDigit(rune(v[1])) {
+		v = v[1:]
+	}
+	var tokens []string
+	start := -1
+	digit := false
+	for i, r := range v {
+		isDigit := unicode.IsDigit(r)
+		isAlpha := unicode.IsLetter(r)
+		if start >= 0 && (!(isDigit || isAlpha) || isDigit != digit) {
+			tokens = append(tokens, v[start:i])
+			start = -1
+		}
+		if start < 0 && (isDigit || isAlpha) {
+			start, digit = i, isDigit
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, v[start:])
+	}
+	return tokens
+}
+
+// compareToken は要素を 1 つ比較する。要素が無い側は数値の 0 として扱う。
+func compareToken(x, y string) int {
+	xn, yn := isNumeric(x), isNumeric(y)
+	switch {
+	case xn && yn:
+		return compareNumeric(x, y)
+	case xn:
+		// 数字は後続リリースの英字より前、リリース前の英字より後
+		if preReleases[y] {
+			return 1
+		}
+		return -1
+	case yn:
+		return -compareToken(y, x)
+	}
+	return strings.Compare(x, y)
+}
+
+// isNumeric は要素が数字のみ、または要素が無い (0 とみなす) 場合に true を返す。
+func isNumeric(s string) bool {
+	return s == "" || unicode.IsDigit(rune(s[0]))
+}
+
+// compareNumeric は桁数の制限なく数字列を数値として比較する。
+func compareNumeric(x, y string) int {
+	x, y = strings.TrimLeft(x, "0"), strings.TrimLeft(y, "0")
+	if len(x) != len(y) {
+		return len(x) - len(y)
+	}
+	return strings.Compare(x, y)
+}
@@ -0,0 +1,26 @@
+package vuln
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareVersions(t *testing.T) {
+	less := [][2]string{
+		{"1.2", "1.10"},
+		{"2.4.9", "2.4.49"},
+		{"1.0rc1", "1.0"},
+		{"1.0.0-beta.2", "1.0.0"},
+		{"1.1.1", "1.1.1t"},
+		{"1.0.2a", "1.0.2b"},
+		{"9", "10.0"},
+		{"1.2.3", "18446744073709551616"},
+	}
+	for _, p := range less {
+		require.Negative(t, CompareVersions(p[0], p[1]), p)
+		require.Positive(t, CompareVersions(p[1], p[0]), p)
+	}
+	require.Zero(t, CompareVersions("v1.0", "1.0.0"))
+	require.Zero(t, CompareVersions("1.0-RC1", "1.0.rc1"))
+}
@@ -11,7 +11,7 @@ import (
 
 // AuditLogRepository は domrepo.AuditLogRepository の実装。
 type AuditLogRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 var _ domrepo.AuditLogRepository = (*AuditLogRepository)(nil)
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// ExportJobRepository は domrepo.ExportJobRepository の実装。
+type ExportJobRepository struct {
+	DB *sql.DB
+}
+
+var _ domrepo.ExportJobRepository = (*ExportJobRepository)(nil)
+
+const exportJobColumns = "id, project_id, format, scopes, status, progress, file_name, file_path, content_type, file_size, error, created_by, created_at, started_at, finished_at, expires_at"
+
+// Create は新しいエクスポートジョブを登録する。
+func (r *ExportJobRepository) Create(ctx context.Context, j *model.ExportJob) error {
+	_, err := r.DB.ExecContext(ctx,
+		`INSERT INTO export_jobs (`+exportJobColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		j.ID, j.ProjectID, j.Format, pq.Array(j.Scopes), j.Status, j.Progress, j.FileName, j.FilePath, j.ContentType, j.FileSize, j.Error, j.CreatedBy, j.CreatedAt, j.StartedAt, j.FinishedAt, j.ExpiresAt,
+	)
+	return err
+}
+
+// Get は ID でエクスポートジョブを取得する。
+func (r *ExportJobRepository) Get(ctx context.Context, id string) (*model.ExportJob, error) {
+	row := r.DB.QueryRowContext(ctx, `SELECT `+exportJobColumns+` FROM export_jobs WHERE id = ?`, id)
+	j, err := scanExportJob(row)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// Update はジョブの進捗・結果を更新する。
+func (r *ExportJobRepository) Update(ctx context.Context, j *model.ExportJob) error {
+	_, err := r.DB.ExecContext(ctx,
+		`UPDATE export_jobs SET status = ?, progress = ?, file_name = ?, file_path = ?, content_type = ?, file_size = ?, error = ?, started_at = ?, finished_at = ?, expires_at = ? WHERE id = ?`,
+		j.Status, j.Progress, j.FileName, j.FilePath, j.ContentType, j.FileSize, j.Error, j.StartedAt, j.FinishedAt, j.ExpiresAt, j.ID,
+	)
+	return err
+}
+
+// ListByStatus は指定ステータスのジョブを作成日時順に返す。
+func (r *ExportJobRepository) ListByStatus(ctx context.Context, statuses []string) ([]model.ExportJob, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(statuses)), ",")
+	args := make([]any, 0, len(statuses))
+	for _, s := range statuses {
+		args = append(args, s)
+	}
+	query := fmt.Sprintf(`SELECT %s FROM export_jobs WHERE status IN (%s) ORDER BY created_at`, exportJobColumns, placeholders)
+	return r.query(ctx, query, args...)
+}
+
+// ListExpired は before 時点で保持期限を過ぎた完了済みジョブを返す。
+func (r *ExportJobRepository) ListExpired(ctx context.Context, before time.Time) ([]model.ExportJob, error) {
+	query := `SELECT ` + exportJobColumns + ` FROM export_jobs WHERE status = ? AND expires_at < ? ORDER BY expires_at`
+	return r.query(ctx, query, model.ExportJobSucceeded, before.UTC())
+}
+
+func (r *ExportJobRepository) query(ctx context.Context, query string, args ...any) ([]model.ExportJob, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []model.ExportJob
+	for rows.Next() {
+		j, err := scanExportJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *j)
+	}
+	return jobs, rows.Err()
+}
+
+// scanExportJob は 1 行分のジョブを読み取る。
+func scanExportJob(s interface{ Scan(...any) error }) (*model.ExportJob, error) {
+	var j model.ExportJob
+	var scopes pq.StringArray
+	var fileName, filePath, contentType, errMsg sql.NullString
+	var fileSize sql.NullInt64
+	var startedAt, finishedAt, expiresAt sql.NullTime
+	if err := s.Scan(&j.ID, &j.ProjectID, &j.Format, &scopes, &j.Status, &j.Progress, &fileName, &filePath, &contentType, &fileSize, &errMsg, &j.CreatedBy, &j.CreatedAt, &startedAt, &finishedAt, &expiresAt); err != nil {
+		return nil, err
+	}
+	j.Scopes = []string(scopes)
+	j.FileName = strPtr(fileName)
+	j.FilePath = strPtr(filePath)
+	j.ContentType = strPtr(contentType)
+	if fileSize.Valid {
+		j.FileSize = &fileSize.Int64
+	}
+	j.Error = strPtr(errMsg)
+	j.StartedAt = timePtr(startedAt)
+	j.FinishedAt = timePtr(finishedAt)
+	j.ExpiresAt = timePtr(expiresAt)
+	return &j, nil
+}
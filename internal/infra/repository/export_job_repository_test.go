@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+var exportJobTestColumns = []string{"id", "project_id", "format", "scopes", "status", "progress", "file_name", "file_path", "content_type", "file_size", "error", "created_by", "created_at", "started_at", "finished_at", "expires_at"}
+
+func TestExportJobRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ExportJobRepository{DB: db}
+	now := dbtime.DBTime{Time: time.Now()}
+	j := &model.ExportJob{ID: uuid.NewString(), ProjectID: uuid.NewString(), Format: "csv", Scopes: []string{"IN_SCOPE"}, Status: model.ExportJobQueued, CreatedBy: "alice", CreatedAt: now}
+	query := regexp.QuoteMeta("INSERT INTO export_jobs (id, project_id, format, scopes, status, progress, file_name, file_path, content_type, file_size, error, created_by, created_at, started_at, finished_at, expires_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	mock.ExpectExec(query).
+		WithArgs(j.ID, j.ProjectID, j.Format, sqlmock.AnyArg(), j.Status, 0, nil, nil, nil, nil, nil, j.CreatedBy, j.CreatedAt, nil, nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.Create(context.Background(), j))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportJobRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ExportJobRepository{DB: db}
+	id := uuid.NewString()
+	now := time.Now()
+	query := regexp.QuoteMeta("SELECT id, project_id, format, scopes, status, progress, file_name, file_path, content_type, file_size, error, created_by, created_at, started_at, finished_at, expires_at FROM export_jobs WHERE id = ?")
+	mock.ExpectQuery(query).WithArgs(id).WillReturnRows(sqlmock.NewRows(exportJobTestColumns).
+		AddRow(id, uuid.NewString(), "spdx-json", "{IN_SCOPE,REVIEW_NEEDED}", model.ExportJobSucceeded, 100, "P1.spdx.json", "/data/x", "application/spdx+json", int64(42), nil, "alice", now, now, now, now))
+
+	j, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"IN_SCOPE", "REVIEW_NEEDED"}, j.Scopes)
+	require.Equal(t, "P1.spdx.json", *j.FileName)
+	require.Equal(t, int64(42), *j.FileSize)
+	require.Nil(t, j.Error)
+	require.NotNil(t, j.ExpiresAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportJobRepository_Update(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ExportJobRepository{DB: db}
+	msg := "boom"
+	j := &model.ExportJob{ID: uuid.NewString(), Status: model.ExportJobFailed, Progress: 10, Error: &msg}
+	query := regexp.QuoteMeta("UPDATE export_jobs SET status = ?, progress = ?, file_name = ?, file_path = ?, content_type = ?, file_size = ?, error = ?, started_at = ?, finished_at = ?, expires_at = ? WHERE id = ?")
+	mock.ExpectExec(query).
+		WithArgs(j.Status, j.Progress, nil, nil, nil, nil, j.Error, nil, nil, nil, j.ID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.Update(context.Background(), j))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportJobRepository_ListByStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ExportJobRepository{DB: db}
+	query := regexp.QuoteMeta("FROM export_jobs WHERE status IN (?,?) ORDER BY created_at")
+	mock.ExpectQuery(query).WithArgs(model.ExportJobQueued, model.ExportJobRunning).WillReturnRows(sqlmock.NewRows(exportJobTestColumns).
+		AddRow(uuid.NewString(), uuid.NewString(), "csv", "{IN_SCOPE}", model.ExportJobQueued, 0, nil, nil, nil, nil, nil, "alice", time.Now(), nil, nil, nil))
+
+	jobs, err := repo.ListByStatus(context.Background(), []string{model.ExportJobQueued, model.ExportJobRunning})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Nil(t, jobs[0].FileSize)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	jobs, err = repo.ListByStatus(context.Background(), nil)
+	require.NoError(t, err)
+	require.Nil(t, jobs)
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// ExportTemplateRepository は domrepo.ExportTemplateRepository の実装。
+type ExportTemplateRepository struct {
+	DB *sql.DB
+}
+
+var _ domrepo.ExportTemplateRepository = (*ExportTemplateRepository)(nil)
+
+const exportTemplateColumns = "id, name, engine, file_extension, description, body, updated_by, created_at, updated_at"
+
+// List は全テンプレートを名前順で返す。
+func (r *ExportTemplateRepository) List(ctx context.Context) ([]model.ExportTemplate, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT `+exportTemplateColumns+` FROM export_templates ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.ExportTemplate
+	for rows.Next() {
+		t, err := scanExportTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *t)
+	}
+	return res, rows.Err()
+}
+
+// Get は ID でテンプレートを取得する。
+func (r *ExportTemplateRepository) Get(ctx context.Context, id string) (*model.ExportTemplate, error) {
+	return scanExportTemplate(r.DB.QueryRowContext(ctx, `SELECT `+exportTemplateColumns+` FROM export_templates WHERE id = ?`, id))
+}
+
+// FindByName は名前でテンプレートを取得する。
+func (r *ExportTemplateRepository) FindByName(ctx context.Context, name string) (*model.ExportTemplate, error) {
+	return scanExportTemplate(r.DB.QueryRowContext(ctx, `SELECT `+exportTemplateColumns+` FROM export_templates WHERE name = ?`, name))
+}
+
+// Create は新しいテンプレートを登録する。
+func (r *ExportTemplateRepository) Create(ctx context.Context, t *model.ExportTemplate) error {
+	_, err := r.DB.ExecContext(ctx,
+		`INSERT INTO export_templates (`+exportTemplateColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		t.ID, t.Name, t.Engine, t.FileExtension, t.Description, t.Body, t.UpdatedBy, t.CreatedAt, t.UpdatedAt,
+	)
+	return err
+}
+
+// Update は既存テンプレートを更新する。
+func (r *ExportTemplateRepository) Update(ctx context.Context, t *model.ExportTemplate) error {
+	_, err := r.DB.ExecContext(ctx,
+		`UPDATE export_templates SET name = ?, engine = ?, file_extension = ?, description = ?, body = ?, updated_by = ?, updated_at = ? WHERE id = ?`,
+		t.Name, t.Engine, t.FileExtension, t.Description, t.Body, t.UpdatedBy, t.UpdatedAt, t.ID,
+	)
+	return err
+}
+
+// Delete は ID 指定でテンプレートを削除する。
+func (r *ExportTemplateRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.DB.ExecContext(ctx, `DELETE FROM export_templates WHERE id = ?`, id)
+	return err
+}
+
+// scanExportTemplate は 1 行分のテンプレートを読み取る。
+func scanExportTemplate(s interface{ Scan(...any) error }) (*model.ExportTemplate, error) {
+	var t model.ExportTemplate
+	var desc sql.NullString
+	if err := s.Scan(&t.ID, &t.Name, &t.Engine, &t.FileExtension, &desc, &t.Body, &t.UpdatedBy, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	t.Description = strPtr(desc)
+	return &t, nil
+}
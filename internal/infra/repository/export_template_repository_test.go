@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+var exportTemplateTestColumns = []string{"id", "name", "engine", "file_extension", "description", "body", "updated_by", "created_at", "updated_at"}
+
+func TestExportTemplateRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ExportTemplateRepository{DB: db}
+	now := dbtime.DBTime{Time: time.Now()}
+	tpl := &model.ExportTemplate{ID: uuid.NewString(), Name: "customer-a", Engine: model.ExportTemplateText, FileExtension: "txt", Body: "{{.Project.Code}}", UpdatedBy: "admin", CreatedAt: now, UpdatedAt: now}
+	query := regexp.QuoteMeta("INSERT INTO export_templates (id, name, engine, file_extension, description, body, updated_by, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	mock.ExpectExec(query).
+		WithArgs(tpl.ID, tpl.Name, tpl.Engine, tpl.FileExtension, nil, tpl.Body, tpl.UpdatedBy, tpl.CreatedAt, tpl.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.Create(context.Background(), tpl))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportTemplateRepository_FindByName(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ExportTemplateRepository{DB: db}
+	id := uuid.NewString()
+	now := time.Now()
+	query := regexp.QuoteMeta("SELECT id, name, engine, file_extension, description, body, updated_by, created_at, updated_at FROM export_templates WHERE name = ?")
+	mock.ExpectQuery(query).WithArgs("customer-a").WillReturnRows(sqlmock.NewRows(exportTemplateTestColumns).
+		AddRow(id, "customer-a", "html", "html", "A 社向け", "<p>{{.Project.Name}}</p>", "admin", now, now))
+
+	tpl, err := repo.FindByName(context.Background(), "customer-a")
+	require.NoError(t, err)
+	require.Equal(t, id, tpl.ID)
+	require.Equal(t, model.ExportTemplateHTML, tpl.Engine)
+	require.Equal(t, "A 社向け", *tpl.Description)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportTemplateRepository_Update(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ExportTemplateRepository{DB: db}
+	now := dbtime.DBTime{Time: time.Now()}
+	tpl := &model.ExportTemplate{ID: uuid.NewString(), Name: "customer-a", Engine: model.ExportTemplateText, FileExtension: "csv", Body: "x", UpdatedBy: "admin", UpdatedAt: now}
+	query := regexp.QuoteMeta("UPDATE export_templates SET name = ?, engine = ?, file_extension = ?, description = ?, body = ?, updated_by = ?, updated_at = ? WHERE id = ?")
+	mock.ExpectExec(query).
+		WithArgs(tpl.Name, tpl.Engine, tpl.FileExtension, nil, tpl.Body, tpl.UpdatedBy, tpl.UpdatedAt, tpl.ID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.Update(context.Background(), tpl))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
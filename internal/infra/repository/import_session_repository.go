@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// ImportSessionRepository は domrepo.ImportSessionRepository の実装。
+type ImportSessionRepository struct {
+	DB DBTX
+}
+
+var _ domrepo.ImportSessionRepository = (*ImportSessionRepository)(nil)
+
+const importSessionColumns = "id, project_id, format, document_name, usage_role, status, created_by, created_at, committed_by, committed_at"
+
+const importSessionItemColumns = "id, session_id, seq, ref, name, version, purl, license_concluded, license_declared, homepage_url, supplier, hash_sha256, hash_sha512, copyright_text, cpe_list, direct_dependency, usage_role, usage_role_override, layers, inclusion_note, depends_on, proposal, reason, oss_id, oss_version_id, decision, result, usage_id"
+
+// Create はセッションと項目を登録する。
+func (r *ImportSessionRepository) Create(ctx context.Context, s *model.ImportSession, items []model.ImportSessionItem) error {
+	_, err := r.DB.ExecContext(ctx,
+		`INSERT INTO import_sessions (`+importSessionColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		s.ID, s.ProjectID, s.Format, s.DocumentName, s.UsageRole, s.Status, s.CreatedBy, s.CreatedAt, s.CommittedBy, s.CommittedAt,
+	)
+	if err != nil {
+		return err
+	}
+	for _, it := range items {
+		_, err := r.DB.ExecContext(ctx,
+			`INSERT INTO import_session_items (`+importSessionItemColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			it.ID, it.SessionID, it.Seq, it.Ref, it.Name, it.Version, it.Purl, it.LicenseConcluded, it.LicenseDeclared, it.HomepageURL, it.Supplier, it.HashSha256, it.HashSha512, it.CopyrightText, pq.Array(it.CpeList), it.DirectDependency, it.UsageRole, it.UsageRoleOverride, pq.Array(it.Layers), it.InclusionNote, pq.Array(it.DependsOn), it.Proposal, it.Reason, it.OssID, it.OssVersionID, it.Decision, it.Result, it.UsageID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get は ID でセッションを取得する。
+func (r *ImportSessionRepository) Get(ctx context.Context, id string) (*model.ImportSession, error) {
+	return scanImportSession(r.DB.QueryRowContext(ctx, `SELECT `+importSessionColumns+` FROM import_sessions WHERE id = ?`, id))
+}
+
+// ListByProject はプロジェクトのセッションを新しい順に返す。
+func (r *ImportSessionRepository) ListByProject(ctx context.Context, projectID string) ([]model.ImportSession, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT `+importSessionColumns+` FROM import_sessions WHERE project_id = ? ORDER BY created_at DESC`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.ImportSession
+	for rows.Next() {
+		s, err := scanImportSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *s)
+	}
+	return res, rows.Err()
+}
+
+// Update はセッションの状態を更新する。
+func (r *ImportSessionRepository) Update(ctx context.Context, s *model.ImportSession) error {
+	_, err := r.DB.ExecContext(ctx,
+		`UPDATE import_sessions SET status = ?, committed_by = ?, committed_at = ? WHERE id = ?`,
+		s.Status, s.CommittedBy, s.CommittedAt, s.ID,
+	)
+	return err
+}
+
+// Delete はセッションと項目を削除する。
+func (r *ImportSessionRepository) Delete(ctx context.Context, id string) error {
+	if _, err := r.DB.ExecContext(ctx, `DELETE FROM import_session_items WHERE session_id = ?`, id); err != nil {
+		return err
+	}
+	_, err := r.DB.ExecContext(ctx, `DELETE FROM import_sessions WHERE id = ?`, id)
+	return err
+}
+
+// ListItems はセッションの項目を文書内の順序で返す。
+func (r *ImportSessionRepository) ListItems(ctx context.Context, sessionID string) ([]model.ImportSessionItem, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT `+importSessionItemColumns+` FROM import_session_items WHERE session_id = ? ORDER BY seq`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.ImportSessionItem
+	for rows.Next() {
+		it, err := scanImportSessionItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *it)
+	}
+	return res, rows.Err()
+}
+
+// GetItem はセッション内の項目を取得する。
+func (r *ImportSessionRepository) GetItem(ctx context.Context, sessionID, itemID string) (*model.ImportSessionItem, error) {
+	return scanImportSessionItem(r.DB.QueryRowContext(ctx, `SELECT `+importSessionItemColumns+` FROM import_session_items WHERE session_id = ? AND id = ?`, sessionID, itemID))
+}
+
+// UpdateItem はレビュー結果と取り込み結果を更新する。
+func (r *ImportSessionRepository) UpdateItem(ctx context.Context, it *model.ImportSessionItem) error {
+	_, err := r.DB.ExecContext(ctx,
+		`UPDATE import_session_items SET usage_role_override = ?, reason = ?, oss_id = ?, oss_version_id = ?, decision = ?, result = ?, usage_id = ? WHERE id = ?`,
+		it.UsageRoleOverride, it.Reason, it.OssID, it.OssVersionID, it.Decision, it.Result, it.UsageID, it.ID,
+	)
+	return err
+}
+
+// scanImportSession は 1 行分のセッションを読み取る。
+func scanImportSession(s interface{ Scan(...any) error }) (*model.ImportSession, error) {
+	var m model.ImportSession
+	var docName, role, committedBy sql.NullString
+	var committedAt sql.NullTime
+	if err := s.Scan(&m.ID, &m.ProjectID, &m.Format, &docName, &role, &m.Status, &m.CreatedBy, &m.CreatedAt, &committedBy, &committedAt); err != nil {
+		return nil, err
+	}
+	m.DocumentName = strPtr(docName)
+	m.UsageRole = strPtr(role)
+	m.CommittedBy = strPtr(committedBy)
+	m.CommittedAt = timePtr(committedAt)
+	return &m, nil
+}
+
+// scanImportSessionItem は 1 行分の項目を読み取る。
+func scanImportSessionItem(s interface{ Scan(...any) error }) (*model.ImportSessionItem, error) {
+	var it model.ImportSessionItem
+	var purl, licConc, licDecl, homepage, supplier, hash, hash512, copyright sql.NullString
+	var role, roleOverride, note, reason, ossID, versionID, result, usageID sql.NullString
+	var cpeList, layers, dependsOn pq.StringArray
+	if err := s.Scan(&it.ID, &it.SessionID, &it.Seq, &it.Ref, &it.Name, &it.Version, &purl, &licConc, &licDecl, &homepage, &supplier, &hash, &hash512, &copyright, &cpeList, &it.DirectDependency, &role, &roleOverride, &layers, &note, &dependsOn, &it.Proposal, &reason, &ossID, &versionID, &it.Decision, &result, &usageID); err != nil {
+		return nil, err
+	}
+	it.Purl = strPtr(purl)
+	it.LicenseConcluded = strPtr(licConc)
+	it.LicenseDeclared = strPtr(licDecl)
+	it.HomepageURL = strPtr(homepage)
+	it.Supplier = strPtr(supplier)
+	it.HashSha256 = strPtr(hash)
+	it.HashSha512 = strPtr(hash512)
+	it.CopyrightText = strPtr(copyright)
+	it.CpeList = []string(cpeList)
+	it.UsageRole = strPtr(role)
+	it.UsageRoleOverride = strPtr(roleOverride)
+	it.Layers = []string(layers)
+	it.InclusionNote = strPtr(note)
+	it.DependsOn = []string(dependsOn)
+	it.Reason = strPtr(reason)
+	it.OssID = strPtr(ossID)
+	it.OssVersionID = strPtr(versionID)
+	it.Result = strPtr(result)
+	it.UsageID = strPtr(usageID)
+	return &it, nil
+}
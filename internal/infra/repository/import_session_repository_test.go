@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+func TestImportSessionRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ImportSessionRepository{DB: db}
+	now := dbtime.DBTime{Time: time.Now()}
+	sess := &model.ImportSession{ID: uuid.NewString(), ProjectID: uuid.NewString(), Format: "cyclonedx-json", Status: model.ImportSessionOpen, CreatedBy: "alice", CreatedAt: now}
+	item := model.ImportSessionItem{ID: uuid.NewString(), SessionID: sess.ID, Seq: 1, Ref: "a", Name: "left-pad", Version: "1.3.0", Proposal: model.ImportProposalNewComponent, Decision: model.ImportDecisionPending}
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_sessions (id, project_id, format, document_name, usage_role, status, created_by, created_at, committed_by, committed_at) VALUES")).
+		WithArgs(sess.ID, sess.ProjectID, "cyclonedx-json", nil, nil, "OPEN", "alice", now, nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_session_items")).
+		WithArgs(item.ID, sess.ID, 1, "a", "left-pad", "1.3.0", nil, nil, nil, nil, nil, nil, nil, nil, sqlmock.AnyArg(), false, nil, nil, sqlmock.AnyArg(), nil, sqlmock.AnyArg(), "NEW_COMPONENT", nil, nil, nil, "PENDING", nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.Create(context.Background(), sess, []model.ImportSessionItem{item}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportSessionRepository_UpdateItem(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ImportSessionRepository{DB: db}
+	ossID := uuid.NewString()
+	detected, override := "DEV_ONLY", "BUILD_ONLY"
+	it := &model.ImportSessionItem{ID: uuid.NewString(), UsageRole: &detected, UsageRoleOverride: &override, OssID: &ossID, Decision: model.ImportDecisionRemapped}
+	// 推定した利用形態は更新しない
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE import_session_items SET usage_role_override = ?, reason = ?, oss_id = ?, oss_version_id = ?, decision = ?, result = ?, usage_id = ? WHERE id = ?")).
+		WithArgs(&override, nil, ossID, nil, "REMAPPED", nil, nil, it.ID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.UpdateItem(context.Background(), it))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportSessionRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ImportSessionRepository{DB: db}
+	id := uuid.NewString()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM import_session_items WHERE session_id = ?")).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM import_sessions WHERE id = ?")).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.Delete(context.Background(), id))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
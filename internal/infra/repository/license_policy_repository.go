@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// LicensePolicyRuleRepository は domrepo.LicensePolicyRuleRepository の実装。
+type LicensePolicyRuleRepository struct {
+	DB DBTX
+}
+
+var _ domrepo.LicensePolicyRuleRepository = (*LicensePolicyRuleRepository)(nil)
+
+const licensePolicyRuleColumns = "id, name, description, severity, licenses, categories, usage_roles, scope_statuses, project_statuses, enabled, updated_by, created_at, updated_at"
+
+// List は全ルールを名前順で返す。
+func (r *LicensePolicyRuleRepository) List(ctx context.Context) ([]model.LicensePolicyRule, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT `+licensePolicyRuleColumns+` FROM license_policy_rules ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.LicensePolicyRule
+	for rows.Next() {
+		rule, err := scanLicensePolicyRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *rule)
+	}
+	return res, rows.Err()
+}
+
+// Get は ID でルールを取得する。
+func (r *LicensePolicyRuleRepository) Get(ctx context.Context, id string) (*model.LicensePolicyRule, error) {
+	return scanLicensePolicyRule(r.DB.QueryRowContext(ctx, `SELECT `+licensePolicyRuleColumns+` FROM license_policy_rules WHERE id = ?`, id))
+}
+
+// FindByName は名前でルールを取得する。
+func (r *LicensePolicyRuleRepository) FindByName(ctx context.Context, name string) (*model.LicensePolicyRule, error) {
+	return scanLicensePolicyRule(r.DB.QueryRowContext(ctx, `SELECT `+licensePolicyRuleColumns+` FROM license_policy_rules WHERE name = ?`, name))
+}
+
+// Create は新しいルールを登録する。
+func (r *LicensePolicyRuleRepository) Create(ctx context.Context, rule *model.LicensePolicyRule) error {
+	_, err := r.DB.ExecContext(ctx,
+		`INSERT INTO license_policy_rules (`+licensePolicyRuleColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rule.ID, rule.Name, rule.Description, rule.Severity, pq.Array(rule.Licenses), pq.Array(rule.Categories), pq.Array(rule.UsageRoles), pq.Array(rule.ScopeStatuses), pq.Array(rule.ProjectStatuses), rule.Enabled, rule.UpdatedBy, rule.CreatedAt, rule.UpdatedAt,
+	)
+	return err
+}
+
+// Update は既存ルールを更新する。
+func (r *LicensePolicyRuleRepository) Update(ctx context.Context, rule *model.LicensePolicyRule) error {
+	_, err := r.DB.ExecContext(ctx,
+		`UPDATE license_policy_rules SET name = ?, description = ?, severity = ?, licenses = ?, categories = ?, usage_roles = ?, scope_statuses = ?, project_statuses = ?, enabled = ?, updated_by = ?, updated_at = ? WHERE id = ?`,
+		rule.Name, rule.Description, rule.Severity, pq.Array(rule.Licenses), pq.Array(rule.Categories), pq.Array(rule.UsageRoles), pq.Array(rule.ScopeStatuses), pq.Array(rule.ProjectStatuses), rule.Enabled, rule.UpdatedBy, rule.UpdatedAt, rule.ID,
+	)
+	return err
+}
+
+// Delete は ID 指定でルールを削除する。
+func (r *LicensePolicyRuleRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.DB.ExecContext(ctx, `DELETE FROM license_policy_rules WHERE id = ?`, id)
+	return err
+}
+
+// scanLicensePolicyRule は 1 行分のルールを読み取る。
+func scanLicensePolicyRule(s interface{ Scan(...any) error }) (*model.LicensePolicyRule, error) {
+	var rule model.LicensePolicyRule
+	var desc sql.NullString
+	var licenses, categories, usageRoles, scopeStatuses, projectStatuses pq.StringArray
+	if err := s.Scan(&rule.ID, &rule.Name, &desc, &rule.Severity, &licenses, &categories, &usageRoles, &scopeStatuses, &projectStatuses, &rule.Enabled, &rule.UpdatedBy, &rule.CreatedAt, &rule.UpdatedAt); err != nil {
+		return nil, err
+	}
+	rule.Description = strPtr(desc)
+	rule.Licenses = []string(licenses)
+	rule.Categories = []string(categories)
+	rule.UsageRoles = []string(usageRoles)
+	rule.ScopeStatuses = []string(scopeStatuses)
+	rule.ProjectStatuses = []string(projectStatuses)
+	return &rule, nil
+}
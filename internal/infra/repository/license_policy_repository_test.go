@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+func TestLicensePolicyRuleRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &LicensePolicyRuleRepository{DB: db}
+	now := dbtime.DBTime{Time: time.Now()}
+	rule := &model.LicensePolicyRule{ID: uuid.NewString(), Name: "deny-agpl", Severity: model.PolicySeverityDeny, Licenses: []string{"AGPL-*"}, ScopeStatuses: []string{"IN_SCOPE"}, Enabled: true, UpdatedBy: "admin", CreatedAt: now, UpdatedAt: now}
+	query := regexp.QuoteMeta("INSERT INTO license_policy_rules (id, name, description, severity, licenses, categories, usage_roles, scope_statuses, project_statuses, enabled, updated_by, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	mock.ExpectExec(query).
+		WithArgs(rule.ID, rule.Name, nil, "DENY", pq.Array(rule.Licenses), pq.Array([]string(nil)), pq.Array([]string(nil)), pq.Array(rule.ScopeStatuses), pq.Array([]string(nil)), true, "admin", now, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.Create(context.Background(), rule))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLicensePolicyRuleRepository_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &LicensePolicyRuleRepository{DB: db}
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, severity, licenses, categories, usage_roles, scope_statuses, project_statuses, enabled, updated_by, created_at, updated_at FROM license_policy_rules ORDER BY name")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "severity", "licenses", "categories", "usage_roles", "scope_statuses", "project_statuses", "enabled", "updated_by", "created_at", "updated_at"}).
+			AddRow(uuid.NewString(), "warn-gpl", "GPL の静的リンク", "WARN", "{GPL-*}", nil, "{STATIC_LINK}", nil, nil, true, "admin", now, now))
+
+	rules, err := repo.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	require.Equal(t, []string{"GPL-*"}, rules[0].Licenses)
+	require.Empty(t, rules[0].Categories)
+	require.Equal(t, []string{"STATIC_LINK"}, rules[0].UsageRoles)
+	require.Equal(t, "GPL の静的リンク", *rules[0].Description)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// LicenseRepository は domrepo.LicenseRepository の実装。
+type LicenseRepository struct {
+	DB DBTX
+}
+
+var _ domrepo.LicenseRepository = (*LicenseRepository)(nil)
+
+const (
+	licenseColumns = "id, name, osi_approved, fsf_libre, category, custom, updated_by, created_at, updated_at"
+	// licenseHasText は本文が登録されているかどうかを表す式。
+	licenseHasText = "COALESCE(text, '') <> ''"
+	// licenseSeedBatch は Seed の INSERT 1 文あたりの行数。
+	licenseSeedBatch = 100
+)
+
+// Search はフィルタに合致するライセンスを ID 順で返す。本文は読み込まず、登録有無のみ設定する。
+func (r *LicenseRepository) Search(ctx context.Context, f domrepo.LicenseFilter) ([]model.License, int, error) {
+	var args []any
+	var wheres []string
+	if f.Query != "" {
+		q := "%" + strings.ToLower(f.Query) + "%"
+		wheres = append(wheres, "(LOWER(id) LIKE ? OR LOWER(name) LIKE ?)")
+		args = append(args, q, q)
+	}
+	if f.Category != "" {
+		wheres = append(wheres, "category = ?")
+		args = append(args, f.Category)
+	}
+	if f.Custom != nil {
+		wheres = append(wheres, "custom = ?")
+		args = append(args, *f.Custom)
+	}
+	if f.HasText != nil {
+		wheres = append(wheres, "("+licenseHasText+") = ?")
+		args = append(args, *f.HasText)
+	}
+	whereSQL := whereClause(wheres)
+	var total int
+	if err := r.DB.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM licenses %s", whereSQL), args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	offset := (f.Page - 1) * f.Size
+	query := fmt.Sprintf(`SELECT `+licenseColumns+`, `+licenseHasText+` FROM licenses %s ORDER BY id LIMIT ? OFFSET ?`, whereSQL)
+	rows, err := r.DB.QueryContext(ctx, query, append(args, f.Size, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var res []model.License
+	for rows.Next() {
+		var hasText bool
+		l, err := scanLicense(rows, &hasText)
+		if err != nil {
+			return nil, 0, err
+		}
+		l.HasText = hasText
+		res = append(res, *l)
+	}
+	return res, total, rows.Err()
+}
+
+// Get は ID でライセンスを本文と共に取得する。
+func (r *LicenseRepository) Get(ctx context.Context, id string) (*model.License, error) {
+	row := r.DB.QueryRowContext(ctx, `SELECT `+licenseColumns+`, text FROM licenses WHERE id = ?`, id)
+	var text sql.NullString
+	l, err := scanLicense(row, &text)
+	if err != nil {
+		return nil, err
+	}
+	l.Text = strPtr(text)
+	l.HasText = text.String != ""
+	return l, nil
+}
+
+// Create は新しいライセンスを登録する。
+func (r *LicenseRepository) Create(ctx context.Context, l *model.License) error {
+	_, err := r.DB.ExecContext(ctx,
+		`INSERT INTO licenses (`+licenseColumns+`, text) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		l.ID, l.Name, l.OsiApproved, l.FsfLibre, l.Category, l.Custom, l.UpdatedBy, l.CreatedAt, l.UpdatedAt, l.Text,
+	)
+	return err
+}
+
+// Update は既存ライセンスを更新する。
+func (r *LicenseRepository) Update(ctx context.Context, l *model.License) error {
+	_, err := r.DB.ExecContext(ctx,
+		`UPDATE licenses SET name = ?, osi_approved = ?, fsf_libre = ?, category = ?, text = ?, updated_by = ?, updated_at = ? WHERE id = ?`,
+		l.Name, l.OsiApproved, l.FsfLibre, l.Category, l.Text, l.UpdatedBy, l.UpdatedAt, l.ID,
+	)
+	return err
+}
+
+// Delete は ID 指定でライセンスを削除する。
+func (r *LicenseRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.DB.ExecContext(ctx, `DELETE FROM licenses WHERE id = ?`, id)
+	return err
+}
+
+// Seed は未登録のライセンスを 1 トランザクションで登録する。
+// 登録済みの項目は管理者による編集を保持するため、本文が未登録の場合に同梱の本文で補う以外は変更しない。
+func (r *LicenseRepository) Seed(ctx context.Context, ls []model.License) error {
+	return withTx(ctx, r.DB, func(tx DBTX) error {
+		for start := 0; start < len(ls); start += licenseSeedBatch {
+			batch := ls[start:min(start+licenseSeedBatch, len(ls))]
+			values := make([]string, len(batch))
+			args := make([]any, 0, len(batch)*10)
+			for i, l := range batch {
+				values[i] = "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+				args = append(args, l.ID, l.Name, l.OsiApproved, l.FsfLibre, l.Category, l.Custom, l.UpdatedBy, l.CreatedAt, l.UpdatedAt, l.Text)
+			}
+			query := `INSERT INTO licenses (` + licenseColumns + `, text) VALUES ` + strings.Join(values, ", ") + ` ON CONFLICT (id) DO UPDATE SET text = excluded.text WHERE licenses.text IS NULL AND excluded.text IS NOT NULL`
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// scanLicense は 1 行分のライセンスを読み取る。extra には licenseColumns に続く列の格納先を指定する。
+func scanLicense(s interface{ Scan(...any) error }, extra ...any) (*model.License, error) {
+	var l model.License
+	var category, updatedBy sql.NullString
+	dest := append([]any{&l.ID, &l.Name, &l.OsiApproved, &l.FsfLibre, &category, &l.Custom, &updatedBy, &l.CreatedAt, &l.UpdatedAt}, extra...)
+	if err := s.Scan(dest...); err != nil {
+		return nil, err
+	}
+	l.Category = strPtr(category)
+	l.UpdatedBy = strPtr(updatedBy)
+	return &l, nil
+}
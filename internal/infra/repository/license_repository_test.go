@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+func TestLicenseRepository_Seed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &LicenseRepository{DB: db}
+	now := dbtime.DBTime{Time: time.Now()}
+	ls := make([]model.License, licenseSeedBatch+1)
+	for i := range ls {
+		ls[i] = model.License{ID: fmt.Sprintf("L-%d", i), Name: "License", CreatedAt: now, UpdatedAt: now}
+	}
+	// 一定件数ごとに分割し、1 トランザクションで登録する
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO licenses (id, name, osi_approved, fsf_libre, category, custom, updated_by, created_at, updated_at, text) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?")).
+		WillReturnResult(sqlmock.NewResult(0, licenseSeedBatch))
+	mock.ExpectExec(regexp.QuoteMeta("VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO UPDATE SET text = excluded.text WHERE licenses.text IS NULL AND excluded.text IS NOT NULL")).
+		WithArgs("L-100", "License", false, false, nil, false, nil, now, now, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.Seed(context.Background(), ls))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLicenseRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &LicenseRepository{DB: db}
+	now := time.Now()
+	query := regexp.QuoteMeta("SELECT id, name, osi_approved, fsf_libre, category, custom, updated_by, created_at, updated_at, text FROM licenses WHERE id = ?")
+	mock.ExpectQuery(query).WithArgs("LicenseRef-acme").WillReturnRows(sqlmock.NewRows(
+		[]string{"id", "name", "osi_approved", "fsf_libre", "category", "custom", "updated_by", "created_at", "updated_at", "text"}).
+		AddRow("LicenseRef-acme", "ACME License", false, false, nil, true, "admin", now, now, "ACME"))
+
+	l, err := repo.Get(context.Background(), "LicenseRef-acme")
+	require.NoError(t, err)
+	require.True(t, l.Custom)
+	require.Nil(t, l.Category)
+	require.Equal(t, "admin", *l.UpdatedBy)
+	require.Equal(t, "ACME", *l.Text)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
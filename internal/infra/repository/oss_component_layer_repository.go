@@ -2,14 +2,13 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 
 	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
 )
 
 // OssComponentLayerRepository は domrepo.OssComponentLayerRepository の実装。
 type OssComponentLayerRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 var _ domrepo.OssComponentLayerRepository = (*OssComponentLayerRepository)(nil)
@@ -38,19 +37,15 @@ func (r *OssComponentLayerRepository) ListByOssID(ctx context.Context, ossID str
 
 // Replace は指定コンポーネントのレイヤーを与えられた値で置き換える。
 func (r *OssComponentLayerRepository) Replace(ctx context.Context, ossID string, layers []string) error {
-	tx, err := r.DB.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	if _, err := tx.ExecContext(ctx, `DELETE FROM oss_component_layers WHERE oss_id = ?`, ossID); err != nil {
-		tx.Rollback()
-		return err
-	}
-	for _, l := range layers {
-		if _, err := tx.ExecContext(ctx, `INSERT INTO oss_component_layers (oss_id, layer) VALUES (?, ?)`, ossID, l); err != nil {
-			tx.Rollback()
+	return withTx(ctx, r.DB, func(tx DBTX) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM oss_component_layers WHERE oss_id = ?`, ossID); err != nil {
 			return err
 		}
-	}
-	return tx.Commit()
+		for _, l := range layers {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO oss_component_layers (oss_id, layer) VALUES (?, ?)`, ossID, l); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
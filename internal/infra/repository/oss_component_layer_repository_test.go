@@ -53,6 +53,26 @@ func TestOssComponentLayerRepository_Replace(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestOssComponentLayerRepository_Replace_InTx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ossID := uuid.NewString()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM oss_component_layers WHERE oss_id = ?`)).WithArgs(ossID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO oss_component_layers (oss_id, layer) VALUES (?, ?)`)).WithArgs(ossID, "LIB").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	// 呼び出し元のトランザクション内ではコミットしない
+	repo := &OssComponentLayerRepository{DB: tx}
+	require.NoError(t, repo.Replace(context.Background(), ossID, []string{"LIB"}))
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestOssComponentLayerRepository_Replace_Error(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2,7 +2,6 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 
@@ -12,7 +11,7 @@ import (
 
 // OssComponentRepository は domrepo.OssComponentRepository の実装。
 type OssComponentRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 // Search はフィルタに合致する OSS コンポーネント一覧を返す。
@@ -70,3 +69,24 @@ func (r *OssComponentRepository) Create(ctx context.Context, c *model.OssCompone
 	_, err := r.DB.ExecContext(ctx, query, c.ID, c.Name, c.NormalizedName, c.HomepageURL, c.RepositoryURL, c.Description, c.PrimaryLanguage, c.DefaultUsageRole, c.Deprecated, c.CreatedAt, c.UpdatedAt)
 	return err
 }
+
+// Get は ID でコンポーネントを取得する。
+func (r *OssComponentRepository) Get(ctx context.Context, id string) (*model.OssComponent, error) {
+	return scanOssComponent(r.DB.QueryRowContext(ctx, `SELECT `+ossComponentColumns+` FROM oss_components WHERE id = ?`, id))
+}
+
+// FindByNormalizedName は正規化名が完全一致するコンポーネントを返す。
+func (r *OssComponentRepository) FindByNormalizedName(ctx context.Context, name string) (*model.OssComponent, error) {
+	return scanOssComponent(r.DB.QueryRowContext(ctx, `SELECT `+ossComponentColumns+` FROM oss_components WHERE normalized_name = ?`, name))
+}
+
+const ossComponentColumns = "id, name, normalized_name, homepage_url, repository_url, description, primary_language, default_usage_role, deprecated, created_at, updated_at"
+
+// scanOssComponent は 1 行分のコンポーネントを読み取る。
+func scanOssComponent(s interface{ Scan(...any) error }) (*model.OssComponent, error) {
+	var c model.OssComponent
+	if err := s.Scan(&c.ID, &c.Name, &c.NormalizedName, &c.HomepageURL, &c.RepositoryURL, &c.Description, &c.PrimaryLanguage, &c.DefaultUsageRole, &c.Deprecated, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -62,3 +62,40 @@ func TestOssComponentRepository_Create(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestOssComponentRepository_FindByNormalizedName(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &OssComponentRepository{DB: db}
+
+	query := regexp.QuoteMeta("SELECT id, name, normalized_name, homepage_url, repository_url, description, primary_language, default_usage_role, deprecated, created_at, updated_at FROM oss_components WHERE normalized_name = ?")
+	now := dbtime.DBTime{Time: time.Now()}
+	mock.ExpectQuery(query).WithArgs("redis").WillReturnRows(sqlmock.NewRows([]string{"id", "name", "normalized_name", "homepage_url", "repository_url", "description", "primary_language", "default_usage_role", "deprecated", "created_at", "updated_at"}).AddRow(uuid.NewString(), "Redis", "redis", nil, nil, nil, nil, "SERVER_ENV", false, now, now))
+
+	c, err := repo.FindByNormalizedName(context.Background(), "redis")
+	require.NoError(t, err)
+	require.Equal(t, "Redis", c.Name)
+	require.Equal(t, "SERVER_ENV", *c.DefaultUsageRole)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOssComponentRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &OssComponentRepository{DB: db}
+
+	id := uuid.NewString()
+	query := regexp.QuoteMeta("SELECT id, name, normalized_name, homepage_url, repository_url, description, primary_language, default_usage_role, deprecated, created_at, updated_at FROM oss_components WHERE id = ?")
+	now := dbtime.DBTime{Time: time.Now()}
+	mock.ExpectQuery(query).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "normalized_name", "homepage_url", "repository_url", "description", "primary_language", "default_usage_role", "deprecated", "created_at", "updated_at"}).AddRow(id, "Redis", "redis", nil, nil, nil, nil, nil, false, now, now))
+
+	c, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, "redis", c.NormalizedName)
+	require.Nil(t, c.DefaultUsageRole)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,7 +2,6 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 
 	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
 
@@ -12,7 +11,7 @@ import (
 
 // OssComponentTagRepository は domrepo.OssComponentTagRepository の実装。
 type OssComponentTagRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 var _ domrepo.OssComponentTagRepository = (*OssComponentTagRepository)(nil)
@@ -43,19 +42,15 @@ func (r *OssComponentTagRepository) ListByOssID(ctx context.Context, ossID strin
 
 // Replace は指定コンポーネントのタグを指定IDで置き換える。
 func (r *OssComponentTagRepository) Replace(ctx context.Context, ossID string, tagIDs []string) error {
-	tx, err := r.DB.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	if _, err := tx.ExecContext(ctx, `DELETE FROM oss_component_tags WHERE oss_id = ?`, ossID); err != nil {
-		tx.Rollback()
-		return err
-	}
-	for _, id := range tagIDs {
-		if _, err := tx.ExecContext(ctx, `INSERT INTO oss_component_tags (oss_id, tag_id) VALUES (?, ?)`, ossID, id); err != nil {
-			tx.Rollback()
+	return withTx(ctx, r.DB, func(tx DBTX) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM oss_component_tags WHERE oss_id = ?`, ossID); err != nil {
 			return err
 		}
-	}
-	return tx.Commit()
+		for _, id := range tagIDs {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO oss_component_tags (oss_id, tag_id) VALUES (?, ?)`, ossID, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
@@ -13,7 +13,7 @@ import (
 
 // OssVersionRepository は domrepo.OssVersionRepository の実装。
 type OssVersionRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 var _ domrepo.OssVersionRepository = (*OssVersionRepository)(nil)
@@ -41,7 +41,7 @@ func (r *OssVersionRepository) Search(ctx context.Context, f domrepo.OssVersionF
 	}
 
 	offset := (f.Page - 1) * f.Size
-	listQuery := fmt.Sprintf(`SELECT id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, created_at, updated_at FROM oss_versions %s ORDER BY created_at DESC LIMIT ? OFFSET ?`, whereSQL)
+	listQuery := fmt.Sprintf(`SELECT id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, hash_sha512, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, copyright_text, created_at, updated_at FROM oss_versions %s ORDER BY created_at DESC LIMIT ? OFFSET ?`, whereSQL)
 	argsWithLimit := append(args, f.Size, offset)
 	rows, err := r.DB.QueryContext(ctx, listQuery, argsWithLimit...)
 	if err != nil {
@@ -53,11 +53,11 @@ func (r *OssVersionRepository) Search(ctx context.Context, f domrepo.OssVersionF
 	for rows.Next() {
 		var v model.OssVersion
 		var releaseDate sql.NullTime
-		var licenseRaw, licenseConc, purl, hash sql.NullString
-		var modDesc, supplier, fork sql.NullString
+		var licenseRaw, licenseConc, purl, hash, hash512 sql.NullString
+		var modDesc, supplier, fork, copyright sql.NullString
 		var lastReviewed sql.NullTime
 		var cpeList pq.StringArray
-		if err := rows.Scan(&v.ID, &v.OssID, &v.Version, &releaseDate, &licenseRaw, &licenseConc, &purl, &cpeList, &hash, &v.Modified, &modDesc, &v.ReviewStatus, &lastReviewed, &v.ScopeStatus, &supplier, &fork, &v.CreatedAt, &v.UpdatedAt); err != nil {
+		if err := rows.Scan(&v.ID, &v.OssID, &v.Version, &releaseDate, &licenseRaw, &licenseConc, &purl, &cpeList, &hash, &hash512, &v.Modified, &modDesc, &v.ReviewStatus, &lastReviewed, &v.ScopeStatus, &supplier, &fork, &copyright, &v.CreatedAt, &v.UpdatedAt); err != nil {
 			return nil, 0, err
 		}
 		v.ReleaseDate = timePtr(releaseDate)
@@ -66,10 +66,12 @@ func (r *OssVersionRepository) Search(ctx context.Context, f domrepo.OssVersionF
 		v.Purl = strPtr(purl)
 		v.CpeList = []string(cpeList)
 		v.HashSha256 = strPtr(hash)
+		v.HashSha512 = strPtr(hash512)
 		v.ModificationDescription = strPtr(modDesc)
 		v.LastReviewedAt = timePtr(lastReviewed)
 		v.SupplierType = strPtr(supplier)
 		v.ForkOriginURL = strPtr(fork)
+		v.CopyrightText = strPtr(copyright)
 		versions = append(versions, v)
 	}
 	return versions, total, rows.Err()
@@ -77,14 +79,30 @@ func (r *OssVersionRepository) Search(ctx context.Context, f domrepo.OssVersionF
 
 // Get は ID でバージョンを取得する。
 func (r *OssVersionRepository) Get(ctx context.Context, id string) (*model.OssVersion, error) {
-	row := r.DB.QueryRowContext(ctx, `SELECT id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, created_at, updated_at FROM oss_versions WHERE id = ?`, id)
+	return scanOssVersion(r.DB.QueryRowContext(ctx, `SELECT `+ossVersionColumns+` FROM oss_versions WHERE id = ?`, id))
+}
+
+// FindByPurl は purl が一致するバージョンを返す。
+func (r *OssVersionRepository) FindByPurl(ctx context.Context, purl string) (*model.OssVersion, error) {
+	return scanOssVersion(r.DB.QueryRowContext(ctx, `SELECT `+ossVersionColumns+` FROM oss_versions WHERE purl = ? ORDER BY created_at LIMIT 1`, purl))
+}
+
+// FindByVersion はコンポーネント内でバージョン文字列が一致するものを返す。
+func (r *OssVersionRepository) FindByVersion(ctx context.Context, ossID, version string) (*model.OssVersion, error) {
+	return scanOssVersion(r.DB.QueryRowContext(ctx, `SELECT `+ossVersionColumns+` FROM oss_versions WHERE oss_id = ? AND version = ? ORDER BY created_at LIMIT 1`, ossID, version))
+}
+
+const ossVersionColumns = "id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, hash_sha512, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, copyright_text, created_at, updated_at"
+
+// scanOssVersion は 1 行分のバージョンを読み取る。
+func scanOssVersion(s interface{ Scan(...any) error }) (*model.OssVersion, error) {
 	var v model.OssVersion
 	var releaseDate sql.NullTime
-	var licenseRaw, licenseConc, purl, hash sql.NullString
-	var modDesc, supplier, fork sql.NullString
+	var licenseRaw, licenseConc, purl, hash, hash512 sql.NullString
+	var modDesc, supplier, fork, copyright sql.NullString
 	var lastReviewed sql.NullTime
 	var cpeList pq.StringArray
-	if err := row.Scan(&v.ID, &v.OssID, &v.Version, &releaseDate, &licenseRaw, &licenseConc, &purl, &cpeList, &hash, &v.Modified, &modDesc, &v.ReviewStatus, &lastReviewed, &v.ScopeStatus, &supplier, &fork, &v.CreatedAt, &v.UpdatedAt); err != nil {
+	if err := s.Scan(&v.ID, &v.OssID, &v.Version, &releaseDate, &licenseRaw, &licenseConc, &purl, &cpeList, &hash, &hash512, &v.Modified, &modDesc, &v.ReviewStatus, &lastReviewed, &v.ScopeStatus, &supplier, &fork, &copyright, &v.CreatedAt, &v.UpdatedAt); err != nil {
 		return nil, err
 	}
 	v.ReleaseDate = timePtr(releaseDate)
@@ -93,18 +111,20 @@ func (r *OssVersionRepository) Get(ctx context.Context, id string) (*model.OssVe
 	v.Purl = strPtr(purl)
 	v.CpeList = []string(cpeList)
 	v.HashSha256 = strPtr(hash)
+	v.HashSha512 = strPtr(hash512)
 	v.ModificationDescription = strPtr(modDesc)
 	v.LastReviewedAt = timePtr(lastReviewed)
 	v.SupplierType = strPtr(supplier)
 	v.ForkOriginURL = strPtr(fork)
+	v.CopyrightText = strPtr(copyright)
 	return &v, nil
 }
 
 // Create は新しいバージョンを登録する。
 func (r *OssVersionRepository) Create(ctx context.Context, v *model.OssVersion) error {
 	_, err := r.DB.ExecContext(ctx,
-		`INSERT INTO oss_versions (id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		v.ID, v.OssID, v.Version, v.ReleaseDate, v.LicenseExpressionRaw, v.LicenseConcluded, v.Purl, pq.Array(v.CpeList), v.HashSha256, v.Modified, v.ModificationDescription, v.ReviewStatus, v.LastReviewedAt, v.ScopeStatus, v.SupplierType, v.ForkOriginURL, v.CreatedAt, v.UpdatedAt,
+		`INSERT INTO oss_versions (id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, hash_sha512, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, copyright_text, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		v.ID, v.OssID, v.Version, v.ReleaseDate, v.LicenseExpressionRaw, v.LicenseConcluded, v.Purl, pq.Array(v.CpeList), v.HashSha256, v.HashSha512, v.Modified, v.ModificationDescription, v.ReviewStatus, v.LastReviewedAt, v.ScopeStatus, v.SupplierType, v.ForkOriginURL, v.CopyrightText, v.CreatedAt, v.UpdatedAt,
 	)
 	return err
 }
@@ -112,8 +132,8 @@ func (r *OssVersionRepository) Create(ctx context.Context, v *model.OssVersion)
 // Update は既存バージョンを更新する。
 func (r *OssVersionRepository) Update(ctx context.Context, v *model.OssVersion) error {
 	_, err := r.DB.ExecContext(ctx,
-		`UPDATE oss_versions SET release_date = ?, license_expression_raw = ?, license_concluded = ?, purl = ?, cpe_list = ?, hash_sha256 = ?, modified = ?, modification_description = ?, review_status = ?, last_reviewed_at = ?, scope_status = ?, supplier_type = ?, fork_origin_url = ?, updated_at = ? WHERE id = ?`,
-		v.ReleaseDate, v.LicenseExpressionRaw, v.LicenseConcluded, v.Purl, pq.Array(v.CpeList), v.HashSha256, v.Modified, v.ModificationDescription, v.ReviewStatus, v.LastReviewedAt, v.ScopeStatus, v.SupplierType, v.ForkOriginURL, v.UpdatedAt, v.ID,
+		`UPDATE oss_versions SET release_date = ?, license_expression_raw = ?, license_concluded = ?, purl = ?, cpe_list = ?, hash_sha256 = ?, hash_sha512 = ?, modified = ?, modification_description = ?, review_status = ?, last_reviewed_at = ?, scope_status = ?, supplier_type = ?, fork_origin_url = ?, copyright_text = ?, updated_at = ? WHERE id = ?`,
+		v.ReleaseDate, v.LicenseExpressionRaw, v.LicenseConcluded, v.Purl, pq.Array(v.CpeList), v.HashSha256, v.HashSha512, v.Modified, v.ModificationDescription, v.ReviewStatus, v.LastReviewedAt, v.ScopeStatus, v.SupplierType, v.ForkOriginURL, v.CopyrightText, v.UpdatedAt, v.ID,
 	)
 	return err
 }
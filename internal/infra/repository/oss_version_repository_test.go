@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"regexp"
 	"testing"
 	"time"
@@ -29,10 +30,10 @@ func TestOssVersionRepository_Search(t *testing.T) {
 	countQuery := regexp.QuoteMeta("SELECT COUNT(*) FROM oss_versions WHERE oss_id = ?")
 	mock.ExpectQuery(countQuery).WithArgs(f.OssID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 
-	listQuery := regexp.QuoteMeta("SELECT id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, created_at, updated_at FROM oss_versions WHERE oss_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?")
+	listQuery := regexp.QuoteMeta("SELECT id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, hash_sha512, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, copyright_text, created_at, updated_at FROM oss_versions WHERE oss_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?")
 	now := dbtime.DBTime{Time: time.Now()}
-	rows := sqlmock.NewRows([]string{"id", "oss_id", "version", "release_date", "license_expression_raw", "license_concluded", "purl", "cpe_list", "hash_sha256", "modified", "modification_description", "review_status", "last_reviewed_at", "scope_status", "supplier_type", "fork_origin_url", "created_at", "updated_at"}).
-		AddRow(uuid.NewString(), f.OssID, "1.0.0", now, nil, nil, nil, pq.StringArray{"cpe:/a"}, nil, false, nil, "draft", nil, "IN_SCOPE", nil, nil, now, now)
+	rows := sqlmock.NewRows([]string{"id", "oss_id", "version", "release_date", "license_expression_raw", "license_concluded", "purl", "cpe_list", "hash_sha256", "hash_sha512", "modified", "modification_description", "review_status", "last_reviewed_at", "scope_status", "supplier_type", "fork_origin_url", "copyright_text", "created_at", "updated_at"}).
+		AddRow(uuid.NewString(), f.OssID, "1.0.0", now, nil, nil, nil, pq.StringArray{"cpe:/a"}, nil, nil, false, nil, "draft", nil, "IN_SCOPE", nil, nil, nil, now, now)
 	mock.ExpectQuery(listQuery).WithArgs(f.OssID, 10, 0).WillReturnRows(rows)
 
 	res, total, err := repo.Search(context.Background(), f)
@@ -60,9 +61,9 @@ func TestOssVersionRepository_Create(t *testing.T) {
 		UpdatedAt:    dbtime.DBTime{Time: time.Now()},
 	}
 
-	query := regexp.QuoteMeta("INSERT INTO oss_versions (id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	query := regexp.QuoteMeta("INSERT INTO oss_versions (id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, hash_sha512, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, copyright_text, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
 	mock.ExpectExec(query).
-		WithArgs(v.ID, v.OssID, v.Version, v.ReleaseDate, v.LicenseExpressionRaw, v.LicenseConcluded, v.Purl, sqlmock.AnyArg(), v.HashSha256, v.Modified, v.ModificationDescription, v.ReviewStatus, v.LastReviewedAt, v.ScopeStatus, v.SupplierType, v.ForkOriginURL, v.CreatedAt, v.UpdatedAt).
+		WithArgs(v.ID, v.OssID, v.Version, v.ReleaseDate, v.LicenseExpressionRaw, v.LicenseConcluded, v.Purl, sqlmock.AnyArg(), v.HashSha256, v.HashSha512, v.Modified, v.ModificationDescription, v.ReviewStatus, v.LastReviewedAt, v.ScopeStatus, v.SupplierType, v.ForkOriginURL, v.CopyrightText, v.CreatedAt, v.UpdatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err = repo.Create(context.Background(), v)
@@ -86,9 +87,9 @@ func TestOssVersionRepository_Update(t *testing.T) {
 		UpdatedAt:    dbtime.DBTime{Time: time.Now()},
 	}
 
-	query := regexp.QuoteMeta("UPDATE oss_versions SET release_date = ?, license_expression_raw = ?, license_concluded = ?, purl = ?, cpe_list = ?, hash_sha256 = ?, modified = ?, modification_description = ?, review_status = ?, last_reviewed_at = ?, scope_status = ?, supplier_type = ?, fork_origin_url = ?, updated_at = ? WHERE id = ?")
+	query := regexp.QuoteMeta("UPDATE oss_versions SET release_date = ?, license_expression_raw = ?, license_concluded = ?, purl = ?, cpe_list = ?, hash_sha256 = ?, hash_sha512 = ?, modified = ?, modification_description = ?, review_status = ?, last_reviewed_at = ?, scope_status = ?, supplier_type = ?, fork_origin_url = ?, copyright_text = ?, updated_at = ? WHERE id = ?")
 	mock.ExpectExec(query).
-		WithArgs(v.ReleaseDate, v.LicenseExpressionRaw, v.LicenseConcluded, v.Purl, sqlmock.AnyArg(), v.HashSha256, v.Modified, v.ModificationDescription, v.ReviewStatus, v.LastReviewedAt, v.ScopeStatus, v.SupplierType, v.ForkOriginURL, v.UpdatedAt, v.ID).
+		WithArgs(v.ReleaseDate, v.LicenseExpressionRaw, v.LicenseConcluded, v.Purl, sqlmock.AnyArg(), v.HashSha256, v.HashSha512, v.Modified, v.ModificationDescription, v.ReviewStatus, v.LastReviewedAt, v.ScopeStatus, v.SupplierType, v.ForkOriginURL, v.CopyrightText, v.UpdatedAt, v.ID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err = repo.Update(context.Background(), v)
@@ -112,3 +113,40 @@ func TestOssVersionRepository_Delete(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestOssVersionRepository_FindByPurl(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &OssVersionRepository{DB: db}
+
+	purl := "pkg:npm/lodash@4.17.21"
+	query := regexp.QuoteMeta("SELECT id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, hash_sha512, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, copyright_text, created_at, updated_at FROM oss_versions WHERE purl = ? ORDER BY created_at LIMIT 1")
+	now := dbtime.DBTime{Time: time.Now()}
+	rows := sqlmock.NewRows([]string{"id", "oss_id", "version", "release_date", "license_expression_raw", "license_concluded", "purl", "cpe_list", "hash_sha256", "hash_sha512", "modified", "modification_description", "review_status", "last_reviewed_at", "scope_status", "supplier_type", "fork_origin_url", "copyright_text", "created_at", "updated_at"}).
+		AddRow(uuid.NewString(), uuid.NewString(), "4.17.21", nil, "MIT", nil, purl, pq.StringArray{}, nil, nil, false, nil, "draft", nil, "IN_SCOPE", nil, nil, nil, now, now)
+	mock.ExpectQuery(query).WithArgs(purl).WillReturnRows(rows)
+
+	v, err := repo.FindByPurl(context.Background(), purl)
+	require.NoError(t, err)
+	require.Equal(t, "4.17.21", v.Version)
+	require.Equal(t, purl, *v.Purl)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOssVersionRepository_FindByVersion(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &OssVersionRepository{DB: db}
+
+	ossID := uuid.NewString()
+	query := regexp.QuoteMeta("SELECT id, oss_id, version, release_date, license_expression_raw, license_concluded, purl, cpe_list, hash_sha256, hash_sha512, modified, modification_description, review_status, last_reviewed_at, scope_status, supplier_type, fork_origin_url, copyright_text, created_at, updated_at FROM oss_versions WHERE oss_id = ? AND version = ? ORDER BY created_at LIMIT 1")
+	mock.ExpectQuery(query).WithArgs(ossID, "1.0.0").WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.FindByVersion(context.Background(), ossID, "1.0.0")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// ProjectObligationRepository は domrepo.ProjectObligationRepository の実装。
+type ProjectObligationRepository struct {
+	DB DBTX
+}
+
+var _ domrepo.ProjectObligationRepository = (*ProjectObligationRepository)(nil)
+
+const projectObligationColumns = "id, project_id, usage_id, license, obligation, description, status, evidence_note, closed_by, closed_at, created_at, updated_at"
+
+// projectObligationDetailSelect は義務に利用情報・コンポーネント名・バージョンを結合して取得する SELECT 句。
+const projectObligationDetailSelect = `SELECT o.id, o.project_id, o.usage_id, o.license, o.obligation, o.description, o.status, o.evidence_note, o.closed_by, o.closed_at, o.created_at, o.updated_at, c.name, v.version, u.usage_role, u.scope_status ` +
+	`FROM project_obligations o JOIN project_usages u ON u.id = o.usage_id JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id`
+
+// ListDetails はプロジェクトの義務をコンポーネント名・バージョン順で返す。
+func (r *ProjectObligationRepository) ListDetails(ctx context.Context, projectID string) ([]model.ProjectObligationDetail, error) {
+	rows, err := r.DB.QueryContext(ctx, projectObligationDetailSelect+` WHERE o.project_id = ? ORDER BY c.normalized_name, v.version, o.license, o.obligation`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.ProjectObligationDetail
+	for rows.Next() {
+		d, err := scanProjectObligationDetail(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *d)
+	}
+	return res, rows.Err()
+}
+
+// Get は ID で義務を取得する。
+func (r *ProjectObligationRepository) Get(ctx context.Context, id string) (*model.ProjectObligationDetail, error) {
+	return scanProjectObligationDetail(r.DB.QueryRowContext(ctx, projectObligationDetailSelect+` WHERE o.id = ?`, id))
+}
+
+// Update は状態・証跡・完了者を更新する。
+func (r *ProjectObligationRepository) Update(ctx context.Context, o *model.ProjectObligation) error {
+	_, err := r.DB.ExecContext(ctx,
+		`UPDATE project_obligations SET status = ?, evidence_note = ?, closed_by = ?, closed_at = ?, updated_at = ? WHERE id = ?`,
+		o.Status, o.EvidenceNote, o.ClosedBy, o.ClosedAt, o.UpdatedAt, o.ID,
+	)
+	return err
+}
+
+// Sync は導出した義務をプロジェクトに反映する。利用・ライセンス・種類の組で既存の義務と照合する。
+func (r *ProjectObligationRepository) Sync(ctx context.Context, projectID string, derived []model.ProjectObligation) (added, removed int, err error) {
+	type key struct{ usage, license, obligation string }
+	err = withTx(ctx, r.DB, func(tx DBTX) error {
+		rows, err := tx.QueryContext(ctx, `SELECT id, usage_id, license, obligation, status FROM project_obligations WHERE project_id = ?`, projectID)
+		if err != nil {
+			return err
+		}
+		type existing struct{ id, status string }
+		current := map[key]existing{}
+		for rows.Next() {
+			var k key
+			var e existing
+			if err := rows.Scan(&e.id, &k.usage, &k.license, &k.obligation, &e.status); err != nil {
+				rows.Close()
+				return err
+			}
+			current[k] = e
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		wanted := map[key]bool{}
+		for _, o := range derived {
+			k := key{o.UsageID, o.License, o.Obligation}
+			if wanted[k] {
+				continue
+			}
+			wanted[k] = true
+			if _, ok := current[k]; ok {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO project_obligations (`+projectObligationColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				o.ID, projectID, o.UsageID, o.License, o.Obligation, o.Description, o.Status, o.EvidenceNote, o.ClosedBy, o.ClosedAt, o.CreatedAt, o.UpdatedAt,
+			); err != nil {
+				return err
+			}
+			added++
+		}
+		for k, e := range current {
+			if wanted[k] || e.status != model.ObligationOpen {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM project_obligations WHERE id = ?`, e.id); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return added, removed, nil
+}
+
+// scanProjectObligationDetail は projectObligationDetailSelect の 1 行分を読み取る。
+func scanProjectObligationDetail(s interface{ Scan(...any) error }) (*model.ProjectObligationDetail, error) {
+	var d model.ProjectObligationDetail
+	o := &d.Obligation
+	var note, closedBy sql.NullString
+	var closedAt sql.NullTime
+	if err := s.Scan(&o.ID, &o.ProjectID, &o.UsageID, &o.License, &o.Obligation, &o.Description, &o.Status, &note, &closedBy, &closedAt, &o.CreatedAt, &o.UpdatedAt,
+		&d.ComponentName, &d.Version, &d.UsageRole, &d.ScopeStatus); err != nil {
+		return nil, err
+	}
+	o.EvidenceNote = strPtr(note)
+	o.ClosedBy = strPtr(closedBy)
+	o.ClosedAt = timePtr(closedAt)
+	return &d, nil
+}
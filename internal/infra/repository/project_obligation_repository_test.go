@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+func TestProjectObligationRepository_Sync(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ProjectObligationRepository{DB: db}
+	now := dbtime.DBTime{Time: time.Now()}
+	pid, uid := uuid.NewString(), uuid.NewString()
+	staleID, closedID := uuid.NewString(), uuid.NewString()
+	derived := []model.ProjectObligation{
+		{ID: uuid.NewString(), UsageID: uid, License: "MIT", Obligation: "LICENSE_TEXT", Description: "d", Status: model.ObligationOpen, CreatedAt: now, UpdatedAt: now},
+		{ID: uuid.NewString(), UsageID: uid, License: "GPL-2.0-only", Obligation: "SOURCE_OFFER", Description: "d", Status: model.ObligationOpen, CreatedAt: now, UpdatedAt: now},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, usage_id, license, obligation, status FROM project_obligations WHERE project_id = ?")).WithArgs(pid).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "usage_id", "license", "obligation", "status"}).
+			AddRow(uuid.NewString(), uid, "MIT", "LICENSE_TEXT", "FULFILLED").
+			AddRow(staleID, uid, "LGPL-2.1-only", "RELINKABLE_OBJECTS", "OPEN").
+			AddRow(closedID, uid, "LGPL-2.1-only", "SOURCE_OFFER", "WAIVED"))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO project_obligations (id, project_id, usage_id, license, obligation, description, status, evidence_note, closed_by, closed_at, created_at, updated_at) VALUES")).
+		WithArgs(derived[1].ID, pid, uid, "GPL-2.0-only", "SOURCE_OFFER", "d", "OPEN", nil, nil, nil, now, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM project_obligations WHERE id = ?")).WithArgs(staleID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	added, removed, err := repo.Sync(context.Background(), pid, derived)
+	require.NoError(t, err)
+	require.Equal(t, 1, added)
+	require.Equal(t, 1, removed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -11,7 +11,7 @@ import (
 
 // ProjectRepository は domrepo.ProjectRepository の実装。
 type ProjectRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 var _ domrepo.ProjectRepository = (*ProjectRepository)(nil)
@@ -38,7 +38,7 @@ func (r *ProjectRepository) Search(ctx context.Context, f domrepo.ProjectFilter)
 	}
 
 	offset := (f.Page - 1) * f.Size
-	listQuery := fmt.Sprintf(`SELECT id, project_code, name, department, manager, delivery_date, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects %s ORDER BY created_at DESC LIMIT ? OFFSET ?`, whereSQL)
+	listQuery := fmt.Sprintf(`SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects %s ORDER BY created_at DESC LIMIT ? OFFSET ?`, whereSQL)
 	argsWithLimit := append(args, f.Size, offset)
 	rows, err := r.DB.QueryContext(ctx, listQuery, argsWithLimit...)
 	if err != nil {
@@ -52,7 +52,7 @@ func (r *ProjectRepository) Search(ctx context.Context, f domrepo.ProjectFilter)
 		var dept, mgr, desc sql.NullString
 		var delivery sql.NullTime
 		var usageCount int
-		if err := rows.Scan(&p.ID, &p.ProjectCode, &p.Name, &dept, &mgr, &delivery, &desc, &p.CreatedAt, &p.UpdatedAt, &usageCount); err != nil {
+		if err := rows.Scan(&p.ID, &p.ProjectCode, &p.Name, &dept, &mgr, &delivery, &p.Status, &desc, &p.CreatedAt, &p.UpdatedAt, &usageCount); err != nil {
 			return nil, 0, err
 		}
 		p.Department = strPtr(dept)
@@ -67,12 +67,12 @@ func (r *ProjectRepository) Search(ctx context.Context, f domrepo.ProjectFilter)
 
 // Get は ID を指定してプロジェクトを取得する。
 func (r *ProjectRepository) Get(ctx context.Context, id string) (*model.Project, error) {
-	row := r.DB.QueryRowContext(ctx, `SELECT id, project_code, name, department, manager, delivery_date, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?`, id)
+	row := r.DB.QueryRowContext(ctx, `SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?`, id)
 	var p model.Project
 	var dept, mgr, desc sql.NullString
 	var delivery sql.NullTime
 	var usageCount int
-	if err := row.Scan(&p.ID, &p.ProjectCode, &p.Name, &dept, &mgr, &delivery, &desc, &p.CreatedAt, &p.UpdatedAt, &usageCount); err != nil {
+	if err := row.Scan(&p.ID, &p.ProjectCode, &p.Name, &dept, &mgr, &delivery, &p.Status, &desc, &p.CreatedAt, &p.UpdatedAt, &usageCount); err != nil {
 		return nil, err
 	}
 	p.Department = strPtr(dept)
@@ -85,13 +85,13 @@ func (r *ProjectRepository) Get(ctx context.Context, id string) (*model.Project,
 
 // Create は新しいプロジェクトを登録する。
 func (r *ProjectRepository) Create(ctx context.Context, p *model.Project) error {
-	_, err := r.DB.ExecContext(ctx, `INSERT INTO projects (id, project_code, name, department, manager, delivery_date, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, p.ID, p.ProjectCode, p.Name, p.Department, p.Manager, p.DeliveryDate, p.Description, p.CreatedAt, p.UpdatedAt)
+	_, err := r.DB.ExecContext(ctx, `INSERT INTO projects (id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, p.ID, p.ProjectCode, p.Name, p.Department, p.Manager, p.DeliveryDate, p.Status, p.Description, p.CreatedAt, p.UpdatedAt)
 	return err
 }
 
 // Update は既存プロジェクトを更新する。
 func (r *ProjectRepository) Update(ctx context.Context, p *model.Project) error {
-	_, err := r.DB.ExecContext(ctx, `UPDATE projects SET name = ?, department = ?, manager = ?, delivery_date = ?, description = ?, updated_at = ? WHERE id = ?`, p.Name, p.Department, p.Manager, p.DeliveryDate, p.Description, p.UpdatedAt, p.ID)
+	_, err := r.DB.ExecContext(ctx, `UPDATE projects SET name = ?, department = ?, manager = ?, delivery_date = ?, status = ?, description = ?, updated_at = ? WHERE id = ?`, p.Name, p.Department, p.Manager, p.DeliveryDate, p.Status, p.Description, p.UpdatedAt, p.ID)
 	return err
 }
 
@@ -28,10 +28,10 @@ func TestProjectRepository_Search(t *testing.T) {
 	countQuery := regexp.QuoteMeta("SELECT COUNT(*) FROM projects WHERE project_code LIKE ?")
 	mock.ExpectQuery(countQuery).WithArgs("%PRJ%").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 
-	listQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE project_code LIKE ? ORDER BY created_at DESC LIMIT ? OFFSET ?")
+	listQuery := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE project_code LIKE ? ORDER BY created_at DESC LIMIT ? OFFSET ?")
 	now := dbtime.DBTime{Time: time.Now()}
-	rows := sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "description", "created_at", "updated_at", "count"}).
-		AddRow(uuid.NewString(), "PRJ-1", "Proj", nil, nil, nil, nil, now, now, 0)
+	rows := sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).
+		AddRow(uuid.NewString(), "PRJ-1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0)
 	mock.ExpectQuery(listQuery).WithArgs("%PRJ%", 10, 0).WillReturnRows(rows)
 
 	res, total, err := repo.Search(context.Background(), f)
@@ -49,10 +49,10 @@ func TestProjectRepository_Get(t *testing.T) {
 	repo := &ProjectRepository{DB: db}
 
 	id := uuid.NewString()
-	query := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
+	query := regexp.QuoteMeta("SELECT id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at, (SELECT COUNT(*) FROM project_usages u WHERE u.project_id = projects.id) FROM projects WHERE id = ?")
 	now := dbtime.DBTime{Time: time.Now()}
-	mock.ExpectQuery(query).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "description", "created_at", "updated_at", "count"}).
-		AddRow(id, "PRJ-1", "Proj", nil, nil, nil, nil, now, now, 0))
+	mock.ExpectQuery(query).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"id", "project_code", "name", "department", "manager", "delivery_date", "status", "description", "created_at", "updated_at", "count"}).
+		AddRow(id, "PRJ-1", "Proj", nil, nil, nil, "ACTIVE", nil, now, now, 0))
 
 	p, err := repo.Get(context.Background(), id)
 	require.NoError(t, err)
@@ -69,8 +69,8 @@ func TestProjectRepository_Create(t *testing.T) {
 
 	p := &model.Project{ID: uuid.NewString(), ProjectCode: "PRJ-1", Name: "Proj", CreatedAt: dbtime.DBTime{Time: time.Now()}, UpdatedAt: dbtime.DBTime{Time: time.Now()}}
 
-	query := regexp.QuoteMeta("INSERT INTO projects (id, project_code, name, department, manager, delivery_date, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)")
-	mock.ExpectExec(query).WithArgs(p.ID, p.ProjectCode, p.Name, p.Department, p.Manager, p.DeliveryDate, p.Description, p.CreatedAt, p.UpdatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+	query := regexp.QuoteMeta("INSERT INTO projects (id, project_code, name, department, manager, delivery_date, status, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	mock.ExpectExec(query).WithArgs(p.ID, p.ProjectCode, p.Name, p.Department, p.Manager, p.DeliveryDate, p.Status, p.Description, p.CreatedAt, p.UpdatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err = repo.Create(context.Background(), p)
 	require.NoError(t, err)
@@ -86,8 +86,8 @@ func TestProjectRepository_Update(t *testing.T) {
 
 	p := &model.Project{ID: uuid.NewString(), Name: "Proj", UpdatedAt: dbtime.DBTime{Time: time.Now()}}
 
-	query := regexp.QuoteMeta("UPDATE projects SET name = ?, department = ?, manager = ?, delivery_date = ?, description = ?, updated_at = ? WHERE id = ?")
-	mock.ExpectExec(query).WithArgs(p.Name, p.Department, p.Manager, p.DeliveryDate, p.Description, p.UpdatedAt, p.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	query := regexp.QuoteMeta("UPDATE projects SET name = ?, department = ?, manager = ?, delivery_date = ?, status = ?, description = ?, updated_at = ? WHERE id = ?")
+	mock.ExpectExec(query).WithArgs(p.Name, p.Department, p.Manager, p.DeliveryDate, p.Status, p.Description, p.UpdatedAt, p.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err = repo.Update(context.Background(), p)
 	require.NoError(t, err)
@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
 
 	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
 
@@ -13,7 +16,7 @@ import (
 
 // ProjectUsageRepository は domrepo.ProjectUsageRepository の実装。
 type ProjectUsageRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 var _ domrepo.ProjectUsageRepository = (*ProjectUsageRepository)(nil)
@@ -69,6 +72,21 @@ func (r *ProjectUsageRepository) Search(ctx context.Context, f domrepo.ProjectUs
 	return usages, total, rows.Err()
 }
 
+// FindByVersion はプロジェクト内で指定バージョンを参照する利用情報を返す。
+func (r *ProjectUsageRepository) FindByVersion(ctx context.Context, projectID, ossVersionID string) (*model.ProjectUsage, error) {
+	row := r.DB.QueryRowContext(ctx, `SELECT id, project_id, oss_id, oss_version_id, usage_role, scope_status, inclusion_note, direct_dependency, added_at, evaluated_at, evaluated_by FROM project_usages WHERE project_id = ? AND oss_version_id = ? ORDER BY added_at LIMIT 1`, projectID, ossVersionID)
+	var u model.ProjectUsage
+	var note, evalBy sql.NullString
+	var evalAt sql.NullTime
+	if err := row.Scan(&u.ID, &u.ProjectID, &u.OssID, &u.OssVersionID, &u.UsageRole, &u.ScopeStatus, &note, &u.DirectDependency, &u.AddedAt, &evalAt, &evalBy); err != nil {
+		return nil, err
+	}
+	u.InclusionNote = strPtr(note)
+	u.EvaluatedAt = timePtr(evalAt)
+	u.EvaluatedBy = strPtr(evalBy)
+	return &u, nil
+}
+
 // Create は新しい利用情報を登録する。
 func (r *ProjectUsageRepository) Create(ctx context.Context, u *model.ProjectUsage) error {
 	query := `INSERT INTO project_usages (id, project_id, oss_id, oss_version_id, usage_role, scope_status, inclusion_note, direct_dependency, added_at, evaluated_at, evaluated_by) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
@@ -95,3 +113,97 @@ func (r *ProjectUsageRepository) UpdateScope(ctx context.Context, id string, sco
 	_, err := r.DB.ExecContext(ctx, query, scopeStatus, inclusionNote, evaluatedAt, evaluatedBy, id)
 	return err
 }
+
+// ListDetails はプロジェクトの利用情報をコンポーネント名・バージョン順で取得する。
+func (r *ProjectUsageRepository) ListDetails(ctx context.Context, projectID string, scopes []string) ([]model.ProjectUsageDetail, error) {
+	var args []any
+	wheres := []string{"u.project_id = ?"}
+	args = append(args, projectID)
+	if len(scopes) > 0 {
+		placeholders := make([]string, len(scopes))
+		for i, s := range scopes {
+			placeholders[i] = "?"
+			args = append(args, s)
+		}
+		wheres = append(wheres, fmt.Sprintf("u.scope_status IN (%s)", strings.Join(placeholders, ",")))
+	}
+	whereSQL := whereClause(wheres)
+
+	query := fmt.Sprintf(`SELECT u.id, u.project_id, u.oss_id, u.oss_version_id, u.usage_role, u.scope_status, u.inclusion_note, u.direct_dependency, u.added_at, u.evaluated_at, u.evaluated_by, c.name, c.normalized_name, c.homepage_url, c.repository_url, c.description, c.primary_language, v.version, v.release_date, v.license_expression_raw, v.license_concluded, v.purl, v.cpe_list, v.hash_sha256, v.hash_sha512, v.modified, v.modification_description, v.review_status, v.last_reviewed_at, v.scope_status, v.supplier_type, v.fork_origin_url, v.copyright_text, v.created_at, v.updated_at FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id %s ORDER BY c.normalized_name, v.version`, whereSQL)
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var details []model.ProjectUsageDetail
+	for rows.Next() {
+		var d model.ProjectUsageDetail
+		u := &d.Usage
+		c := &d.Component
+		v := &d.Version
+		var note, evalBy sql.NullString
+		var evalAt sql.NullTime
+		var homepage, repo, desc, lang sql.NullString
+		var releaseDate, lastReviewed sql.NullTime
+		var licenseRaw, licenseConc, purl, hash, hash512 sql.NullString
+		var modDesc, supplier, fork, copyright sql.NullString
+		var cpeList pq.StringArray
+		if err := rows.Scan(
+			&u.ID, &u.ProjectID, &u.OssID, &u.OssVersionID, &u.UsageRole, &u.ScopeStatus, &note, &u.DirectDependency, &u.AddedAt, &evalAt, &evalBy,
+			&c.Name, &c.NormalizedName, &homepage, &repo, &desc, &lang,
+			&v.Version, &releaseDate, &licenseRaw, &licenseConc, &purl, &cpeList, &hash, &hash512, &v.Modified, &modDesc, &v.ReviewStatus, &lastReviewed, &v.ScopeStatus, &supplier, &fork, &copyright, &v.CreatedAt, &v.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		u.InclusionNote = strPtr(note)
+		u.EvaluatedAt = timePtr(evalAt)
+		u.EvaluatedBy = strPtr(evalBy)
+		c.ID = u.OssID
+		c.HomepageURL = strPtr(homepage)
+		c.RepositoryURL = strPtr(repo)
+		c.Description = strPtr(desc)
+		c.PrimaryLanguage = strPtr(lang)
+		v.ID = u.OssVersionID
+		v.OssID = u.OssID
+		v.ReleaseDate = timePtr(releaseDate)
+		v.LicenseExpressionRaw = strPtr(licenseRaw)
+		v.LicenseConcluded = strPtr(licenseConc)
+		v.Purl = strPtr(purl)
+		v.CpeList = []string(cpeList)
+		v.HashSha256 = strPtr(hash)
+		v.HashSha512 = strPtr(hash512)
+		v.ModificationDescription = strPtr(modDesc)
+		v.LastReviewedAt = timePtr(lastReviewed)
+		v.SupplierType = strPtr(supplier)
+		v.ForkOriginURL = strPtr(fork)
+		v.CopyrightText = strPtr(copyright)
+		details = append(details, d)
+	}
+	return details, rows.Err()
+}
+
+// AddDependency は利用情報間の依存関係を登録する。登録済みの場合は何もしない。
+func (r *ProjectUsageRepository) AddDependency(ctx context.Context, usageID, dependsOnUsageID string) error {
+	_, err := r.DB.ExecContext(ctx, `INSERT INTO project_usage_dependencies (usage_id, depends_on_usage_id) VALUES (?, ?) ON CONFLICT DO NOTHING`, usageID, dependsOnUsageID)
+	return err
+}
+
+// ListDependencies はプロジェクトの利用情報間の依存関係を依存元・依存先の利用 ID 順で返す。
+func (r *ProjectUsageRepository) ListDependencies(ctx context.Context, projectID string) (map[string][]string, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT d.usage_id, d.depends_on_usage_id FROM project_usage_dependencies d JOIN project_usages u ON u.id = d.usage_id WHERE u.project_id = ? ORDER BY d.usage_id, d.depends_on_usage_id`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deps := map[string][]string{}
+	for rows.Next() {
+		var from, to string
+		if err := rows.Scan(&from, &to); err != nil {
+			return nil, err
+		}
+		deps[from] = append(deps[from], to)
+	}
+	return deps, rows.Err()
+}
@@ -172,3 +172,77 @@ func TestProjectUsageRepository_UpdateScope(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestProjectUsageRepository_ListDetails(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ProjectUsageRepository{DB: db}
+
+	pid := uuid.NewString()
+	ossID := uuid.NewString()
+	verID := uuid.NewString()
+	now := dbtime.DBTime{Time: time.Now()}
+	query := regexp.QuoteMeta("SELECT u.id, u.project_id, u.oss_id, u.oss_version_id, u.usage_role, u.scope_status, u.inclusion_note, u.direct_dependency, u.added_at, u.evaluated_at, u.evaluated_by, c.name, c.normalized_name, c.homepage_url, c.repository_url, c.description, c.primary_language, v.version, v.release_date, v.license_expression_raw, v.license_concluded, v.purl, v.cpe_list, v.hash_sha256, v.hash_sha512, v.modified, v.modification_description, v.review_status, v.last_reviewed_at, v.scope_status, v.supplier_type, v.fork_origin_url, v.copyright_text, v.created_at, v.updated_at FROM project_usages u JOIN oss_components c ON c.id = u.oss_id JOIN oss_versions v ON v.id = u.oss_version_id WHERE u.project_id = ? AND u.scope_status IN (?,?) ORDER BY c.normalized_name, v.version")
+	rows := sqlmock.NewRows([]string{"id", "project_id", "oss_id", "oss_version_id", "usage_role", "scope_status", "inclusion_note", "direct_dependency", "added_at", "evaluated_at", "evaluated_by", "name", "normalized_name", "homepage_url", "repository_url", "description", "primary_language", "version", "release_date", "license_expression_raw", "license_concluded", "purl", "cpe_list", "hash_sha256", "hash_sha512", "modified", "modification_description", "review_status", "last_reviewed_at", "v_scope_status", "supplier_type", "fork_origin_url", "copyright_text", "created_at", "updated_at"}).
+		AddRow(uuid.NewString(), pid, ossID, verID, "STATIC_LINK", "IN_SCOPE", nil, true, now, nil, nil,
+			"zlib", "zlib", "https://zlib.net", nil, nil, "C",
+			"1.3", nil, "Zlib", "Zlib", "pkg:generic/zlib@1.3", "{cpe:2.3:a:zlib:zlib:1.3:*:*:*:*:*:*:*}", nil, nil, true, "patched", "verified", nil, "IN_SCOPE", "INTERNAL_FORK", "https://github.com/madler/zlib", "Copyright (C) 1995-2023 Jean-loup Gailly and Mark Adler", now, now)
+	mock.ExpectQuery(query).WithArgs(pid, "IN_SCOPE", "REVIEW_NEEDED").WillReturnRows(rows)
+
+	res, err := repo.ListDetails(context.Background(), pid, []string{"IN_SCOPE", "REVIEW_NEEDED"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	d := res[0]
+	require.Equal(t, ossID, d.Component.ID)
+	require.Equal(t, "zlib", d.Component.Name)
+	require.Equal(t, verID, d.Version.ID)
+	require.Equal(t, ossID, d.Version.OssID)
+	require.Equal(t, []string{"cpe:2.3:a:zlib:zlib:1.3:*:*:*:*:*:*:*"}, d.Version.CpeList)
+	require.Equal(t, "Copyright (C) 1995-2023 Jean-loup Gailly and Mark Adler", *d.Version.CopyrightText)
+	require.Equal(t, "INTERNAL_FORK", *d.Version.SupplierType)
+	require.True(t, d.Version.Modified)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProjectUsageRepository_FindByVersion(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ProjectUsageRepository{DB: db}
+
+	projectID, versionID := uuid.NewString(), uuid.NewString()
+	query := regexp.QuoteMeta("SELECT id, project_id, oss_id, oss_version_id, usage_role, scope_status, inclusion_note, direct_dependency, added_at, evaluated_at, evaluated_by FROM project_usages WHERE project_id = ? AND oss_version_id = ? ORDER BY added_at LIMIT 1")
+	now := dbtime.DBTime{Time: time.Now()}
+	mock.ExpectQuery(query).WithArgs(projectID, versionID).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id", "oss_id", "oss_version_id", "usage_role", "scope_status", "inclusion_note", "direct_dependency", "added_at", "evaluated_at", "evaluated_by"}).
+		AddRow(uuid.NewString(), projectID, uuid.NewString(), versionID, "RUNTIME_REQUIRED", "IN_SCOPE", nil, true, now, nil, nil))
+
+	u, err := repo.FindByVersion(context.Background(), projectID, versionID)
+	require.NoError(t, err)
+	require.Equal(t, versionID, u.OssVersionID)
+	require.True(t, u.DirectDependency)
+	require.Nil(t, u.InclusionNote)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProjectUsageRepository_Dependencies(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &ProjectUsageRepository{DB: db}
+
+	pid, u1, u2, u3 := uuid.NewString(), uuid.NewString(), uuid.NewString(), uuid.NewString()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO project_usage_dependencies (usage_id, depends_on_usage_id) VALUES (?, ?) ON CONFLICT DO NOTHING")).
+		WithArgs(u1, u2).WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, repo.AddDependency(context.Background(), u1, u2))
+
+	query := regexp.QuoteMeta("SELECT d.usage_id, d.depends_on_usage_id FROM project_usage_dependencies d JOIN project_usages u ON u.id = d.usage_id WHERE u.project_id = ? ORDER BY d.usage_id, d.depends_on_usage_id")
+	mock.ExpectQuery(query).WithArgs(pid).WillReturnRows(sqlmock.NewRows([]string{"usage_id", "depends_on_usage_id"}).AddRow(u1, u2).AddRow(u1, u3))
+	deps, err := repo.ListDependencies(context.Background(), pid)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{u1: {u2, u3}}, deps)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,7 +2,6 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 
 	"github.com/ramsesyok/oss-catalog/internal/domain/model"
 	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
@@ -10,7 +9,7 @@ import (
 
 // ScopePolicyRepository は domrepo.ScopePolicyRepository の実装。
 type ScopePolicyRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 var _ domrepo.ScopePolicyRepository = (*ScopePolicyRepository)(nil)
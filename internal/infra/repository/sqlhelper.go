@@ -1,12 +1,41 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"strings"
 
 	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
 )
 
+// DBTX は *sql.DB と *sql.Tx に共通するクエリ実行メソッドを表す。
+// トランザクション内で利用するリポジトリは DB にこの型を用いる。
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// withTx は db が *sql.DB の場合は新しいトランザクションで fn を実行し、成功時にコミットする。
+// db が既にトランザクションの場合はそのまま fn を実行し、コミットは呼び出し元に委ねる。
+func withTx(ctx context.Context, db DBTX, fn func(tx DBTX) error) error {
+	beginner, ok := db.(interface {
+		BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+	})
+	if !ok {
+		return fn(db)
+	}
+	tx, err := beginner.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
 // whereClause は条件句の配列から WHERE 句文字列を生成する。
 func whereClause(wheres []string) string {
 	if len(wheres) == 0 {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -25,12 +26,16 @@ func setupSQLiteDB(t *testing.T) *sql.DB {
 	db, err := sql.Open("sqlite3", "file:test?mode=memory&cache=shared&_loc=auto")
 	require.NoError(t, err)
 	_, file, _, _ := runtime.Caller(0)
-	path := filepath.Join(filepath.Dir(file), "..", "..", "..", "migrations", "0001_create_tables.up.sql")
-	sqlBytes, err := os.ReadFile(path)
-	require.NoError(t, err)
-	sqlStr := strings.ReplaceAll(string(sqlBytes), "TIMESTAMPTZ", "TIMESTAMP")
-	_, err = db.Exec(sqlStr)
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(file), "..", "..", "..", "migrations", "*.up.sql"))
 	require.NoError(t, err)
+	sort.Strings(paths)
+	for _, path := range paths {
+		sqlBytes, err := os.ReadFile(path)
+		require.NoError(t, err)
+		sqlStr := strings.ReplaceAll(string(sqlBytes), "TIMESTAMPTZ", "TIMESTAMP")
+		_, err = db.Exec(sqlStr)
+		require.NoError(t, err)
+	}
 	return db
 }
 
@@ -200,6 +205,15 @@ func TestRepositories_SQLite(t *testing.T) {
 		require.Equal(t, 1, total)
 		require.Equal(t, usage.ID, res[0].ID)
 
+		details, err := usageRepo.ListDetails(ctx, proj.ID, []string{"IN_SCOPE"})
+		require.NoError(t, err)
+		require.Len(t, details, 1)
+		require.Equal(t, "Redis", details[0].Component.Name)
+		require.Equal(t, "1.0.0", details[0].Version.Version)
+		details, err = usageRepo.ListDetails(ctx, proj.ID, []string{"OUT_SCOPE"})
+		require.NoError(t, err)
+		require.Len(t, details, 0)
+
 		usage.UsageRole = "DEV_TOOL"
 		require.NoError(t, usageRepo.Update(ctx, usage))
 
@@ -211,6 +225,336 @@ func TestRepositories_SQLite(t *testing.T) {
 		require.NoError(t, usageRepo.Delete(ctx, usage.ID))
 	})
 
+	t.Run("ExportJobRepository", func(t *testing.T) {
+		db := setupSQLiteDB(t)
+		defer db.Close()
+		projRepo := &ProjectRepository{DB: db}
+		repo := &ExportJobRepository{DB: db}
+
+		now := dbtime.DBTime{Time: time.Now()}
+		proj := &model.Project{ID: uuid.NewString(), ProjectCode: "P1", Name: "Proj", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, projRepo.Create(ctx, proj))
+
+		job := &model.ExportJob{ID: uuid.NewString(), ProjectID: proj.ID, Format: "csv", Scopes: []string{"IN_SCOPE"}, Status: model.ExportJobQueued, CreatedBy: "tester", CreatedAt: now}
+		require.NoError(t, repo.Create(ctx, job))
+		queued, err := repo.ListByStatus(ctx, []string{model.ExportJobQueued, model.ExportJobRunning})
+		require.NoError(t, err)
+		require.Len(t, queued, 1)
+		require.Equal(t, []string{"IN_SCOPE"}, queued[0].Scopes)
+
+		path := "/tmp/x.csv"
+		size := int64(10)
+		expires := dbtime.DBTime{Time: time.Now().Add(-time.Minute)}
+		job.Status = model.ExportJobSucceeded
+		job.Progress = 100
+		job.FilePath = &path
+		job.FileSize = &size
+		job.FinishedAt = &now
+		job.ExpiresAt = &expires
+		require.NoError(t, repo.Update(ctx, job))
+
+		got, err := repo.Get(ctx, job.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ExportJobSucceeded, got.Status)
+		require.Equal(t, int64(10), *got.FileSize)
+		expired, err := repo.ListExpired(ctx, time.Now())
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		expired, err = repo.ListExpired(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Len(t, expired, 0)
+	})
+
+	t.Run("ExportTemplateRepository", func(t *testing.T) {
+		db := setupSQLiteDB(t)
+		defer db.Close()
+		repo := &ExportTemplateRepository{DB: db}
+
+		now := dbtime.DBTime{Time: time.Now()}
+		tpl := &model.ExportTemplate{ID: uuid.NewString(), Name: "customer-a", Engine: model.ExportTemplateText, FileExtension: "txt", Body: "{{.Project.Code}}", UpdatedBy: "admin", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, repo.Create(ctx, tpl))
+
+		desc := "A 社向け"
+		tpl.Description = &desc
+		tpl.Body = "{{.Project.Name}}"
+		require.NoError(t, repo.Update(ctx, tpl))
+
+		got, err := repo.FindByName(ctx, "customer-a")
+		require.NoError(t, err)
+		require.Equal(t, "{{.Project.Name}}", got.Body)
+		require.Equal(t, desc, *got.Description)
+		list, err := repo.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+
+		require.NoError(t, repo.Delete(ctx, tpl.ID))
+		_, err = repo.Get(ctx, tpl.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("LicenseRepository", func(t *testing.T) {
+		db := setupSQLiteDB(t)
+		defer db.Close()
+		repo := &LicenseRepository{DB: db}
+
+		now := dbtime.DBTime{Time: time.Now()}
+		permissive, text := "PERMISSIVE", "MIT License ..."
+		seed := []model.License{
+			{ID: "MIT", Name: "MIT License", OsiApproved: true, FsfLibre: true, Category: &permissive, Text: &text, CreatedAt: now, UpdatedAt: now},
+			{ID: "Beerware", Name: "Beerware License", CreatedAt: now, UpdatedAt: now},
+		}
+		require.NoError(t, repo.Seed(ctx, seed))
+
+		// 登録済みの項目は再投入で上書きしない
+		edited := "edited"
+		mit, err := repo.Get(ctx, "MIT")
+		require.NoError(t, err)
+		mit.Text = &edited
+		require.NoError(t, repo.Update(ctx, mit))
+		require.NoError(t, repo.Seed(ctx, seed))
+		mit, err = repo.Get(ctx, "MIT")
+		require.NoError(t, err)
+		require.Equal(t, edited, *mit.Text)
+		require.Equal(t, permissive, *mit.Category)
+
+		// 本文が未登録の項目は同梱の本文が追加されると補う
+		beerText := "Beerware ..."
+		seed[1].Text = &beerText
+		require.NoError(t, repo.Seed(ctx, seed))
+		beer, err := repo.Get(ctx, "Beerware")
+		require.NoError(t, err)
+		require.Equal(t, beerText, *beer.Text)
+		require.True(t, beer.HasText)
+
+		custom := &model.License{ID: "LicenseRef-acme", Name: "ACME License", Custom: true, CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, repo.Create(ctx, custom))
+
+		list, total, err := repo.Search(ctx, domrepo.LicenseFilter{Query: "LICENSE", Page: 1, Size: 2})
+		require.NoError(t, err)
+		require.Equal(t, 3, total)
+		require.Len(t, list, 2)
+		require.Equal(t, "Beerware", list[0].ID)
+		require.Nil(t, list[0].Text)
+		require.True(t, list[0].HasText)
+		require.False(t, list[1].HasText)
+		noText := false
+		list, total, err = repo.Search(ctx, domrepo.LicenseFilter{HasText: &noText, Page: 1, Size: 10})
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		require.Equal(t, "LicenseRef-acme", list[0].ID)
+		isCustom := true
+		list, total, err = repo.Search(ctx, domrepo.LicenseFilter{Custom: &isCustom, Page: 1, Size: 10})
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		require.Equal(t, "LicenseRef-acme", list[0].ID)
+		_, total, err = repo.Search(ctx, domrepo.LicenseFilter{Category: permissive, Page: 1, Size: 10})
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+
+		require.NoError(t, repo.Delete(ctx, custom.ID))
+		_, err = repo.Get(ctx, custom.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("LicensePolicyRuleRepository", func(t *testing.T) {
+		db := setupSQLiteDB(t)
+		defer db.Close()
+		repo := &LicensePolicyRuleRepository{DB: db}
+
+		now := dbtime.DBTime{Time: time.Now()}
+		rule := &model.LicensePolicyRule{ID: uuid.NewString(), Name: "deny-agpl", Severity: model.PolicySeverityDeny, Licenses: []string{"AGPL-*"}, ScopeStatuses: []string{"IN_SCOPE"}, Enabled: true, UpdatedBy: "admin", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, repo.Create(ctx, rule))
+
+		rule.Categories = []string{"NETWORK_COPYLEFT"}
+		rule.Enabled = false
+		require.NoError(t, repo.Update(ctx, rule))
+		got, err := repo.FindByName(ctx, "deny-agpl")
+		require.NoError(t, err)
+		require.Equal(t, []string{"AGPL-*"}, got.Licenses)
+		require.Equal(t, []string{"NETWORK_COPYLEFT"}, got.Categories)
+		require.Empty(t, got.UsageRoles)
+		require.False(t, got.Enabled)
+
+		require.NoError(t, repo.Delete(ctx, rule.ID))
+		list, err := repo.List(ctx)
+		require.NoError(t, err)
+		require.Empty(t, list)
+	})
+
+	t.Run("ProjectObligationRepository", func(t *testing.T) {
+		db := setupSQLiteDB(t)
+		defer db.Close()
+		compRepo := &OssComponentRepository{DB: db}
+		verRepo := &OssVersionRepository{DB: db}
+		projRepo := &ProjectRepository{DB: db}
+		usageRepo := &ProjectUsageRepository{DB: db}
+		repo := &ProjectObligationRepository{DB: db}
+
+		now := dbtime.DBTime{Time: time.Now()}
+		comp := &model.OssComponent{ID: uuid.NewString(), Name: "zlib", NormalizedName: "zlib", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, compRepo.Create(ctx, comp))
+		ver := &model.OssVersion{ID: uuid.NewString(), OssID: comp.ID, Version: "1.3", ReviewStatus: "draft", ScopeStatus: "IN_SCOPE", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, verRepo.Create(ctx, ver))
+		proj := &model.Project{ID: uuid.NewString(), ProjectCode: "P1", Name: "Proj", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, projRepo.Create(ctx, proj))
+		usage := &model.ProjectUsage{ID: uuid.NewString(), ProjectID: proj.ID, OssID: comp.ID, OssVersionID: ver.ID, UsageRole: "STATIC_LINK", ScopeStatus: "IN_SCOPE", AddedAt: now}
+		require.NoError(t, usageRepo.Create(ctx, usage))
+
+		derived := []model.ProjectObligation{
+			{ID: uuid.NewString(), UsageID: usage.ID, License: "Zlib", Obligation: "LICENSE_TEXT", Description: "d", Status: model.ObligationOpen, CreatedAt: now, UpdatedAt: now},
+			{ID: uuid.NewString(), UsageID: usage.ID, License: "Zlib", Obligation: "STATE_CHANGES", Description: "d", Status: model.ObligationOpen, CreatedAt: now, UpdatedAt: now},
+		}
+		added, removed, err := repo.Sync(ctx, proj.ID, derived)
+		require.NoError(t, err)
+		require.Equal(t, 2, added)
+		require.Equal(t, 0, removed)
+
+		d, err := repo.Get(ctx, derived[0].ID)
+		require.NoError(t, err)
+		require.Equal(t, "zlib", d.ComponentName)
+		o := &d.Obligation
+		note := "NOTICE に掲載"
+		user := "tester"
+		o.Status = model.ObligationFulfilled
+		o.EvidenceNote = &note
+		o.ClosedBy = &user
+		o.ClosedAt = &now
+		require.NoError(t, repo.Update(ctx, o))
+
+		// 導出されなくなった OPEN の義務のみ削除する
+		added, removed, err = repo.Sync(ctx, proj.ID, nil)
+		require.NoError(t, err)
+		require.Equal(t, 0, added)
+		require.Equal(t, 1, removed)
+
+		list, err := repo.ListDetails(ctx, proj.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, "zlib", list[0].ComponentName)
+		require.Equal(t, "1.3", list[0].Version)
+		require.Equal(t, "STATIC_LINK", list[0].UsageRole)
+		require.Equal(t, model.ObligationFulfilled, list[0].Obligation.Status)
+		require.Equal(t, "tester", *list[0].Obligation.ClosedBy)
+	})
+
+	t.Run("VulnerabilityRepository", func(t *testing.T) {
+		db := setupSQLiteDB(t)
+		defer db.Close()
+		repo := &VulnerabilityRepository{DB: db}
+
+		now := dbtime.DBTime{Time: time.Now()}
+		score := 9.8
+		severity := "CRITICAL"
+		v := &model.Vulnerability{ID: "CVE-2023-0001", Source: model.VulnerabilitySourceNVD, Severity: &severity, CvssScore: &score, Weaknesses: []string{"CWE-787"}, PublishedAt: &now, CreatedAt: now, UpdatedAt: now}
+		end := "1.3.1"
+		matches := []model.CpeMatch{{Criteria: "cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", Vendor: "zlib", Product: "zlib", VersionEndExcluding: &end}}
+		metrics := []model.CvssMetric{
+			{Version: "2.0", Source: "nvd@nist.gov", Type: "Primary", BaseScore: 7.5, BaseSeverity: "HIGH", Vector: "AV:N/AC:L/Au:N/C:P/I:P/A:P"},
+			{Version: "3.1", Source: "nvd@nist.gov", Type: "Primary", BaseScore: 9.8, BaseSeverity: "CRITICAL", Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"},
+		}
+		created, err := repo.Upsert(ctx, v, metrics, matches, nil)
+		require.NoError(t, err)
+		require.True(t, created)
+
+		// 置き換え時は CVSS 評価・CPE 条件も入れ替える
+		created, err = repo.Upsert(ctx, v, metrics[1:], matches, nil)
+		require.NoError(t, err)
+		require.False(t, created)
+
+		got, err := repo.Get(ctx, v.ID)
+		require.NoError(t, err)
+		require.Equal(t, 9.8, *got.CvssScore)
+		require.Equal(t, []string{"CWE-787"}, got.Weaknesses)
+		require.NotNil(t, got.PublishedAt)
+		require.Nil(t, got.Description)
+
+		cvss, err := repo.ListCvss(ctx, v.ID)
+		require.NoError(t, err)
+		require.Len(t, cvss, 1)
+		require.Equal(t, "3.1", cvss[0].Version)
+
+		found, err := repo.FindCpeMatches(ctx, "zlib", "zlib")
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		require.Equal(t, "1.3.1", *found[0].VersionEndExcluding)
+		require.Nil(t, found[0].VersionStartIncluding)
+
+		list, err := repo.ListByIDs(ctx, []string{v.ID, "CVE-2000-0000"})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+
+		// OSV の脆弱性はバージョン範囲と別名を持つ
+		fixed := "1.3.1"
+		osv := &model.Vulnerability{ID: "GHSA-0000-0000-0001", Source: model.VulnerabilitySourceOSV, Aliases: []string{v.ID}, CreatedAt: now, UpdatedAt: now}
+		packages := []model.PackageRange{
+			{Ecosystem: "Debian:12", PackageName: "zlib", PackageKey: "deb/debian/zlib", RangeType: "ECOSYSTEM", Fixed: &fixed},
+			{Ecosystem: "Debian:12", PackageName: "zlib", PackageKey: "deb/debian/zlib", RangeType: "VERSIONS", Versions: []string{"1:1.2.13.dfsg-1"}},
+		}
+		_, err = repo.Upsert(ctx, osv, nil, nil, packages)
+		require.NoError(t, err)
+		_, err = repo.Upsert(ctx, osv, nil, nil, packages)
+		require.NoError(t, err)
+
+		ranges, err := repo.FindPackageRanges(ctx, "deb/debian/zlib")
+		require.NoError(t, err)
+		require.Len(t, ranges, 2)
+		require.Nil(t, ranges[0].Introduced)
+		require.Equal(t, "1.3.1", *ranges[0].Fixed)
+		require.Equal(t, []string{"1:1.2.13.dfsg-1"}, ranges[1].Versions)
+
+		aliases, err := repo.ListAliases(ctx, []string{v.ID})
+		require.NoError(t, err)
+		require.Equal(t, []model.VulnerabilityAlias{{VulnerabilityID: osv.ID, Alias: v.ID}}, aliases)
+	})
+
+	t.Run("VulnerabilityAnalysisRepository", func(t *testing.T) {
+		db := setupSQLiteDB(t)
+		defer db.Close()
+		compRepo := &OssComponentRepository{DB: db}
+		verRepo := &OssVersionRepository{DB: db}
+		projRepo := &ProjectRepository{DB: db}
+		usageRepo := &ProjectUsageRepository{DB: db}
+		vulnRepo := &VulnerabilityRepository{DB: db}
+		repo := &VulnerabilityAnalysisRepository{DB: db}
+
+		now := dbtime.DBTime{Time: time.Now()}
+		comp := &model.OssComponent{ID: uuid.NewString(), Name: "zlib", NormalizedName: "zlib", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, compRepo.Create(ctx, comp))
+		ver := &model.OssVersion{ID: uuid.NewString(), OssID: comp.ID, Version: "1.2.11", ReviewStatus: "draft", ScopeStatus: "IN_SCOPE", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, verRepo.Create(ctx, ver))
+		proj := &model.Project{ID: uuid.NewString(), ProjectCode: "P1", Name: "Proj", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, projRepo.Create(ctx, proj))
+		usage := &model.ProjectUsage{ID: uuid.NewString(), ProjectID: proj.ID, OssID: comp.ID, OssVersionID: ver.ID, UsageRole: "STATIC_LINK", ScopeStatus: "IN_SCOPE", AddedAt: now}
+		require.NoError(t, usageRepo.Create(ctx, usage))
+		_, err := vulnRepo.Upsert(ctx, &model.Vulnerability{ID: "CVE-2022-37434", Source: model.VulnerabilitySourceNVD, CreatedAt: now, UpdatedAt: now}, nil, nil, nil)
+		require.NoError(t, err)
+
+		justification := model.JustificationVulnerableCodeNotInExecutePath
+		a := &model.VulnerabilityAnalysis{ID: uuid.NewString(), ProjectID: proj.ID, UsageID: usage.ID, VulnerabilityID: "CVE-2022-37434", State: model.AnalysisUnderInvestigation, UpdatedBy: "tester", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, repo.Create(ctx, a))
+		// 利用と脆弱性の組で 1 件のみ
+		dup := *a
+		dup.ID = uuid.NewString()
+		require.Error(t, repo.Create(ctx, &dup))
+
+		a.State = model.AnalysisNotAffected
+		a.Justification = &justification
+		require.NoError(t, repo.Update(ctx, a))
+		got, err := repo.Find(ctx, usage.ID, "CVE-2022-37434")
+		require.NoError(t, err)
+		require.Equal(t, model.AnalysisNotAffected, got.State)
+		require.Equal(t, justification, *got.Justification)
+		require.Nil(t, got.Response)
+
+		list, err := repo.ListByProject(ctx, proj.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+
+		require.NoError(t, repo.Delete(ctx, a.ID))
+		_, err = repo.Find(ctx, usage.ID, "CVE-2022-37434")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
 	t.Run("ScopePolicyRepository", func(t *testing.T) {
 		db := setupSQLiteDB(t)
 		defer db.Close()
@@ -235,4 +579,58 @@ func TestRepositories_SQLite(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, logs, 1)
 	})
+
+	t.Run("ImportSessionRepository", func(t *testing.T) {
+		db := setupSQLiteDB(t)
+		defer db.Close()
+		projRepo := &ProjectRepository{DB: db}
+		repo := &ImportSessionRepository{DB: db}
+
+		now := dbtime.DBTime{Time: time.Now()}
+		proj := &model.Project{ID: uuid.NewString(), ProjectCode: "P1", Name: "Proj", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, projRepo.Create(ctx, proj))
+
+		sess := &model.ImportSession{ID: uuid.NewString(), ProjectID: proj.ID, Format: "spdx-json", Status: model.ImportSessionOpen, CreatedBy: "user", CreatedAt: now}
+		purl := "pkg:npm/left-pad@1.3.0"
+		items := []model.ImportSessionItem{
+			{ID: uuid.NewString(), SessionID: sess.ID, Seq: 2, Ref: "b", Name: "lodash", Version: "4.17.21", Proposal: model.ImportProposalNewComponent, Decision: model.ImportDecisionPending},
+			{ID: uuid.NewString(), SessionID: sess.ID, Seq: 1, Ref: "a", Name: "left-pad", Version: "1.3.0", Purl: &purl, CpeList: []string{"cpe:2.3:a:left-pad"}, DirectDependency: true, Proposal: model.ImportProposalNewComponent, Decision: model.ImportDecisionPending},
+		}
+		require.NoError(t, repo.Create(ctx, sess, items))
+
+		list, err := repo.ListByProject(ctx, proj.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+
+		got, err := repo.ListItems(ctx, sess.ID)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		require.Equal(t, "left-pad", got[0].Name)
+		require.Equal(t, purl, *got[0].Purl)
+		require.Equal(t, []string{"cpe:2.3:a:left-pad"}, got[0].CpeList)
+
+		it := got[1]
+		result := "SKIPPED"
+		it.Decision = model.ImportDecisionRejected
+		it.Result = &result
+		require.NoError(t, repo.UpdateItem(ctx, &it))
+		item, err := repo.GetItem(ctx, sess.ID, it.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ImportDecisionRejected, item.Decision)
+		require.Equal(t, "SKIPPED", *item.Result)
+
+		user := "reviewer"
+		sess.Status = model.ImportSessionCommitted
+		sess.CommittedBy = &user
+		sess.CommittedAt = &now
+		require.NoError(t, repo.Update(ctx, sess))
+		s, err := repo.Get(ctx, sess.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ImportSessionCommitted, s.Status)
+		require.Equal(t, "reviewer", *s.CommittedBy)
+
+		require.NoError(t, repo.Delete(ctx, sess.ID))
+		_, err = repo.Get(ctx, sess.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
 }
@@ -2,7 +2,6 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 
 	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
 
@@ -12,7 +11,7 @@ import (
 
 // TagRepository は domrepo.TagRepository の実装。
 type TagRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 var _ domrepo.TagRepository = (*TagRepository)(nil)
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// VulnerabilityAnalysisRepository は domrepo.VulnerabilityAnalysisRepository の実装。
+type VulnerabilityAnalysisRepository struct {
+	DB DBTX
+}
+
+var _ domrepo.VulnerabilityAnalysisRepository = (*VulnerabilityAnalysisRepository)(nil)
+
+const vulnerabilityAnalysisColumns = "id, project_id, usage_id, vulnerability_id, state, justification, response, note, updated_by, created_at, updated_at"
+
+// ListByProject はプロジェクトの分析を利用・脆弱性 ID 順で返す。
+func (r *VulnerabilityAnalysisRepository) ListByProject(ctx context.Context, projectID string) ([]model.VulnerabilityAnalysis, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT `+vulnerabilityAnalysisColumns+` FROM vulnerability_analyses WHERE project_id = ? ORDER BY usage_id, vulnerability_id`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.VulnerabilityAnalysis
+	for rows.Next() {
+		a, err := scanVulnerabilityAnalysis(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *a)
+	}
+	return res, rows.Err()
+}
+
+// Find は利用と脆弱性の組で分析を取得する。
+func (r *VulnerabilityAnalysisRepository) Find(ctx context.Context, usageID, vulnerabilityID string) (*model.VulnerabilityAnalysis, error) {
+	return scanVulnerabilityAnalysis(r.DB.QueryRowContext(ctx, `SELECT `+vulnerabilityAnalysisColumns+` FROM vulnerability_analyses WHERE usage_id = ? AND vulnerability_id = ?`, usageID, vulnerabilityID))
+}
+
+// Create は分析を登録する。
+func (r *VulnerabilityAnalysisRepository) Create(ctx context.Context, a *model.VulnerabilityAnalysis) error {
+	_, err := r.DB.ExecContext(ctx,
+		`INSERT INTO vulnerability_analyses (`+vulnerabilityAnalysisColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		a.ID, a.ProjectID, a.UsageID, a.VulnerabilityID, a.State, a.Justification, a.Response, a.Note, a.UpdatedBy, a.CreatedAt, a.UpdatedAt,
+	)
+	return err
+}
+
+// Update は状態・根拠・対応方針・メモ・更新者を更新する。
+func (r *VulnerabilityAnalysisRepository) Update(ctx context.Context, a *model.VulnerabilityAnalysis) error {
+	_, err := r.DB.ExecContext(ctx,
+		`UPDATE vulnerability_analyses SET state = ?, justification = ?, response = ?, note = ?, updated_by = ?, updated_at = ? WHERE id = ?`,
+		a.State, a.Justification, a.Response, a.Note, a.UpdatedBy, a.UpdatedAt, a.ID,
+	)
+	return err
+}
+
+// Delete は分析を削除する。
+func (r *VulnerabilityAnalysisRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.DB.ExecContext(ctx, `DELETE FROM vulnerability_analyses WHERE id = ?`, id)
+	return err
+}
+
+func scanVulnerabilityAnalysis(s interface{ Scan(...any) error }) (*model.VulnerabilityAnalysis, error) {
+	var a model.VulnerabilityAnalysis
+	var justification, response, note sql.NullString
+	if err := s.Scan(&a.ID, &a.ProjectID, &a.UsageID, &a.VulnerabilityID, &a.State, &justification, &response, &note, &a.UpdatedBy, &a.CreatedAt, &a.UpdatedAt); err != nil {
+		return nil, err
+	}
+	a.Justification = strPtr(justification)
+	a.Response = strPtr(response)
+	a.Note = strPtr(note)
+	return &a, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+func TestVulnerabilityAnalysisRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &VulnerabilityAnalysisRepository{DB: db}
+	ctx := context.Background()
+	now := dbtime.DBTime{Time: time.Now()}
+	pid, uid := uuid.NewString(), uuid.NewString()
+	note := "zlib の inflateGetHeader は使用していない"
+	a := &model.VulnerabilityAnalysis{ID: uuid.NewString(), ProjectID: pid, UsageID: uid, VulnerabilityID: "CVE-2022-37434", State: model.AnalysisNotAffected, Note: &note, UpdatedBy: "tester", CreatedAt: now, UpdatedAt: now}
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerability_analyses (id, project_id, usage_id, vulnerability_id, state, justification, response, note, updated_by, created_at, updated_at) VALUES")).
+		WithArgs(a.ID, pid, uid, "CVE-2022-37434", "not_affected", nil, nil, note, "tester", now, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, repo.Create(ctx, a))
+
+	columns := []string{"id", "project_id", "usage_id", "vulnerability_id", "state", "justification", "response", "note", "updated_by", "created_at", "updated_at"}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_analyses WHERE usage_id = ? AND vulnerability_id = ?")).WithArgs(uid, "CVE-2022-37434").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(a.ID, pid, uid, "CVE-2022-37434", "not_affected", "vulnerable_code_not_in_execute_path", nil, note, "tester", now, now))
+	got, err := repo.Find(ctx, uid, "CVE-2022-37434")
+	require.NoError(t, err)
+	require.Equal(t, "vulnerable_code_not_in_execute_path", *got.Justification)
+	require.Nil(t, got.Response)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM vulnerability_analyses WHERE usage_id = ? AND vulnerability_id = ?")).WithArgs(uid, "CVE-2000-0000").WillReturnError(sql.ErrNoRows)
+	_, err = repo.Find(ctx, uid, "CVE-2000-0000")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
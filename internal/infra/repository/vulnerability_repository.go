@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	domrepo "github.com/ramsesyok/oss-catalog/internal/domain/repository"
+)
+
+// VulnerabilityRepository は domrepo.VulnerabilityRepository の実装。
+type VulnerabilityRepository struct {
+	DB DBTX
+}
+
+var _ domrepo.VulnerabilityRepository = (*VulnerabilityRepository)(nil)
+
+const vulnerabilityColumns = "id, source, description, status, severity, cvss_score, cvss_version, cvss_vector, weaknesses, reference_urls, published_at, last_modified_at, created_at, updated_at"
+
+const cpeMatchColumns = "vulnerability_id, criteria, vendor, product, version_start_including, version_start_excluding, version_end_including, version_end_excluding, match_criteria_id"
+
+const packageRangeColumns = "vulnerability_id, ecosystem, package_name, package_key, range_type, introduced, fixed, last_affected, versions"
+
+// Get は ID で脆弱性を取得する。
+func (r *VulnerabilityRepository) Get(ctx context.Context, id string) (*model.Vulnerability, error) {
+	return scanVulnerability(r.DB.QueryRowContext(ctx, `SELECT `+vulnerabilityColumns+` FROM vulnerabilities WHERE id = ?`, id))
+}
+
+// ListByIDs は ID が一致する脆弱性を ID 順で返す。
+func (r *VulnerabilityRepository) ListByIDs(ctx context.Context, ids []string) ([]model.Vulnerability, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	in, args := inClause(ids)
+	rows, err := r.DB.QueryContext(ctx, fmt.Sprintf(`SELECT %s FROM vulnerabilities WHERE id IN (%s) ORDER BY id`, vulnerabilityColumns, in), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.Vulnerability
+	for rows.Next() {
+		v, err := scanVulnerability(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *v)
+	}
+	return res, rows.Err()
+}
+
+// ListCvss は脆弱性の CVSS 評価を新しいバージョン順で返す。
+func (r *VulnerabilityRepository) ListCvss(ctx context.Context, id string) ([]model.CvssMetric, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT vulnerability_id, version, source, type, base_score, base_severity, vector FROM vulnerability_cvss WHERE vulnerability_id = ? ORDER BY version DESC, type, source`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.CvssMetric
+	for rows.Next() {
+		var m model.CvssMetric
+		if err := rows.Scan(&m.VulnerabilityID, &m.Version, &m.Source, &m.Type, &m.BaseScore, &m.BaseSeverity, &m.Vector); err != nil {
+			return nil, err
+		}
+		res = append(res, m)
+	}
+	return res, rows.Err()
+}
+
+// FindCpeMatches はベンダ名・製品名が一致する CPE 条件を返す。
+func (r *VulnerabilityRepository) FindCpeMatches(ctx context.Context, vendor, product string) ([]model.CpeMatch, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT `+cpeMatchColumns+` FROM vulnerability_cpe_matches WHERE vendor = ? AND product = ? ORDER BY vulnerability_id`, vendor, product)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.CpeMatch
+	for rows.Next() {
+		var m model.CpeMatch
+		var startIncl, startExcl, endIncl, endExcl, criteriaID sql.NullString
+		if err := rows.Scan(&m.VulnerabilityID, &m.Criteria, &m.Vendor, &m.Product, &startIncl, &startExcl, &endIncl, &endExcl, &criteriaID); err != nil {
+			return nil, err
+		}
+		m.VersionStartIncluding = strPtr(startIncl)
+		m.VersionStartExcluding = strPtr(startExcl)
+		m.VersionEndIncluding = strPtr(endIncl)
+		m.VersionEndExcluding = strPtr(endExcl)
+		m.MatchCriteriaID = strPtr(criteriaID)
+		res = append(res, m)
+	}
+	return res, rows.Err()
+}
+
+// FindPackageRanges は purl のキーが一致するバージョン範囲を返す。
+func (r *VulnerabilityRepository) FindPackageRanges(ctx context.Context, key string) ([]model.PackageRange, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT `+packageRangeColumns+` FROM vulnerability_package_ranges WHERE package_key = ? ORDER BY vulnerability_id`, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.PackageRange
+	for rows.Next() {
+		var p model.PackageRange
+		var introduced, fixed, lastAffected sql.NullString
+		var versions pq.StringArray
+		if err := rows.Scan(&p.VulnerabilityID, &p.Ecosystem, &p.PackageName, &p.PackageKey, &p.RangeType, &introduced, &fixed, &lastAffected, &versions); err != nil {
+			return nil, err
+		}
+		p.Introduced = strPtr(introduced)
+		p.Fixed = strPtr(fixed)
+		p.LastAffected = strPtr(lastAffected)
+		p.Versions = []string(versions)
+		res = append(res, p)
+	}
+	return res, rows.Err()
+}
+
+// ListAliases は ids の脆弱性の別名と、ids を別名に持つ脆弱性の組を返す。
+func (r *VulnerabilityRepository) ListAliases(ctx context.Context, ids []string) ([]model.VulnerabilityAlias, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	in, args := inClause(ids)
+	rows, err := r.DB.QueryContext(ctx, fmt.Sprintf(`SELECT vulnerability_id, alias FROM vulnerability_aliases WHERE vulnerability_id IN (%s) OR alias IN (%s) ORDER BY vulnerability_id, alias`, in, in), append(args, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.VulnerabilityAlias
+	for rows.Next() {
+		var a model.VulnerabilityAlias
+		if err := rows.Scan(&a.VulnerabilityID, &a.Alias); err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+	return res, rows.Err()
+}
+
+// Upsert は脆弱性を登録し、既に存在する場合は登録日時を残して置き換える。
+// CVSS 評価・CPE 条件・バージョン範囲・別名は削除して登録し直す。
+func (r *VulnerabilityRepository) Upsert(ctx context.Context, v *model.Vulnerability, metrics []model.CvssMetric, matches []model.CpeMatch, packages []model.PackageRange) (created bool, err error) {
+	err = withTx(ctx, r.DB, func(tx DBTX) error {
+		res, err := tx.ExecContext(ctx,
+			`UPDATE vulnerabilities SET source = ?, description = ?, status = ?, severity = ?, cvss_score = ?, cvss_version = ?, cvss_vector = ?, weaknesses = ?, reference_urls = ?, published_at = ?, last_modified_at = ?, updated_at = ? WHERE id = ?`,
+			v.Source, v.Description, v.Status, v.Severity, v.CvssScore, v.CvssVersion, v.CvssVector, pq.Array(v.Weaknesses), pq.Array(v.ReferenceURLs), v.PublishedAt, v.LastModifiedAt, v.UpdatedAt, v.ID,
+		)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			created = true
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO vulnerabilities (`+vulnerabilityColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				v.ID, v.Source, v.Description, v.Status, v.Severity, v.CvssScore, v.CvssVersion, v.CvssVector, pq.Array(v.Weaknesses), pq.Array(v.ReferenceURLs), v.PublishedAt, v.LastModifiedAt, v.CreatedAt, v.UpdatedAt,
+			); err != nil {
+				return err
+			}
+		} else {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM vulnerability_cvss WHERE vulnerability_id = ?`, v.ID); err != nil {
+				return err
+			}
+			for _, table := range []string{"vulnerability_cpe_matches", "vulnerability_package_ranges", "vulnerability_aliases"} {
+				if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE vulnerability_id = ?`, v.ID); err != nil {
+					return err
+				}
+			}
+		}
+		for _, m := range metrics {
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO vulnerability_cvss (vulnerability_id, version, source, type, base_score, base_severity, vector) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				v.ID, m.Version, m.Source, m.Type, m.BaseScore, m.BaseSeverity, m.Vector,
+			); err != nil {
+				return err
+			}
+		}
+		for _, m := range matches {
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO vulnerability_cpe_matches (`+cpeMatchColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				v.ID, m.Criteria, m.Vendor, m.Product, m.VersionStartIncluding, m.VersionStartExcluding, m.VersionEndIncluding, m.VersionEndExcluding, m.MatchCriteriaID,
+			); err != nil {
+				return err
+			}
+		}
+		for _, p := range packages {
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO vulnerability_package_ranges (`+packageRangeColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				v.ID, p.Ecosystem, p.PackageName, p.PackageKey, p.RangeType, p.Introduced, p.Fixed, p.LastAffected, pq.Array(p.Versions),
+			); err != nil {
+				return err
+			}
+		}
+		for _, a := range v.Aliases {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO vulnerability_aliases (vulnerability_id, alias) VALUES (?, ?)`, v.ID, a); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return created, err
+}
+
+// scanVulnerability は 1 行分の脆弱性を読み取る。
+func scanVulnerability(s interface{ Scan(...any) error }) (*model.Vulnerability, error) {
+	var v model.Vulnerability
+	var desc, status, severity, cvssVersion, cvssVector sql.NullString
+	var score sql.NullFloat64
+	var weaknesses, refs pq.StringArray
+	// 日時は SQLite で文字列として返る場合があるため、sql.NullTime ではなく DBTime で読み取る
+	if err := s.Scan(&v.ID, &v.Source, &desc, &status, &severity, &score, &cvssVersion, &cvssVector, &weaknesses, &refs, &v.PublishedAt, &v.LastModifiedAt, &v.CreatedAt, &v.UpdatedAt); err != nil {
+		return nil, err
+	}
+	v.Description = strPtr(desc)
+	v.Status = strPtr(status)
+	v.Severity = strPtr(severity)
+	if score.Valid {
+		v.CvssScore = &score.Float64
+	}
+	v.CvssVersion = strPtr(cvssVersion)
+	v.CvssVector = strPtr(cvssVector)
+	v.Weaknesses = []string(weaknesses)
+	v.ReferenceURLs = []string(refs)
+	return &v, nil
+}
+
+// inClause は ids を IN 句のプレースホルダ ("?,?,...") と引数に展開する。
+func inClause(ids []string) (string, []any) {
+	placeholders := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+	return strings.Join(placeholders, ","), args
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ramsesyok/oss-catalog/internal/domain/model"
+	"github.com/ramsesyok/oss-catalog/pkg/dbtime"
+)
+
+func TestVulnerabilityRepository_Upsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &VulnerabilityRepository{DB: db}
+	now := dbtime.DBTime{Time: time.Now()}
+	v := &model.Vulnerability{ID: "CVE-2021-44228", Source: model.VulnerabilitySourceNVD, CreatedAt: now, UpdatedAt: now}
+	metrics := []model.CvssMetric{{Version: "3.1", Source: "nvd@nist.gov", Type: "Primary", BaseScore: 10, BaseSeverity: "CRITICAL", Vector: "CVSS:3.1/AV:N"}}
+	matches := []model.CpeMatch{{Criteria: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", Vendor: "apache", Product: "log4j"}}
+
+	// 既存の脆弱性は置き換える
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE vulnerabilities SET source = ?")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM vulnerability_cvss WHERE vulnerability_id = ?")).WithArgs(v.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM vulnerability_cpe_matches WHERE vulnerability_id = ?")).WithArgs(v.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM vulnerability_package_ranges WHERE vulnerability_id = ?")).WithArgs(v.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM vulnerability_aliases WHERE vulnerability_id = ?")).WithArgs(v.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerability_cvss")).WithArgs(v.ID, "3.1", "nvd@nist.gov", "Primary", 10.0, "CRITICAL", "CVSS:3.1/AV:N").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerability_cpe_matches")).WithArgs(v.ID, matches[0].Criteria, "apache", "log4j", nil, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	created, err := repo.Upsert(context.Background(), v, metrics, matches, nil)
+	require.NoError(t, err)
+	require.False(t, created)
+
+	// 存在しない脆弱性は登録する
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE vulnerabilities SET source = ?")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerabilities (" + vulnerabilityColumns + ")")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerability_package_ranges")).WithArgs("GHSA-jfh8-c2jp-5v3q", "Maven", "org.apache.logging.log4j:log4j-core", "maven/org.apache.logging.log4j/log4j-core", "ECOSYSTEM", "2.0-beta9", "2.15.0", nil, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO vulnerability_aliases (vulnerability_id, alias) VALUES (?, ?)")).WithArgs("GHSA-jfh8-c2jp-5v3q", "CVE-2021-44228").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	intro, fixed := "2.0-beta9", "2.15.0"
+	ghsa := &model.Vulnerability{ID: "GHSA-jfh8-c2jp-5v3q", Source: model.VulnerabilitySourceOSV, Aliases: []string{"CVE-2021-44228"}, CreatedAt: now, UpdatedAt: now}
+	packages := []model.PackageRange{{Ecosystem: "Maven", PackageName: "org.apache.logging.log4j:log4j-core", PackageKey: "maven/org.apache.logging.log4j/log4j-core", RangeType: "ECOSYSTEM", Introduced: &intro, Fixed: &fixed}}
+	created, err = repo.Upsert(context.Background(), ghsa, nil, nil, packages)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVulnerabilityRepository_ListAliases(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &VulnerabilityRepository{DB: db}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT vulnerability_id, alias FROM vulnerability_aliases WHERE vulnerability_id IN (?,?) OR alias IN (?,?)")).
+		WithArgs("CVE-2021-44228", "GHSA-jfh8-c2jp-5v3q", "CVE-2021-44228", "GHSA-jfh8-c2jp-5v3q").
+		WillReturnRows(sqlmock.NewRows([]string{"vulnerability_id", "alias"}).AddRow("GHSA-jfh8-c2jp-5v3q", "CVE-2021-44228"))
+
+	list, err := repo.ListAliases(context.Background(), []string{"CVE-2021-44228", "GHSA-jfh8-c2jp-5v3q"})
+	require.NoError(t, err)
+	require.Equal(t, []model.VulnerabilityAlias{{VulnerabilityID: "GHSA-jfh8-c2jp-5v3q", Alias: "CVE-2021-44228"}}, list)
+
+	list, err = repo.ListAliases(context.Background(), nil)
+	require.NoError(t, err)
+	require.Empty(t, list)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
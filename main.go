@@ -3,16 +3,20 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"flag"
 	"log"
 	"net"
+	"os"
 	"runtime"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	apirouter "github.com/ramsesyok/oss-catalog/internal/api"
 	gen "github.com/ramsesyok/oss-catalog/internal/api/gen"
 	"github.com/ramsesyok/oss-catalog/internal/api/handler"
 	"github.com/ramsesyok/oss-catalog/internal/config"
+	domservice "github.com/ramsesyok/oss-catalog/internal/domain/service"
 	infradb "github.com/ramsesyok/oss-catalog/internal/infra/db"
 	"github.com/ramsesyok/oss-catalog/internal/infra/migration"
 	infrarepo "github.com/ramsesyok/oss-catalog/internal/infra/repository"
@@ -24,7 +28,7 @@ import (
 
 const serviceName = "oss-catalog"
 
-func runServer(host, port, dsn string, origins []string) error {
+func runServer(host, port, dsn string, origins []string, exp config.ExportConfig) error {
 	// OASテンプレートの読み込み
 	swagger, err := gen.GetSwagger()
 	if err != nil {
@@ -43,17 +47,52 @@ func runServer(host, port, dsn string, origins []string) error {
 		return err
 	}
 
+	licenseRepo := &infrarepo.LicenseRepository{DB: dbConn.DB}
+	layerRepo := &infrarepo.OssComponentLayerRepository{DB: dbConn.DB}
+	if err := domservice.SeedLicenses(context.Background(), licenseRepo); err != nil {
+		return err
+	}
+
+	exportJobRepo := &infrarepo.ExportJobRepository{DB: dbConn.DB}
+	projectRepo := &infrarepo.ProjectRepository{DB: dbConn.DB}
+	projectUsageRepo := &infrarepo.ProjectUsageRepository{DB: dbConn.DB}
+	vulnerabilities := newVulnerabilityService(dbConn)
+	exportJobs := &domservice.ExportJobService{
+		JobRepo:   exportJobRepo,
+		Exporter:  &domservice.ExportService{ProjectRepo: projectRepo, ProjectUsageRepo: projectUsageRepo, LicenseRepo: licenseRepo, OssComponentLayerRepo: layerRepo, Vulnerabilities: vulnerabilities},
+		Dir:       exp.Dir,
+		Workers:   exp.Workers,
+		Retention: exp.Retention,
+	}
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	defer func() {
+		cancelJobs()
+		exportJobs.Wait()
+	}()
+	if err := exportJobs.Start(jobCtx); err != nil {
+		return err
+	}
+
 	h := handler.Handler{
 		AuditRepo:             &infrarepo.AuditLogRepository{DB: dbConn.DB},
 		ScopePolicyRepo:       &infrarepo.ScopePolicyRepository{DB: dbConn.DB},
 		OssComponentRepo:      &infrarepo.OssComponentRepository{DB: dbConn.DB},
-		OssComponentLayerRepo: &infrarepo.OssComponentLayerRepository{DB: dbConn.DB},
+		OssComponentLayerRepo: layerRepo,
 		OssComponentTagRepo:   &infrarepo.OssComponentTagRepository{DB: dbConn.DB},
 		TagRepo:               &infrarepo.TagRepository{DB: dbConn.DB},
 		OssVersionRepo:        &infrarepo.OssVersionRepository{DB: dbConn.DB},
-		ProjectRepo:           &infrarepo.ProjectRepository{DB: dbConn.DB},
-		ProjectUsageRepo:      &infrarepo.ProjectUsageRepository{DB: dbConn.DB},
+		ProjectRepo:           projectRepo,
+		ProjectUsageRepo:      projectUsageRepo,
 		UserRepo:              &infrarepo.UserRepository{DB: dbConn.DB},
+		ExportJobRepo:         exportJobRepo,
+		ExportTemplateRepo:    &infrarepo.ExportTemplateRepository{DB: dbConn.DB},
+		LicenseRepo:           licenseRepo,
+		LicensePolicyRepo:     &infrarepo.LicensePolicyRuleRepository{DB: dbConn.DB},
+		ObligationRepo:        &infrarepo.ProjectObligationRepository{DB: dbConn.DB},
+		ExportJobs:            exportJobs,
+		Imports:               newImportService(dbConn),
+		CatalogImports:        newCatalogImportService(dbConn, catalogEnums(swagger)),
+		Vulnerabilities:       vulnerabilities,
 	}
 
 	e := echo.New()
@@ -74,6 +113,97 @@ func runServer(host, port, dsn string, origins []string) error {
 	return e.Start(net.JoinHostPort(host, port))
 }
 
+// newImportService は SBOM 取り込みサービスを組み立てる。
+// 確定処理はトランザクションに束ねたリポジトリで実行する。
+func newImportService(dbConn *infradb.DB) *domservice.ImportService {
+	svc := importServiceFor(dbConn.DB)
+	svc.WithinTx = func(ctx context.Context, fn func(ctx context.Context, s *domservice.ImportService) error) error {
+		return dbConn.WithinTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			return fn(ctx, importServiceFor(tx))
+		})
+	}
+	return svc
+}
+
+// importServiceFor は db (接続またはトランザクション) を使う取り込みサービスを返す。
+func importServiceFor(db infrarepo.DBTX) *domservice.ImportService {
+	return &domservice.ImportService{
+		ProjectRepo:           &infrarepo.ProjectRepository{DB: db},
+		OssComponentRepo:      &infrarepo.OssComponentRepository{DB: db},
+		OssComponentLayerRepo: &infrarepo.OssComponentLayerRepository{DB: db},
+		OssVersionRepo:        &infrarepo.OssVersionRepository{DB: db},
+		ProjectUsageRepo:      &infrarepo.ProjectUsageRepository{DB: db},
+		ScopePolicyRepo:       &infrarepo.ScopePolicyRepository{DB: db},
+		AuditRepo:             &infrarepo.AuditLogRepository{DB: db},
+		SessionRepo:           &infrarepo.ImportSessionRepository{DB: db},
+	}
+}
+
+// newCatalogImportService は OSS カタログ一括取り込みサービスを組み立てる。
+// 有効な行の登録はトランザクションに束ねたリポジトリで実行する。
+func newCatalogImportService(dbConn *infradb.DB, enums map[string][]string) *domservice.CatalogImportService {
+	svc := catalogImportServiceFor(dbConn.DB, enums)
+	svc.WithinTx = func(ctx context.Context, fn func(ctx context.Context, s *domservice.CatalogImportService) error) error {
+		return dbConn.WithinTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			return fn(ctx, catalogImportServiceFor(tx, enums))
+		})
+	}
+	return svc
+}
+
+// catalogImportServiceFor は db (接続またはトランザクション) を使う一括取り込みサービスを返す。
+func catalogImportServiceFor(db infrarepo.DBTX, enums map[string][]string) *domservice.CatalogImportService {
+	return &domservice.CatalogImportService{
+		OssComponentRepo:      &infrarepo.OssComponentRepository{DB: db},
+		OssComponentLayerRepo: &infrarepo.OssComponentLayerRepository{DB: db},
+		OssComponentTagRepo:   &infrarepo.OssComponentTagRepository{DB: db},
+		TagRepo:               &infrarepo.TagRepository{DB: db},
+		OssVersionRepo:        &infrarepo.OssVersionRepository{DB: db},
+		AuditRepo:             &infrarepo.AuditLogRepository{DB: db},
+		Enums:                 enums,
+	}
+}
+
+// newVulnerabilityService は脆弱性サービスを組み立てる。
+// フィードの取り込みはファイル毎にトランザクションに束ねたリポジトリで実行する。
+func newVulnerabilityService(dbConn *infradb.DB) *domservice.VulnerabilityService {
+	svc := vulnerabilityServiceFor(dbConn.DB)
+	svc.WithinTx = func(ctx context.Context, fn func(ctx context.Context, s *domservice.VulnerabilityService) error) error {
+		return dbConn.WithinTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			return fn(ctx, vulnerabilityServiceFor(tx))
+		})
+	}
+	return svc
+}
+
+// vulnerabilityServiceFor は db (接続またはトランザクション) を使う脆弱性サービスを返す。
+func vulnerabilityServiceFor(db infrarepo.DBTX) *domservice.VulnerabilityService {
+	return &domservice.VulnerabilityService{
+		VulnerabilityRepo: &infrarepo.VulnerabilityRepository{DB: db},
+		ProjectRepo:       &infrarepo.ProjectRepository{DB: db},
+		ProjectUsageRepo:  &infrarepo.ProjectUsageRepository{DB: db},
+		AnalysisRepo:      &infrarepo.VulnerabilityAnalysisRepository{DB: db},
+		AuditRepo:         &infrarepo.AuditLogRepository{DB: db},
+	}
+}
+
+// catalogEnums は一括取り込みの検証に用いる列挙型の許容値を OpenAPI 定義から取り出す。
+func catalogEnums(swagger *openapi3.T) map[string][]string {
+	enums := map[string][]string{}
+	for _, name := range []string{"Layer", "UsageRole", "SupplierType"} {
+		ref, ok := swagger.Components.Schemas[name]
+		if !ok || ref.Value == nil {
+			continue
+		}
+		for _, v := range ref.Value.Enum {
+			if s, ok := v.(string); ok {
+				enums[name] = append(enums[name], s)
+			}
+		}
+	}
+	return enums
+}
+
 func main() {
 	cfgPath := flag.String("config", "", "config file path")
 	svcFlag := flag.String("service", "", "windows service control (install|uninstall)")
@@ -84,6 +214,25 @@ func main() {
 		log.Fatalf("load config: %v", err)
 	}
 
+	if flag.Arg(0) == "import-catalog" {
+		if err := runCatalogImport(cfg.DB.DSN, flag.Args()[1:], os.Stdout); err != nil {
+			log.Fatalf("import-catalog: %v", err)
+		}
+		return
+	}
+	if flag.Arg(0) == "import-nvd" {
+		if err := runNVDImport(cfg.DB.DSN, flag.Args()[1:], os.Stdout); err != nil {
+			log.Fatalf("import-nvd: %v", err)
+		}
+		return
+	}
+	if flag.Arg(0) == "import-osv" {
+		if err := runOSVImport(cfg.DB.DSN, flag.Args()[1:], os.Stdout); err != nil {
+			log.Fatalf("import-osv: %v", err)
+		}
+		return
+	}
+
 	if runtime.GOOS == "windows" {
 		switch *svcFlag {
 		case "install":
@@ -100,14 +249,14 @@ func main() {
 
 		isSvc, err := isWindowsService()
 		if err == nil && isSvc {
-			if err := runService(serviceName, cfg.Server.Host, cfg.Server.Port, cfg.DB.DSN, cfg.Server.AllowedOrigins); err != nil {
+			if err := runService(serviceName, cfg.Server.Host, cfg.Server.Port, cfg.DB.DSN, cfg.Server.AllowedOrigins, cfg.Export); err != nil {
 				log.Fatalf("service run failed: %v", err)
 			}
 			return
 		}
 	}
 
-	if err := runServer(cfg.Server.Host, cfg.Server.Port, cfg.DB.DSN, cfg.Server.AllowedOrigins); err != nil {
+	if err := runServer(cfg.Server.Host, cfg.Server.Port, cfg.DB.DSN, cfg.Server.AllowedOrigins, cfg.Export); err != nil {
 		log.Fatalf("server error: %v", err)
 	}
 }
@@ -0,0 +1 @@
+ALTER TABLE oss_versions DROP COLUMN copyright_text;
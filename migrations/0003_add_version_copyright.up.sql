@@ -0,0 +1 @@
+ALTER TABLE oss_versions ADD COLUMN copyright_text TEXT;
@@ -0,0 +1,20 @@
+CREATE TABLE export_jobs (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    format TEXT NOT NULL,
+    scopes TEXT[] NOT NULL,
+    status TEXT NOT NULL,
+    progress INTEGER NOT NULL DEFAULT 0,
+    file_name TEXT,
+    file_path TEXT,
+    content_type TEXT,
+    file_size BIGINT,
+    error TEXT,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_export_jobs_status ON export_jobs (status);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS import_session_items;
+DROP TABLE IF EXISTS import_sessions;
@@ -0,0 +1,42 @@
+CREATE TABLE import_sessions (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    format TEXT NOT NULL,
+    document_name TEXT,
+    usage_role TEXT,
+    status TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    committed_by TEXT,
+    committed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_import_sessions_project ON import_sessions (project_id);
+
+CREATE TABLE import_session_items (
+    id UUID PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES import_sessions(id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    ref TEXT NOT NULL,
+    name TEXT NOT NULL,
+    version TEXT NOT NULL,
+    purl TEXT,
+    license_concluded TEXT,
+    license_declared TEXT,
+    homepage_url TEXT,
+    supplier TEXT,
+    hash_sha256 TEXT,
+    copyright_text TEXT,
+    cpe_list TEXT[],
+    direct_dependency BOOLEAN NOT NULL DEFAULT FALSE,
+    usage_role TEXT,
+    proposal TEXT NOT NULL,
+    reason TEXT,
+    oss_id UUID,
+    oss_version_id UUID,
+    decision TEXT NOT NULL,
+    result TEXT,
+    usage_id UUID
+);
+
+CREATE INDEX idx_import_session_items_session ON import_session_items (session_id, seq);
@@ -0,0 +1,2 @@
+ALTER TABLE import_session_items DROP COLUMN inclusion_note;
+ALTER TABLE import_session_items DROP COLUMN layers;
@@ -0,0 +1,2 @@
+ALTER TABLE import_session_items ADD COLUMN layers TEXT[];
+ALTER TABLE import_session_items ADD COLUMN inclusion_note TEXT;
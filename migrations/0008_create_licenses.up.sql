@@ -0,0 +1,14 @@
+CREATE TABLE licenses (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    osi_approved BOOLEAN NOT NULL DEFAULT FALSE,
+    fsf_libre BOOLEAN NOT NULL DEFAULT FALSE,
+    category TEXT,
+    text TEXT,
+    custom BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_licenses_category ON licenses (category);
@@ -0,0 +1,14 @@
+CREATE TABLE license_policy_rules (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT,
+    severity TEXT NOT NULL,
+    licenses TEXT[],
+    categories TEXT[],
+    usage_roles TEXT[],
+    scope_statuses TEXT[],
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,17 @@
+CREATE TABLE project_obligations (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    usage_id UUID NOT NULL REFERENCES project_usages(id) ON DELETE CASCADE,
+    license TEXT NOT NULL,
+    obligation TEXT NOT NULL,
+    description TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'OPEN',
+    evidence_note TEXT,
+    closed_by TEXT,
+    closed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (usage_id, license, obligation)
+);
+
+CREATE INDEX idx_project_obligations_project ON project_obligations (project_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS vulnerability_cpe_matches;
+DROP TABLE IF EXISTS vulnerability_cvss;
+DROP TABLE IF EXISTS vulnerabilities;
@@ -0,0 +1,43 @@
+CREATE TABLE vulnerabilities (
+    id TEXT PRIMARY KEY,
+    source TEXT NOT NULL,
+    description TEXT,
+    status TEXT,
+    severity TEXT,
+    cvss_score DOUBLE PRECISION,
+    cvss_version TEXT,
+    cvss_vector TEXT,
+    weaknesses TEXT[],
+    reference_urls TEXT[],
+    published_at TIMESTAMPTZ,
+    last_modified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE vulnerability_cvss (
+    vulnerability_id TEXT NOT NULL REFERENCES vulnerabilities(id) ON DELETE CASCADE,
+    version TEXT NOT NULL,
+    source TEXT NOT NULL,
+    type TEXT NOT NULL,
+    base_score DOUBLE PRECISION NOT NULL,
+    base_severity TEXT NOT NULL,
+    vector TEXT NOT NULL
+);
+
+CREATE INDEX idx_vulnerability_cvss_vuln ON vulnerability_cvss (vulnerability_id);
+
+CREATE TABLE vulnerability_cpe_matches (
+    vulnerability_id TEXT NOT NULL REFERENCES vulnerabilities(id) ON DELETE CASCADE,
+    criteria TEXT NOT NULL,
+    vendor TEXT NOT NULL,
+    product TEXT NOT NULL,
+    version_start_including TEXT,
+    version_start_excluding TEXT,
+    version_end_including TEXT,
+    version_end_excluding TEXT,
+    match_criteria_id TEXT
+);
+
+CREATE INDEX idx_vulnerability_cpe_matches_vuln ON vulnerability_cpe_matches (vulnerability_id);
+CREATE INDEX idx_vulnerability_cpe_matches_product ON vulnerability_cpe_matches (vendor, product);
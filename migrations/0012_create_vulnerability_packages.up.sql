@@ -0,0 +1,22 @@
+CREATE TABLE vulnerability_aliases (
+    vulnerability_id TEXT NOT NULL REFERENCES vulnerabilities(id) ON DELETE CASCADE,
+    alias TEXT NOT NULL,
+    PRIMARY KEY (vulnerability_id, alias)
+);
+
+CREATE INDEX idx_vulnerability_aliases_alias ON vulnerability_aliases (alias);
+
+CREATE TABLE vulnerability_package_ranges (
+    vulnerability_id TEXT NOT NULL REFERENCES vulnerabilities(id) ON DELETE CASCADE,
+    ecosystem TEXT NOT NULL,
+    package_name TEXT NOT NULL,
+    package_key TEXT NOT NULL,
+    range_type TEXT NOT NULL,
+    introduced TEXT,
+    fixed TEXT,
+    last_affected TEXT,
+    versions TEXT[]
+);
+
+CREATE INDEX idx_vulnerability_package_ranges_vuln ON vulnerability_package_ranges (vulnerability_id);
+CREATE INDEX idx_vulnerability_package_ranges_key ON vulnerability_package_ranges (package_key);
@@ -0,0 +1,16 @@
+CREATE TABLE vulnerability_analyses (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    usage_id UUID NOT NULL REFERENCES project_usages(id) ON DELETE CASCADE,
+    vulnerability_id TEXT NOT NULL REFERENCES vulnerabilities(id) ON DELETE CASCADE,
+    state TEXT NOT NULL,
+    justification TEXT,
+    response TEXT,
+    note TEXT,
+    updated_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (usage_id, vulnerability_id)
+);
+
+CREATE INDEX idx_vulnerability_analyses_project ON vulnerability_analyses (project_id);
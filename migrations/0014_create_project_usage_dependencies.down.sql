@@ -0,0 +1,2 @@
+ALTER TABLE import_session_items DROP COLUMN depends_on;
+DROP TABLE project_usage_dependencies;
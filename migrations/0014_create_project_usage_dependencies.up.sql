@@ -0,0 +1,9 @@
+CREATE TABLE project_usage_dependencies (
+    usage_id UUID NOT NULL REFERENCES project_usages(id) ON DELETE CASCADE,
+    depends_on_usage_id UUID NOT NULL REFERENCES project_usages(id) ON DELETE CASCADE,
+    PRIMARY KEY (usage_id, depends_on_usage_id)
+);
+
+CREATE INDEX idx_project_usage_dependencies_depends_on ON project_usage_dependencies (depends_on_usage_id);
+
+ALTER TABLE import_session_items ADD COLUMN depends_on TEXT[];
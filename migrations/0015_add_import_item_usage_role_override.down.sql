@@ -0,0 +1 @@
+ALTER TABLE import_session_items DROP COLUMN usage_role_override;
@@ -0,0 +1 @@
+ALTER TABLE import_session_items ADD COLUMN usage_role_override TEXT;
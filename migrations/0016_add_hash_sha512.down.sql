@@ -0,0 +1,2 @@
+ALTER TABLE import_session_items DROP COLUMN hash_sha512;
+ALTER TABLE oss_versions DROP COLUMN hash_sha512;
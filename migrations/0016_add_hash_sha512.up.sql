@@ -0,0 +1,2 @@
+ALTER TABLE oss_versions ADD COLUMN hash_sha512 TEXT;
+ALTER TABLE import_session_items ADD COLUMN hash_sha512 TEXT;
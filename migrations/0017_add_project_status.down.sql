@@ -0,0 +1,2 @@
+ALTER TABLE license_policy_rules DROP COLUMN project_statuses;
+ALTER TABLE projects DROP COLUMN status;
@@ -0,0 +1,2 @@
+ALTER TABLE projects ADD COLUMN status TEXT NOT NULL DEFAULT 'ACTIVE';
+ALTER TABLE license_policy_rules ADD COLUMN project_statuses TEXT[];
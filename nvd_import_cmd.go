@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	infradb "github.com/ramsesyok/oss-catalog/internal/infra/db"
+	"github.com/ramsesyok/oss-catalog/internal/infra/migration"
+)
+
+// runNVDImport は import-nvd サブコマンドを実行する。
+// 媒体で持ち込んだ NVD CVE JSON 2.0 フィード (.json / .json.gz) をファイル毎に取り込み、結果を out に書き出す。
+// 年別フィード (nvdcve-2.0-2024.json.gz など) と差分フィード (nvdcve-2.0-modified.json.gz) を並べて指定できる。
+//
+//	oss-catalog [-config path] import-nvd [-user name] nvdcve-2.0-*.json.gz ...
+func runNVDImport(dsn string, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("import-nvd", flag.ContinueOnError)
+	user := fs.String("user", "cli", "user name recorded in audit logs")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("usage: import-nvd [-user name] <nvdcve-2.0-*.json[.gz]>...")
+	}
+
+	dbConn, err := infradb.Open(dsn)
+	if err != nil {
+		return err
+	}
+	defer dbConn.Close()
+	if err := migration.Apply(dbConn.DB, dsn); err != nil {
+		return err
+	}
+
+	svc := newVulnerabilityService(dbConn)
+	for _, path := range fs.Args() {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		report, err := svc.ImportNVD(context.Background(), f, filepath.Base(path), *user)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		fmt.Fprintf(out, "%s\ttotal=%d created=%d updated=%d unchanged=%d\n", path, report.Total, report.Created, report.Updated, report.Unchanged)
+	}
+	return nil
+}
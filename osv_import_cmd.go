@@ -0,0 +1,72 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+
+	infradb "github.com/ramsesyok/oss-catalog/internal/infra/db"
+	"github.com/ramsesyok/oss-catalog/internal/infra/migration"
+)
+
+// runOSVImport は import-osv サブコマンドを実行する。
+// 媒体で持ち込んだ OSV のダンプを指定毎に取り込み、結果を out に書き出す。
+// エコシステム別の all.zip、またはそれらや展開済みの *.json を置いたディレクトリを指定できる。
+//
+//	oss-catalog [-config path] import-osv [-user name] <dir|all.zip> ...
+func runOSVImport(dsn string, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("import-osv", flag.ContinueOnError)
+	user := fs.String("user", "cli", "user name recorded in audit logs")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("usage: import-osv [-user name] <dir|all.zip>...")
+	}
+
+	dbConn, err := infradb.Open(dsn)
+	if err != nil {
+		return err
+	}
+	defer dbConn.Close()
+	if err := migration.Apply(dbConn.DB, dsn); err != nil {
+		return err
+	}
+
+	svc := newVulnerabilityService(dbConn)
+	for _, path := range fs.Args() {
+		fsys, closeFn, err := openOSVSource(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		report, err := svc.ImportOSV(context.Background(), fsys, filepath.Base(path), *user)
+		closeFn()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		fmt.Fprintf(out, "%s\ttotal=%d created=%d updated=%d unchanged=%d skipped=%d\n", path, report.Total, report.Created, report.Updated, report.Unchanged, report.Skipped)
+	}
+	return nil
+}
+
+// openOSVSource は取り込み元のディレクトリまたは zip を開く。
+func openOSVSource(path string) (iofs.FS, func() error, error) {
+	st, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if st.IsDir() {
+		return os.DirFS(path), func() error { return nil }, nil
+	}
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return zr, zr.Close, nil
+}
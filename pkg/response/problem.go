@@ -27,3 +27,21 @@ func Unauthorized(c echo.Context, code, detail string) error {
 func Forbidden(c echo.Context, code, detail string) error {
 	return respond(c, http.StatusForbidden, "FORBIDDEN", code, detail)
 }
+
+// Conflict returns 409 Problem JSON with field errors.
+func Conflict(c echo.Context, code, detail string, errs []gen.ProblemFieldError) error {
+	p := gen.Problem{Title: "CONFLICT", Status: http.StatusConflict, Code: &code, Detail: &detail}
+	if len(errs) > 0 {
+		p.Errors = &errs
+	}
+	return c.JSON(http.StatusConflict, p)
+}
+
+// BadRequest returns 400 Problem JSON with field errors.
+func BadRequest(c echo.Context, code, detail string, errs []gen.ProblemFieldError) error {
+	p := gen.Problem{Title: "BAD_REQUEST", Status: http.StatusBadRequest, Code: &code, Detail: &detail}
+	if len(errs) > 0 {
+		p.Errors = &errs
+	}
+	return c.JSON(http.StatusBadRequest, p)
+}
@@ -2,11 +2,17 @@
 
 package main
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/ramsesyok/oss-catalog/internal/config"
+)
 
 var errUnsupported = errors.New("windows service not supported")
 
-func isWindowsService() (bool, error)                                 { return false, errUnsupported }
-func runService(name, host, port, dsn string, origins []string) error { return errUnsupported }
-func installService(name, desc string) error                          { return errUnsupported }
-func removeService(name string) error                                 { return errUnsupported }
+func isWindowsService() (bool, error) { return false, errUnsupported }
+func runService(name, host, port, dsn string, origins []string, exp config.ExportConfig) error {
+	return errUnsupported
+}
+func installService(name, desc string) error { return errUnsupported }
+func removeService(name string) error        { return errUnsupported }
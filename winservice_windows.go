@@ -8,6 +8,8 @@ import (
 
 	"golang.org/x/sys/windows/svc"
 	"golang.org/x/sys/windows/svc/mgr"
+
+	"github.com/ramsesyok/oss-catalog/internal/config"
 )
 
 // isWindowsService returns true if running under the Windows service manager.
@@ -20,13 +22,14 @@ type service struct {
 	port    string
 	dsn     string
 	origins []string
+	export  config.ExportConfig
 }
 
 func (m *service) Execute(args []string, r <-chan svc.ChangeRequest, s chan<- svc.Status) (bool, uint32) {
 	const accepted = svc.AcceptStop | svc.AcceptShutdown
 	s <- svc.Status{State: svc.StartPending}
 	go func() {
-		if err := runServer(m.host, m.port, m.dsn, m.origins); err != nil {
+		if err := runServer(m.host, m.port, m.dsn, m.origins, m.export); err != nil {
 			log.Printf("server error: %v", err)
 		}
 	}()
@@ -44,8 +47,8 @@ func (m *service) Execute(args []string, r <-chan svc.ChangeRequest, s chan<- sv
 	}
 }
 
-func runService(name, host, port, dsn string, origins []string) error {
-	return svc.Run(name, &service{host: host, port: port, dsn: dsn, origins: origins})
+func runService(name, host, port, dsn string, origins []string, exp config.ExportConfig) error {
+	return svc.Run(name, &service{host: host, port: port, dsn: dsn, origins: origins, export: exp})
 }
 
 func installService(name, desc string) error {